@@ -26,6 +26,8 @@ const (
 type GetAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`       // 查询组织账户（调用者须为组织成员），为空时查询用户本人账户
+	MemberId      string                 `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"` // 按成员查询（所有者/管理员可查任一成员，普通成员只能查自己）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,11 +69,30 @@ func (x *GetAccountRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetAccountRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetAccountRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
 type GetAccountReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	Balance       float64                `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
 	Quotas        []*FreeQuota           `protobuf:"bytes,3,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`   // 账户计费时区，为空表示使用服务默认时区
+	Packages      []*UserPackage         `protobuf:"bytes,5,rep,name=packages,proto3" json:"packages,omitempty"`   // 有效（未过期且有剩余）的用量包，按到期时间正序
+	Rates         []*ServiceRate         `protobuf:"bytes,6,rep,name=rates,proto3" json:"rates,omitempty"`         // 各服务当前适用单价（含账户价格规则与合同价）
+	Contracts     []*Contract            `protobuf:"bytes,7,rep,name=contracts,proto3" json:"contracts,omitempty"` // 未结算的合同
+	Member        *OrgMember             `protobuf:"bytes,8,opt,name=member,proto3" json:"member,omitempty"`       // 按成员查询时为该成员（含角色、消费上限与本月消费）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,12 +148,52 @@ func (x *GetAccountReply) GetQuotas() []*FreeQuota {
 	return nil
 }
 
+func (x *GetAccountReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAccountReply) GetPackages() []*UserPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *GetAccountReply) GetRates() []*ServiceRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *GetAccountReply) GetContracts() []*Contract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *GetAccountReply) GetMember() *OrgMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
 type FreeQuota struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
 	TotalQuota    int32                  `protobuf:"varint,2,opt,name=totalQuota,proto3" json:"totalQuota,omitempty"`
 	UsedQuota     int32                  `protobuf:"varint,3,opt,name=usedQuota,proto3" json:"usedQuota,omitempty"`
-	ResetMonth    string                 `protobuf:"bytes,4,opt,name=resetMonth,proto3" json:"resetMonth,omitempty"`
+	ResetMonth    string                 `protobuf:"bytes,4,opt,name=resetMonth,proto3" json:"resetMonth,omitempty"`   // 周期标识：自然月为 YYYY-MM，其余周期为类型前缀 + 开始日期（如 D2024-11-05）
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`               // 计量单位：call / token / mb / second
+	Cycle         string                 `protobuf:"bytes,6,opt,name=cycle,proto3" json:"cycle,omitempty"`             // 周期类型：daily / weekly / monthly / anniversary
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=periodStart,proto3" json:"periodStart,omitempty"` // 周期开始时间（含）
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=periodEnd,proto3" json:"periodEnd,omitempty"`     // 周期结束时间（不含），即下次重置时间
+	Rollover      *FreeQuotaRollover     `protobuf:"bytes,9,opt,name=rollover,proto3" json:"rollover,omitempty"`       // 上期结转的额度（已计入 totalQuota / usedQuota），没有结转时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -195,19 +256,116 @@ func (x *FreeQuota) GetResetMonth() string {
 	return ""
 }
 
+func (x *FreeQuota) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *FreeQuota) GetCycle() string {
+	if x != nil {
+		return x.Cycle
+	}
+	return ""
+}
+
+func (x *FreeQuota) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *FreeQuota) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *FreeQuota) GetRollover() *FreeQuotaRollover {
+	if x != nil {
+		return x.Rollover
+	}
+	return nil
+}
+
+// FreeQuotaRollover 上期结转的免费额度，扣费时优先使用，本周期结束时过期
+type FreeQuotaRollover struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         int32                  `protobuf:"varint,1,opt,name=quota,proto3" json:"quota,omitempty"`        // 结转的额度
+	Used          int32                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`          // 已使用的结转额度
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // 过期时间（本周期结束时间）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeQuotaRollover) Reset() {
+	*x = FreeQuotaRollover{}
+	mi := &file_billing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeQuotaRollover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeQuotaRollover) ProtoMessage() {}
+
+func (x *FreeQuotaRollover) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeQuotaRollover.ProtoReflect.Descriptor instead.
+func (*FreeQuotaRollover) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FreeQuotaRollover) GetQuota() int32 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *FreeQuotaRollover) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *FreeQuotaRollover) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type RechargeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	PaymentMethod string                 `protobuf:"bytes,3,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"` // wechat, alipay
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`           // 币种，必填，例如：CNY, USD
+	OrgId         string                 `protobuf:"bytes,5,opt,name=orgId,proto3" json:"orgId,omitempty"`                 // 为组织钱包充值（调用者须为组织所有者或管理员），为空时为本人充值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RechargeRequest) Reset() {
 	*x = RechargeRequest{}
-	mi := &file_billing_proto_msgTypes[3]
+	mi := &file_billing_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +377,7 @@ func (x *RechargeRequest) String() string {
 func (*RechargeRequest) ProtoMessage() {}
 
 func (x *RechargeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[3]
+	mi := &file_billing_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +390,7 @@ func (x *RechargeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RechargeRequest.ProtoReflect.Descriptor instead.
 func (*RechargeRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{3}
+	return file_billing_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RechargeRequest) GetUserId() string {
@@ -263,6 +421,13 @@ func (x *RechargeRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *RechargeRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
 type RechargeReply struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	RechargeOrderId string                 `protobuf:"bytes,1,opt,name=rechargeOrderId,proto3" json:"rechargeOrderId,omitempty"` // 充值订单ID（billing-service生成，格式：recharge_{uid}_{timestamp}）
@@ -273,7 +438,7 @@ type RechargeReply struct {
 
 func (x *RechargeReply) Reset() {
 	*x = RechargeReply{}
-	mi := &file_billing_proto_msgTypes[4]
+	mi := &file_billing_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -285,7 +450,7 @@ func (x *RechargeReply) String() string {
 func (*RechargeReply) ProtoMessage() {}
 
 func (x *RechargeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[4]
+	mi := &file_billing_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +463,7 @@ func (x *RechargeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RechargeReply.ProtoReflect.Descriptor instead.
 func (*RechargeReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{4}
+	return file_billing_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RechargeReply) GetRechargeOrderId() string {
@@ -318,15 +483,26 @@ func (x *RechargeReply) GetPaymentUrl() string {
 type ListRecordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`              // 页码分页（兼容旧客户端）：cursor 为空且 page > 0 时按 OFFSET 分页并返回 total
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`      // 每页条数，默认 20，最大 100
+	RequestId     string                 `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`     // 按调用方请求ID查询该请求产生的消费记录（传入时忽略分页和过滤条件）
+	ServiceName   string                 `protobuf:"bytes,5,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 按服务过滤
+	Type          int32                  `protobuf:"varint,6,opt,name=type,proto3" json:"type,omitempty"`              // 按扣费类型过滤：1:免费额度, 2:余额扣费, 3:用量包, 4:合同补差，0 表示不过滤
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 起始时间（含）
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间（不含）
+	MinAmount     float64                `protobuf:"fixed64,9,opt,name=minAmount,proto3" json:"minAmount,omitempty"`   // 最小扣费金额（含）
+	AppId         string                 `protobuf:"bytes,10,opt,name=appId,proto3" json:"appId,omitempty"`            // 按应用ID过滤（扣费来源信息中的 appId）
+	Cursor        string                 `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`          // 游标分页：上一页返回的 nextCursor，为空时从最新记录开始（page 未传时）
+	WithTotal     bool                   `protobuf:"varint,12,opt,name=withTotal,proto3" json:"withTotal,omitempty"`   // 游标分页时是否统计总数（需要 COUNT，重度用户较慢）
+	OrgId         string                 `protobuf:"bytes,13,opt,name=orgId,proto3" json:"orgId,omitempty"`            // 查询组织账户的消费记录，为空时查询用户本人账户
+	MemberId      string                 `protobuf:"bytes,14,opt,name=memberId,proto3" json:"memberId,omitempty"`      // 只返回该组织成员的消费记录（普通成员始终只返回自己的）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListRecordsRequest) Reset() {
 	*x = ListRecordsRequest{}
-	mi := &file_billing_proto_msgTypes[5]
+	mi := &file_billing_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +514,7 @@ func (x *ListRecordsRequest) String() string {
 func (*ListRecordsRequest) ProtoMessage() {}
 
 func (x *ListRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[5]
+	mi := &file_billing_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +527,7 @@ func (x *ListRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{5}
+	return file_billing_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListRecordsRequest) GetUserId() string {
@@ -375,17 +551,96 @@ func (x *ListRecordsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListRecordsRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ListRecordsRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ListRecordsRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ListRecordsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListRecordsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListRecordsRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListRecordsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListRecordsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRecordsRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListRecordsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListRecordsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
 type ListRecordsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Records       []*BillingRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`          // 总数（页码分页或 withTotal 时返回，否则为 0）
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // 下一页游标，没有更多记录时为空
+	HasMore       bool                   `protobuf:"varint,4,opt,name=hasMore,proto3" json:"hasMore,omitempty"`      // 是否还有更多记录
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListRecordsReply) Reset() {
 	*x = ListRecordsReply{}
-	mi := &file_billing_proto_msgTypes[6]
+	mi := &file_billing_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +652,7 @@ func (x *ListRecordsReply) String() string {
 func (*ListRecordsReply) ProtoMessage() {}
 
 func (x *ListRecordsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[6]
+	mi := &file_billing_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +665,7 @@ func (x *ListRecordsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRecordsReply.ProtoReflect.Descriptor instead.
 func (*ListRecordsReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{6}
+	return file_billing_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListRecordsReply) GetRecords() []*BillingRecord {
@@ -427,21 +682,37 @@ func (x *ListRecordsReply) GetTotal() int32 {
 	return 0
 }
 
+func (x *ListRecordsReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListRecordsReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type BillingRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"` // 1:免费额度, 2:余额扣费
+	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"` // 1:免费额度, 2:余额扣费, 3:用量包, 4:合同补差（服务名为空）
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Metadata      *DeductMetadata        `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"` // 扣费来源信息（扣费时未传则为空）
+	MemberId      string                 `protobuf:"bytes,8,opt,name=memberId,proto3" json:"memberId,omitempty"` // 组织账户中实际使用的成员，个人账户为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BillingRecord) Reset() {
 	*x = BillingRecord{}
-	mi := &file_billing_proto_msgTypes[7]
+	mi := &file_billing_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +724,7 @@ func (x *BillingRecord) String() string {
 func (*BillingRecord) ProtoMessage() {}
 
 func (x *BillingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[7]
+	mi := &file_billing_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +737,7 @@ func (x *BillingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingRecord.ProtoReflect.Descriptor instead.
 func (*BillingRecord) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{7}
+	return file_billing_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BillingRecord) GetId() string {
@@ -511,30 +782,47 @@ func (x *BillingRecord) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CheckQuotaRequest struct {
+func (x *BillingRecord) GetMetadata() *DeductMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BillingRecord) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+// DeductMetadata 扣费来源信息，用于将消费记录关联到产生它的 API 请求
+type DeductMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`                                                                     // 调用方请求ID
+	ApiKeyId      string                 `protobuf:"bytes,2,opt,name=apiKeyId,proto3" json:"apiKeyId,omitempty"`                                                                       // API Key ID
+	AppId         string                 `protobuf:"bytes,3,opt,name=appId,proto3" json:"appId,omitempty"`                                                                             // 应用ID，默认取 app_id 中间件从 X-App-Id 解析的值
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`                                                                     // 接口/操作，例如 POST /v1/chat/completions
+	Labels        map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 自定义标签
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckQuotaRequest) Reset() {
-	*x = CheckQuotaRequest{}
-	mi := &file_billing_proto_msgTypes[8]
+func (x *DeductMetadata) Reset() {
+	*x = DeductMetadata{}
+	mi := &file_billing_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckQuotaRequest) String() string {
+func (x *DeductMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckQuotaRequest) ProtoMessage() {}
+func (*DeductMetadata) ProtoMessage() {}
 
-func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[8]
+func (x *DeductMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,45 +833,120 @@ func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
-func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeductMetadata.ProtoReflect.Descriptor instead.
+func (*DeductMetadata) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CheckQuotaRequest) GetUserId() string {
+func (x *DeductMetadata) GetRequestId() string {
 	if x != nil {
-		return x.UserId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CheckQuotaRequest) GetServiceName() string {
+func (x *DeductMetadata) GetApiKeyId() string {
 	if x != nil {
-		return x.ServiceName
+		return x.ApiKeyId
 	}
 	return ""
 }
 
-func (x *CheckQuotaRequest) GetCount() int32 {
+func (x *DeductMetadata) GetAppId() string {
 	if x != nil {
-		return x.Count
+		return x.AppId
 	}
-	return 0
-}
-
-type CheckQuotaReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *CheckQuotaReply) Reset() {
-	*x = CheckQuotaReply{}
-	mi := &file_billing_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DeductMetadata) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *DeductMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CheckQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckQuotaRequest) Reset() {
+	*x = CheckQuotaRequest{}
+	mi := &file_billing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaRequest) ProtoMessage() {}
+
+func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckQuotaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CheckQuotaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`              // free / balance / insufficient balance / budget exceeded / rate limited / degraded
+	RetryAfterMs  int64                  `protobuf:"varint,3,opt,name=retryAfterMs,proto3" json:"retryAfterMs,omitempty"` // 被限流时建议的重试等待时间（毫秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckQuotaReply) Reset() {
+	*x = CheckQuotaReply{}
+	mi := &file_billing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CheckQuotaReply) String() string {
@@ -593,7 +956,7 @@ func (x *CheckQuotaReply) String() string {
 func (*CheckQuotaReply) ProtoMessage() {}
 
 func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[9]
+	mi := &file_billing_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +969,7 @@ func (x *CheckQuotaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckQuotaReply.ProtoReflect.Descriptor instead.
 func (*CheckQuotaReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{9}
+	return file_billing_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CheckQuotaReply) GetAllowed() bool {
@@ -623,19 +986,28 @@ func (x *CheckQuotaReply) GetReason() string {
 	return ""
 }
 
+func (x *CheckQuotaReply) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
 type DeductQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`      // 用量（按服务计量单位）
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`       // 预计算费用，可选，仅服务允许调用方定价时生效，否则按 单价 × 用量 计费
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`         // 用量单位，可选，传入时必须与服务计量单位一致
+	Metadata      *DeductMetadata        `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"` // 扣费来源信息（可选）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeductQuotaRequest) Reset() {
 	*x = DeductQuotaRequest{}
-	mi := &file_billing_proto_msgTypes[10]
+	mi := &file_billing_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +1019,7 @@ func (x *DeductQuotaRequest) String() string {
 func (*DeductQuotaRequest) ProtoMessage() {}
 
 func (x *DeductQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[10]
+	mi := &file_billing_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +1032,7 @@ func (x *DeductQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeductQuotaRequest.ProtoReflect.Descriptor instead.
 func (*DeductQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{10}
+	return file_billing_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeductQuotaRequest) GetUserId() string {
@@ -691,6 +1063,20 @@ func (x *DeductQuotaRequest) GetCost() float64 {
 	return 0
 }
 
+func (x *DeductQuotaRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *DeductQuotaRequest) GetMetadata() *DeductMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type DeductQuotaReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -701,7 +1087,7 @@ type DeductQuotaReply struct {
 
 func (x *DeductQuotaReply) Reset() {
 	*x = DeductQuotaReply{}
-	mi := &file_billing_proto_msgTypes[11]
+	mi := &file_billing_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +1099,7 @@ func (x *DeductQuotaReply) String() string {
 func (*DeductQuotaReply) ProtoMessage() {}
 
 func (x *DeductQuotaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[11]
+	mi := &file_billing_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +1112,7 @@ func (x *DeductQuotaReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeductQuotaReply.ProtoReflect.Descriptor instead.
 func (*DeductQuotaReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{11}
+	return file_billing_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeductQuotaReply) GetSuccess() bool {
@@ -743,31 +1129,31 @@ func (x *DeductQuotaReply) GetRecordId() string {
 	return ""
 }
 
-type RechargeCallbackRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RechargeOrderId string                 `protobuf:"bytes,1,opt,name=rechargeOrderId,proto3" json:"rechargeOrderId,omitempty"` // 充值订单ID（billing-service生成，格式：recharge_{uid}_{timestamp}）
-	PaymentId       string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`             // 支付流水号（payment-service返回的payment_id）
-	Amount          float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`                 // 充值金额
-	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                   // 支付状态
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type QuotaItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 用量（按服务计量单位）
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`    // 用量单位，可选，传入时必须与服务计量单位一致
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`  // 预计算费用，可选，仅服务允许调用方定价时生效
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeCallbackRequest) Reset() {
-	*x = RechargeCallbackRequest{}
-	mi := &file_billing_proto_msgTypes[12]
+func (x *QuotaItem) Reset() {
+	*x = QuotaItem{}
+	mi := &file_billing_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeCallbackRequest) String() string {
+func (x *QuotaItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeCallbackRequest) ProtoMessage() {}
+func (*QuotaItem) ProtoMessage() {}
 
-func (x *RechargeCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[12]
+func (x *QuotaItem) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,61 +1164,62 @@ func (x *RechargeCallbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeCallbackRequest.ProtoReflect.Descriptor instead.
-func (*RechargeCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QuotaItem.ProtoReflect.Descriptor instead.
+func (*QuotaItem) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RechargeCallbackRequest) GetRechargeOrderId() string {
+func (x *QuotaItem) GetServiceName() string {
 	if x != nil {
-		return x.RechargeOrderId
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *RechargeCallbackRequest) GetPaymentId() string {
+func (x *QuotaItem) GetCount() int32 {
 	if x != nil {
-		return x.PaymentId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *RechargeCallbackRequest) GetAmount() float64 {
+func (x *QuotaItem) GetUnit() string {
 	if x != nil {
-		return x.Amount
+		return x.Unit
 	}
-	return 0
+	return ""
 }
 
-func (x *RechargeCallbackRequest) GetStatus() string {
+func (x *QuotaItem) GetCost() float64 {
 	if x != nil {
-		return x.Status
+		return x.Cost
 	}
-	return ""
+	return 0
 }
 
-type RechargeCallbackReply struct {
+type BatchCheckQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Items         []*QuotaItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeCallbackReply) Reset() {
-	*x = RechargeCallbackReply{}
-	mi := &file_billing_proto_msgTypes[13]
+func (x *BatchCheckQuotaRequest) Reset() {
+	*x = BatchCheckQuotaRequest{}
+	mi := &file_billing_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeCallbackReply) String() string {
+func (x *BatchCheckQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeCallbackReply) ProtoMessage() {}
+func (*BatchCheckQuotaRequest) ProtoMessage() {}
 
-func (x *RechargeCallbackReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[13]
+func (x *BatchCheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,42 +1230,49 @@ func (x *RechargeCallbackReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeCallbackReply.ProtoReflect.Descriptor instead.
-func (*RechargeCallbackReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BatchCheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*BatchCheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RechargeCallbackReply) GetSuccess() bool {
+func (x *BatchCheckQuotaRequest) GetUserId() string {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-// 统计相关消息
-type GetStatsTodayRequest struct {
+func (x *BatchCheckQuotaRequest) GetItems() []*QuotaItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchCheckQuotaReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	RetryAfterMs  int64                  `protobuf:"varint,3,opt,name=retryAfterMs,proto3" json:"retryAfterMs,omitempty"` // 被限流时建议的重试等待时间（毫秒）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsTodayRequest) Reset() {
-	*x = GetStatsTodayRequest{}
-	mi := &file_billing_proto_msgTypes[14]
+func (x *BatchCheckQuotaReply) Reset() {
+	*x = BatchCheckQuotaReply{}
+	mi := &file_billing_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsTodayRequest) String() string {
+func (x *BatchCheckQuotaReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsTodayRequest) ProtoMessage() {}
+func (*BatchCheckQuotaReply) ProtoMessage() {}
 
-func (x *GetStatsTodayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[14]
+func (x *BatchCheckQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,48 +1283,56 @@ func (x *GetStatsTodayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsTodayRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsTodayRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BatchCheckQuotaReply.ProtoReflect.Descriptor instead.
+func (*BatchCheckQuotaReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetStatsTodayRequest) GetUserId() string {
+func (x *BatchCheckQuotaReply) GetAllowed() bool {
 	if x != nil {
-		return x.UserId
+		return x.Allowed
 	}
-	return ""
+	return false
 }
 
-func (x *GetStatsTodayRequest) GetServiceName() string {
+func (x *BatchCheckQuotaReply) GetReason() string {
 	if x != nil {
-		return x.ServiceName
+		return x.Reason
 	}
 	return ""
 }
 
-type GetStatsMonthRequest struct {
+func (x *BatchCheckQuotaReply) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+type BatchDeductQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	Items         []*QuotaItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Metadata      *DeductMetadata        `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // 扣费来源信息（可选，适用于所有服务项）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsMonthRequest) Reset() {
-	*x = GetStatsMonthRequest{}
-	mi := &file_billing_proto_msgTypes[15]
+func (x *BatchDeductQuotaRequest) Reset() {
+	*x = BatchDeductQuotaRequest{}
+	mi := &file_billing_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsMonthRequest) String() string {
+func (x *BatchDeductQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsMonthRequest) ProtoMessage() {}
+func (*BatchDeductQuotaRequest) ProtoMessage() {}
 
-func (x *GetStatsMonthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[15]
+func (x *BatchDeductQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,47 +1343,55 @@ func (x *GetStatsMonthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsMonthRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsMonthRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use BatchDeductQuotaRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeductQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetStatsMonthRequest) GetUserId() string {
+func (x *BatchDeductQuotaRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetStatsMonthRequest) GetServiceName() string {
+func (x *BatchDeductQuotaRequest) GetItems() []*QuotaItem {
 	if x != nil {
-		return x.ServiceName
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type GetStatsSummaryRequest struct {
+func (x *BatchDeductQuotaRequest) GetMetadata() *DeductMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type BatchDeductQuotaReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RecordIds     []string               `protobuf:"bytes,2,rep,name=recordIds,proto3" json:"recordIds,omitempty"` // 与请求 items 一一对应
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsSummaryRequest) Reset() {
-	*x = GetStatsSummaryRequest{}
-	mi := &file_billing_proto_msgTypes[16]
+func (x *BatchDeductQuotaReply) Reset() {
+	*x = BatchDeductQuotaReply{}
+	mi := &file_billing_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsSummaryRequest) String() string {
+func (x *BatchDeductQuotaReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsSummaryRequest) ProtoMessage() {}
+func (*BatchDeductQuotaReply) ProtoMessage() {}
 
-func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[16]
+func (x *BatchDeductQuotaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,46 +1402,5024 @@ func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BatchDeductQuotaReply.ProtoReflect.Descriptor instead.
+func (*BatchDeductQuotaReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetStatsSummaryRequest) GetUserId() string {
+func (x *BatchDeductQuotaReply) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return ""
+	return false
+}
+
+func (x *BatchDeductQuotaReply) GetRecordIds() []string {
+	if x != nil {
+		return x.RecordIds
+	}
+	return nil
+}
+
+type StreamDeductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlationId,proto3" json:"correlationId,omitempty"` // 调用方生成的关联ID，原样返回
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`      // 用量（按服务计量单位）
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`         // 用量单位，可选，传入时必须与服务计量单位一致
+	Cost          float64                `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`       // 预计算费用，可选，仅服务允许调用方定价时生效
+	Metadata      *DeductMetadata        `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"` // 扣费来源信息（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeductRequest) Reset() {
+	*x = StreamDeductRequest{}
+	mi := &file_billing_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeductRequest) ProtoMessage() {}
+
+func (x *StreamDeductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeductRequest.ProtoReflect.Descriptor instead.
+func (*StreamDeductRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamDeductRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *StreamDeductRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamDeductRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *StreamDeductRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StreamDeductRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StreamDeductRequest) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *StreamDeductRequest) GetMetadata() *DeductMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type StreamDeductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CorrelationId string                 `protobuf:"bytes,1,opt,name=correlationId,proto3" json:"correlationId,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	RecordId      string                 `protobuf:"bytes,3,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	ErrorCode     int32                  `protobuf:"varint,4,opt,name=errorCode,proto3" json:"errorCode,omitempty"` // 失败时的错误码（与 unary 接口一致）
+	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeductReply) Reset() {
+	*x = StreamDeductReply{}
+	mi := &file_billing_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeductReply) ProtoMessage() {}
+
+func (x *StreamDeductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeductReply.ProtoReflect.Descriptor instead.
+func (*StreamDeductReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamDeductReply) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *StreamDeductReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StreamDeductReply) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *StreamDeductReply) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *StreamDeductReply) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AcquireLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`           // 申请的调用次数
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"` // 租约时长（秒），不传使用默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireLeaseRequest) Reset() {
+	*x = AcquireLeaseRequest{}
+	mi := &file_billing_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireLeaseRequest) ProtoMessage() {}
+
+func (x *AcquireLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireLeaseRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AcquireLeaseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcquireLeaseRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AcquireLeaseRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AcquireLeaseRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type AcquireLeaseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	GrantedCount  int32                  `protobuf:"varint,2,opt,name=grantedCount,proto3" json:"grantedCount,omitempty"` // 实际授予的调用次数（余额/额度不足时可能小于申请值）
+	FreeCount     int32                  `protobuf:"varint,3,opt,name=freeCount,proto3" json:"freeCount,omitempty"`       // 其中占用免费额度的次数
+	PaidCount     int32                  `protobuf:"varint,4,opt,name=paidCount,proto3" json:"paidCount,omitempty"`       // 其中占用余额的次数
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	PackageCount  int32                  `protobuf:"varint,6,opt,name=packageCount,proto3" json:"packageCount,omitempty"` // 其中占用用量包的次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireLeaseReply) Reset() {
+	*x = AcquireLeaseReply{}
+	mi := &file_billing_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireLeaseReply) ProtoMessage() {}
+
+func (x *AcquireLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireLeaseReply.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcquireLeaseReply) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *AcquireLeaseReply) GetGrantedCount() int32 {
+	if x != nil {
+		return x.GrantedCount
+	}
+	return 0
+}
+
+func (x *AcquireLeaseReply) GetFreeCount() int32 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *AcquireLeaseReply) GetPaidCount() int32 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+func (x *AcquireLeaseReply) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AcquireLeaseReply) GetPackageCount() int32 {
+	if x != nil {
+		return x.PackageCount
+	}
+	return 0
+}
+
+type ReportLeaseUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	UsedCount     int32                  `protobuf:"varint,2,opt,name=usedCount,proto3" json:"usedCount,omitempty"`    // 自上次上报以来的用量（增量）
+	Renew         bool                   `protobuf:"varint,3,opt,name=renew,proto3" json:"renew,omitempty"`            // 是否续期
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"`  // 续期时长（秒），不传使用默认值
+	ServiceName   string                 `protobuf:"bytes,5,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 租约的服务，须与申请时一致（服务令牌策略按该字段检查）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLeaseUsageRequest) Reset() {
+	*x = ReportLeaseUsageRequest{}
+	mi := &file_billing_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLeaseUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLeaseUsageRequest) ProtoMessage() {}
+
+func (x *ReportLeaseUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLeaseUsageRequest.ProtoReflect.Descriptor instead.
+func (*ReportLeaseUsageRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReportLeaseUsageRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *ReportLeaseUsageRequest) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *ReportLeaseUsageRequest) GetRenew() bool {
+	if x != nil {
+		return x.Renew
+	}
+	return false
+}
+
+func (x *ReportLeaseUsageRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ReportLeaseUsageRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ReportLeaseUsageReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RemainingCount int32                  `protobuf:"varint,1,opt,name=remainingCount,proto3" json:"remainingCount,omitempty"` // 租约剩余可用次数
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportLeaseUsageReply) Reset() {
+	*x = ReportLeaseUsageReply{}
+	mi := &file_billing_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLeaseUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLeaseUsageReply) ProtoMessage() {}
+
+func (x *ReportLeaseUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLeaseUsageReply.ProtoReflect.Descriptor instead.
+func (*ReportLeaseUsageReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReportLeaseUsageReply) GetRemainingCount() int32 {
+	if x != nil {
+		return x.RemainingCount
+	}
+	return 0
+}
+
+func (x *ReportLeaseUsageReply) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReleaseLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	UsedCount     int32                  `protobuf:"varint,2,opt,name=usedCount,proto3" json:"usedCount,omitempty"`    // 自上次上报以来的用量（增量）
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 租约的服务，须与申请时一致（服务令牌策略按该字段检查）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLeaseRequest) Reset() {
+	*x = ReleaseLeaseRequest{}
+	mi := &file_billing_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLeaseRequest) ProtoMessage() {}
+
+func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReleaseLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *ReleaseLeaseRequest) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *ReleaseLeaseRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type ReleaseLeaseReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReclaimedCount int32                  `protobuf:"varint,2,opt,name=reclaimedCount,proto3" json:"reclaimedCount,omitempty"` // 归还的未用次数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseLeaseReply) Reset() {
+	*x = ReleaseLeaseReply{}
+	mi := &file_billing_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLeaseReply) ProtoMessage() {}
+
+func (x *ReleaseLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLeaseReply.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReleaseLeaseReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseLeaseReply) GetReclaimedCount() int32 {
+	if x != nil {
+		return x.ReclaimedCount
+	}
+	return 0
+}
+
+type RechargeCallbackRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RechargeOrderId string                 `protobuf:"bytes,1,opt,name=rechargeOrderId,proto3" json:"rechargeOrderId,omitempty"` // 充值订单ID（billing-service生成，格式：recharge_{uid}_{timestamp}）
+	PaymentId       string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`             // 支付流水号（payment-service返回的payment_id）
+	Amount          float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`                 // 充值金额
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                   // 支付状态
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RechargeCallbackRequest) Reset() {
+	*x = RechargeCallbackRequest{}
+	mi := &file_billing_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RechargeCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeCallbackRequest) ProtoMessage() {}
+
+func (x *RechargeCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeCallbackRequest.ProtoReflect.Descriptor instead.
+func (*RechargeCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RechargeCallbackRequest) GetRechargeOrderId() string {
+	if x != nil {
+		return x.RechargeOrderId
+	}
+	return ""
+}
+
+func (x *RechargeCallbackRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RechargeCallbackRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeCallbackRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RechargeCallbackReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RechargeCallbackReply) Reset() {
+	*x = RechargeCallbackReply{}
+	mi := &file_billing_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RechargeCallbackReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeCallbackReply) ProtoMessage() {}
+
+func (x *RechargeCallbackReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeCallbackReply.ProtoReflect.Descriptor instead.
+func (*RechargeCallbackReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RechargeCallbackReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 统计相关消息
+type GetStatsTodayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	OrgId         string                 `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`             // 统计组织账户，为空时统计用户本人账户
+	MemberId      string                 `protobuf:"bytes,4,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 只统计该组织成员（普通成员始终只统计自己）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsTodayRequest) Reset() {
+	*x = GetStatsTodayRequest{}
+	mi := &file_billing_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsTodayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsTodayRequest) ProtoMessage() {}
+
+func (x *GetStatsTodayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsTodayRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsTodayRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStatsTodayRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsTodayRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetStatsTodayRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetStatsTodayRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetStatsMonthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	OrgId         string                 `protobuf:"bytes,3,opt,name=orgId,proto3" json:"orgId,omitempty"`             // 统计组织账户，为空时统计用户本人账户
+	MemberId      string                 `protobuf:"bytes,4,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 只统计该组织成员（普通成员始终只统计自己）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsMonthRequest) Reset() {
+	*x = GetStatsMonthRequest{}
+	mi := &file_billing_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsMonthRequest) ProtoMessage() {}
+
+func (x *GetStatsMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsMonthRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStatsMonthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsMonthRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetStatsMonthRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetStatsMonthRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetStatsSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`       // 统计组织账户，为空时统计用户本人账户
+	MemberId      string                 `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"` // 只统计该组织成员（普通成员始终只统计自己）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsSummaryRequest) Reset() {
+	*x = GetStatsSummaryRequest{}
+	mi := &file_billing_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsSummaryRequest) ProtoMessage() {}
+
+func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStatsSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsSummaryRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetStatsSummaryRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetStatsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 如果请求时指定了服务名称，这里返回；否则为空
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`  // 总调用次数
+	TotalCost     float64                `protobuf:"fixed64,4,opt,name=totalCost,proto3" json:"totalCost,omitempty"`   // 总费用（仅余额扣费部分）
+	FreeCount     int32                  `protobuf:"varint,5,opt,name=freeCount,proto3" json:"freeCount,omitempty"`    // 免费额度使用次数
+	PaidCount     int32                  `protobuf:"varint,6,opt,name=paidCount,proto3" json:"paidCount,omitempty"`    // 余额扣费次数
+	Period        string                 `protobuf:"bytes,7,opt,name=period,proto3" json:"period,omitempty"`           // 统计周期：today 或 month
+	MemberId      string                 `protobuf:"bytes,8,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 按组织成员统计时为成员ID（userId 为组织ID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsReply) Reset() {
+	*x = GetStatsReply{}
+	mi := &file_billing_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsReply) ProtoMessage() {}
+
+func (x *GetStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsReply.ProtoReflect.Descriptor instead.
+func (*GetStatsReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStatsReply) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsReply) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetStatsReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStatsReply) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetStatsReply) GetFreeCount() int32 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *GetStatsReply) GetPaidCount() int32 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+func (x *GetStatsReply) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetStatsReply) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ServiceStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 服务名称
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`  // 总调用次数
+	TotalCost     float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`   // 总费用
+	FreeCount     int32                  `protobuf:"varint,4,opt,name=freeCount,proto3" json:"freeCount,omitempty"`    // 免费额度使用次数
+	PaidCount     int32                  `protobuf:"varint,5,opt,name=paidCount,proto3" json:"paidCount,omitempty"`    // 余额扣费次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceStats) Reset() {
+	*x = ServiceStats{}
+	mi := &file_billing_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStats) ProtoMessage() {}
+
+func (x *ServiceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStats.ProtoReflect.Descriptor instead.
+func (*ServiceStats) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ServiceStats) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceStats) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ServiceStats) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *ServiceStats) GetFreeCount() int32 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *ServiceStats) GetPaidCount() int32 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+type GetStatsSummaryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"` // 所有服务总调用次数
+	TotalCost     float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`  // 所有服务总费用
+	Services      []*ServiceStats        `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`      // 各服务统计
+	MemberId      string                 `protobuf:"bytes,5,opt,name=memberId,proto3" json:"memberId,omitempty"`      // 按组织成员统计时为成员ID（userId 为组织ID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsSummaryReply) Reset() {
+	*x = GetStatsSummaryReply{}
+	mi := &file_billing_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsSummaryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsSummaryReply) ProtoMessage() {}
+
+func (x *GetStatsSummaryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsSummaryReply.ProtoReflect.Descriptor instead.
+func (*GetStatsSummaryReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetStatsSummaryReply) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStatsSummaryReply) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStatsSummaryReply) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetStatsSummaryReply) GetServices() []*ServiceStats {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GetStatsSummaryReply) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type GetUsageSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 开始时间（含），按所在时间桶的起点对齐
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间（不含）
+	Granularity   string                 `protobuf:"bytes,5,opt,name=granularity,proto3" json:"granularity,omitempty"` // 粒度：hour / day / month，默认 day
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`       // IANA 时区，例如 Asia/Shanghai，默认 UTC
+	OrgId         string                 `protobuf:"bytes,7,opt,name=orgId,proto3" json:"orgId,omitempty"`             // 统计组织账户，为空时统计用户本人账户
+	MemberId      string                 `protobuf:"bytes,8,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 只统计该组织成员（普通成员始终只统计自己）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSeriesRequest) Reset() {
+	*x = GetUsageSeriesRequest{}
+	mi := &file_billing_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSeriesRequest) ProtoMessage() {}
+
+func (x *GetUsageSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUsageSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetUsageSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetUsageSeriesRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+// UsagePoint 单个时间桶的用量
+type UsagePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`    // 时间桶起点（按请求时区对齐）
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"` // 总调用次数
+	TotalCost     float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`  // 总费用（仅余额扣费部分）
+	FreeCount     int32                  `protobuf:"varint,4,opt,name=freeCount,proto3" json:"freeCount,omitempty"`   // 免费额度使用次数
+	PaidCount     int32                  `protobuf:"varint,5,opt,name=paidCount,proto3" json:"paidCount,omitempty"`   // 余额扣费次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePoint) Reset() {
+	*x = UsagePoint{}
+	mi := &file_billing_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePoint) ProtoMessage() {}
+
+func (x *UsagePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePoint.ProtoReflect.Descriptor instead.
+func (*UsagePoint) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UsagePoint) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UsagePoint) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetFreeCount() int32 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetPaidCount() int32 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+type GetLiveUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，不传则统计所有服务
+	Granularity   string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"` // 粒度：minute / hour，默认 minute
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`          // 返回最近的时间片数（含当前时间片），minute 默认 60 最大 120，hour 默认 24 最大 48
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLiveUsageRequest) Reset() {
+	*x = GetLiveUsageRequest{}
+	mi := &file_billing_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLiveUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiveUsageRequest) ProtoMessage() {}
+
+func (x *GetLiveUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiveUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetLiveUsageRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetLiveUsageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLiveUsageRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetLiveUsageRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetLiveUsageRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type GetUsageSeriesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Granularity   string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Points        []*UsagePoint          `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"`     // 按时间正序，无数据的时间桶为 0
+	MemberId      string                 `protobuf:"bytes,6,opt,name=memberId,proto3" json:"memberId,omitempty"` // 按组织成员统计时为成员ID（userId 为组织ID）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSeriesReply) Reset() {
+	*x = GetUsageSeriesReply{}
+	mi := &file_billing_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSeriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSeriesReply) ProtoMessage() {}
+
+func (x *GetUsageSeriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSeriesReply.ProtoReflect.Descriptor instead.
+func (*GetUsageSeriesReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetUsageSeriesReply) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesReply) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesReply) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetUsageSeriesReply) GetPoints() []*UsagePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetUsageSeriesReply) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type CreateExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`       // csv / xlsx / pdf
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"` // 起始时间（含）
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 结束时间（不含）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExportRequest) Reset() {
+	*x = CreateExportRequest{}
+	mi := &file_billing_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportRequest) ProtoMessage() {}
+
+func (x *CreateExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportRequest.ProtoReflect.Descriptor instead.
+func (*CreateExportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateExportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateExportRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateExportRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateExportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *ExportJob             `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExportReply) Reset() {
+	*x = CreateExportReply{}
+	mi := &file_billing_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportReply) ProtoMessage() {}
+
+func (x *CreateExportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportReply.ProtoReflect.Descriptor instead.
+func (*CreateExportReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateExportReply) GetExport() *ExportJob {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=exportId,proto3" json:"exportId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportRequest) Reset() {
+	*x = GetExportRequest{}
+	mi := &file_billing_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportRequest) ProtoMessage() {}
+
+func (x *GetExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportRequest.ProtoReflect.Descriptor instead.
+func (*GetExportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type GetExportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *ExportJob             `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExportReply) Reset() {
+	*x = GetExportReply{}
+	mi := &file_billing_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportReply) ProtoMessage() {}
+
+func (x *GetExportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportReply.ProtoReflect.Descriptor instead.
+func (*GetExportReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetExportReply) GetExport() *ExportJob {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+// ExportJob 账单导出任务
+type ExportJob struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ExportId             string                 `protobuf:"bytes,1,opt,name=exportId,proto3" json:"exportId,omitempty"`
+	Format               string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Status               string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending / running / success / failed / expired
+	StartTime            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	RowCount             int32                  `protobuf:"varint,6,opt,name=rowCount,proto3" json:"rowCount,omitempty"`                        // 明细行数（消费记录 + 充值订单）
+	FileSize             int64                  `protobuf:"varint,7,opt,name=fileSize,proto3" json:"fileSize,omitempty"`                        // 文件大小（字节）
+	DownloadUrl          string                 `protobuf:"bytes,8,opt,name=downloadUrl,proto3" json:"downloadUrl,omitempty"`                   // 下载链接（status 为 success 时返回）
+	DownloadUrlExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=downloadUrlExpiresAt,proto3" json:"downloadUrlExpiresAt,omitempty"` // 下载链接过期时间，过期后重新调用 GetExport 获取
+	FileExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=fileExpiresAt,proto3" json:"fileExpiresAt,omitempty"`              // 文件过期时间，之后需要重新导出
+	ErrorMessage         string                 `protobuf:"bytes,11,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`                // 失败原因（status 为 failed 时返回）
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	FinishedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ExportJob) Reset() {
+	*x = ExportJob{}
+	mi := &file_billing_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJob) ProtoMessage() {}
+
+func (x *ExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJob.ProtoReflect.Descriptor instead.
+func (*ExportJob) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportJob) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *ExportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportJob) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportJob) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExportJob) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *ExportJob) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ExportJob) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExportJob) GetDownloadUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiresAt
+	}
+	return nil
+}
+
+func (x *ExportJob) GetFileExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FileExpiresAt
+	}
+	return nil
+}
+
+func (x *ExportJob) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ExportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExportJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type SetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`     // 为空表示全部服务合计
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`             // 每月预算金额（余额消费，不含免费额度）
+	AlertPercent  float64                `protobuf:"fixed64,4,opt,name=alertPercent,proto3" json:"alertPercent,omitempty"` // 提醒阈值（预算金额的百分比，0-100），0 表示不提醒
+	HardLimit     bool                   `protobuf:"varint,5,opt,name=hardLimit,proto3" json:"hardLimit,omitempty"`        // 是否为硬性上限：超出预算的扣费被拒绝
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetRequest) Reset() {
+	*x = SetBudgetRequest{}
+	mi := &file_billing_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetRequest) ProtoMessage() {}
+
+func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SetBudgetRequest) GetAlertPercent() float64 {
+	if x != nil {
+		return x.AlertPercent
+	}
+	return 0
+}
+
+func (x *SetBudgetRequest) GetHardLimit() bool {
+	if x != nil {
+		return x.HardLimit
+	}
+	return false
+}
+
+type SetBudgetReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetReply) Reset() {
+	*x = SetBudgetReply{}
+	mi := &file_billing_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetReply) ProtoMessage() {}
+
+func (x *SetBudgetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetReply.ProtoReflect.Descriptor instead.
+func (*SetBudgetReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetBudgetReply) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_billing_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListBudgetsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListBudgetsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsReply) Reset() {
+	*x = ListBudgetsReply{}
+	mi := &file_billing_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsReply) ProtoMessage() {}
+
+func (x *ListBudgetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsReply.ProtoReflect.Descriptor instead.
+func (*ListBudgetsReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListBudgetsReply) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 为空表示全部服务合计的预算
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_billing_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteBudgetRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type DeleteBudgetReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetReply) Reset() {
+	*x = DeleteBudgetReply{}
+	mi := &file_billing_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetReply) ProtoMessage() {}
+
+func (x *DeleteBudgetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetReply.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteBudgetReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetAccountTimezoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA 时区名称，如 America/Los_Angeles；为空表示恢复默认时区
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountTimezoneRequest) Reset() {
+	*x = SetAccountTimezoneRequest{}
+	mi := &file_billing_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountTimezoneRequest) ProtoMessage() {}
+
+func (x *SetAccountTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetAccountTimezoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAccountTimezoneRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type SetAccountTimezoneReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountTimezoneReply) Reset() {
+	*x = SetAccountTimezoneReply{}
+	mi := &file_billing_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountTimezoneReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountTimezoneReply) ProtoMessage() {}
+
+func (x *SetAccountTimezoneReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountTimezoneReply.ProtoReflect.Descriptor instead.
+func (*SetAccountTimezoneReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetAccountTimezoneReply) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ListPackageCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackageCatalogRequest) Reset() {
+	*x = ListPackageCatalogRequest{}
+	mi := &file_billing_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackageCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackageCatalogRequest) ProtoMessage() {}
+
+func (x *ListPackageCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackageCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ListPackageCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{52}
+}
+
+type ListPackageCatalogReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*UsagePackage        `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackageCatalogReply) Reset() {
+	*x = ListPackageCatalogReply{}
+	mi := &file_billing_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackageCatalogReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackageCatalogReply) ProtoMessage() {}
+
+func (x *ListPackageCatalogReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackageCatalogReply.ProtoReflect.Descriptor instead.
+func (*ListPackageCatalogReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListPackageCatalogReply) GetPackages() []*UsagePackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+// UsagePackage 用量包目录项
+type UsagePackage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Units         int64                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`             // 包含用量（按服务计量单位）
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`                // 计量单位：call / token / mb / second
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`            // 售价（单位：元）
+	ValidMonths   int32                  `protobuf:"varint,7,opt,name=validMonths,proto3" json:"validMonths,omitempty"` // 有效期（月），从支付成功时起算
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePackage) Reset() {
+	*x = UsagePackage{}
+	mi := &file_billing_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePackage) ProtoMessage() {}
+
+func (x *UsagePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePackage.ProtoReflect.Descriptor instead.
+func (*UsagePackage) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UsagePackage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *UsagePackage) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UsagePackage) GetValidMonths() int32 {
+	if x != nil {
+		return x.ValidMonths
+	}
+	return 0
+}
+
+type PurchasePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	PackageId     string                 `protobuf:"bytes,2,opt,name=packageId,proto3" json:"packageId,omitempty"`         // 用量包ID（见 ListPackageCatalog）
+	PaymentMethod string                 `protobuf:"bytes,3,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty"` // wechat, alipay
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`           // 币种，必填，例如：CNY, USD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchasePackageRequest) Reset() {
+	*x = PurchasePackageRequest{}
+	mi := &file_billing_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchasePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchasePackageRequest) ProtoMessage() {}
+
+func (x *PurchasePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchasePackageRequest.ProtoReflect.Descriptor instead.
+func (*PurchasePackageRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PurchasePackageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PurchasePackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *PurchasePackageRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PurchasePackageRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type PurchasePackageReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`       // 订单ID（格式：package_{uid}_{timestamp}），支付回调沿用 RechargeCallback
+	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=paymentUrl,proto3" json:"paymentUrl,omitempty"` // 支付URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchasePackageReply) Reset() {
+	*x = PurchasePackageReply{}
+	mi := &file_billing_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchasePackageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchasePackageReply) ProtoMessage() {}
+
+func (x *PurchasePackageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchasePackageReply.ProtoReflect.Descriptor instead.
+func (*PurchasePackageReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PurchasePackageReply) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PurchasePackageReply) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+type ListUserPackagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`        // 按服务过滤
+	IncludeExpired bool                   `protobuf:"varint,3,opt,name=includeExpired,proto3" json:"includeExpired,omitempty"` // 是否包含已过期与已用完的用量包
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUserPackagesRequest) Reset() {
+	*x = ListUserPackagesRequest{}
+	mi := &file_billing_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPackagesRequest) ProtoMessage() {}
+
+func (x *ListUserPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListUserPackagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserPackagesRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ListUserPackagesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListUserPackagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*UserPackage         `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserPackagesReply) Reset() {
+	*x = ListUserPackagesReply{}
+	mi := &file_billing_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserPackagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPackagesReply) ProtoMessage() {}
+
+func (x *ListUserPackagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPackagesReply.ProtoReflect.Descriptor instead.
+func (*ListUserPackagesReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListUserPackagesReply) GetPackages() []*UserPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+// UserPackage 账户持有的用量包
+type UserPackage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PackageId      string                 `protobuf:"bytes,2,opt,name=packageId,proto3" json:"packageId,omitempty"` // 目录中的用量包ID
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,4,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	TotalUnits     int64                  `protobuf:"varint,5,opt,name=totalUnits,proto3" json:"totalUnits,omitempty"`
+	UsedUnits      int64                  `protobuf:"varint,6,opt,name=usedUnits,proto3" json:"usedUnits,omitempty"`           // 已使用（含尚未落库的扣费）
+	RemainingUnits int64                  `protobuf:"varint,7,opt,name=remainingUnits,proto3" json:"remainingUnits,omitempty"` // 剩余用量，已过期时为 0
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 下单时间
+	OrderId        string                 `protobuf:"bytes,10,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserPackage) Reset() {
+	*x = UserPackage{}
+	mi := &file_billing_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPackage) ProtoMessage() {}
+
+func (x *UserPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPackage.ProtoReflect.Descriptor instead.
+func (*UserPackage) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UserPackage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserPackage) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *UserPackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPackage) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UserPackage) GetTotalUnits() int64 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+func (x *UserPackage) GetUsedUnits() int64 {
+	if x != nil {
+		return x.UsedUnits
+	}
+	return 0
+}
+
+func (x *UserPackage) GetRemainingUnits() int64 {
+	if x != nil {
+		return x.RemainingUnits
+	}
+	return 0
+}
+
+func (x *UserPackage) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserPackage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserPackage) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Organization 组织，组织ID即共享钱包的账户ID
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_billing_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Organization) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// OrgMember 组织成员
+type OrgMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 成员用户ID
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`               // owner / admin / member
+	SpendLimit    float64                `protobuf:"fixed64,4,opt,name=spendLimit,proto3" json:"spendLimit,omitempty"` // 每月余额消费上限（组织账户时区的自然月），0 表示不限制
+	Spent         float64                `protobuf:"fixed64,5,opt,name=spent,proto3" json:"spent,omitempty"`           // 本月记入组织账户的余额消费（已落库部分）
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`     // 加入时间（被邀请的时间）
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`           // invited（已邀请，等待接受） / active（已加入）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgMember) Reset() {
+	*x = OrgMember{}
+	mi := &file_billing_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMember) ProtoMessage() {}
+
+func (x *OrgMember) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OrgMember) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *OrgMember) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *OrgMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OrgMember) GetSpendLimit() float64 {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return 0
+}
+
+func (x *OrgMember) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *OrgMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrgMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"` // 创建者（所有者）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // 组织名称，最长 64 个字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_billing_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationRequest) Reset() {
+	*x = GetOrganizationRequest{}
+	mi := &file_billing_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequest) ProtoMessage() {}
+
+func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrganizationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type OrganizationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Members       []*OrgMember           `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"` // 按加入时间正序，普通成员只返回自己
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationReply) Reset() {
+	*x = OrganizationReply{}
+	mi := &file_billing_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationReply) ProtoMessage() {}
+
+func (x *OrganizationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationReply.ProtoReflect.Descriptor instead.
+func (*OrganizationReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *OrganizationReply) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *OrganizationReply) GetMembers() []*OrgMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddOrgMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"` // 操作者
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"`       // 被添加的用户ID
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`               // admin / member，默认 member（只有所有者可添加管理员）
+	SpendLimit    float64                `protobuf:"fixed64,5,opt,name=spendLimit,proto3" json:"spendLimit,omitempty"` // 每月余额消费上限，0 表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrgMemberRequest) Reset() {
+	*x = AddOrgMemberRequest{}
+	mi := &file_billing_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrgMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrgMemberRequest) ProtoMessage() {}
+
+func (x *AddOrgMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrgMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddOrgMemberRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddOrgMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddOrgMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *AddOrgMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AddOrgMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddOrgMemberRequest) GetSpendLimit() float64 {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return 0
+}
+
+type AcceptOrgInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"` // 被邀请的用户
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrgInvitationRequest) Reset() {
+	*x = AcceptOrgInvitationRequest{}
+	mi := &file_billing_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrgInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrgInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptOrgInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrgInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrgInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AcceptOrgInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptOrgInvitationRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type UpdateOrgMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"` // 操作者
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`               // admin / member，为空时不变
+	SpendLimit    float64                `protobuf:"fixed64,5,opt,name=spendLimit,proto3" json:"spendLimit,omitempty"` // 每月余额消费上限，0 表示不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrgMemberRequest) Reset() {
+	*x = UpdateOrgMemberRequest{}
+	mi := &file_billing_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrgMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrgMemberRequest) ProtoMessage() {}
+
+func (x *UpdateOrgMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrgMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrgMemberRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateOrgMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateOrgMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UpdateOrgMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateOrgMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateOrgMemberRequest) GetSpendLimit() float64 {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return 0
+}
+
+type RemoveOrgMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"` // 操作者
+	OrgId         string                 `protobuf:"bytes,2,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=memberId,proto3" json:"memberId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrgMemberRequest) Reset() {
+	*x = RemoveOrgMemberRequest{}
+	mi := &file_billing_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrgMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrgMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveOrgMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type RemoveOrgMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrgMemberReply) Reset() {
+	*x = RemoveOrgMemberReply{}
+	mi := &file_billing_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrgMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberReply) ProtoMessage() {}
+
+func (x *RemoveOrgMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberReply.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveOrgMemberReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Budget 消费预算
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 为空表示全部服务合计
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AlertPercent  float64                `protobuf:"fixed64,3,opt,name=alertPercent,proto3" json:"alertPercent,omitempty"`
+	HardLimit     bool                   `protobuf:"varint,4,opt,name=hardLimit,proto3" json:"hardLimit,omitempty"`
+	Period        string                 `protobuf:"bytes,5,opt,name=period,proto3" json:"period,omitempty"`    // 当前统计周期（自然月），例如 2026-10
+	Spent         float64                `protobuf:"fixed64,6,opt,name=spent,proto3" json:"spent,omitempty"`    // 本周期已消费金额（含尚未落库的扣费）
+	Alerted       bool                   `protobuf:"varint,7,opt,name=alerted,proto3" json:"alerted,omitempty"` // 本周期是否已发送提醒
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_billing_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Budget) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Budget) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Budget) GetAlertPercent() float64 {
+	if x != nil {
+		return x.AlertPercent
+	}
+	return 0
+}
+
+func (x *Budget) GetHardLimit() bool {
+	if x != nil {
+		return x.HardLimit
+	}
+	return false
+}
+
+func (x *Budget) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Budget) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *Budget) GetAlerted() bool {
+	if x != nil {
+		return x.Alerted
+	}
+	return false
+}
+
+func (x *Budget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RateLimitRule 服务限流规则，按请求次数计算
+type RateLimitRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // "*" 表示未单独配置的服务
+	PerSecond     int64                  `protobuf:"varint,2,opt,name=perSecond,proto3" json:"perSecond,omitempty"`    // 每秒请求数上限（令牌桶，容量与每秒补充数相同），0 表示不限
+	PerDay        int64                  `protobuf:"varint,3,opt,name=perDay,proto3" json:"perDay,omitempty"`          // 每日请求数上限（自然日），0 表示不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitRule) Reset() {
+	*x = RateLimitRule{}
+	mi := &file_billing_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitRule) ProtoMessage() {}
+
+func (x *RateLimitRule) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitRule.ProtoReflect.Descriptor instead.
+func (*RateLimitRule) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RateLimitRule) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RateLimitRule) GetPerSecond() int64 {
+	if x != nil {
+		return x.PerSecond
+	}
+	return 0
+}
+
+func (x *RateLimitRule) GetPerDay() int64 {
+	if x != nil {
+		return x.PerDay
+	}
+	return 0
+}
+
+type SetUserRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Plan          string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`           // 限流套餐（billing.rate_limit.plans），为空表示默认套餐
+	Overrides     []*RateLimitRule       `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"` // 用户级规则，优先于套餐规则
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRateLimitRequest) Reset() {
+	*x = SetUserRateLimitRequest{}
+	mi := &file_billing_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRateLimitRequest) ProtoMessage() {}
+
+func (x *SetUserRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetUserRateLimitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserRateLimitRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *SetUserRateLimitRequest) GetOverrides() []*RateLimitRule {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type GetUserRateLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRateLimitRequest) Reset() {
+	*x = GetUserRateLimitRequest{}
+	mi := &file_billing_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRateLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRateLimitRequest) ProtoMessage() {}
+
+func (x *GetUserRateLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRateLimitRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRateLimitRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetUserRateLimitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserRateLimitReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Plan          string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"` // 生效的套餐
+	Overrides     []*RateLimitRule       `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	Effective     []*RateLimitRule       `protobuf:"bytes,4,rep,name=effective,proto3" json:"effective,omitempty"` // 各服务生效的规则
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // 未设置过时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRateLimitReply) Reset() {
+	*x = UserRateLimitReply{}
+	mi := &file_billing_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRateLimitReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRateLimitReply) ProtoMessage() {}
+
+func (x *UserRateLimitReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRateLimitReply.ProtoReflect.Descriptor instead.
+func (*UserRateLimitReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UserRateLimitReply) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserRateLimitReply) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *UserRateLimitReply) GetOverrides() []*RateLimitRule {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *UserRateLimitReply) GetEffective() []*RateLimitRule {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+func (x *UserRateLimitReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ServiceRate 服务适用单价
+type ServiceRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ListPrice     float64                `protobuf:"fixed64,2,opt,name=listPrice,proto3" json:"listPrice,omitempty"` // 目录价
+	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"` // 适用单价
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`         // 来源：list / override / discount / contract
+	RuleId        string                 `protobuf:"bytes,5,opt,name=ruleId,proto3" json:"ruleId,omitempty"`         // 生效的价格规则，目录价时为空
+	ContractId    string                 `protobuf:"bytes,6,opt,name=contractId,proto3" json:"contractId,omitempty"` // 合同价所属合同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRate) Reset() {
+	*x = ServiceRate{}
+	mi := &file_billing_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRate) ProtoMessage() {}
+
+func (x *ServiceRate) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRate.ProtoReflect.Descriptor instead.
+func (*ServiceRate) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ServiceRate) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceRate) GetListPrice() float64 {
+	if x != nil {
+		return x.ListPrice
+	}
+	return 0
+}
+
+func (x *ServiceRate) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ServiceRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ServiceRate) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ServiceRate) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+// PriceRule 账户价格规则，unitPrice 与 discountPercent 二选一
+type PriceRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName     string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"`           // "*" 表示全部服务，单独设置了服务的规则优先
+	UnitPrice       float64                `protobuf:"fixed64,4,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`             // 单价覆盖
+	DiscountPercent float64                `protobuf:"fixed64,5,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"` // 目录价折扣（百分比，例如 20 表示 8 折）
+	ContractId      string                 `protobuf:"bytes,6,opt,name=contractId,proto3" json:"contractId,omitempty"`             // 合同价所属合同，单独设置的规则为空
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=endsAt,proto3" json:"endsAt,omitempty"` // 结束时间（不含），为空表示长期有效
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceRule) Reset() {
+	*x = PriceRule{}
+	mi := &file_billing_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRule) ProtoMessage() {}
+
+func (x *PriceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRule.ProtoReflect.Descriptor instead.
+func (*PriceRule) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PriceRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceRule) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PriceRule) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *PriceRule) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *PriceRule) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *PriceRule) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *PriceRule) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PriceRule) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PriceRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreatePriceRuleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ServiceName     string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	UnitPrice       float64                `protobuf:"fixed64,3,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	DiscountPercent float64                `protobuf:"fixed64,4,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=startsAt,proto3" json:"startsAt,omitempty"` // 为空表示立即生效
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endsAt,proto3" json:"endsAt,omitempty"`     // 为空表示长期有效
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePriceRuleRequest) Reset() {
+	*x = CreatePriceRuleRequest{}
+	mi := &file_billing_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceRuleRequest) ProtoMessage() {}
+
+func (x *CreatePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreatePriceRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePriceRuleRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *CreatePriceRuleRequest) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CreatePriceRuleRequest) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *CreatePriceRuleRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePriceRuleRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type ListPriceRulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	IncludeExpired bool                   `protobuf:"varint,2,opt,name=includeExpired,proto3" json:"includeExpired,omitempty"` // 是否包含已过期的规则
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPriceRulesRequest) Reset() {
+	*x = ListPriceRulesRequest{}
+	mi := &file_billing_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceRulesRequest) ProtoMessage() {}
+
+func (x *ListPriceRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceRulesRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListPriceRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPriceRulesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListPriceRulesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*PriceRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`         // 按开始时间倒序
+	Effective     []*ServiceRate         `protobuf:"bytes,2,rep,name=effective,proto3" json:"effective,omitempty"` // 各服务当前适用单价
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceRulesReply) Reset() {
+	*x = ListPriceRulesReply{}
+	mi := &file_billing_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceRulesReply) ProtoMessage() {}
+
+func (x *ListPriceRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceRulesReply.ProtoReflect.Descriptor instead.
+func (*ListPriceRulesReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListPriceRulesReply) GetRules() []*PriceRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListPriceRulesReply) GetEffective() []*ServiceRate {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type DeletePriceRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceRuleRequest) Reset() {
+	*x = DeletePriceRuleRequest{}
+	mi := &file_billing_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleRequest) ProtoMessage() {}
+
+func (x *DeletePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeletePriceRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePriceRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DeletePriceRuleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceRuleReply) Reset() {
+	*x = DeletePriceRuleReply{}
+	mi := &file_billing_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleReply) ProtoMessage() {}
+
+func (x *DeletePriceRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleReply.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{81}
+}
+
+// ContractRate 合同价（有效期与合同期限一致）
+type ContractRate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName     string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // "*" 表示全部服务
+	UnitPrice       float64                `protobuf:"fixed64,2,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	DiscountPercent float64                `protobuf:"fixed64,3,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ContractRate) Reset() {
+	*x = ContractRate{}
+	mi := &file_billing_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractRate) ProtoMessage() {}
+
+func (x *ContractRate) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractRate.ProtoReflect.Descriptor instead.
+func (*ContractRate) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ContractRate) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ContractRate) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ContractRate) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+// Contract 承诺消费合同
+type Contract struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommitAmount    float64                `protobuf:"fixed64,3,opt,name=commitAmount,proto3" json:"commitAmount,omitempty"`       // 承诺金额（创建时预付计入余额）
+	DrawnAmount     float64                `protobuf:"fixed64,4,opt,name=drawnAmount,proto3" json:"drawnAmount,omitempty"`         // 合同期内的余额消费
+	RemainingAmount float64                `protobuf:"fixed64,5,opt,name=remainingAmount,proto3" json:"remainingAmount,omitempty"` // 未消耗的承诺金额
+	StartsAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=endsAt,proto3" json:"endsAt,omitempty"`                  // 到期时间（不含）
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                  // active / settled
+	TrueUpAmount    float64                `protobuf:"fixed64,9,opt,name=trueUpAmount,proto3" json:"trueUpAmount,omitempty"`    // 应补差额（结算后）
+	TrueUpCharged   float64                `protobuf:"fixed64,10,opt,name=trueUpCharged,proto3" json:"trueUpCharged,omitempty"` // 实际扣款，余额不足时小于应补差额
+	SettledAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=settledAt,proto3" json:"settledAt,omitempty"`           // 未结算时为空
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Rates           []*ContractRate        `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Contract) Reset() {
+	*x = Contract{}
+	mi := &file_billing_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contract) ProtoMessage() {}
+
+func (x *Contract) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contract.ProtoReflect.Descriptor instead.
+func (*Contract) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Contract) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contract) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Contract) GetCommitAmount() float64 {
+	if x != nil {
+		return x.CommitAmount
+	}
+	return 0
+}
+
+func (x *Contract) GetDrawnAmount() float64 {
+	if x != nil {
+		return x.DrawnAmount
+	}
+	return 0
+}
+
+func (x *Contract) GetRemainingAmount() float64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *Contract) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Contract) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Contract) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Contract) GetTrueUpAmount() float64 {
+	if x != nil {
+		return x.TrueUpAmount
+	}
+	return 0
+}
+
+func (x *Contract) GetTrueUpCharged() float64 {
+	if x != nil {
+		return x.TrueUpCharged
+	}
+	return 0
+}
+
+func (x *Contract) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *Contract) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contract) GetRates() []*ContractRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type CreateContractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CommitAmount  float64                `protobuf:"fixed64,2,opt,name=commitAmount,proto3" json:"commitAmount,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startsAt,proto3" json:"startsAt,omitempty"` // 为空表示立即开始
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	Rates         []*ContractRate        `protobuf:"bytes,5,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContractRequest) Reset() {
+	*x = CreateContractRequest{}
+	mi := &file_billing_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractRequest) ProtoMessage() {}
+
+func (x *CreateContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractRequest.ProtoReflect.Descriptor instead.
+func (*CreateContractRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateContractRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateContractRequest) GetCommitAmount() float64 {
+	if x != nil {
+		return x.CommitAmount
+	}
+	return 0
+}
+
+func (x *CreateContractRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateContractRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateContractRequest) GetRates() []*ContractRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ListContractsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	IncludeSettled bool                   `protobuf:"varint,2,opt,name=includeSettled,proto3" json:"includeSettled,omitempty"` // 是否包含已结算的合同
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListContractsRequest) Reset() {
+	*x = ListContractsRequest{}
+	mi := &file_billing_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContractsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractsRequest) ProtoMessage() {}
+
+func (x *ListContractsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractsRequest.ProtoReflect.Descriptor instead.
+func (*ListContractsRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListContractsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListContractsRequest) GetIncludeSettled() bool {
+	if x != nil {
+		return x.IncludeSettled
+	}
+	return false
+}
+
+type ListContractsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contracts     []*Contract            `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"` // 按开始时间倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContractsReply) Reset() {
+	*x = ListContractsReply{}
+	mi := &file_billing_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContractsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractsReply) ProtoMessage() {}
+
+func (x *ListContractsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractsReply.ProtoReflect.Descriptor instead.
+func (*ListContractsReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListContractsReply) GetContracts() []*Contract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+type GetRevenueReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`            // 开始时间（含），按 UTC 日/月起点对齐
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`                // 结束时间（不含）
+	Granularity    string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"`        // 粒度：day / month，默认 day
+	ServiceName    string                 `protobuf:"bytes,4,opt,name=serviceName,proto3" json:"serviceName,omitempty"`        // 可选，只统计指定服务
+	GroupByService bool                   `protobuf:"varint,5,opt,name=groupByService,proto3" json:"groupByService,omitempty"` // 是否按服务拆分
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRevenueReportRequest) Reset() {
+	*x = GetRevenueReportRequest{}
+	mi := &file_billing_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueReportRequest) ProtoMessage() {}
+
+func (x *GetRevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetRevenueReportRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetRevenueReportRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetRevenueReportRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetRevenueReportRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetRevenueReportRequest) GetGroupByService() bool {
+	if x != nil {
+		return x.GroupByService
+	}
+	return false
+}
+
+// RevenueItem 单个时间桶（及服务）的收入
+type RevenueItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=periodStart,proto3" json:"periodStart,omitempty"` // 时间桶起点（UTC）
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // groupByService 为 true 时返回
+	Revenue       float64                `protobuf:"fixed64,3,opt,name=revenue,proto3" json:"revenue,omitempty"`       // 余额扣费收入
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`  // 总调用次数
+	FreeCount     int64                  `protobuf:"varint,5,opt,name=freeCount,proto3" json:"freeCount,omitempty"`    // 免费额度使用次数
+	PaidCount     int64                  `protobuf:"varint,6,opt,name=paidCount,proto3" json:"paidCount,omitempty"`    // 余额扣费次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueItem) Reset() {
+	*x = RevenueItem{}
+	mi := &file_billing_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueItem) ProtoMessage() {}
+
+func (x *RevenueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueItem.ProtoReflect.Descriptor instead.
+func (*RevenueItem) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RevenueItem) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *RevenueItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *RevenueItem) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *RevenueItem) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *RevenueItem) GetFreeCount() int64 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *RevenueItem) GetPaidCount() int64 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+type GetRevenueReportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Granularity   string                 `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Items         []*RevenueItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // 按时间桶升序，无数据的时间桶补零（按服务拆分时只返回有数据的服务）
+	TotalRevenue  float64                `protobuf:"fixed64,3,opt,name=totalRevenue,proto3" json:"totalRevenue,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	FreeCount     int64                  `protobuf:"varint,5,opt,name=freeCount,proto3" json:"freeCount,omitempty"`
+	PaidCount     int64                  `protobuf:"varint,6,opt,name=paidCount,proto3" json:"paidCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueReportReply) Reset() {
+	*x = GetRevenueReportReply{}
+	mi := &file_billing_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueReportReply) ProtoMessage() {}
+
+func (x *GetRevenueReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueReportReply.ProtoReflect.Descriptor instead.
+func (*GetRevenueReportReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetRevenueReportReply) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetRevenueReportReply) GetItems() []*RevenueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetRevenueReportReply) GetTotalRevenue() float64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetFreeCount() int64 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *GetRevenueReportReply) GetPaidCount() int64 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+type GetRechargeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 开始时间（含），按 UTC 日/月起点对齐
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间（不含）
+	Granularity   string                 `protobuf:"bytes,3,opt,name=granularity,proto3" json:"granularity,omitempty"` // 粒度：day / month，默认 day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRechargeReportRequest) Reset() {
+	*x = GetRechargeReportRequest{}
+	mi := &file_billing_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRechargeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRechargeReportRequest) ProtoMessage() {}
+
+func (x *GetRechargeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRechargeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRechargeReportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetRechargeReportRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetRechargeReportRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetRechargeReportRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+// RechargeItem 单个时间桶的充值统计
+type RechargeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=periodStart,proto3" json:"periodStart,omitempty"` // 时间桶起点（UTC）
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`         // 成功充值金额
+	OrderCount    int64                  `protobuf:"varint,3,opt,name=orderCount,proto3" json:"orderCount,omitempty"`  // 成功充值笔数
+	UserCount     int64                  `protobuf:"varint,4,opt,name=userCount,proto3" json:"userCount,omitempty"`    // 充值用户数（去重）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RechargeItem) Reset() {
+	*x = RechargeItem{}
+	mi := &file_billing_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RechargeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeItem) ProtoMessage() {}
+
+func (x *RechargeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeItem.ProtoReflect.Descriptor instead.
+func (*RechargeItem) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RechargeItem) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *RechargeItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeItem) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *RechargeItem) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+type GetRechargeReportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Granularity   string                 `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	Items         []*RechargeItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // 按时间桶升序，无数据的时间桶补零
+	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	TotalOrders   int64                  `protobuf:"varint,4,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
+	TotalUsers    int64                  `protobuf:"varint,5,opt,name=totalUsers,proto3" json:"totalUsers,omitempty"` // 整个区间内的充值用户数（去重）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRechargeReportReply) Reset() {
+	*x = GetRechargeReportReply{}
+	mi := &file_billing_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRechargeReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRechargeReportReply) ProtoMessage() {}
+
+func (x *GetRechargeReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRechargeReportReply.ProtoReflect.Descriptor instead.
+func (*GetRechargeReportReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetRechargeReportReply) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetRechargeReportReply) GetItems() []*RechargeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetRechargeReportReply) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *GetRechargeReportReply) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *GetRechargeReportReply) GetTotalUsers() int64 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
 }
 
-type GetStatsReply struct {
+type GetUserActivityReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 如果请求时指定了服务名称，这里返回；否则为空
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`  // 总调用次数
-	TotalCost     float64                `protobuf:"fixed64,4,opt,name=totalCost,proto3" json:"totalCost,omitempty"`   // 总费用（仅余额扣费部分）
-	FreeCount     int32                  `protobuf:"varint,5,opt,name=freeCount,proto3" json:"freeCount,omitempty"`    // 免费额度使用次数
-	PaidCount     int32                  `protobuf:"varint,6,opt,name=paidCount,proto3" json:"paidCount,omitempty"`    // 余额扣费次数
-	Period        string                 `protobuf:"bytes,7,opt,name=period,proto3" json:"period,omitempty"`           // 统计周期：today 或 month
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 开始时间（含），按 UTC 日起点对齐
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间（不含）
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，只统计指定服务
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsReply) Reset() {
-	*x = GetStatsReply{}
-	mi := &file_billing_proto_msgTypes[17]
+func (x *GetUserActivityReportRequest) Reset() {
+	*x = GetUserActivityReportRequest{}
+	mi := &file_billing_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsReply) String() string {
+func (x *GetUserActivityReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsReply) ProtoMessage() {}
+func (*GetUserActivityReportRequest) ProtoMessage() {}
 
-func (x *GetStatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[17]
+func (x *GetUserActivityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,86 +6430,126 @@ func (x *GetStatsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsReply.ProtoReflect.Descriptor instead.
-func (*GetStatsReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetUserActivityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUserActivityReportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetStatsReply) GetUserId() string {
+func (x *GetUserActivityReportRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStatsReply) GetServiceName() string {
+func (x *GetUserActivityReportRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetUserActivityReportRequest) GetServiceName() string {
 	if x != nil {
 		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *GetStatsReply) GetTotalCount() int32 {
+type GetUserActivityReportReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ActiveUsers    int64                  `protobuf:"varint,1,opt,name=activeUsers,proto3" json:"activeUsers,omitempty"`        // 区间内有调用的用户数
+	PayingUsers    int64                  `protobuf:"varint,2,opt,name=payingUsers,proto3" json:"payingUsers,omitempty"`        // 区间内有余额扣费的用户数
+	NewPayingUsers int64                  `protobuf:"varint,3,opt,name=newPayingUsers,proto3" json:"newPayingUsers,omitempty"`  // 首次余额扣费发生在区间内的用户数
+	ConversionRate float64                `protobuf:"fixed64,4,opt,name=conversionRate,proto3" json:"conversionRate,omitempty"` // 付费转化率 = payingUsers / activeUsers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUserActivityReportReply) Reset() {
+	*x = GetUserActivityReportReply{}
+	mi := &file_billing_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserActivityReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActivityReportReply) ProtoMessage() {}
+
+func (x *GetUserActivityReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[94]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetStatsReply) GetTotalCost() float64 {
+// Deprecated: Use GetUserActivityReportReply.ProtoReflect.Descriptor instead.
+func (*GetUserActivityReportReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetUserActivityReportReply) GetActiveUsers() int64 {
 	if x != nil {
-		return x.TotalCost
+		return x.ActiveUsers
 	}
 	return 0
 }
 
-func (x *GetStatsReply) GetFreeCount() int32 {
+func (x *GetUserActivityReportReply) GetPayingUsers() int64 {
 	if x != nil {
-		return x.FreeCount
+		return x.PayingUsers
 	}
 	return 0
 }
 
-func (x *GetStatsReply) GetPaidCount() int32 {
+func (x *GetUserActivityReportReply) GetNewPayingUsers() int64 {
 	if x != nil {
-		return x.PaidCount
+		return x.NewPayingUsers
 	}
 	return 0
 }
 
-func (x *GetStatsReply) GetPeriod() string {
+func (x *GetUserActivityReportReply) GetConversionRate() float64 {
 	if x != nil {
-		return x.Period
+		return x.ConversionRate
 	}
-	return ""
+	return 0
 }
 
-type ServiceStats struct {
+type ListTopConsumersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   string                 `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 服务名称
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`  // 总调用次数
-	TotalCost     float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`   // 总费用
-	FreeCount     int32                  `protobuf:"varint,4,opt,name=freeCount,proto3" json:"freeCount,omitempty"`    // 免费额度使用次数
-	PaidCount     int32                  `protobuf:"varint,5,opt,name=paidCount,proto3" json:"paidCount,omitempty"`    // 余额扣费次数
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`     // 开始时间（含），按 UTC 日起点对齐
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`         // 结束时间（不含）
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"` // 可选，只统计指定服务
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=orderBy,proto3" json:"orderBy,omitempty"`         // 排序：revenue / count，默认 revenue
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`            // 返回条数，默认 10，最大 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceStats) Reset() {
-	*x = ServiceStats{}
-	mi := &file_billing_proto_msgTypes[18]
+func (x *ListTopConsumersRequest) Reset() {
+	*x = ListTopConsumersRequest{}
+	mi := &file_billing_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceStats) String() string {
+func (x *ListTopConsumersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceStats) ProtoMessage() {}
+func (*ListTopConsumersRequest) ProtoMessage() {}
 
-func (x *ServiceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[18]
+func (x *ListTopConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,71 +6560,137 @@ func (x *ServiceStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceStats.ProtoReflect.Descriptor instead.
-func (*ServiceStats) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListTopConsumersRequest.ProtoReflect.Descriptor instead.
+func (*ListTopConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *ServiceStats) GetServiceName() string {
+func (x *ListTopConsumersRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListTopConsumersRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListTopConsumersRequest) GetServiceName() string {
 	if x != nil {
 		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *ServiceStats) GetTotalCount() int32 {
+func (x *ListTopConsumersRequest) GetOrderBy() string {
 	if x != nil {
-		return x.TotalCount
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTopConsumersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *ServiceStats) GetTotalCost() float64 {
+// TopConsumer 消费排行条目
+type TopConsumer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	PaidCount     int64                  `protobuf:"varint,4,opt,name=paidCount,proto3" json:"paidCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopConsumer) Reset() {
+	*x = TopConsumer{}
+	mi := &file_billing_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopConsumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopConsumer) ProtoMessage() {}
+
+func (x *TopConsumer) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[96]
 	if x != nil {
-		return x.TotalCost
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopConsumer.ProtoReflect.Descriptor instead.
+func (*TopConsumer) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TopConsumer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopConsumer) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
 	}
 	return 0
 }
 
-func (x *ServiceStats) GetFreeCount() int32 {
+func (x *TopConsumer) GetTotalCount() int64 {
 	if x != nil {
-		return x.FreeCount
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *ServiceStats) GetPaidCount() int32 {
+func (x *TopConsumer) GetPaidCount() int64 {
 	if x != nil {
 		return x.PaidCount
 	}
 	return 0
 }
 
-type GetStatsSummaryReply struct {
+type ListTopConsumersReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"` // 所有服务总调用次数
-	TotalCost     float64                `protobuf:"fixed64,3,opt,name=totalCost,proto3" json:"totalCost,omitempty"`  // 所有服务总费用
-	Services      []*ServiceStats        `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`      // 各服务统计
+	Consumers     []*TopConsumer         `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsSummaryReply) Reset() {
-	*x = GetStatsSummaryReply{}
-	mi := &file_billing_proto_msgTypes[19]
+func (x *ListTopConsumersReply) Reset() {
+	*x = ListTopConsumersReply{}
+	mi := &file_billing_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsSummaryReply) String() string {
+func (x *ListTopConsumersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsSummaryReply) ProtoMessage() {}
+func (*ListTopConsumersReply) ProtoMessage() {}
 
-func (x *GetStatsSummaryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_billing_proto_msgTypes[19]
+func (x *ListTopConsumersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,35 +6701,118 @@ func (x *GetStatsSummaryReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsSummaryReply.ProtoReflect.Descriptor instead.
-func (*GetStatsSummaryReply) Descriptor() ([]byte, []int) {
-	return file_billing_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListTopConsumersReply.ProtoReflect.Descriptor instead.
+func (*ListTopConsumersReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetStatsSummaryReply) GetUserId() string {
+func (x *ListTopConsumersReply) GetConsumers() []*TopConsumer {
 	if x != nil {
-		return x.UserId
+		return x.Consumers
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStatsSummaryReply) GetTotalCount() int32 {
+type GetBalanceLiabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceLiabilityRequest) Reset() {
+	*x = GetBalanceLiabilityRequest{}
+	mi := &file_billing_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceLiabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceLiabilityRequest) ProtoMessage() {}
+
+func (x *GetBalanceLiabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[98]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceLiabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceLiabilityRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{98}
+}
+
+type GetBalanceLiabilityReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalBalance   float64                `protobuf:"fixed64,1,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`    // 全部用户余额合计（已落库部分，不含异步队列中尚未落库的扣费）
+	Accounts       int64                  `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`             // 账户总数
+	FundedAccounts int64                  `protobuf:"varint,3,opt,name=fundedAccounts,proto3" json:"fundedAccounts,omitempty"` // 余额大于 0 的账户数
+	AsOf           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=asOf,proto3" json:"asOf,omitempty"`                      // 统计时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBalanceLiabilityReply) Reset() {
+	*x = GetBalanceLiabilityReply{}
+	mi := &file_billing_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceLiabilityReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceLiabilityReply) ProtoMessage() {}
+
+func (x *GetBalanceLiabilityReply) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceLiabilityReply.ProtoReflect.Descriptor instead.
+func (*GetBalanceLiabilityReply) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetBalanceLiabilityReply) GetTotalBalance() float64 {
+	if x != nil {
+		return x.TotalBalance
 	}
 	return 0
 }
 
-func (x *GetStatsSummaryReply) GetTotalCost() float64 {
+func (x *GetBalanceLiabilityReply) GetAccounts() int64 {
 	if x != nil {
-		return x.TotalCost
+		return x.Accounts
 	}
 	return 0
 }
 
-func (x *GetStatsSummaryReply) GetServices() []*ServiceStats {
+func (x *GetBalanceLiabilityReply) GetFundedAccounts() int64 {
 	if x != nil {
-		return x.Services
+		return x.FundedAccounts
+	}
+	return 0
+}
+
+func (x *GetBalanceLiabilityReply) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
 	}
 	return nil
 }
@@ -1245,13 +6822,20 @@ var File_billing_proto protoreflect.FileDescriptor
 const file_billing_proto_rawDesc = "" +
 	"\n" +
 	"\rbilling.proto\x12\n" +
-	"billing.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"+\n" +
+	"billing.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\x11GetAccountRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\tR\x06userId\"r\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x03 \x01(\tR\bmemberId\"\xd5\x02\n" +
 	"\x0fGetAccountReply\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
 	"\abalance\x18\x02 \x01(\x01R\abalance\x12-\n" +
-	"\x06quotas\x18\x03 \x03(\v2\x15.billing.v1.FreeQuotaR\x06quotas\"\x8b\x01\n" +
+	"\x06quotas\x18\x03 \x03(\v2\x15.billing.v1.FreeQuotaR\x06quotas\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x123\n" +
+	"\bpackages\x18\x05 \x03(\v2\x17.billing.v1.UserPackageR\bpackages\x12-\n" +
+	"\x05rates\x18\x06 \x03(\v2\x17.billing.v1.ServiceRateR\x05rates\x122\n" +
+	"\tcontracts\x18\a \x03(\v2\x14.billing.v1.ContractR\tcontracts\x12-\n" +
+	"\x06member\x18\b \x01(\v2\x15.billing.v1.OrgMemberR\x06member\"\xe8\x02\n" +
 	"\tFreeQuota\x12 \n" +
 	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x1e\n" +
 	"\n" +
@@ -1260,61 +6844,172 @@ const file_billing_proto_rawDesc = "" +
 	"\tusedQuota\x18\x03 \x01(\x05R\tusedQuota\x12\x1e\n" +
 	"\n" +
 	"resetMonth\x18\x04 \x01(\tR\n" +
-	"resetMonth\"\x83\x01\n" +
+	"resetMonth\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05cycle\x18\x06 \x01(\tR\x05cycle\x12<\n" +
+	"\vperiodStart\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x128\n" +
+	"\tperiodEnd\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x129\n" +
+	"\brollover\x18\t \x01(\v2\x1d.billing.v1.FreeQuotaRolloverR\brollover\"w\n" +
+	"\x11FreeQuotaRollover\x12\x14\n" +
+	"\x05quota\x18\x01 \x01(\x05R\x05quota\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x05R\x04used\x128\n" +
+	"\texpiresAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x99\x01\n" +
 	"\x0fRechargeRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12$\n" +
 	"\rpaymentMethod\x18\x03 \x01(\tR\rpaymentMethod\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"Y\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05orgId\x18\x05 \x01(\tR\x05orgId\"Y\n" +
 	"\rRechargeReply\x12(\n" +
 	"\x0frechargeOrderId\x18\x01 \x01(\tR\x0frechargeOrderId\x12\x1e\n" +
 	"\n" +
 	"paymentUrl\x18\x02 \x01(\tR\n" +
-	"paymentUrl\"\\\n" +
+	"paymentUrl\"\xbc\x03\n" +
 	"\x12ListRecordsRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"]\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\trequestId\x18\x04 \x01(\tR\trequestId\x12 \n" +
+	"\vserviceName\x18\x05 \x01(\tR\vserviceName\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\x05R\x04type\x128\n" +
+	"\tstartTime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1c\n" +
+	"\tminAmount\x18\t \x01(\x01R\tminAmount\x12\x14\n" +
+	"\x05appId\x18\n" +
+	" \x01(\tR\x05appId\x12\x16\n" +
+	"\x06cursor\x18\v \x01(\tR\x06cursor\x12\x1c\n" +
+	"\twithTotal\x18\f \x01(\bR\twithTotal\x12\x14\n" +
+	"\x05orgId\x18\r \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x0e \x01(\tR\bmemberId\"\x97\x01\n" +
 	"\x10ListRecordsReply\x123\n" +
 	"\arecords\x18\x01 \x03(\v2\x19.billing.v1.BillingRecordR\arecords\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xbd\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x18\n" +
+	"\ahasMore\x18\x04 \x01(\bR\ahasMore\"\x91\x02\n" +
 	"\rBillingRecord\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
 	"\x05count\x18\x05 \x01(\x05R\x05count\x128\n" +
-	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"c\n" +
+	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x126\n" +
+	"\bmetadata\x18\a \x01(\v2\x1a.billing.v1.DeductMetadataR\bmetadata\x12\x1a\n" +
+	"\bmemberId\x18\b \x01(\tR\bmemberId\"\xf9\x01\n" +
+	"\x0eDeductMetadata\x12\x1c\n" +
+	"\trequestId\x18\x01 \x01(\tR\trequestId\x12\x1a\n" +
+	"\bapiKeyId\x18\x02 \x01(\tR\bapiKeyId\x12\x14\n" +
+	"\x05appId\x18\x03 \x01(\tR\x05appId\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12>\n" +
+	"\x06labels\x18\x05 \x03(\v2&.billing.v1.DeductMetadata.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"c\n" +
 	"\x11CheckQuotaRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
 	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"C\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"g\n" +
 	"\x0fCheckQuotaReply\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"x\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\"\n" +
+	"\fretryAfterMs\x18\x03 \x01(\x03R\fretryAfterMs\"\xc4\x01\n" +
 	"\x12DeductQuotaRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
 	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x14\n" +
 	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x12\n" +
-	"\x04cost\x18\x04 \x01(\x01R\x04cost\"H\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x126\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x1a.billing.v1.DeductMetadataR\bmetadata\"H\n" +
 	"\x10DeductQuotaReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
-	"\brecordId\x18\x02 \x01(\tR\brecordId\"\x91\x01\n" +
+	"\brecordId\x18\x02 \x01(\tR\brecordId\"k\n" +
+	"\tQuotaItem\x12 \n" +
+	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\"]\n" +
+	"\x16BatchCheckQuotaRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.billing.v1.QuotaItemR\x05items\"l\n" +
+	"\x14BatchCheckQuotaReply\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\"\n" +
+	"\fretryAfterMs\x18\x03 \x01(\x03R\fretryAfterMs\"\x96\x01\n" +
+	"\x17BatchDeductQuotaRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.billing.v1.QuotaItemR\x05items\x126\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1a.billing.v1.DeductMetadataR\bmetadata\"O\n" +
+	"\x15BatchDeductQuotaReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1c\n" +
+	"\trecordIds\x18\x02 \x03(\tR\trecordIds\"\xeb\x01\n" +
+	"\x13StreamDeductRequest\x12$\n" +
+	"\rcorrelationId\x18\x01 \x01(\tR\rcorrelationId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\x01R\x04cost\x126\n" +
+	"\bmetadata\x18\a \x01(\v2\x1a.billing.v1.DeductMetadataR\bmetadata\"\xb1\x01\n" +
+	"\x11StreamDeductReply\x12$\n" +
+	"\rcorrelationId\x18\x01 \x01(\tR\rcorrelationId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1a\n" +
+	"\brecordId\x18\x03 \x01(\tR\brecordId\x12\x1c\n" +
+	"\terrorCode\x18\x04 \x01(\x05R\terrorCode\x12\"\n" +
+	"\ferrorMessage\x18\x05 \x01(\tR\ferrorMessage\"\x85\x01\n" +
+	"\x13AcquireLeaseRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1e\n" +
+	"\n" +
+	"ttlSeconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"\xeb\x01\n" +
+	"\x11AcquireLeaseReply\x12\x18\n" +
+	"\aleaseId\x18\x01 \x01(\tR\aleaseId\x12\"\n" +
+	"\fgrantedCount\x18\x02 \x01(\x05R\fgrantedCount\x12\x1c\n" +
+	"\tfreeCount\x18\x03 \x01(\x05R\tfreeCount\x12\x1c\n" +
+	"\tpaidCount\x18\x04 \x01(\x05R\tpaidCount\x128\n" +
+	"\texpiresAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
+	"\fpackageCount\x18\x06 \x01(\x05R\fpackageCount\"\xa9\x01\n" +
+	"\x17ReportLeaseUsageRequest\x12\x18\n" +
+	"\aleaseId\x18\x01 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\tusedCount\x18\x02 \x01(\x05R\tusedCount\x12\x14\n" +
+	"\x05renew\x18\x03 \x01(\bR\x05renew\x12\x1e\n" +
+	"\n" +
+	"ttlSeconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\x12 \n" +
+	"\vserviceName\x18\x05 \x01(\tR\vserviceName\"y\n" +
+	"\x15ReportLeaseUsageReply\x12&\n" +
+	"\x0eremainingCount\x18\x01 \x01(\x05R\x0eremainingCount\x128\n" +
+	"\texpiresAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"o\n" +
+	"\x13ReleaseLeaseRequest\x12\x18\n" +
+	"\aleaseId\x18\x01 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\tusedCount\x18\x02 \x01(\x05R\tusedCount\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\"U\n" +
+	"\x11ReleaseLeaseReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
+	"\x0ereclaimedCount\x18\x02 \x01(\x05R\x0ereclaimedCount\"\x91\x01\n" +
 	"\x17RechargeCallbackRequest\x12(\n" +
 	"\x0frechargeOrderId\x18\x01 \x01(\tR\x0frechargeOrderId\x12\x1c\n" +
 	"\tpaymentId\x18\x02 \x01(\tR\tpaymentId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\"1\n" +
 	"\x15RechargeCallbackReply\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x82\x01\n" +
 	"\x14GetStatsTodayRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
-	"\vserviceName\x18\x02 \x01(\tR\vserviceName\"P\n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05orgId\x18\x03 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x04 \x01(\tR\bmemberId\"\x82\x01\n" +
 	"\x14GetStatsMonthRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
-	"\vserviceName\x18\x02 \x01(\tR\vserviceName\"0\n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05orgId\x18\x03 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x04 \x01(\tR\bmemberId\"b\n" +
 	"\x16GetStatsSummaryRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xdb\x01\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x03 \x01(\tR\bmemberId\"\xf7\x01\n" +
 	"\rGetStatsReply\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
 	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x1e\n" +
@@ -1324,7 +7019,8 @@ const file_billing_proto_rawDesc = "" +
 	"\ttotalCost\x18\x04 \x01(\x01R\ttotalCost\x12\x1c\n" +
 	"\tfreeCount\x18\x05 \x01(\x05R\tfreeCount\x12\x1c\n" +
 	"\tpaidCount\x18\x06 \x01(\x05R\tpaidCount\x12\x16\n" +
-	"\x06period\x18\a \x01(\tR\x06period\"\xaa\x01\n" +
+	"\x06period\x18\a \x01(\tR\x06period\x12\x1a\n" +
+	"\bmemberId\x18\b \x01(\tR\bmemberId\"\xaa\x01\n" +
 	"\fServiceStats\x12 \n" +
 	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x1e\n" +
 	"\n" +
@@ -1332,14 +7028,352 @@ const file_billing_proto_rawDesc = "" +
 	"totalCount\x12\x1c\n" +
 	"\ttotalCost\x18\x03 \x01(\x01R\ttotalCost\x12\x1c\n" +
 	"\tfreeCount\x18\x04 \x01(\x05R\tfreeCount\x12\x1c\n" +
-	"\tpaidCount\x18\x05 \x01(\x05R\tpaidCount\"\xa2\x01\n" +
+	"\tpaidCount\x18\x05 \x01(\x05R\tpaidCount\"\xbe\x01\n" +
 	"\x14GetStatsSummaryReply\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
 	"\n" +
 	"totalCount\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x1c\n" +
 	"\ttotalCost\x18\x03 \x01(\x01R\ttotalCost\x124\n" +
-	"\bservices\x18\x04 \x03(\v2\x18.billing.v1.ServiceStatsR\bservices2\xb8\x05\n" +
+	"\bservices\x18\x04 \x03(\v2\x18.billing.v1.ServiceStatsR\bservices\x12\x1a\n" +
+	"\bmemberId\x18\x05 \x01(\tR\bmemberId\"\xb1\x02\n" +
+	"\x15GetUsageSeriesRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x128\n" +
+	"\tstartTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vgranularity\x18\x05 \x01(\tR\vgranularity\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05orgId\x18\a \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\b \x01(\tR\bmemberId\"\xc0\x01\n" +
+	"\n" +
+	"UsagePoint\x128\n" +
+	"\tstartTime\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1c\n" +
+	"\ttotalCost\x18\x03 \x01(\x01R\ttotalCost\x12\x1c\n" +
+	"\tfreeCount\x18\x04 \x01(\x05R\tfreeCount\x12\x1c\n" +
+	"\tpaidCount\x18\x05 \x01(\x05R\tpaidCount\"\x89\x01\n" +
+	"\x13GetLiveUsageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\"\xd9\x01\n" +
+	"\x13GetUsageSeriesReply\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12.\n" +
+	"\x06points\x18\x05 \x03(\v2\x16.billing.v1.UsagePointR\x06points\x12\x1a\n" +
+	"\bmemberId\x18\x06 \x01(\tR\bmemberId\"\xb5\x01\n" +
+	"\x13CreateExportRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x128\n" +
+	"\tstartTime\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"B\n" +
+	"\x11CreateExportReply\x12-\n" +
+	"\x06export\x18\x01 \x01(\v2\x15.billing.v1.ExportJobR\x06export\"F\n" +
+	"\x10GetExportRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bexportId\x18\x02 \x01(\tR\bexportId\"?\n" +
+	"\x0eGetExportReply\x12-\n" +
+	"\x06export\x18\x01 \x01(\v2\x15.billing.v1.ExportJobR\x06export\"\xcd\x04\n" +
+	"\tExportJob\x12\x1a\n" +
+	"\bexportId\x18\x01 \x01(\tR\bexportId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x128\n" +
+	"\tstartTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1a\n" +
+	"\browCount\x18\x06 \x01(\x05R\browCount\x12\x1a\n" +
+	"\bfileSize\x18\a \x01(\x03R\bfileSize\x12 \n" +
+	"\vdownloadUrl\x18\b \x01(\tR\vdownloadUrl\x12N\n" +
+	"\x14downloadUrlExpiresAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x14downloadUrlExpiresAt\x12@\n" +
+	"\rfileExpiresAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rfileExpiresAt\x12\"\n" +
+	"\ferrorMessage\x18\v \x01(\tR\ferrorMessage\x128\n" +
+	"\tcreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\n" +
+	"finishedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\xa6\x01\n" +
+	"\x10SetBudgetRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\"\n" +
+	"\falertPercent\x18\x04 \x01(\x01R\falertPercent\x12\x1c\n" +
+	"\thardLimit\x18\x05 \x01(\bR\thardLimit\"<\n" +
+	"\x0eSetBudgetReply\x12*\n" +
+	"\x06budget\x18\x01 \x01(\v2\x12.billing.v1.BudgetR\x06budget\",\n" +
+	"\x12ListBudgetsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\x10ListBudgetsReply\x12,\n" +
+	"\abudgets\x18\x01 \x03(\v2\x12.billing.v1.BudgetR\abudgets\"O\n" +
+	"\x13DeleteBudgetRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\"-\n" +
+	"\x11DeleteBudgetReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x19SetAccountTimezoneRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"5\n" +
+	"\x17SetAccountTimezoneReply\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\"\x1b\n" +
+	"\x19ListPackageCatalogRequest\"O\n" +
+	"\x17ListPackageCatalogReply\x124\n" +
+	"\bpackages\x18\x01 \x03(\v2\x18.billing.v1.UsagePackageR\bpackages\"\xb6\x01\n" +
+	"\fUsagePackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x03R\x05units\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12 \n" +
+	"\vvalidMonths\x18\a \x01(\x05R\vvalidMonths\"\x90\x01\n" +
+	"\x16PurchasePackageRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tpackageId\x18\x02 \x01(\tR\tpackageId\x12$\n" +
+	"\rpaymentMethod\x18\x03 \x01(\tR\rpaymentMethod\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"P\n" +
+	"\x14PurchasePackageReply\x12\x18\n" +
+	"\aorderId\x18\x01 \x01(\tR\aorderId\x12\x1e\n" +
+	"\n" +
+	"paymentUrl\x18\x02 \x01(\tR\n" +
+	"paymentUrl\"{\n" +
+	"\x17ListUserPackagesRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12&\n" +
+	"\x0eincludeExpired\x18\x03 \x01(\bR\x0eincludeExpired\"L\n" +
+	"\x15ListUserPackagesReply\x123\n" +
+	"\bpackages\x18\x01 \x03(\v2\x17.billing.v1.UserPackageR\bpackages\"\xe5\x02\n" +
+	"\vUserPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tpackageId\x18\x02 \x01(\tR\tpackageId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vserviceName\x18\x04 \x01(\tR\vserviceName\x12\x1e\n" +
+	"\n" +
+	"totalUnits\x18\x05 \x01(\x03R\n" +
+	"totalUnits\x12\x1c\n" +
+	"\tusedUnits\x18\x06 \x01(\x03R\tusedUnits\x12&\n" +
+	"\x0eremainingUnits\x18\a \x01(\x03R\x0eremainingUnits\x128\n" +
+	"\texpiresAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\aorderId\x18\n" +
+	" \x01(\tR\aorderId\"\x8c\x01\n" +
+	"\fOrganization\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aownerId\x18\x03 \x01(\tR\aownerId\x128\n" +
+	"\tcreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd9\x01\n" +
+	"\tOrgMember\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x02 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"spendLimit\x18\x04 \x01(\x01R\n" +
+	"spendLimit\x12\x14\n" +
+	"\x05spent\x18\x05 \x01(\x01R\x05spent\x128\n" +
+	"\tcreatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"G\n" +
+	"\x19CreateOrganizationRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"F\n" +
+	"\x16GetOrganizationRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\"\x82\x01\n" +
+	"\x11OrganizationReply\x12<\n" +
+	"\forganization\x18\x01 \x01(\v2\x18.billing.v1.OrganizationR\forganization\x12/\n" +
+	"\amembers\x18\x02 \x03(\v2\x15.billing.v1.OrgMemberR\amembers\"\x93\x01\n" +
+	"\x13AddOrgMemberRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x03 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"spendLimit\x18\x05 \x01(\x01R\n" +
+	"spendLimit\"J\n" +
+	"\x1aAcceptOrgInvitationRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\"\x96\x01\n" +
+	"\x16UpdateOrgMemberRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x03 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"spendLimit\x18\x05 \x01(\x01R\n" +
+	"spendLimit\"b\n" +
+	"\x16RemoveOrgMemberRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\tR\x05orgId\x12\x1a\n" +
+	"\bmemberId\x18\x03 \x01(\tR\bmemberId\"0\n" +
+	"\x14RemoveOrgMemberReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x86\x02\n" +
+	"\x06Budget\x12 \n" +
+	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\"\n" +
+	"\falertPercent\x18\x03 \x01(\x01R\falertPercent\x12\x1c\n" +
+	"\thardLimit\x18\x04 \x01(\bR\thardLimit\x12\x16\n" +
+	"\x06period\x18\x05 \x01(\tR\x06period\x12\x14\n" +
+	"\x05spent\x18\x06 \x01(\x01R\x05spent\x12\x18\n" +
+	"\aalerted\x18\a \x01(\bR\aalerted\x128\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\rRateLimitRule\x12 \n" +
+	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x1c\n" +
+	"\tperSecond\x18\x02 \x01(\x03R\tperSecond\x12\x16\n" +
+	"\x06perDay\x18\x03 \x01(\x03R\x06perDay\"~\n" +
+	"\x17SetUserRateLimitRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\x127\n" +
+	"\toverrides\x18\x03 \x03(\v2\x19.billing.v1.RateLimitRuleR\toverrides\"1\n" +
+	"\x17GetUserRateLimitRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\xec\x01\n" +
+	"\x12UserRateLimitReply\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\x127\n" +
+	"\toverrides\x18\x03 \x03(\v2\x19.billing.v1.RateLimitRuleR\toverrides\x127\n" +
+	"\teffective\x18\x04 \x03(\v2\x19.billing.v1.RateLimitRuleR\teffective\x128\n" +
+	"\tupdatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbb\x01\n" +
+	"\vServiceRate\x12 \n" +
+	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x1c\n" +
+	"\tlistPrice\x18\x02 \x01(\x01R\tlistPrice\x12\x1c\n" +
+	"\tunitPrice\x18\x03 \x01(\x01R\tunitPrice\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x16\n" +
+	"\x06ruleId\x18\x05 \x01(\tR\x06ruleId\x12\x1e\n" +
+	"\n" +
+	"contractId\x18\x06 \x01(\tR\n" +
+	"contractId\"\xe3\x02\n" +
+	"\tPriceRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\x12\x1c\n" +
+	"\tunitPrice\x18\x04 \x01(\x01R\tunitPrice\x12(\n" +
+	"\x0fdiscountPercent\x18\x05 \x01(\x01R\x0fdiscountPercent\x12\x1e\n" +
+	"\n" +
+	"contractId\x18\x06 \x01(\tR\n" +
+	"contractId\x126\n" +
+	"\bstartsAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x122\n" +
+	"\x06endsAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x128\n" +
+	"\tcreatedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x86\x02\n" +
+	"\x16CreatePriceRuleRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x1c\n" +
+	"\tunitPrice\x18\x03 \x01(\x01R\tunitPrice\x12(\n" +
+	"\x0fdiscountPercent\x18\x04 \x01(\x01R\x0fdiscountPercent\x126\n" +
+	"\bstartsAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x122\n" +
+	"\x06endsAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"W\n" +
+	"\x15ListPriceRulesRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0eincludeExpired\x18\x02 \x01(\bR\x0eincludeExpired\"y\n" +
+	"\x13ListPriceRulesReply\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.billing.v1.PriceRuleR\x05rules\x125\n" +
+	"\teffective\x18\x02 \x03(\v2\x17.billing.v1.ServiceRateR\teffective\"H\n" +
+	"\x16DeletePriceRuleRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06ruleId\x18\x02 \x01(\tR\x06ruleId\"\x16\n" +
+	"\x14DeletePriceRuleReply\"x\n" +
+	"\fContractRate\x12 \n" +
+	"\vserviceName\x18\x01 \x01(\tR\vserviceName\x12\x1c\n" +
+	"\tunitPrice\x18\x02 \x01(\x01R\tunitPrice\x12(\n" +
+	"\x0fdiscountPercent\x18\x03 \x01(\x01R\x0fdiscountPercent\"\x94\x04\n" +
+	"\bContract\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\fcommitAmount\x18\x03 \x01(\x01R\fcommitAmount\x12 \n" +
+	"\vdrawnAmount\x18\x04 \x01(\x01R\vdrawnAmount\x12(\n" +
+	"\x0fremainingAmount\x18\x05 \x01(\x01R\x0fremainingAmount\x126\n" +
+	"\bstartsAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x122\n" +
+	"\x06endsAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\"\n" +
+	"\ftrueUpAmount\x18\t \x01(\x01R\ftrueUpAmount\x12$\n" +
+	"\rtrueUpCharged\x18\n" +
+	" \x01(\x01R\rtrueUpCharged\x128\n" +
+	"\tsettledAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\x128\n" +
+	"\tcreatedAt\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12.\n" +
+	"\x05rates\x18\r \x03(\v2\x18.billing.v1.ContractRateR\x05rates\"\xef\x01\n" +
+	"\x15CreateContractRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fcommitAmount\x18\x02 \x01(\x01R\fcommitAmount\x126\n" +
+	"\bstartsAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x122\n" +
+	"\x06endsAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12.\n" +
+	"\x05rates\x18\x05 \x03(\v2\x18.billing.v1.ContractRateR\x05rates\"V\n" +
+	"\x14ListContractsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0eincludeSettled\x18\x02 \x01(\bR\x0eincludeSettled\"H\n" +
+	"\x12ListContractsReply\x122\n" +
+	"\tcontracts\x18\x01 \x03(\v2\x14.billing.v1.ContractR\tcontracts\"\xf5\x01\n" +
+	"\x17GetRevenueReportRequest\x128\n" +
+	"\tstartTime\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\x12 \n" +
+	"\vserviceName\x18\x04 \x01(\tR\vserviceName\x12&\n" +
+	"\x0egroupByService\x18\x05 \x01(\bR\x0egroupByService\"\xe3\x01\n" +
+	"\vRevenueItem\x12<\n" +
+	"\vperiodStart\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12 \n" +
+	"\vserviceName\x18\x02 \x01(\tR\vserviceName\x12\x18\n" +
+	"\arevenue\x18\x03 \x01(\x01R\arevenue\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x04 \x01(\x03R\n" +
+	"totalCount\x12\x1c\n" +
+	"\tfreeCount\x18\x05 \x01(\x03R\tfreeCount\x12\x1c\n" +
+	"\tpaidCount\x18\x06 \x01(\x03R\tpaidCount\"\xe8\x01\n" +
+	"\x15GetRevenueReportReply\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.billing.v1.RevenueItemR\x05items\x12\"\n" +
+	"\ftotalRevenue\x18\x03 \x01(\x01R\ftotalRevenue\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x04 \x01(\x03R\n" +
+	"totalCount\x12\x1c\n" +
+	"\tfreeCount\x18\x05 \x01(\x03R\tfreeCount\x12\x1c\n" +
+	"\tpaidCount\x18\x06 \x01(\x03R\tpaidCount\"\xac\x01\n" +
+	"\x18GetRechargeReportRequest\x128\n" +
+	"\tstartTime\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vgranularity\x18\x03 \x01(\tR\vgranularity\"\xa2\x01\n" +
+	"\fRechargeItem\x12<\n" +
+	"\vperiodStart\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1e\n" +
+	"\n" +
+	"orderCount\x18\x03 \x01(\x03R\n" +
+	"orderCount\x12\x1c\n" +
+	"\tuserCount\x18\x04 \x01(\x03R\tuserCount\"\xce\x01\n" +
+	"\x16GetRechargeReportReply\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12.\n" +
+	"\x05items\x18\x02 \x03(\v2\x18.billing.v1.RechargeItemR\x05items\x12 \n" +
+	"\vtotalAmount\x18\x03 \x01(\x01R\vtotalAmount\x12 \n" +
+	"\vtotalOrders\x18\x04 \x01(\x03R\vtotalOrders\x12\x1e\n" +
+	"\n" +
+	"totalUsers\x18\x05 \x01(\x03R\n" +
+	"totalUsers\"\xb0\x01\n" +
+	"\x1cGetUserActivityReportRequest\x128\n" +
+	"\tstartTime\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\"\xb0\x01\n" +
+	"\x1aGetUserActivityReportReply\x12 \n" +
+	"\vactiveUsers\x18\x01 \x01(\x03R\vactiveUsers\x12 \n" +
+	"\vpayingUsers\x18\x02 \x01(\x03R\vpayingUsers\x12&\n" +
+	"\x0enewPayingUsers\x18\x03 \x01(\x03R\x0enewPayingUsers\x12&\n" +
+	"\x0econversionRate\x18\x04 \x01(\x01R\x0econversionRate\"\xdb\x01\n" +
+	"\x17ListTopConsumersRequest\x128\n" +
+	"\tstartTime\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\vserviceName\x18\x03 \x01(\tR\vserviceName\x12\x18\n" +
+	"\aorderBy\x18\x04 \x01(\tR\aorderBy\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"}\n" +
+	"\vTopConsumer\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\x01R\arevenue\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12\x1c\n" +
+	"\tpaidCount\x18\x04 \x01(\x03R\tpaidCount\"N\n" +
+	"\x15ListTopConsumersReply\x125\n" +
+	"\tconsumers\x18\x01 \x03(\v2\x17.billing.v1.TopConsumerR\tconsumers\"\x1c\n" +
+	"\x1aGetBalanceLiabilityRequest\"\xb2\x01\n" +
+	"\x18GetBalanceLiabilityReply\x12\"\n" +
+	"\ftotalBalance\x18\x01 \x01(\x01R\ftotalBalance\x12\x1a\n" +
+	"\baccounts\x18\x02 \x01(\x03R\baccounts\x12&\n" +
+	"\x0efundedAccounts\x18\x03 \x01(\x03R\x0efundedAccounts\x12.\n" +
+	"\x04asOf\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf2\xa0\x16\n" +
 	"\x0eBillingService\x12i\n" +
 	"\n" +
 	"GetAccount\x12\x1d.billing.v1.GetAccountRequest\x1a\x1b.billing.v1.GetAccountReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/billing/account\x12g\n" +
@@ -1347,12 +7381,48 @@ const file_billing_proto_rawDesc = "" +
 	"\vListRecords\x12\x1e.billing.v1.ListRecordsRequest\x1a\x1c.billing.v1.ListRecordsReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/billing/records\x12q\n" +
 	"\rGetStatsToday\x12 .billing.v1.GetStatsTodayRequest\x1a\x19.billing.v1.GetStatsReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/billing/stats/today\x12q\n" +
 	"\rGetStatsMonth\x12 .billing.v1.GetStatsMonthRequest\x1a\x19.billing.v1.GetStatsReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/billing/stats/month\x12~\n" +
-	"\x0fGetStatsSummary\x12\".billing.v1.GetStatsSummaryRequest\x1a .billing.v1.GetStatsSummaryReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/billing/stats/summary2\x85\x03\n" +
+	"\x0fGetStatsSummary\x12\".billing.v1.GetStatsSummaryRequest\x1a .billing.v1.GetStatsSummaryReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/billing/stats/summary\x12z\n" +
+	"\x0eGetUsageSeries\x12!.billing.v1.GetUsageSeriesRequest\x1a\x1f.billing.v1.GetUsageSeriesReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/billing/stats/series\x12t\n" +
+	"\fGetLiveUsage\x12\x1f.billing.v1.GetLiveUsageRequest\x1a\x1f.billing.v1.GetUsageSeriesReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/billing/stats/live\x12r\n" +
+	"\fCreateExport\x12\x1f.billing.v1.CreateExportRequest\x1a\x1d.billing.v1.CreateExportReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/billing/exports\x12q\n" +
+	"\tGetExport\x12\x1c.billing.v1.GetExportRequest\x1a\x1a.billing.v1.GetExportReply\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/billing/exports/{exportId}\x12i\n" +
+	"\tSetBudget\x12\x1c.billing.v1.SetBudgetRequest\x1a\x1a.billing.v1.SetBudgetReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/billing/budgets\x12l\n" +
+	"\vListBudgets\x12\x1e.billing.v1.ListBudgetsRequest\x1a\x1c.billing.v1.ListBudgetsReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/billing/budgets\x12o\n" +
+	"\fDeleteBudget\x12\x1f.billing.v1.DeleteBudgetRequest\x1a\x1d.billing.v1.DeleteBudgetReply\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/billing/budgets\x12\x8d\x01\n" +
+	"\x12SetAccountTimezone\x12%.billing.v1.SetAccountTimezoneRequest\x1a#.billing.v1.SetAccountTimezoneReply\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /api/v1/billing/account/timezone\x12\x8a\x01\n" +
+	"\x12ListPackageCatalog\x12%.billing.v1.ListPackageCatalogRequest\x1a#.billing.v1.ListPackageCatalogReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/billing/packages/catalog\x12\x85\x01\n" +
+	"\x0fPurchasePackage\x12\".billing.v1.PurchasePackageRequest\x1a .billing.v1.PurchasePackageReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/billing/packages/purchase\x12|\n" +
+	"\x10ListUserPackages\x12#.billing.v1.ListUserPackagesRequest\x1a!.billing.v1.ListUserPackagesReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/billing/packages\x12{\n" +
+	"\x12CreateOrganization\x12%.billing.v1.CreateOrganizationRequest\x1a\x1d.billing.v1.OrganizationReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/billing/orgs\x12z\n" +
+	"\x0fGetOrganization\x12\".billing.v1.GetOrganizationRequest\x1a\x1d.billing.v1.OrganizationReply\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/billing/orgs/{orgId}\x12w\n" +
+	"\fAddOrgMember\x12\x1f.billing.v1.AddOrgMemberRequest\x1a\x15.billing.v1.OrgMember\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/billing/orgs/{orgId}/members\x12\x8f\x01\n" +
+	"\x13AcceptOrgInvitation\x12&.billing.v1.AcceptOrgInvitationRequest\x1a\x15.billing.v1.OrgMember\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/billing/orgs/{orgId}/invitation/accept\x12\x88\x01\n" +
+	"\x0fUpdateOrgMember\x12\".billing.v1.UpdateOrgMemberRequest\x1a\x15.billing.v1.OrgMember\":\x82\xd3\xe4\x93\x024:\x01*\x1a//api/v1/billing/orgs/{orgId}/members/{memberId}\x12\x90\x01\n" +
+	"\x0fRemoveOrgMember\x12\".billing.v1.RemoveOrgMemberRequest\x1a .billing.v1.RemoveOrgMemberReply\"7\x82\xd3\xe4\x93\x021*//api/v1/billing/orgs/{orgId}/members/{memberId}2\xf4\b\n" +
 	"\x16BillingInternalService\x12o\n" +
 	"\n" +
 	"CheckQuota\x12\x1d.billing.v1.CheckQuotaRequest\x1a\x1b.billing.v1.CheckQuotaReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/internal/v1/billing/check\x12s\n" +
 	"\vDeductQuota\x12\x1e.billing.v1.DeductQuotaRequest\x1a\x1c.billing.v1.DeductQuotaReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/internal/v1/billing/deduct\x12\x84\x01\n" +
-	"\x10RechargeCallback\x12#.billing.v1.RechargeCallbackRequest\x1a!.billing.v1.RechargeCallbackReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/internal/v1/billing/callbackB#Z!billing-service/api/billing/v1;v1b\x06proto3"
+	"\x0fBatchCheckQuota\x12\".billing.v1.BatchCheckQuotaRequest\x1a .billing.v1.BatchCheckQuotaReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /internal/v1/billing/check/batch\x12\x88\x01\n" +
+	"\x10BatchDeductQuota\x12#.billing.v1.BatchDeductQuotaRequest\x1a!.billing.v1.BatchDeductQuotaReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/internal/v1/billing/deduct/batch\x12\x84\x01\n" +
+	"\x10RechargeCallback\x12#.billing.v1.RechargeCallbackRequest\x1a!.billing.v1.RechargeCallbackReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/internal/v1/billing/callback\x12R\n" +
+	"\fStreamDeduct\x12\x1f.billing.v1.StreamDeductRequest\x1a\x1d.billing.v1.StreamDeductReply(\x010\x01\x12}\n" +
+	"\fAcquireLease\x12\x1f.billing.v1.AcquireLeaseRequest\x1a\x1d.billing.v1.AcquireLeaseReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/internal/v1/billing/lease/acquire\x12\x88\x01\n" +
+	"\x10ReportLeaseUsage\x12#.billing.v1.ReportLeaseUsageRequest\x1a!.billing.v1.ReportLeaseUsageReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/internal/v1/billing/lease/report\x12}\n" +
+	"\fReleaseLease\x12\x1f.billing.v1.ReleaseLeaseRequest\x1a\x1d.billing.v1.ReleaseLeaseReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/internal/v1/billing/lease/release2\x8e\r\n" +
+	"\x13BillingAdminService\x12\x85\x01\n" +
+	"\x10GetRevenueReport\x12#.billing.v1.GetRevenueReportRequest\x1a!.billing.v1.GetRevenueReportReply\")\x82\xd3\xe4\x93\x02#\x12!/admin/v1/billing/reports/revenue\x12\x89\x01\n" +
+	"\x11GetRechargeReport\x12$.billing.v1.GetRechargeReportRequest\x1a\".billing.v1.GetRechargeReportReply\"*\x82\xd3\xe4\x93\x02$\x12\"/admin/v1/billing/reports/recharge\x12\x92\x01\n" +
+	"\x15GetUserActivityReport\x12(.billing.v1.GetUserActivityReportRequest\x1a&.billing.v1.GetUserActivityReportReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/admin/v1/billing/reports/users\x12\x8b\x01\n" +
+	"\x10ListTopConsumers\x12#.billing.v1.ListTopConsumersRequest\x1a!.billing.v1.ListTopConsumersReply\"/\x82\xd3\xe4\x93\x02)\x12'/admin/v1/billing/reports/top-consumers\x12\x90\x01\n" +
+	"\x13GetBalanceLiability\x12&.billing.v1.GetBalanceLiabilityRequest\x1a$.billing.v1.GetBalanceLiabilityReply\"+\x82\xd3\xe4\x93\x02%\x12#/admin/v1/billing/reports/liability\x12\x8a\x01\n" +
+	"\x10SetUserRateLimit\x12#.billing.v1.SetUserRateLimitRequest\x1a\x1e.billing.v1.UserRateLimitReply\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/admin/v1/billing/rate-limits/{userId}\x12\x87\x01\n" +
+	"\x10GetUserRateLimit\x12#.billing.v1.GetUserRateLimitRequest\x1a\x1e.billing.v1.UserRateLimitReply\".\x82\xd3\xe4\x93\x02(\x12&/admin/v1/billing/rate-limits/{userId}\x12\x7f\n" +
+	"\x0fCreatePriceRule\x12\".billing.v1.CreatePriceRuleRequest\x1a\x15.billing.v1.PriceRule\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/admin/v1/billing/price-rules/{userId}\x12\x84\x01\n" +
+	"\x0eListPriceRules\x12!.billing.v1.ListPriceRulesRequest\x1a\x1f.billing.v1.ListPriceRulesReply\".\x82\xd3\xe4\x93\x02(\x12&/admin/v1/billing/price-rules/{userId}\x12\x90\x01\n" +
+	"\x0fDeletePriceRule\x12\".billing.v1.DeletePriceRuleRequest\x1a .billing.v1.DeletePriceRuleReply\"7\x82\xd3\xe4\x93\x021*//admin/v1/billing/price-rules/{userId}/{ruleId}\x12z\n" +
+	"\x0eCreateContract\x12!.billing.v1.CreateContractRequest\x1a\x14.billing.v1.Contract\"/\x82\xd3\xe4\x93\x02):\x01*\"$/admin/v1/billing/contracts/{userId}\x12\x7f\n" +
+	"\rListContracts\x12 .billing.v1.ListContractsRequest\x1a\x1e.billing.v1.ListContractsReply\",\x82\xd3\xe4\x93\x02&\x12$/admin/v1/billing/contracts/{userId}B#Z!billing-service/api/billing/v1;v1b\x06proto3"
 
 var (
 	file_billing_proto_rawDescOnce sync.Once
@@ -1366,58 +7436,287 @@ func file_billing_proto_rawDescGZIP() []byte {
 	return file_billing_proto_rawDescData
 }
 
-var file_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_billing_proto_goTypes = []any{
-	(*GetAccountRequest)(nil),       // 0: billing.v1.GetAccountRequest
-	(*GetAccountReply)(nil),         // 1: billing.v1.GetAccountReply
-	(*FreeQuota)(nil),               // 2: billing.v1.FreeQuota
-	(*RechargeRequest)(nil),         // 3: billing.v1.RechargeRequest
-	(*RechargeReply)(nil),           // 4: billing.v1.RechargeReply
-	(*ListRecordsRequest)(nil),      // 5: billing.v1.ListRecordsRequest
-	(*ListRecordsReply)(nil),        // 6: billing.v1.ListRecordsReply
-	(*BillingRecord)(nil),           // 7: billing.v1.BillingRecord
-	(*CheckQuotaRequest)(nil),       // 8: billing.v1.CheckQuotaRequest
-	(*CheckQuotaReply)(nil),         // 9: billing.v1.CheckQuotaReply
-	(*DeductQuotaRequest)(nil),      // 10: billing.v1.DeductQuotaRequest
-	(*DeductQuotaReply)(nil),        // 11: billing.v1.DeductQuotaReply
-	(*RechargeCallbackRequest)(nil), // 12: billing.v1.RechargeCallbackRequest
-	(*RechargeCallbackReply)(nil),   // 13: billing.v1.RechargeCallbackReply
-	(*GetStatsTodayRequest)(nil),    // 14: billing.v1.GetStatsTodayRequest
-	(*GetStatsMonthRequest)(nil),    // 15: billing.v1.GetStatsMonthRequest
-	(*GetStatsSummaryRequest)(nil),  // 16: billing.v1.GetStatsSummaryRequest
-	(*GetStatsReply)(nil),           // 17: billing.v1.GetStatsReply
-	(*ServiceStats)(nil),            // 18: billing.v1.ServiceStats
-	(*GetStatsSummaryReply)(nil),    // 19: billing.v1.GetStatsSummaryReply
-	(*timestamppb.Timestamp)(nil),   // 20: google.protobuf.Timestamp
+	(*GetAccountRequest)(nil),            // 0: billing.v1.GetAccountRequest
+	(*GetAccountReply)(nil),              // 1: billing.v1.GetAccountReply
+	(*FreeQuota)(nil),                    // 2: billing.v1.FreeQuota
+	(*FreeQuotaRollover)(nil),            // 3: billing.v1.FreeQuotaRollover
+	(*RechargeRequest)(nil),              // 4: billing.v1.RechargeRequest
+	(*RechargeReply)(nil),                // 5: billing.v1.RechargeReply
+	(*ListRecordsRequest)(nil),           // 6: billing.v1.ListRecordsRequest
+	(*ListRecordsReply)(nil),             // 7: billing.v1.ListRecordsReply
+	(*BillingRecord)(nil),                // 8: billing.v1.BillingRecord
+	(*DeductMetadata)(nil),               // 9: billing.v1.DeductMetadata
+	(*CheckQuotaRequest)(nil),            // 10: billing.v1.CheckQuotaRequest
+	(*CheckQuotaReply)(nil),              // 11: billing.v1.CheckQuotaReply
+	(*DeductQuotaRequest)(nil),           // 12: billing.v1.DeductQuotaRequest
+	(*DeductQuotaReply)(nil),             // 13: billing.v1.DeductQuotaReply
+	(*QuotaItem)(nil),                    // 14: billing.v1.QuotaItem
+	(*BatchCheckQuotaRequest)(nil),       // 15: billing.v1.BatchCheckQuotaRequest
+	(*BatchCheckQuotaReply)(nil),         // 16: billing.v1.BatchCheckQuotaReply
+	(*BatchDeductQuotaRequest)(nil),      // 17: billing.v1.BatchDeductQuotaRequest
+	(*BatchDeductQuotaReply)(nil),        // 18: billing.v1.BatchDeductQuotaReply
+	(*StreamDeductRequest)(nil),          // 19: billing.v1.StreamDeductRequest
+	(*StreamDeductReply)(nil),            // 20: billing.v1.StreamDeductReply
+	(*AcquireLeaseRequest)(nil),          // 21: billing.v1.AcquireLeaseRequest
+	(*AcquireLeaseReply)(nil),            // 22: billing.v1.AcquireLeaseReply
+	(*ReportLeaseUsageRequest)(nil),      // 23: billing.v1.ReportLeaseUsageRequest
+	(*ReportLeaseUsageReply)(nil),        // 24: billing.v1.ReportLeaseUsageReply
+	(*ReleaseLeaseRequest)(nil),          // 25: billing.v1.ReleaseLeaseRequest
+	(*ReleaseLeaseReply)(nil),            // 26: billing.v1.ReleaseLeaseReply
+	(*RechargeCallbackRequest)(nil),      // 27: billing.v1.RechargeCallbackRequest
+	(*RechargeCallbackReply)(nil),        // 28: billing.v1.RechargeCallbackReply
+	(*GetStatsTodayRequest)(nil),         // 29: billing.v1.GetStatsTodayRequest
+	(*GetStatsMonthRequest)(nil),         // 30: billing.v1.GetStatsMonthRequest
+	(*GetStatsSummaryRequest)(nil),       // 31: billing.v1.GetStatsSummaryRequest
+	(*GetStatsReply)(nil),                // 32: billing.v1.GetStatsReply
+	(*ServiceStats)(nil),                 // 33: billing.v1.ServiceStats
+	(*GetStatsSummaryReply)(nil),         // 34: billing.v1.GetStatsSummaryReply
+	(*GetUsageSeriesRequest)(nil),        // 35: billing.v1.GetUsageSeriesRequest
+	(*UsagePoint)(nil),                   // 36: billing.v1.UsagePoint
+	(*GetLiveUsageRequest)(nil),          // 37: billing.v1.GetLiveUsageRequest
+	(*GetUsageSeriesReply)(nil),          // 38: billing.v1.GetUsageSeriesReply
+	(*CreateExportRequest)(nil),          // 39: billing.v1.CreateExportRequest
+	(*CreateExportReply)(nil),            // 40: billing.v1.CreateExportReply
+	(*GetExportRequest)(nil),             // 41: billing.v1.GetExportRequest
+	(*GetExportReply)(nil),               // 42: billing.v1.GetExportReply
+	(*ExportJob)(nil),                    // 43: billing.v1.ExportJob
+	(*SetBudgetRequest)(nil),             // 44: billing.v1.SetBudgetRequest
+	(*SetBudgetReply)(nil),               // 45: billing.v1.SetBudgetReply
+	(*ListBudgetsRequest)(nil),           // 46: billing.v1.ListBudgetsRequest
+	(*ListBudgetsReply)(nil),             // 47: billing.v1.ListBudgetsReply
+	(*DeleteBudgetRequest)(nil),          // 48: billing.v1.DeleteBudgetRequest
+	(*DeleteBudgetReply)(nil),            // 49: billing.v1.DeleteBudgetReply
+	(*SetAccountTimezoneRequest)(nil),    // 50: billing.v1.SetAccountTimezoneRequest
+	(*SetAccountTimezoneReply)(nil),      // 51: billing.v1.SetAccountTimezoneReply
+	(*ListPackageCatalogRequest)(nil),    // 52: billing.v1.ListPackageCatalogRequest
+	(*ListPackageCatalogReply)(nil),      // 53: billing.v1.ListPackageCatalogReply
+	(*UsagePackage)(nil),                 // 54: billing.v1.UsagePackage
+	(*PurchasePackageRequest)(nil),       // 55: billing.v1.PurchasePackageRequest
+	(*PurchasePackageReply)(nil),         // 56: billing.v1.PurchasePackageReply
+	(*ListUserPackagesRequest)(nil),      // 57: billing.v1.ListUserPackagesRequest
+	(*ListUserPackagesReply)(nil),        // 58: billing.v1.ListUserPackagesReply
+	(*UserPackage)(nil),                  // 59: billing.v1.UserPackage
+	(*Organization)(nil),                 // 60: billing.v1.Organization
+	(*OrgMember)(nil),                    // 61: billing.v1.OrgMember
+	(*CreateOrganizationRequest)(nil),    // 62: billing.v1.CreateOrganizationRequest
+	(*GetOrganizationRequest)(nil),       // 63: billing.v1.GetOrganizationRequest
+	(*OrganizationReply)(nil),            // 64: billing.v1.OrganizationReply
+	(*AddOrgMemberRequest)(nil),          // 65: billing.v1.AddOrgMemberRequest
+	(*AcceptOrgInvitationRequest)(nil),   // 66: billing.v1.AcceptOrgInvitationRequest
+	(*UpdateOrgMemberRequest)(nil),       // 67: billing.v1.UpdateOrgMemberRequest
+	(*RemoveOrgMemberRequest)(nil),       // 68: billing.v1.RemoveOrgMemberRequest
+	(*RemoveOrgMemberReply)(nil),         // 69: billing.v1.RemoveOrgMemberReply
+	(*Budget)(nil),                       // 70: billing.v1.Budget
+	(*RateLimitRule)(nil),                // 71: billing.v1.RateLimitRule
+	(*SetUserRateLimitRequest)(nil),      // 72: billing.v1.SetUserRateLimitRequest
+	(*GetUserRateLimitRequest)(nil),      // 73: billing.v1.GetUserRateLimitRequest
+	(*UserRateLimitReply)(nil),           // 74: billing.v1.UserRateLimitReply
+	(*ServiceRate)(nil),                  // 75: billing.v1.ServiceRate
+	(*PriceRule)(nil),                    // 76: billing.v1.PriceRule
+	(*CreatePriceRuleRequest)(nil),       // 77: billing.v1.CreatePriceRuleRequest
+	(*ListPriceRulesRequest)(nil),        // 78: billing.v1.ListPriceRulesRequest
+	(*ListPriceRulesReply)(nil),          // 79: billing.v1.ListPriceRulesReply
+	(*DeletePriceRuleRequest)(nil),       // 80: billing.v1.DeletePriceRuleRequest
+	(*DeletePriceRuleReply)(nil),         // 81: billing.v1.DeletePriceRuleReply
+	(*ContractRate)(nil),                 // 82: billing.v1.ContractRate
+	(*Contract)(nil),                     // 83: billing.v1.Contract
+	(*CreateContractRequest)(nil),        // 84: billing.v1.CreateContractRequest
+	(*ListContractsRequest)(nil),         // 85: billing.v1.ListContractsRequest
+	(*ListContractsReply)(nil),           // 86: billing.v1.ListContractsReply
+	(*GetRevenueReportRequest)(nil),      // 87: billing.v1.GetRevenueReportRequest
+	(*RevenueItem)(nil),                  // 88: billing.v1.RevenueItem
+	(*GetRevenueReportReply)(nil),        // 89: billing.v1.GetRevenueReportReply
+	(*GetRechargeReportRequest)(nil),     // 90: billing.v1.GetRechargeReportRequest
+	(*RechargeItem)(nil),                 // 91: billing.v1.RechargeItem
+	(*GetRechargeReportReply)(nil),       // 92: billing.v1.GetRechargeReportReply
+	(*GetUserActivityReportRequest)(nil), // 93: billing.v1.GetUserActivityReportRequest
+	(*GetUserActivityReportReply)(nil),   // 94: billing.v1.GetUserActivityReportReply
+	(*ListTopConsumersRequest)(nil),      // 95: billing.v1.ListTopConsumersRequest
+	(*TopConsumer)(nil),                  // 96: billing.v1.TopConsumer
+	(*ListTopConsumersReply)(nil),        // 97: billing.v1.ListTopConsumersReply
+	(*GetBalanceLiabilityRequest)(nil),   // 98: billing.v1.GetBalanceLiabilityRequest
+	(*GetBalanceLiabilityReply)(nil),     // 99: billing.v1.GetBalanceLiabilityReply
+	nil,                                  // 100: billing.v1.DeductMetadata.LabelsEntry
+	(*timestamppb.Timestamp)(nil),        // 101: google.protobuf.Timestamp
 }
 var file_billing_proto_depIdxs = []int32{
-	2,  // 0: billing.v1.GetAccountReply.quotas:type_name -> billing.v1.FreeQuota
-	7,  // 1: billing.v1.ListRecordsReply.records:type_name -> billing.v1.BillingRecord
-	20, // 2: billing.v1.BillingRecord.createdAt:type_name -> google.protobuf.Timestamp
-	18, // 3: billing.v1.GetStatsSummaryReply.services:type_name -> billing.v1.ServiceStats
-	0,  // 4: billing.v1.BillingService.GetAccount:input_type -> billing.v1.GetAccountRequest
-	3,  // 5: billing.v1.BillingService.Recharge:input_type -> billing.v1.RechargeRequest
-	5,  // 6: billing.v1.BillingService.ListRecords:input_type -> billing.v1.ListRecordsRequest
-	14, // 7: billing.v1.BillingService.GetStatsToday:input_type -> billing.v1.GetStatsTodayRequest
-	15, // 8: billing.v1.BillingService.GetStatsMonth:input_type -> billing.v1.GetStatsMonthRequest
-	16, // 9: billing.v1.BillingService.GetStatsSummary:input_type -> billing.v1.GetStatsSummaryRequest
-	8,  // 10: billing.v1.BillingInternalService.CheckQuota:input_type -> billing.v1.CheckQuotaRequest
-	10, // 11: billing.v1.BillingInternalService.DeductQuota:input_type -> billing.v1.DeductQuotaRequest
-	12, // 12: billing.v1.BillingInternalService.RechargeCallback:input_type -> billing.v1.RechargeCallbackRequest
-	1,  // 13: billing.v1.BillingService.GetAccount:output_type -> billing.v1.GetAccountReply
-	4,  // 14: billing.v1.BillingService.Recharge:output_type -> billing.v1.RechargeReply
-	6,  // 15: billing.v1.BillingService.ListRecords:output_type -> billing.v1.ListRecordsReply
-	17, // 16: billing.v1.BillingService.GetStatsToday:output_type -> billing.v1.GetStatsReply
-	17, // 17: billing.v1.BillingService.GetStatsMonth:output_type -> billing.v1.GetStatsReply
-	19, // 18: billing.v1.BillingService.GetStatsSummary:output_type -> billing.v1.GetStatsSummaryReply
-	9,  // 19: billing.v1.BillingInternalService.CheckQuota:output_type -> billing.v1.CheckQuotaReply
-	11, // 20: billing.v1.BillingInternalService.DeductQuota:output_type -> billing.v1.DeductQuotaReply
-	13, // 21: billing.v1.BillingInternalService.RechargeCallback:output_type -> billing.v1.RechargeCallbackReply
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	2,   // 0: billing.v1.GetAccountReply.quotas:type_name -> billing.v1.FreeQuota
+	59,  // 1: billing.v1.GetAccountReply.packages:type_name -> billing.v1.UserPackage
+	75,  // 2: billing.v1.GetAccountReply.rates:type_name -> billing.v1.ServiceRate
+	83,  // 3: billing.v1.GetAccountReply.contracts:type_name -> billing.v1.Contract
+	61,  // 4: billing.v1.GetAccountReply.member:type_name -> billing.v1.OrgMember
+	101, // 5: billing.v1.FreeQuota.periodStart:type_name -> google.protobuf.Timestamp
+	101, // 6: billing.v1.FreeQuota.periodEnd:type_name -> google.protobuf.Timestamp
+	3,   // 7: billing.v1.FreeQuota.rollover:type_name -> billing.v1.FreeQuotaRollover
+	101, // 8: billing.v1.FreeQuotaRollover.expiresAt:type_name -> google.protobuf.Timestamp
+	101, // 9: billing.v1.ListRecordsRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 10: billing.v1.ListRecordsRequest.endTime:type_name -> google.protobuf.Timestamp
+	8,   // 11: billing.v1.ListRecordsReply.records:type_name -> billing.v1.BillingRecord
+	101, // 12: billing.v1.BillingRecord.createdAt:type_name -> google.protobuf.Timestamp
+	9,   // 13: billing.v1.BillingRecord.metadata:type_name -> billing.v1.DeductMetadata
+	100, // 14: billing.v1.DeductMetadata.labels:type_name -> billing.v1.DeductMetadata.LabelsEntry
+	9,   // 15: billing.v1.DeductQuotaRequest.metadata:type_name -> billing.v1.DeductMetadata
+	14,  // 16: billing.v1.BatchCheckQuotaRequest.items:type_name -> billing.v1.QuotaItem
+	14,  // 17: billing.v1.BatchDeductQuotaRequest.items:type_name -> billing.v1.QuotaItem
+	9,   // 18: billing.v1.BatchDeductQuotaRequest.metadata:type_name -> billing.v1.DeductMetadata
+	9,   // 19: billing.v1.StreamDeductRequest.metadata:type_name -> billing.v1.DeductMetadata
+	101, // 20: billing.v1.AcquireLeaseReply.expiresAt:type_name -> google.protobuf.Timestamp
+	101, // 21: billing.v1.ReportLeaseUsageReply.expiresAt:type_name -> google.protobuf.Timestamp
+	33,  // 22: billing.v1.GetStatsSummaryReply.services:type_name -> billing.v1.ServiceStats
+	101, // 23: billing.v1.GetUsageSeriesRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 24: billing.v1.GetUsageSeriesRequest.endTime:type_name -> google.protobuf.Timestamp
+	101, // 25: billing.v1.UsagePoint.startTime:type_name -> google.protobuf.Timestamp
+	36,  // 26: billing.v1.GetUsageSeriesReply.points:type_name -> billing.v1.UsagePoint
+	101, // 27: billing.v1.CreateExportRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 28: billing.v1.CreateExportRequest.endTime:type_name -> google.protobuf.Timestamp
+	43,  // 29: billing.v1.CreateExportReply.export:type_name -> billing.v1.ExportJob
+	43,  // 30: billing.v1.GetExportReply.export:type_name -> billing.v1.ExportJob
+	101, // 31: billing.v1.ExportJob.startTime:type_name -> google.protobuf.Timestamp
+	101, // 32: billing.v1.ExportJob.endTime:type_name -> google.protobuf.Timestamp
+	101, // 33: billing.v1.ExportJob.downloadUrlExpiresAt:type_name -> google.protobuf.Timestamp
+	101, // 34: billing.v1.ExportJob.fileExpiresAt:type_name -> google.protobuf.Timestamp
+	101, // 35: billing.v1.ExportJob.createdAt:type_name -> google.protobuf.Timestamp
+	101, // 36: billing.v1.ExportJob.finishedAt:type_name -> google.protobuf.Timestamp
+	70,  // 37: billing.v1.SetBudgetReply.budget:type_name -> billing.v1.Budget
+	70,  // 38: billing.v1.ListBudgetsReply.budgets:type_name -> billing.v1.Budget
+	54,  // 39: billing.v1.ListPackageCatalogReply.packages:type_name -> billing.v1.UsagePackage
+	59,  // 40: billing.v1.ListUserPackagesReply.packages:type_name -> billing.v1.UserPackage
+	101, // 41: billing.v1.UserPackage.expiresAt:type_name -> google.protobuf.Timestamp
+	101, // 42: billing.v1.UserPackage.createdAt:type_name -> google.protobuf.Timestamp
+	101, // 43: billing.v1.Organization.createdAt:type_name -> google.protobuf.Timestamp
+	101, // 44: billing.v1.OrgMember.createdAt:type_name -> google.protobuf.Timestamp
+	60,  // 45: billing.v1.OrganizationReply.organization:type_name -> billing.v1.Organization
+	61,  // 46: billing.v1.OrganizationReply.members:type_name -> billing.v1.OrgMember
+	101, // 47: billing.v1.Budget.updatedAt:type_name -> google.protobuf.Timestamp
+	71,  // 48: billing.v1.SetUserRateLimitRequest.overrides:type_name -> billing.v1.RateLimitRule
+	71,  // 49: billing.v1.UserRateLimitReply.overrides:type_name -> billing.v1.RateLimitRule
+	71,  // 50: billing.v1.UserRateLimitReply.effective:type_name -> billing.v1.RateLimitRule
+	101, // 51: billing.v1.UserRateLimitReply.updatedAt:type_name -> google.protobuf.Timestamp
+	101, // 52: billing.v1.PriceRule.startsAt:type_name -> google.protobuf.Timestamp
+	101, // 53: billing.v1.PriceRule.endsAt:type_name -> google.protobuf.Timestamp
+	101, // 54: billing.v1.PriceRule.createdAt:type_name -> google.protobuf.Timestamp
+	101, // 55: billing.v1.CreatePriceRuleRequest.startsAt:type_name -> google.protobuf.Timestamp
+	101, // 56: billing.v1.CreatePriceRuleRequest.endsAt:type_name -> google.protobuf.Timestamp
+	76,  // 57: billing.v1.ListPriceRulesReply.rules:type_name -> billing.v1.PriceRule
+	75,  // 58: billing.v1.ListPriceRulesReply.effective:type_name -> billing.v1.ServiceRate
+	101, // 59: billing.v1.Contract.startsAt:type_name -> google.protobuf.Timestamp
+	101, // 60: billing.v1.Contract.endsAt:type_name -> google.protobuf.Timestamp
+	101, // 61: billing.v1.Contract.settledAt:type_name -> google.protobuf.Timestamp
+	101, // 62: billing.v1.Contract.createdAt:type_name -> google.protobuf.Timestamp
+	82,  // 63: billing.v1.Contract.rates:type_name -> billing.v1.ContractRate
+	101, // 64: billing.v1.CreateContractRequest.startsAt:type_name -> google.protobuf.Timestamp
+	101, // 65: billing.v1.CreateContractRequest.endsAt:type_name -> google.protobuf.Timestamp
+	82,  // 66: billing.v1.CreateContractRequest.rates:type_name -> billing.v1.ContractRate
+	83,  // 67: billing.v1.ListContractsReply.contracts:type_name -> billing.v1.Contract
+	101, // 68: billing.v1.GetRevenueReportRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 69: billing.v1.GetRevenueReportRequest.endTime:type_name -> google.protobuf.Timestamp
+	101, // 70: billing.v1.RevenueItem.periodStart:type_name -> google.protobuf.Timestamp
+	88,  // 71: billing.v1.GetRevenueReportReply.items:type_name -> billing.v1.RevenueItem
+	101, // 72: billing.v1.GetRechargeReportRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 73: billing.v1.GetRechargeReportRequest.endTime:type_name -> google.protobuf.Timestamp
+	101, // 74: billing.v1.RechargeItem.periodStart:type_name -> google.protobuf.Timestamp
+	91,  // 75: billing.v1.GetRechargeReportReply.items:type_name -> billing.v1.RechargeItem
+	101, // 76: billing.v1.GetUserActivityReportRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 77: billing.v1.GetUserActivityReportRequest.endTime:type_name -> google.protobuf.Timestamp
+	101, // 78: billing.v1.ListTopConsumersRequest.startTime:type_name -> google.protobuf.Timestamp
+	101, // 79: billing.v1.ListTopConsumersRequest.endTime:type_name -> google.protobuf.Timestamp
+	96,  // 80: billing.v1.ListTopConsumersReply.consumers:type_name -> billing.v1.TopConsumer
+	101, // 81: billing.v1.GetBalanceLiabilityReply.asOf:type_name -> google.protobuf.Timestamp
+	0,   // 82: billing.v1.BillingService.GetAccount:input_type -> billing.v1.GetAccountRequest
+	4,   // 83: billing.v1.BillingService.Recharge:input_type -> billing.v1.RechargeRequest
+	6,   // 84: billing.v1.BillingService.ListRecords:input_type -> billing.v1.ListRecordsRequest
+	29,  // 85: billing.v1.BillingService.GetStatsToday:input_type -> billing.v1.GetStatsTodayRequest
+	30,  // 86: billing.v1.BillingService.GetStatsMonth:input_type -> billing.v1.GetStatsMonthRequest
+	31,  // 87: billing.v1.BillingService.GetStatsSummary:input_type -> billing.v1.GetStatsSummaryRequest
+	35,  // 88: billing.v1.BillingService.GetUsageSeries:input_type -> billing.v1.GetUsageSeriesRequest
+	37,  // 89: billing.v1.BillingService.GetLiveUsage:input_type -> billing.v1.GetLiveUsageRequest
+	39,  // 90: billing.v1.BillingService.CreateExport:input_type -> billing.v1.CreateExportRequest
+	41,  // 91: billing.v1.BillingService.GetExport:input_type -> billing.v1.GetExportRequest
+	44,  // 92: billing.v1.BillingService.SetBudget:input_type -> billing.v1.SetBudgetRequest
+	46,  // 93: billing.v1.BillingService.ListBudgets:input_type -> billing.v1.ListBudgetsRequest
+	48,  // 94: billing.v1.BillingService.DeleteBudget:input_type -> billing.v1.DeleteBudgetRequest
+	50,  // 95: billing.v1.BillingService.SetAccountTimezone:input_type -> billing.v1.SetAccountTimezoneRequest
+	52,  // 96: billing.v1.BillingService.ListPackageCatalog:input_type -> billing.v1.ListPackageCatalogRequest
+	55,  // 97: billing.v1.BillingService.PurchasePackage:input_type -> billing.v1.PurchasePackageRequest
+	57,  // 98: billing.v1.BillingService.ListUserPackages:input_type -> billing.v1.ListUserPackagesRequest
+	62,  // 99: billing.v1.BillingService.CreateOrganization:input_type -> billing.v1.CreateOrganizationRequest
+	63,  // 100: billing.v1.BillingService.GetOrganization:input_type -> billing.v1.GetOrganizationRequest
+	65,  // 101: billing.v1.BillingService.AddOrgMember:input_type -> billing.v1.AddOrgMemberRequest
+	66,  // 102: billing.v1.BillingService.AcceptOrgInvitation:input_type -> billing.v1.AcceptOrgInvitationRequest
+	67,  // 103: billing.v1.BillingService.UpdateOrgMember:input_type -> billing.v1.UpdateOrgMemberRequest
+	68,  // 104: billing.v1.BillingService.RemoveOrgMember:input_type -> billing.v1.RemoveOrgMemberRequest
+	10,  // 105: billing.v1.BillingInternalService.CheckQuota:input_type -> billing.v1.CheckQuotaRequest
+	12,  // 106: billing.v1.BillingInternalService.DeductQuota:input_type -> billing.v1.DeductQuotaRequest
+	15,  // 107: billing.v1.BillingInternalService.BatchCheckQuota:input_type -> billing.v1.BatchCheckQuotaRequest
+	17,  // 108: billing.v1.BillingInternalService.BatchDeductQuota:input_type -> billing.v1.BatchDeductQuotaRequest
+	27,  // 109: billing.v1.BillingInternalService.RechargeCallback:input_type -> billing.v1.RechargeCallbackRequest
+	19,  // 110: billing.v1.BillingInternalService.StreamDeduct:input_type -> billing.v1.StreamDeductRequest
+	21,  // 111: billing.v1.BillingInternalService.AcquireLease:input_type -> billing.v1.AcquireLeaseRequest
+	23,  // 112: billing.v1.BillingInternalService.ReportLeaseUsage:input_type -> billing.v1.ReportLeaseUsageRequest
+	25,  // 113: billing.v1.BillingInternalService.ReleaseLease:input_type -> billing.v1.ReleaseLeaseRequest
+	87,  // 114: billing.v1.BillingAdminService.GetRevenueReport:input_type -> billing.v1.GetRevenueReportRequest
+	90,  // 115: billing.v1.BillingAdminService.GetRechargeReport:input_type -> billing.v1.GetRechargeReportRequest
+	93,  // 116: billing.v1.BillingAdminService.GetUserActivityReport:input_type -> billing.v1.GetUserActivityReportRequest
+	95,  // 117: billing.v1.BillingAdminService.ListTopConsumers:input_type -> billing.v1.ListTopConsumersRequest
+	98,  // 118: billing.v1.BillingAdminService.GetBalanceLiability:input_type -> billing.v1.GetBalanceLiabilityRequest
+	72,  // 119: billing.v1.BillingAdminService.SetUserRateLimit:input_type -> billing.v1.SetUserRateLimitRequest
+	73,  // 120: billing.v1.BillingAdminService.GetUserRateLimit:input_type -> billing.v1.GetUserRateLimitRequest
+	77,  // 121: billing.v1.BillingAdminService.CreatePriceRule:input_type -> billing.v1.CreatePriceRuleRequest
+	78,  // 122: billing.v1.BillingAdminService.ListPriceRules:input_type -> billing.v1.ListPriceRulesRequest
+	80,  // 123: billing.v1.BillingAdminService.DeletePriceRule:input_type -> billing.v1.DeletePriceRuleRequest
+	84,  // 124: billing.v1.BillingAdminService.CreateContract:input_type -> billing.v1.CreateContractRequest
+	85,  // 125: billing.v1.BillingAdminService.ListContracts:input_type -> billing.v1.ListContractsRequest
+	1,   // 126: billing.v1.BillingService.GetAccount:output_type -> billing.v1.GetAccountReply
+	5,   // 127: billing.v1.BillingService.Recharge:output_type -> billing.v1.RechargeReply
+	7,   // 128: billing.v1.BillingService.ListRecords:output_type -> billing.v1.ListRecordsReply
+	32,  // 129: billing.v1.BillingService.GetStatsToday:output_type -> billing.v1.GetStatsReply
+	32,  // 130: billing.v1.BillingService.GetStatsMonth:output_type -> billing.v1.GetStatsReply
+	34,  // 131: billing.v1.BillingService.GetStatsSummary:output_type -> billing.v1.GetStatsSummaryReply
+	38,  // 132: billing.v1.BillingService.GetUsageSeries:output_type -> billing.v1.GetUsageSeriesReply
+	38,  // 133: billing.v1.BillingService.GetLiveUsage:output_type -> billing.v1.GetUsageSeriesReply
+	40,  // 134: billing.v1.BillingService.CreateExport:output_type -> billing.v1.CreateExportReply
+	42,  // 135: billing.v1.BillingService.GetExport:output_type -> billing.v1.GetExportReply
+	45,  // 136: billing.v1.BillingService.SetBudget:output_type -> billing.v1.SetBudgetReply
+	47,  // 137: billing.v1.BillingService.ListBudgets:output_type -> billing.v1.ListBudgetsReply
+	49,  // 138: billing.v1.BillingService.DeleteBudget:output_type -> billing.v1.DeleteBudgetReply
+	51,  // 139: billing.v1.BillingService.SetAccountTimezone:output_type -> billing.v1.SetAccountTimezoneReply
+	53,  // 140: billing.v1.BillingService.ListPackageCatalog:output_type -> billing.v1.ListPackageCatalogReply
+	56,  // 141: billing.v1.BillingService.PurchasePackage:output_type -> billing.v1.PurchasePackageReply
+	58,  // 142: billing.v1.BillingService.ListUserPackages:output_type -> billing.v1.ListUserPackagesReply
+	64,  // 143: billing.v1.BillingService.CreateOrganization:output_type -> billing.v1.OrganizationReply
+	64,  // 144: billing.v1.BillingService.GetOrganization:output_type -> billing.v1.OrganizationReply
+	61,  // 145: billing.v1.BillingService.AddOrgMember:output_type -> billing.v1.OrgMember
+	61,  // 146: billing.v1.BillingService.AcceptOrgInvitation:output_type -> billing.v1.OrgMember
+	61,  // 147: billing.v1.BillingService.UpdateOrgMember:output_type -> billing.v1.OrgMember
+	69,  // 148: billing.v1.BillingService.RemoveOrgMember:output_type -> billing.v1.RemoveOrgMemberReply
+	11,  // 149: billing.v1.BillingInternalService.CheckQuota:output_type -> billing.v1.CheckQuotaReply
+	13,  // 150: billing.v1.BillingInternalService.DeductQuota:output_type -> billing.v1.DeductQuotaReply
+	16,  // 151: billing.v1.BillingInternalService.BatchCheckQuota:output_type -> billing.v1.BatchCheckQuotaReply
+	18,  // 152: billing.v1.BillingInternalService.BatchDeductQuota:output_type -> billing.v1.BatchDeductQuotaReply
+	28,  // 153: billing.v1.BillingInternalService.RechargeCallback:output_type -> billing.v1.RechargeCallbackReply
+	20,  // 154: billing.v1.BillingInternalService.StreamDeduct:output_type -> billing.v1.StreamDeductReply
+	22,  // 155: billing.v1.BillingInternalService.AcquireLease:output_type -> billing.v1.AcquireLeaseReply
+	24,  // 156: billing.v1.BillingInternalService.ReportLeaseUsage:output_type -> billing.v1.ReportLeaseUsageReply
+	26,  // 157: billing.v1.BillingInternalService.ReleaseLease:output_type -> billing.v1.ReleaseLeaseReply
+	89,  // 158: billing.v1.BillingAdminService.GetRevenueReport:output_type -> billing.v1.GetRevenueReportReply
+	92,  // 159: billing.v1.BillingAdminService.GetRechargeReport:output_type -> billing.v1.GetRechargeReportReply
+	94,  // 160: billing.v1.BillingAdminService.GetUserActivityReport:output_type -> billing.v1.GetUserActivityReportReply
+	97,  // 161: billing.v1.BillingAdminService.ListTopConsumers:output_type -> billing.v1.ListTopConsumersReply
+	99,  // 162: billing.v1.BillingAdminService.GetBalanceLiability:output_type -> billing.v1.GetBalanceLiabilityReply
+	74,  // 163: billing.v1.BillingAdminService.SetUserRateLimit:output_type -> billing.v1.UserRateLimitReply
+	74,  // 164: billing.v1.BillingAdminService.GetUserRateLimit:output_type -> billing.v1.UserRateLimitReply
+	76,  // 165: billing.v1.BillingAdminService.CreatePriceRule:output_type -> billing.v1.PriceRule
+	79,  // 166: billing.v1.BillingAdminService.ListPriceRules:output_type -> billing.v1.ListPriceRulesReply
+	81,  // 167: billing.v1.BillingAdminService.DeletePriceRule:output_type -> billing.v1.DeletePriceRuleReply
+	83,  // 168: billing.v1.BillingAdminService.CreateContract:output_type -> billing.v1.Contract
+	86,  // 169: billing.v1.BillingAdminService.ListContracts:output_type -> billing.v1.ListContractsReply
+	126, // [126:170] is the sub-list for method output_type
+	82,  // [82:126] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_billing_proto_init() }
@@ -1431,9 +7730,9 @@ func file_billing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_billing_proto_rawDesc), len(file_billing_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   101,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_billing_proto_goTypes,
 		DependencyIndexes: file_billing_proto_depIdxs,
@@ -59,6 +59,10 @@ func (m *GetAccountRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
 	if len(errors) > 0 {
 		return GetAccountRequestMultiError(errors)
 	}
@@ -199,6 +203,139 @@ func (m *GetAccountReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountReplyValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountReplyValidationError{
+					field:  fmt.Sprintf("Rates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetContracts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Contracts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountReplyValidationError{
+						field:  fmt.Sprintf("Contracts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountReplyValidationError{
+					field:  fmt.Sprintf("Contracts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountReplyValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountReplyValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetAccountReplyMultiError(errors)
 	}
@@ -307,6 +444,97 @@ func (m *FreeQuota) validate(all bool) error {
 
 	// no validation rules for ResetMonth
 
+	// no validation rules for Unit
+
+	// no validation rules for Cycle
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreeQuotaValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreeQuotaValidationError{
+				field:  "PeriodEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRollover()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "Rollover",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreeQuotaValidationError{
+					field:  "Rollover",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRollover()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreeQuotaValidationError{
+				field:  "Rollover",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return FreeQuotaMultiError(errors)
 	}
@@ -384,50 +612,75 @@ var _ interface {
 	ErrorName() string
 } = FreeQuotaValidationError{}
 
-// Validate checks the field values on RechargeRequest with the rules defined
+// Validate checks the field values on FreeQuotaRollover with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *RechargeRequest) Validate() error {
+func (m *FreeQuotaRollover) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RechargeRequest with the rules
+// ValidateAll checks the field values on FreeQuotaRollover with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RechargeRequestMultiError, or nil if none found.
-func (m *RechargeRequest) ValidateAll() error {
+// FreeQuotaRolloverMultiError, or nil if none found.
+func (m *FreeQuotaRollover) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RechargeRequest) validate(all bool) error {
+func (m *FreeQuotaRollover) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for Amount
+	// no validation rules for Quota
 
-	// no validation rules for PaymentMethod
+	// no validation rules for Used
 
-	// no validation rules for Currency
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreeQuotaRolloverValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreeQuotaRolloverValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreeQuotaRolloverValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RechargeRequestMultiError(errors)
+		return FreeQuotaRolloverMultiError(errors)
 	}
 
 	return nil
 }
 
-// RechargeRequestMultiError is an error wrapping multiple validation errors
-// returned by RechargeRequest.ValidateAll() if the designated constraints
+// FreeQuotaRolloverMultiError is an error wrapping multiple validation errors
+// returned by FreeQuotaRollover.ValidateAll() if the designated constraints
 // aren't met.
-type RechargeRequestMultiError []error
+type FreeQuotaRolloverMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RechargeRequestMultiError) Error() string {
+func (m FreeQuotaRolloverMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -436,11 +689,11 @@ func (m RechargeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RechargeRequestMultiError) AllErrors() []error { return m }
+func (m FreeQuotaRolloverMultiError) AllErrors() []error { return m }
 
-// RechargeRequestValidationError is the validation error returned by
-// RechargeRequest.Validate if the designated constraints aren't met.
-type RechargeRequestValidationError struct {
+// FreeQuotaRolloverValidationError is the validation error returned by
+// FreeQuotaRollover.Validate if the designated constraints aren't met.
+type FreeQuotaRolloverValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -448,22 +701,24 @@ type RechargeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RechargeRequestValidationError) Field() string { return e.field }
+func (e FreeQuotaRolloverValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RechargeRequestValidationError) Reason() string { return e.reason }
+func (e FreeQuotaRolloverValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RechargeRequestValidationError) Cause() error { return e.cause }
+func (e FreeQuotaRolloverValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RechargeRequestValidationError) Key() bool { return e.key }
+func (e FreeQuotaRolloverValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RechargeRequestValidationError) ErrorName() string { return "RechargeRequestValidationError" }
+func (e FreeQuotaRolloverValidationError) ErrorName() string {
+	return "FreeQuotaRolloverValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RechargeRequestValidationError) Error() string {
+func (e FreeQuotaRolloverValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -475,14 +730,14 @@ func (e RechargeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRechargeRequest.%s: %s%s",
+		"invalid %sFreeQuotaRollover.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RechargeRequestValidationError{}
+var _ error = FreeQuotaRolloverValidationError{}
 
 var _ interface {
 	Field() string
@@ -490,37 +745,147 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RechargeRequestValidationError{}
+} = FreeQuotaRolloverValidationError{}
 
-// Validate checks the field values on RechargeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RechargeReply) Validate() error {
+// Validate checks the field values on RechargeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RechargeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RechargeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RechargeReplyMultiError, or
-// nil if none found.
-func (m *RechargeReply) ValidateAll() error {
+// ValidateAll checks the field values on RechargeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RechargeRequestMultiError, or nil if none found.
+func (m *RechargeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RechargeReply) validate(all bool) error {
+func (m *RechargeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RechargeOrderId
+	// no validation rules for UserId
 
-	// no validation rules for PaymentUrl
+	// no validation rules for Amount
 
-	if len(errors) > 0 {
-		return RechargeReplyMultiError(errors)
-	}
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Currency
+
+	// no validation rules for OrgId
+
+	if len(errors) > 0 {
+		return RechargeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RechargeRequestMultiError is an error wrapping multiple validation errors
+// returned by RechargeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RechargeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RechargeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RechargeRequestMultiError) AllErrors() []error { return m }
+
+// RechargeRequestValidationError is the validation error returned by
+// RechargeRequest.Validate if the designated constraints aren't met.
+type RechargeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RechargeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RechargeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RechargeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RechargeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RechargeRequestValidationError) ErrorName() string { return "RechargeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RechargeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRechargeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RechargeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RechargeRequestValidationError{}
+
+// Validate checks the field values on RechargeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RechargeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RechargeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RechargeReplyMultiError, or
+// nil if none found.
+func (m *RechargeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RechargeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RechargeOrderId
+
+	// no validation rules for PaymentUrl
+
+	if len(errors) > 0 {
+		return RechargeReplyMultiError(errors)
+	}
 
 	return nil
 }
@@ -624,6 +989,82 @@ func (m *ListRecordsRequest) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	// no validation rules for RequestId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRecordsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRecordsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRecordsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListRecordsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListRecordsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListRecordsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MinAmount
+
+	// no validation rules for AppId
+
+	// no validation rules for Cursor
+
+	// no validation rules for WithTotal
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
 	if len(errors) > 0 {
 		return ListRecordsRequestMultiError(errors)
 	}
@@ -762,6 +1203,10 @@ func (m *ListRecordsReply) validate(all bool) error {
 
 	// no validation rules for Total
 
+	// no validation rules for NextCursor
+
+	// no validation rules for HasMore
+
 	if len(errors) > 0 {
 		return ListRecordsReplyMultiError(errors)
 	}
@@ -901,6 +1346,37 @@ func (m *BillingRecord) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BillingRecordValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BillingRecordValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BillingRecordValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MemberId
+
 	if len(errors) > 0 {
 		return BillingRecordMultiError(errors)
 	}
@@ -979,48 +1455,52 @@ var _ interface {
 	ErrorName() string
 } = BillingRecordValidationError{}
 
-// Validate checks the field values on CheckQuotaRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CheckQuotaRequest) Validate() error {
+// Validate checks the field values on DeductMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeductMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CheckQuotaRequestMultiError, or nil if none found.
-func (m *CheckQuotaRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeductMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeductMetadataMultiError,
+// or nil if none found.
+func (m *DeductMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckQuotaRequest) validate(all bool) error {
+func (m *DeductMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for RequestId
 
-	// no validation rules for ServiceName
+	// no validation rules for ApiKeyId
 
-	// no validation rules for Count
+	// no validation rules for AppId
+
+	// no validation rules for Operation
+
+	// no validation rules for Labels
 
 	if len(errors) > 0 {
-		return CheckQuotaRequestMultiError(errors)
+		return DeductMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckQuotaRequestMultiError is an error wrapping multiple validation errors
-// returned by CheckQuotaRequest.ValidateAll() if the designated constraints
+// DeductMetadataMultiError is an error wrapping multiple validation errors
+// returned by DeductMetadata.ValidateAll() if the designated constraints
 // aren't met.
-type CheckQuotaRequestMultiError []error
+type DeductMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckQuotaRequestMultiError) Error() string {
+func (m DeductMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1029,11 +1509,11 @@ func (m CheckQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckQuotaRequestMultiError) AllErrors() []error { return m }
+func (m DeductMetadataMultiError) AllErrors() []error { return m }
 
-// CheckQuotaRequestValidationError is the validation error returned by
-// CheckQuotaRequest.Validate if the designated constraints aren't met.
-type CheckQuotaRequestValidationError struct {
+// DeductMetadataValidationError is the validation error returned by
+// DeductMetadata.Validate if the designated constraints aren't met.
+type DeductMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1041,24 +1521,22 @@ type CheckQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckQuotaRequestValidationError) Field() string { return e.field }
+func (e DeductMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckQuotaRequestValidationError) Reason() string { return e.reason }
+func (e DeductMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckQuotaRequestValidationError) Cause() error { return e.cause }
+func (e DeductMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckQuotaRequestValidationError) Key() bool { return e.key }
+func (e DeductMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckQuotaRequestValidationError) ErrorName() string {
-	return "CheckQuotaRequestValidationError"
-}
+func (e DeductMetadataValidationError) ErrorName() string { return "DeductMetadataValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CheckQuotaRequestValidationError) Error() string {
+func (e DeductMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1070,14 +1548,14 @@ func (e CheckQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckQuotaRequest.%s: %s%s",
+		"invalid %sDeductMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckQuotaRequestValidationError{}
+var _ error = DeductMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -1085,45 +1563,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckQuotaRequestValidationError{}
+} = DeductMetadataValidationError{}
 
-// Validate checks the field values on CheckQuotaReply with the rules defined
+// Validate checks the field values on CheckQuotaRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CheckQuotaReply) Validate() error {
+func (m *CheckQuotaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckQuotaReply with the rules
+// ValidateAll checks the field values on CheckQuotaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckQuotaReplyMultiError, or nil if none found.
-func (m *CheckQuotaReply) ValidateAll() error {
+// CheckQuotaRequestMultiError, or nil if none found.
+func (m *CheckQuotaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckQuotaReply) validate(all bool) error {
+func (m *CheckQuotaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Allowed
+	// no validation rules for UserId
 
-	// no validation rules for Reason
+	// no validation rules for ServiceName
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return CheckQuotaReplyMultiError(errors)
+		return CheckQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckQuotaReplyMultiError is an error wrapping multiple validation errors
-// returned by CheckQuotaReply.ValidateAll() if the designated constraints
+// CheckQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckQuotaRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CheckQuotaReplyMultiError []error
+type CheckQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckQuotaRequestMultiError) AllErrors() []error { return m }
+
+// CheckQuotaRequestValidationError is the validation error returned by
+// CheckQuotaRequest.Validate if the designated constraints aren't met.
+type CheckQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckQuotaRequestValidationError) ErrorName() string {
+	return "CheckQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckQuotaRequestValidationError{}
+
+// Validate checks the field values on CheckQuotaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckQuotaReplyMultiError, or nil if none found.
+func (m *CheckQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for Reason
+
+	// no validation rules for RetryAfterMs
+
+	if len(errors) > 0 {
+		return CheckQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckQuotaReplyMultiError is an error wrapping multiple validation errors
+// returned by CheckQuotaReply.ValidateAll() if the designated constraints
+// aren't met.
+type CheckQuotaReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m CheckQuotaReplyMultiError) Error() string {
@@ -1221,6 +1809,37 @@ func (m *DeductQuotaRequest) validate(all bool) error {
 
 	// no validation rules for Cost
 
+	// no validation rules for Unit
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeductQuotaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeductQuotaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeductQuotaRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DeductQuotaRequestMultiError(errors)
 	}
@@ -1405,50 +2024,49 @@ var _ interface {
 	ErrorName() string
 } = DeductQuotaReplyValidationError{}
 
-// Validate checks the field values on RechargeCallbackRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RechargeCallbackRequest) Validate() error {
+// Validate checks the field values on QuotaItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RechargeCallbackRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RechargeCallbackRequestMultiError, or nil if none found.
-func (m *RechargeCallbackRequest) ValidateAll() error {
+// ValidateAll checks the field values on QuotaItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaItemMultiError, or nil
+// if none found.
+func (m *QuotaItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RechargeCallbackRequest) validate(all bool) error {
+func (m *QuotaItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RechargeOrderId
+	// no validation rules for ServiceName
 
-	// no validation rules for PaymentId
+	// no validation rules for Count
 
-	// no validation rules for Amount
+	// no validation rules for Unit
 
-	// no validation rules for Status
+	// no validation rules for Cost
 
 	if len(errors) > 0 {
-		return RechargeCallbackRequestMultiError(errors)
+		return QuotaItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// RechargeCallbackRequestMultiError is an error wrapping multiple validation
-// errors returned by RechargeCallbackRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RechargeCallbackRequestMultiError []error
+// QuotaItemMultiError is an error wrapping multiple validation errors returned
+// by QuotaItem.ValidateAll() if the designated constraints aren't met.
+type QuotaItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RechargeCallbackRequestMultiError) Error() string {
+func (m QuotaItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1457,11 +2075,11 @@ func (m RechargeCallbackRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RechargeCallbackRequestMultiError) AllErrors() []error { return m }
+func (m QuotaItemMultiError) AllErrors() []error { return m }
 
-// RechargeCallbackRequestValidationError is the validation error returned by
-// RechargeCallbackRequest.Validate if the designated constraints aren't met.
-type RechargeCallbackRequestValidationError struct {
+// QuotaItemValidationError is the validation error returned by
+// QuotaItem.Validate if the designated constraints aren't met.
+type QuotaItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1469,24 +2087,22 @@ type RechargeCallbackRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RechargeCallbackRequestValidationError) Field() string { return e.field }
+func (e QuotaItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RechargeCallbackRequestValidationError) Reason() string { return e.reason }
+func (e QuotaItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RechargeCallbackRequestValidationError) Cause() error { return e.cause }
+func (e QuotaItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RechargeCallbackRequestValidationError) Key() bool { return e.key }
+func (e QuotaItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RechargeCallbackRequestValidationError) ErrorName() string {
-	return "RechargeCallbackRequestValidationError"
-}
+func (e QuotaItemValidationError) ErrorName() string { return "QuotaItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RechargeCallbackRequestValidationError) Error() string {
+func (e QuotaItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1498,14 +2114,14 @@ func (e RechargeCallbackRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRechargeCallbackRequest.%s: %s%s",
+		"invalid %sQuotaItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RechargeCallbackRequestValidationError{}
+var _ error = QuotaItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1513,46 +2129,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RechargeCallbackRequestValidationError{}
+} = QuotaItemValidationError{}
 
-// Validate checks the field values on RechargeCallbackReply with the rules
+// Validate checks the field values on BatchCheckQuotaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RechargeCallbackReply) Validate() error {
+func (m *BatchCheckQuotaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RechargeCallbackReply with the rules
+// ValidateAll checks the field values on BatchCheckQuotaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RechargeCallbackReplyMultiError, or nil if none found.
-func (m *RechargeCallbackReply) ValidateAll() error {
+// BatchCheckQuotaRequestMultiError, or nil if none found.
+func (m *BatchCheckQuotaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RechargeCallbackReply) validate(all bool) error {
+func (m *BatchCheckQuotaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for UserId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckQuotaRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckQuotaRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckQuotaRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RechargeCallbackReplyMultiError(errors)
+		return BatchCheckQuotaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RechargeCallbackReplyMultiError is an error wrapping multiple validation
-// errors returned by RechargeCallbackReply.ValidateAll() if the designated
+// BatchCheckQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchCheckQuotaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RechargeCallbackReplyMultiError []error
+type BatchCheckQuotaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RechargeCallbackReplyMultiError) Error() string {
+func (m BatchCheckQuotaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1561,11 +2211,11 @@ func (m RechargeCallbackReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RechargeCallbackReplyMultiError) AllErrors() []error { return m }
+func (m BatchCheckQuotaRequestMultiError) AllErrors() []error { return m }
 
-// RechargeCallbackReplyValidationError is the validation error returned by
-// RechargeCallbackReply.Validate if the designated constraints aren't met.
-type RechargeCallbackReplyValidationError struct {
+// BatchCheckQuotaRequestValidationError is the validation error returned by
+// BatchCheckQuotaRequest.Validate if the designated constraints aren't met.
+type BatchCheckQuotaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1573,24 +2223,24 @@ type RechargeCallbackReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RechargeCallbackReplyValidationError) Field() string { return e.field }
+func (e BatchCheckQuotaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RechargeCallbackReplyValidationError) Reason() string { return e.reason }
+func (e BatchCheckQuotaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RechargeCallbackReplyValidationError) Cause() error { return e.cause }
+func (e BatchCheckQuotaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RechargeCallbackReplyValidationError) Key() bool { return e.key }
+func (e BatchCheckQuotaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RechargeCallbackReplyValidationError) ErrorName() string {
-	return "RechargeCallbackReplyValidationError"
+func (e BatchCheckQuotaRequestValidationError) ErrorName() string {
+	return "BatchCheckQuotaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RechargeCallbackReplyValidationError) Error() string {
+func (e BatchCheckQuotaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1602,14 +2252,14 @@ func (e RechargeCallbackReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRechargeCallbackReply.%s: %s%s",
+		"invalid %sBatchCheckQuotaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RechargeCallbackReplyValidationError{}
+var _ error = BatchCheckQuotaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1617,48 +2267,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RechargeCallbackReplyValidationError{}
+} = BatchCheckQuotaRequestValidationError{}
 
-// Validate checks the field values on GetStatsTodayRequest with the rules
+// Validate checks the field values on BatchCheckQuotaReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatsTodayRequest) Validate() error {
+func (m *BatchCheckQuotaReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatsTodayRequest with the rules
+// ValidateAll checks the field values on BatchCheckQuotaReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatsTodayRequestMultiError, or nil if none found.
-func (m *GetStatsTodayRequest) ValidateAll() error {
+// BatchCheckQuotaReplyMultiError, or nil if none found.
+func (m *BatchCheckQuotaReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatsTodayRequest) validate(all bool) error {
+func (m *BatchCheckQuotaReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Allowed
 
-	// no validation rules for ServiceName
+	// no validation rules for Reason
+
+	// no validation rules for RetryAfterMs
 
 	if len(errors) > 0 {
-		return GetStatsTodayRequestMultiError(errors)
+		return BatchCheckQuotaReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatsTodayRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStatsTodayRequest.ValidateAll() if the designated
+// BatchCheckQuotaReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchCheckQuotaReply.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatsTodayRequestMultiError []error
+type BatchCheckQuotaReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatsTodayRequestMultiError) Error() string {
+func (m BatchCheckQuotaReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1667,11 +2319,11 @@ func (m GetStatsTodayRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatsTodayRequestMultiError) AllErrors() []error { return m }
+func (m BatchCheckQuotaReplyMultiError) AllErrors() []error { return m }
 
-// GetStatsTodayRequestValidationError is the validation error returned by
-// GetStatsTodayRequest.Validate if the designated constraints aren't met.
-type GetStatsTodayRequestValidationError struct {
+// BatchCheckQuotaReplyValidationError is the validation error returned by
+// BatchCheckQuotaReply.Validate if the designated constraints aren't met.
+type BatchCheckQuotaReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1679,24 +2331,24 @@ type GetStatsTodayRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatsTodayRequestValidationError) Field() string { return e.field }
+func (e BatchCheckQuotaReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatsTodayRequestValidationError) Reason() string { return e.reason }
+func (e BatchCheckQuotaReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatsTodayRequestValidationError) Cause() error { return e.cause }
+func (e BatchCheckQuotaReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatsTodayRequestValidationError) Key() bool { return e.key }
+func (e BatchCheckQuotaReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatsTodayRequestValidationError) ErrorName() string {
-	return "GetStatsTodayRequestValidationError"
+func (e BatchCheckQuotaReplyValidationError) ErrorName() string {
+	return "BatchCheckQuotaReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatsTodayRequestValidationError) Error() string {
+func (e BatchCheckQuotaReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1708,14 +2360,14 @@ func (e GetStatsTodayRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatsTodayRequest.%s: %s%s",
+		"invalid %sBatchCheckQuotaReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatsTodayRequestValidationError{}
+var _ error = BatchCheckQuotaReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1723,48 +2375,10336 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatsTodayRequestValidationError{}
+} = BatchCheckQuotaReplyValidationError{}
 
-// Validate checks the field values on GetStatsMonthRequest with the rules
+// Validate checks the field values on BatchDeductQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeductQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeductQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeductQuotaRequestMultiError, or nil if none found.
+func (m *BatchDeductQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeductQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeductQuotaRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeductQuotaRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeductQuotaRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchDeductQuotaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchDeductQuotaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchDeductQuotaRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchDeductQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeductQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchDeductQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeductQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeductQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeductQuotaRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeductQuotaRequestValidationError is the validation error returned by
+// BatchDeductQuotaRequest.Validate if the designated constraints aren't met.
+type BatchDeductQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeductQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeductQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeductQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeductQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeductQuotaRequestValidationError) ErrorName() string {
+	return "BatchDeductQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeductQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeductQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeductQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeductQuotaRequestValidationError{}
+
+// Validate checks the field values on BatchDeductQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeductQuotaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeductQuotaReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeductQuotaReplyMultiError, or nil if none found.
+func (m *BatchDeductQuotaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeductQuotaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return BatchDeductQuotaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeductQuotaReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchDeductQuotaReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeductQuotaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeductQuotaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeductQuotaReplyMultiError) AllErrors() []error { return m }
+
+// BatchDeductQuotaReplyValidationError is the validation error returned by
+// BatchDeductQuotaReply.Validate if the designated constraints aren't met.
+type BatchDeductQuotaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeductQuotaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeductQuotaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeductQuotaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeductQuotaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeductQuotaReplyValidationError) ErrorName() string {
+	return "BatchDeductQuotaReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeductQuotaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeductQuotaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeductQuotaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeductQuotaReplyValidationError{}
+
+// Validate checks the field values on StreamDeductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamDeductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamDeductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamDeductRequestMultiError, or nil if none found.
+func (m *StreamDeductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamDeductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Count
+
+	// no validation rules for Unit
+
+	// no validation rules for Cost
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamDeductRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamDeductRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamDeductRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamDeductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamDeductRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamDeductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamDeductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamDeductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamDeductRequestMultiError) AllErrors() []error { return m }
+
+// StreamDeductRequestValidationError is the validation error returned by
+// StreamDeductRequest.Validate if the designated constraints aren't met.
+type StreamDeductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamDeductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamDeductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamDeductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamDeductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamDeductRequestValidationError) ErrorName() string {
+	return "StreamDeductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamDeductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamDeductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamDeductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamDeductRequestValidationError{}
+
+// Validate checks the field values on StreamDeductReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamDeductReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamDeductReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamDeductReplyMultiError, or nil if none found.
+func (m *StreamDeductReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamDeductReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for Success
+
+	// no validation rules for RecordId
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return StreamDeductReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamDeductReplyMultiError is an error wrapping multiple validation errors
+// returned by StreamDeductReply.ValidateAll() if the designated constraints
+// aren't met.
+type StreamDeductReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamDeductReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamDeductReplyMultiError) AllErrors() []error { return m }
+
+// StreamDeductReplyValidationError is the validation error returned by
+// StreamDeductReply.Validate if the designated constraints aren't met.
+type StreamDeductReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamDeductReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamDeductReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamDeductReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamDeductReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamDeductReplyValidationError) ErrorName() string {
+	return "StreamDeductReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamDeductReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamDeductReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamDeductReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamDeductReplyValidationError{}
+
+// Validate checks the field values on AcquireLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcquireLeaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcquireLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcquireLeaseRequestMultiError, or nil if none found.
+func (m *AcquireLeaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcquireLeaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Count
+
+	// no validation rules for TtlSeconds
+
+	if len(errors) > 0 {
+		return AcquireLeaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcquireLeaseRequestMultiError is an error wrapping multiple validation
+// errors returned by AcquireLeaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcquireLeaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcquireLeaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcquireLeaseRequestMultiError) AllErrors() []error { return m }
+
+// AcquireLeaseRequestValidationError is the validation error returned by
+// AcquireLeaseRequest.Validate if the designated constraints aren't met.
+type AcquireLeaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcquireLeaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcquireLeaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcquireLeaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcquireLeaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcquireLeaseRequestValidationError) ErrorName() string {
+	return "AcquireLeaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcquireLeaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcquireLeaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcquireLeaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcquireLeaseRequestValidationError{}
+
+// Validate checks the field values on AcquireLeaseReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AcquireLeaseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcquireLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcquireLeaseReplyMultiError, or nil if none found.
+func (m *AcquireLeaseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcquireLeaseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaseId
+
+	// no validation rules for GrantedCount
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcquireLeaseReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcquireLeaseReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcquireLeaseReplyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PackageCount
+
+	if len(errors) > 0 {
+		return AcquireLeaseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcquireLeaseReplyMultiError is an error wrapping multiple validation errors
+// returned by AcquireLeaseReply.ValidateAll() if the designated constraints
+// aren't met.
+type AcquireLeaseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcquireLeaseReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcquireLeaseReplyMultiError) AllErrors() []error { return m }
+
+// AcquireLeaseReplyValidationError is the validation error returned by
+// AcquireLeaseReply.Validate if the designated constraints aren't met.
+type AcquireLeaseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcquireLeaseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcquireLeaseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcquireLeaseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcquireLeaseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcquireLeaseReplyValidationError) ErrorName() string {
+	return "AcquireLeaseReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcquireLeaseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcquireLeaseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcquireLeaseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcquireLeaseReplyValidationError{}
+
+// Validate checks the field values on ReportLeaseUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportLeaseUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportLeaseUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportLeaseUsageRequestMultiError, or nil if none found.
+func (m *ReportLeaseUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportLeaseUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaseId
+
+	// no validation rules for UsedCount
+
+	// no validation rules for Renew
+
+	// no validation rules for TtlSeconds
+
+	// no validation rules for ServiceName
+
+	if len(errors) > 0 {
+		return ReportLeaseUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportLeaseUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportLeaseUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportLeaseUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportLeaseUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportLeaseUsageRequestMultiError) AllErrors() []error { return m }
+
+// ReportLeaseUsageRequestValidationError is the validation error returned by
+// ReportLeaseUsageRequest.Validate if the designated constraints aren't met.
+type ReportLeaseUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportLeaseUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportLeaseUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportLeaseUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportLeaseUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportLeaseUsageRequestValidationError) ErrorName() string {
+	return "ReportLeaseUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportLeaseUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportLeaseUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportLeaseUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportLeaseUsageRequestValidationError{}
+
+// Validate checks the field values on ReportLeaseUsageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportLeaseUsageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportLeaseUsageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportLeaseUsageReplyMultiError, or nil if none found.
+func (m *ReportLeaseUsageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportLeaseUsageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RemainingCount
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportLeaseUsageReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportLeaseUsageReplyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportLeaseUsageReplyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportLeaseUsageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportLeaseUsageReplyMultiError is an error wrapping multiple validation
+// errors returned by ReportLeaseUsageReply.ValidateAll() if the designated
+// constraints aren't met.
+type ReportLeaseUsageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportLeaseUsageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportLeaseUsageReplyMultiError) AllErrors() []error { return m }
+
+// ReportLeaseUsageReplyValidationError is the validation error returned by
+// ReportLeaseUsageReply.Validate if the designated constraints aren't met.
+type ReportLeaseUsageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportLeaseUsageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportLeaseUsageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportLeaseUsageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportLeaseUsageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportLeaseUsageReplyValidationError) ErrorName() string {
+	return "ReportLeaseUsageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportLeaseUsageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportLeaseUsageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportLeaseUsageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportLeaseUsageReplyValidationError{}
+
+// Validate checks the field values on ReleaseLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseLeaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseLeaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseLeaseRequestMultiError, or nil if none found.
+func (m *ReleaseLeaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseLeaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaseId
+
+	// no validation rules for UsedCount
+
+	// no validation rules for ServiceName
+
+	if len(errors) > 0 {
+		return ReleaseLeaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseLeaseRequestMultiError is an error wrapping multiple validation
+// errors returned by ReleaseLeaseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReleaseLeaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseLeaseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseLeaseRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseLeaseRequestValidationError is the validation error returned by
+// ReleaseLeaseRequest.Validate if the designated constraints aren't met.
+type ReleaseLeaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseLeaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseLeaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseLeaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseLeaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseLeaseRequestValidationError) ErrorName() string {
+	return "ReleaseLeaseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseLeaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseLeaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseLeaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseLeaseRequestValidationError{}
+
+// Validate checks the field values on ReleaseLeaseReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseLeaseReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseLeaseReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseLeaseReplyMultiError, or nil if none found.
+func (m *ReleaseLeaseReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseLeaseReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for ReclaimedCount
+
+	if len(errors) > 0 {
+		return ReleaseLeaseReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseLeaseReplyMultiError is an error wrapping multiple validation errors
+// returned by ReleaseLeaseReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseLeaseReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseLeaseReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseLeaseReplyMultiError) AllErrors() []error { return m }
+
+// ReleaseLeaseReplyValidationError is the validation error returned by
+// ReleaseLeaseReply.Validate if the designated constraints aren't met.
+type ReleaseLeaseReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseLeaseReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseLeaseReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseLeaseReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseLeaseReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseLeaseReplyValidationError) ErrorName() string {
+	return "ReleaseLeaseReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseLeaseReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseLeaseReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseLeaseReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseLeaseReplyValidationError{}
+
+// Validate checks the field values on RechargeCallbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RechargeCallbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RechargeCallbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RechargeCallbackRequestMultiError, or nil if none found.
+func (m *RechargeCallbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RechargeCallbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RechargeOrderId
+
+	// no validation rules for PaymentId
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RechargeCallbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RechargeCallbackRequestMultiError is an error wrapping multiple validation
+// errors returned by RechargeCallbackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RechargeCallbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RechargeCallbackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RechargeCallbackRequestMultiError) AllErrors() []error { return m }
+
+// RechargeCallbackRequestValidationError is the validation error returned by
+// RechargeCallbackRequest.Validate if the designated constraints aren't met.
+type RechargeCallbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RechargeCallbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RechargeCallbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RechargeCallbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RechargeCallbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RechargeCallbackRequestValidationError) ErrorName() string {
+	return "RechargeCallbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RechargeCallbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRechargeCallbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RechargeCallbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RechargeCallbackRequestValidationError{}
+
+// Validate checks the field values on RechargeCallbackReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RechargeCallbackReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RechargeCallbackReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RechargeCallbackReplyMultiError, or nil if none found.
+func (m *RechargeCallbackReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RechargeCallbackReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RechargeCallbackReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RechargeCallbackReplyMultiError is an error wrapping multiple validation
+// errors returned by RechargeCallbackReply.ValidateAll() if the designated
+// constraints aren't met.
+type RechargeCallbackReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RechargeCallbackReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RechargeCallbackReplyMultiError) AllErrors() []error { return m }
+
+// RechargeCallbackReplyValidationError is the validation error returned by
+// RechargeCallbackReply.Validate if the designated constraints aren't met.
+type RechargeCallbackReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RechargeCallbackReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RechargeCallbackReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RechargeCallbackReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RechargeCallbackReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RechargeCallbackReplyValidationError) ErrorName() string {
+	return "RechargeCallbackReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RechargeCallbackReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRechargeCallbackReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RechargeCallbackReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RechargeCallbackReplyValidationError{}
+
+// Validate checks the field values on GetStatsTodayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStatsTodayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatsTodayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatsTodayRequestMultiError, or nil if none found.
+func (m *GetStatsTodayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatsTodayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetStatsTodayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatsTodayRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatsTodayRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatsTodayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatsTodayRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatsTodayRequestMultiError) AllErrors() []error { return m }
+
+// GetStatsTodayRequestValidationError is the validation error returned by
+// GetStatsTodayRequest.Validate if the designated constraints aren't met.
+type GetStatsTodayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatsTodayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatsTodayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatsTodayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatsTodayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatsTodayRequestValidationError) ErrorName() string {
+	return "GetStatsTodayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatsTodayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatsTodayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatsTodayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatsTodayRequestValidationError{}
+
+// Validate checks the field values on GetStatsMonthRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
 func (m *GetStatsMonthRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatsMonthRequest with the rules
+// ValidateAll checks the field values on GetStatsMonthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatsMonthRequestMultiError, or nil if none found.
+func (m *GetStatsMonthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatsMonthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetStatsMonthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatsMonthRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatsMonthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatsMonthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatsMonthRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatsMonthRequestMultiError) AllErrors() []error { return m }
+
+// GetStatsMonthRequestValidationError is the validation error returned by
+// GetStatsMonthRequest.Validate if the designated constraints aren't met.
+type GetStatsMonthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatsMonthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatsMonthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatsMonthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatsMonthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatsMonthRequestValidationError) ErrorName() string {
+	return "GetStatsMonthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatsMonthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatsMonthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatsMonthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatsMonthRequestValidationError{}
+
+// Validate checks the field values on GetStatsSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStatsSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatsSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatsSummaryRequestMultiError, or nil if none found.
+func (m *GetStatsSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatsSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetStatsSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatsSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatsSummaryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatsSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatsSummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatsSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetStatsSummaryRequestValidationError is the validation error returned by
+// GetStatsSummaryRequest.Validate if the designated constraints aren't met.
+type GetStatsSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatsSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatsSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatsSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatsSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatsSummaryRequestValidationError) ErrorName() string {
+	return "GetStatsSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatsSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatsSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatsSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatsSummaryRequestValidationError{}
+
+// Validate checks the field values on GetStatsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetStatsReplyMultiError, or
+// nil if none found.
+func (m *GetStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for TotalCount
+
+	// no validation rules for TotalCost
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	// no validation rules for Period
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatsReplyMultiError is an error wrapping multiple validation errors
+// returned by GetStatsReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetStatsReplyValidationError is the validation error returned by
+// GetStatsReply.Validate if the designated constraints aren't met.
+type GetStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatsReplyValidationError) ErrorName() string { return "GetStatsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatsReplyValidationError{}
+
+// Validate checks the field values on ServiceStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceStatsMultiError, or
+// nil if none found.
+func (m *ServiceStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for TotalCount
+
+	// no validation rules for TotalCost
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	if len(errors) > 0 {
+		return ServiceStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceStatsMultiError is an error wrapping multiple validation errors
+// returned by ServiceStats.ValidateAll() if the designated constraints aren't met.
+type ServiceStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceStatsMultiError) AllErrors() []error { return m }
+
+// ServiceStatsValidationError is the validation error returned by
+// ServiceStats.Validate if the designated constraints aren't met.
+type ServiceStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceStatsValidationError) ErrorName() string { return "ServiceStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceStatsValidationError{}
+
+// Validate checks the field values on GetStatsSummaryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStatsSummaryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStatsSummaryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStatsSummaryReplyMultiError, or nil if none found.
+func (m *GetStatsSummaryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStatsSummaryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TotalCount
+
+	// no validation rules for TotalCost
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStatsSummaryReplyValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStatsSummaryReplyValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStatsSummaryReplyValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetStatsSummaryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStatsSummaryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetStatsSummaryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetStatsSummaryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStatsSummaryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStatsSummaryReplyMultiError) AllErrors() []error { return m }
+
+// GetStatsSummaryReplyValidationError is the validation error returned by
+// GetStatsSummaryReply.Validate if the designated constraints aren't met.
+type GetStatsSummaryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStatsSummaryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStatsSummaryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStatsSummaryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStatsSummaryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStatsSummaryReplyValidationError) ErrorName() string {
+	return "GetStatsSummaryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStatsSummaryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStatsSummaryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStatsSummaryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStatsSummaryReplyValidationError{}
+
+// Validate checks the field values on GetUsageSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageSeriesRequestMultiError, or nil if none found.
+func (m *GetUsageSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsageSeriesRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsageSeriesRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsageSeriesRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsageSeriesRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsageSeriesRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsageSeriesRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Granularity
+
+	// no validation rules for Timezone
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetUsageSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsageSeriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsageSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageSeriesRequestMultiError) AllErrors() []error { return m }
+
+// GetUsageSeriesRequestValidationError is the validation error returned by
+// GetUsageSeriesRequest.Validate if the designated constraints aren't met.
+type GetUsageSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageSeriesRequestValidationError) ErrorName() string {
+	return "GetUsageSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageSeriesRequestValidationError{}
+
+// Validate checks the field values on UsagePoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsagePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsagePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsagePointMultiError, or
+// nil if none found.
+func (m *UsagePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsagePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsagePointValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsagePointValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsagePointValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TotalCount
+
+	// no validation rules for TotalCost
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	if len(errors) > 0 {
+		return UsagePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsagePointMultiError is an error wrapping multiple validation errors
+// returned by UsagePoint.ValidateAll() if the designated constraints aren't met.
+type UsagePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsagePointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsagePointMultiError) AllErrors() []error { return m }
+
+// UsagePointValidationError is the validation error returned by
+// UsagePoint.Validate if the designated constraints aren't met.
+type UsagePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsagePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsagePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsagePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsagePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsagePointValidationError) ErrorName() string { return "UsagePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsagePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsagePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsagePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsagePointValidationError{}
+
+// Validate checks the field values on GetLiveUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLiveUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLiveUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLiveUsageRequestMultiError, or nil if none found.
+func (m *GetLiveUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLiveUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Granularity
+
+	// no validation rules for Points
+
+	if len(errors) > 0 {
+		return GetLiveUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLiveUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLiveUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLiveUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLiveUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLiveUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetLiveUsageRequestValidationError is the validation error returned by
+// GetLiveUsageRequest.Validate if the designated constraints aren't met.
+type GetLiveUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLiveUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLiveUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLiveUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLiveUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLiveUsageRequestValidationError) ErrorName() string {
+	return "GetLiveUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLiveUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLiveUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLiveUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLiveUsageRequestValidationError{}
+
+// Validate checks the field values on GetUsageSeriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageSeriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageSeriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageSeriesReplyMultiError, or nil if none found.
+func (m *GetUsageSeriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageSeriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Granularity
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsageSeriesReplyValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsageSeriesReplyValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsageSeriesReplyValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetUsageSeriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageSeriesReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUsageSeriesReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUsageSeriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageSeriesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageSeriesReplyMultiError) AllErrors() []error { return m }
+
+// GetUsageSeriesReplyValidationError is the validation error returned by
+// GetUsageSeriesReply.Validate if the designated constraints aren't met.
+type GetUsageSeriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageSeriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageSeriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageSeriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageSeriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageSeriesReplyValidationError) ErrorName() string {
+	return "GetUsageSeriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUsageSeriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageSeriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageSeriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageSeriesReplyValidationError{}
+
+// Validate checks the field values on CreateExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateExportRequestMultiError, or nil if none found.
+func (m *CreateExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Format
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateExportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExportRequestMultiError) AllErrors() []error { return m }
+
+// CreateExportRequestValidationError is the validation error returned by
+// CreateExportRequest.Validate if the designated constraints aren't met.
+type CreateExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExportRequestValidationError) ErrorName() string {
+	return "CreateExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExportRequestValidationError{}
+
+// Validate checks the field values on CreateExportReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateExportReplyMultiError, or nil if none found.
+func (m *CreateExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExportReplyValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExportReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateExportReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExportReplyMultiError) AllErrors() []error { return m }
+
+// CreateExportReplyValidationError is the validation error returned by
+// CreateExportReply.Validate if the designated constraints aren't met.
+type CreateExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExportReplyValidationError) ErrorName() string {
+	return "CreateExportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExportReplyValidationError{}
+
+// Validate checks the field values on GetExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExportRequestMultiError, or nil if none found.
+func (m *GetExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ExportId
+
+	if len(errors) > 0 {
+		return GetExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExportRequestMultiError is an error wrapping multiple validation errors
+// returned by GetExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExportRequestMultiError) AllErrors() []error { return m }
+
+// GetExportRequestValidationError is the validation error returned by
+// GetExportRequest.Validate if the designated constraints aren't met.
+type GetExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExportRequestValidationError) ErrorName() string { return "GetExportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExportRequestValidationError{}
+
+// Validate checks the field values on GetExportReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetExportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExportReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetExportReplyMultiError,
+// or nil if none found.
+func (m *GetExportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExportReplyValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExportReplyValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetExportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExportReplyMultiError is an error wrapping multiple validation errors
+// returned by GetExportReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetExportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExportReplyMultiError) AllErrors() []error { return m }
+
+// GetExportReplyValidationError is the validation error returned by
+// GetExportReply.Validate if the designated constraints aren't met.
+type GetExportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExportReplyValidationError) ErrorName() string { return "GetExportReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetExportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExportReplyValidationError{}
+
+// Validate checks the field values on ExportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportJobMultiError, or nil
+// if none found.
+func (m *ExportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportId
+
+	// no validation rules for Format
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RowCount
+
+	// no validation rules for FileSize
+
+	// no validation rules for DownloadUrl
+
+	if all {
+		switch v := interface{}(m.GetDownloadUrlExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "DownloadUrlExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "DownloadUrlExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadUrlExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "DownloadUrlExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFileExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "FileExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "FileExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFileExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "FileExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorMessage
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJobValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportJobMultiError is an error wrapping multiple validation errors returned
+// by ExportJob.ValidateAll() if the designated constraints aren't met.
+type ExportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportJobMultiError) AllErrors() []error { return m }
+
+// ExportJobValidationError is the validation error returned by
+// ExportJob.Validate if the designated constraints aren't met.
+type ExportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportJobValidationError) ErrorName() string { return "ExportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportJobValidationError{}
+
+// Validate checks the field values on SetBudgetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBudgetRequestMultiError, or nil if none found.
+func (m *SetBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Amount
+
+	// no validation rules for AlertPercent
+
+	// no validation rules for HardLimit
+
+	if len(errors) > 0 {
+		return SetBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBudgetRequestMultiError is an error wrapping multiple validation errors
+// returned by SetBudgetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBudgetRequestMultiError) AllErrors() []error { return m }
+
+// SetBudgetRequestValidationError is the validation error returned by
+// SetBudgetRequest.Validate if the designated constraints aren't met.
+type SetBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBudgetRequestValidationError) ErrorName() string { return "SetBudgetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBudgetRequestValidationError{}
+
+// Validate checks the field values on SetBudgetReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetBudgetReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBudgetReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetBudgetReplyMultiError,
+// or nil if none found.
+func (m *SetBudgetReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBudgetReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBudget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetBudgetReplyValidationError{
+					field:  "Budget",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetBudgetReplyValidationError{
+					field:  "Budget",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBudget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetBudgetReplyValidationError{
+				field:  "Budget",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetBudgetReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBudgetReplyMultiError is an error wrapping multiple validation errors
+// returned by SetBudgetReply.ValidateAll() if the designated constraints
+// aren't met.
+type SetBudgetReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBudgetReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBudgetReplyMultiError) AllErrors() []error { return m }
+
+// SetBudgetReplyValidationError is the validation error returned by
+// SetBudgetReply.Validate if the designated constraints aren't met.
+type SetBudgetReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBudgetReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBudgetReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBudgetReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBudgetReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBudgetReplyValidationError) ErrorName() string { return "SetBudgetReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetBudgetReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBudgetReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBudgetReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBudgetReplyValidationError{}
+
+// Validate checks the field values on ListBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBudgetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBudgetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBudgetsRequestMultiError, or nil if none found.
+func (m *ListBudgetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBudgetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ListBudgetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBudgetsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBudgetsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBudgetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBudgetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBudgetsRequestMultiError) AllErrors() []error { return m }
+
+// ListBudgetsRequestValidationError is the validation error returned by
+// ListBudgetsRequest.Validate if the designated constraints aren't met.
+type ListBudgetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBudgetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBudgetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBudgetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBudgetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBudgetsRequestValidationError) ErrorName() string {
+	return "ListBudgetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBudgetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBudgetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBudgetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBudgetsRequestValidationError{}
+
+// Validate checks the field values on ListBudgetsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBudgetsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBudgetsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBudgetsReplyMultiError, or nil if none found.
+func (m *ListBudgetsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBudgetsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBudgets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBudgetsReplyValidationError{
+						field:  fmt.Sprintf("Budgets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBudgetsReplyValidationError{
+						field:  fmt.Sprintf("Budgets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBudgetsReplyValidationError{
+					field:  fmt.Sprintf("Budgets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBudgetsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBudgetsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListBudgetsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListBudgetsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBudgetsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBudgetsReplyMultiError) AllErrors() []error { return m }
+
+// ListBudgetsReplyValidationError is the validation error returned by
+// ListBudgetsReply.Validate if the designated constraints aren't met.
+type ListBudgetsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBudgetsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBudgetsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBudgetsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBudgetsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBudgetsReplyValidationError) ErrorName() string { return "ListBudgetsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBudgetsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBudgetsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBudgetsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBudgetsReplyValidationError{}
+
+// Validate checks the field values on DeleteBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBudgetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBudgetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBudgetRequestMultiError, or nil if none found.
+func (m *DeleteBudgetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBudgetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	if len(errors) > 0 {
+		return DeleteBudgetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBudgetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBudgetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBudgetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBudgetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBudgetRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBudgetRequestValidationError is the validation error returned by
+// DeleteBudgetRequest.Validate if the designated constraints aren't met.
+type DeleteBudgetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBudgetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBudgetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBudgetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBudgetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBudgetRequestValidationError) ErrorName() string {
+	return "DeleteBudgetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBudgetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBudgetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBudgetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBudgetRequestValidationError{}
+
+// Validate checks the field values on DeleteBudgetReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBudgetReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBudgetReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBudgetReplyMultiError, or nil if none found.
+func (m *DeleteBudgetReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBudgetReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteBudgetReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBudgetReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteBudgetReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBudgetReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBudgetReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBudgetReplyMultiError) AllErrors() []error { return m }
+
+// DeleteBudgetReplyValidationError is the validation error returned by
+// DeleteBudgetReply.Validate if the designated constraints aren't met.
+type DeleteBudgetReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBudgetReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBudgetReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBudgetReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBudgetReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBudgetReplyValidationError) ErrorName() string {
+	return "DeleteBudgetReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBudgetReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBudgetReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBudgetReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBudgetReplyValidationError{}
+
+// Validate checks the field values on SetAccountTimezoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAccountTimezoneRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAccountTimezoneRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAccountTimezoneRequestMultiError, or nil if none found.
+func (m *SetAccountTimezoneRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAccountTimezoneRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return SetAccountTimezoneRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAccountTimezoneRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAccountTimezoneRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetAccountTimezoneRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAccountTimezoneRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAccountTimezoneRequestMultiError) AllErrors() []error { return m }
+
+// SetAccountTimezoneRequestValidationError is the validation error returned by
+// SetAccountTimezoneRequest.Validate if the designated constraints aren't met.
+type SetAccountTimezoneRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAccountTimezoneRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAccountTimezoneRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAccountTimezoneRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAccountTimezoneRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAccountTimezoneRequestValidationError) ErrorName() string {
+	return "SetAccountTimezoneRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAccountTimezoneRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAccountTimezoneRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAccountTimezoneRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAccountTimezoneRequestValidationError{}
+
+// Validate checks the field values on SetAccountTimezoneReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAccountTimezoneReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAccountTimezoneReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAccountTimezoneReplyMultiError, or nil if none found.
+func (m *SetAccountTimezoneReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAccountTimezoneReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return SetAccountTimezoneReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAccountTimezoneReplyMultiError is an error wrapping multiple validation
+// errors returned by SetAccountTimezoneReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetAccountTimezoneReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAccountTimezoneReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAccountTimezoneReplyMultiError) AllErrors() []error { return m }
+
+// SetAccountTimezoneReplyValidationError is the validation error returned by
+// SetAccountTimezoneReply.Validate if the designated constraints aren't met.
+type SetAccountTimezoneReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAccountTimezoneReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAccountTimezoneReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAccountTimezoneReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAccountTimezoneReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAccountTimezoneReplyValidationError) ErrorName() string {
+	return "SetAccountTimezoneReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAccountTimezoneReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAccountTimezoneReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAccountTimezoneReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAccountTimezoneReplyValidationError{}
+
+// Validate checks the field values on ListPackageCatalogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageCatalogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageCatalogRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageCatalogRequestMultiError, or nil if none found.
+func (m *ListPackageCatalogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageCatalogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPackageCatalogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageCatalogRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPackageCatalogRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPackageCatalogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageCatalogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageCatalogRequestMultiError) AllErrors() []error { return m }
+
+// ListPackageCatalogRequestValidationError is the validation error returned by
+// ListPackageCatalogRequest.Validate if the designated constraints aren't met.
+type ListPackageCatalogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageCatalogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageCatalogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageCatalogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageCatalogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageCatalogRequestValidationError) ErrorName() string {
+	return "ListPackageCatalogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageCatalogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageCatalogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageCatalogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageCatalogRequestValidationError{}
+
+// Validate checks the field values on ListPackageCatalogReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageCatalogReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageCatalogReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageCatalogReplyMultiError, or nil if none found.
+func (m *ListPackageCatalogReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageCatalogReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPackageCatalogReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPackageCatalogReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPackageCatalogReplyValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPackageCatalogReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageCatalogReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPackageCatalogReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageCatalogReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageCatalogReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageCatalogReplyMultiError) AllErrors() []error { return m }
+
+// ListPackageCatalogReplyValidationError is the validation error returned by
+// ListPackageCatalogReply.Validate if the designated constraints aren't met.
+type ListPackageCatalogReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageCatalogReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageCatalogReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageCatalogReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageCatalogReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageCatalogReplyValidationError) ErrorName() string {
+	return "ListPackageCatalogReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageCatalogReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageCatalogReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageCatalogReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageCatalogReplyValidationError{}
+
+// Validate checks the field values on UsagePackage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsagePackage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsagePackage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsagePackageMultiError, or
+// nil if none found.
+func (m *UsagePackage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsagePackage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Units
+
+	// no validation rules for Unit
+
+	// no validation rules for Price
+
+	// no validation rules for ValidMonths
+
+	if len(errors) > 0 {
+		return UsagePackageMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsagePackageMultiError is an error wrapping multiple validation errors
+// returned by UsagePackage.ValidateAll() if the designated constraints aren't met.
+type UsagePackageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsagePackageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsagePackageMultiError) AllErrors() []error { return m }
+
+// UsagePackageValidationError is the validation error returned by
+// UsagePackage.Validate if the designated constraints aren't met.
+type UsagePackageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsagePackageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsagePackageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsagePackageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsagePackageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsagePackageValidationError) ErrorName() string { return "UsagePackageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsagePackageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsagePackage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsagePackageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsagePackageValidationError{}
+
+// Validate checks the field values on PurchasePackageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurchasePackageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchasePackageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurchasePackageRequestMultiError, or nil if none found.
+func (m *PurchasePackageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchasePackageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for PackageId
+
+	// no validation rules for PaymentMethod
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return PurchasePackageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchasePackageRequestMultiError is an error wrapping multiple validation
+// errors returned by PurchasePackageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurchasePackageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchasePackageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchasePackageRequestMultiError) AllErrors() []error { return m }
+
+// PurchasePackageRequestValidationError is the validation error returned by
+// PurchasePackageRequest.Validate if the designated constraints aren't met.
+type PurchasePackageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchasePackageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchasePackageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchasePackageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchasePackageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchasePackageRequestValidationError) ErrorName() string {
+	return "PurchasePackageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurchasePackageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchasePackageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchasePackageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchasePackageRequestValidationError{}
+
+// Validate checks the field values on PurchasePackageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurchasePackageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchasePackageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurchasePackageReplyMultiError, or nil if none found.
+func (m *PurchasePackageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchasePackageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for PaymentUrl
+
+	if len(errors) > 0 {
+		return PurchasePackageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchasePackageReplyMultiError is an error wrapping multiple validation
+// errors returned by PurchasePackageReply.ValidateAll() if the designated
+// constraints aren't met.
+type PurchasePackageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchasePackageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchasePackageReplyMultiError) AllErrors() []error { return m }
+
+// PurchasePackageReplyValidationError is the validation error returned by
+// PurchasePackageReply.Validate if the designated constraints aren't met.
+type PurchasePackageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchasePackageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchasePackageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchasePackageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchasePackageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchasePackageReplyValidationError) ErrorName() string {
+	return "PurchasePackageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurchasePackageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchasePackageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchasePackageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchasePackageReplyValidationError{}
+
+// Validate checks the field values on ListUserPackagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserPackagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserPackagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserPackagesRequestMultiError, or nil if none found.
+func (m *ListUserPackagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserPackagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for IncludeExpired
+
+	if len(errors) > 0 {
+		return ListUserPackagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserPackagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserPackagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserPackagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserPackagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserPackagesRequestMultiError) AllErrors() []error { return m }
+
+// ListUserPackagesRequestValidationError is the validation error returned by
+// ListUserPackagesRequest.Validate if the designated constraints aren't met.
+type ListUserPackagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserPackagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserPackagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserPackagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserPackagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserPackagesRequestValidationError) ErrorName() string {
+	return "ListUserPackagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserPackagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserPackagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserPackagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserPackagesRequestValidationError{}
+
+// Validate checks the field values on ListUserPackagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserPackagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserPackagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserPackagesReplyMultiError, or nil if none found.
+func (m *ListUserPackagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserPackagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserPackagesReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserPackagesReplyValidationError{
+						field:  fmt.Sprintf("Packages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserPackagesReplyValidationError{
+					field:  fmt.Sprintf("Packages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserPackagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserPackagesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListUserPackagesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserPackagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserPackagesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserPackagesReplyMultiError) AllErrors() []error { return m }
+
+// ListUserPackagesReplyValidationError is the validation error returned by
+// ListUserPackagesReply.Validate if the designated constraints aren't met.
+type ListUserPackagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserPackagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserPackagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserPackagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserPackagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserPackagesReplyValidationError) ErrorName() string {
+	return "ListUserPackagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserPackagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserPackagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserPackagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserPackagesReplyValidationError{}
+
+// Validate checks the field values on UserPackage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPackage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPackage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPackageMultiError, or
+// nil if none found.
+func (m *UserPackage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPackage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PackageId
+
+	// no validation rules for Name
+
+	// no validation rules for ServiceName
+
+	// no validation rules for TotalUnits
+
+	// no validation rules for UsedUnits
+
+	// no validation rules for RemainingUnits
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPackageValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPackageValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPackageValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPackageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPackageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPackageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return UserPackageMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPackageMultiError is an error wrapping multiple validation errors
+// returned by UserPackage.ValidateAll() if the designated constraints aren't met.
+type UserPackageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPackageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPackageMultiError) AllErrors() []error { return m }
+
+// UserPackageValidationError is the validation error returned by
+// UserPackage.Validate if the designated constraints aren't met.
+type UserPackageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPackageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPackageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPackageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPackageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPackageValidationError) ErrorName() string { return "UserPackageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPackageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPackage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPackageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPackageValidationError{}
+
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMultiError) AllErrors() []error { return m }
+
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrganizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationValidationError{}
+
+// Validate checks the field values on OrgMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrgMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrgMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrgMemberMultiError, or nil
+// if none found.
+func (m *OrgMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrgMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	// no validation rules for Role
+
+	// no validation rules for SpendLimit
+
+	// no validation rules for Spent
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrgMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrgMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrgMemberValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return OrgMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrgMemberMultiError is an error wrapping multiple validation errors returned
+// by OrgMember.ValidateAll() if the designated constraints aren't met.
+type OrgMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrgMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrgMemberMultiError) AllErrors() []error { return m }
+
+// OrgMemberValidationError is the validation error returned by
+// OrgMember.Validate if the designated constraints aren't met.
+type OrgMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrgMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrgMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrgMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrgMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrgMemberValidationError) ErrorName() string { return "OrgMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrgMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrgMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrgMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrgMemberValidationError{}
+
+// Validate checks the field values on CreateOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateOrganizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateOrganizationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationRequestValidationError is the validation error returned by
+// CreateOrganizationRequest.Validate if the designated constraints aren't met.
+type CreateOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationRequestValidationError{}
+
+// Validate checks the field values on GetOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrganizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrganizationRequestMultiError, or nil if none found.
+func (m *GetOrganizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrganizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	if len(errors) > 0 {
+		return GetOrganizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrganizationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrganizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// GetOrganizationRequestValidationError is the validation error returned by
+// GetOrganizationRequest.Validate if the designated constraints aren't met.
+type GetOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrganizationRequestValidationError) ErrorName() string {
+	return "GetOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrganizationRequestValidationError{}
+
+// Validate checks the field values on OrganizationReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationReplyMultiError, or nil if none found.
+func (m *OrganizationReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationReplyValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationReplyValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationReplyValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrganizationReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrganizationReplyValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrganizationReplyValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrganizationReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationReplyMultiError is an error wrapping multiple validation errors
+// returned by OrganizationReply.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationReplyMultiError) AllErrors() []error { return m }
+
+// OrganizationReplyValidationError is the validation error returned by
+// OrganizationReply.Validate if the designated constraints aren't met.
+type OrganizationReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationReplyValidationError) ErrorName() string {
+	return "OrganizationReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationReplyValidationError{}
+
+// Validate checks the field values on AddOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrgMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrgMemberRequestMultiError, or nil if none found.
+func (m *AddOrgMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrgMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	// no validation rules for Role
+
+	// no validation rules for SpendLimit
+
+	if len(errors) > 0 {
+		return AddOrgMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrgMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddOrgMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddOrgMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrgMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrgMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddOrgMemberRequestValidationError is the validation error returned by
+// AddOrgMemberRequest.Validate if the designated constraints aren't met.
+type AddOrgMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrgMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrgMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrgMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrgMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrgMemberRequestValidationError) ErrorName() string {
+	return "AddOrgMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrgMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrgMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrgMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrgMemberRequestValidationError{}
+
+// Validate checks the field values on AcceptOrgInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrgInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrgInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrgInvitationRequestMultiError, or nil if none found.
+func (m *AcceptOrgInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrgInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	if len(errors) > 0 {
+		return AcceptOrgInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrgInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by AcceptOrgInvitationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AcceptOrgInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrgInvitationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrgInvitationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptOrgInvitationRequestValidationError is the validation error returned
+// by AcceptOrgInvitationRequest.Validate if the designated constraints aren't met.
+type AcceptOrgInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrgInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrgInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrgInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrgInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrgInvitationRequestValidationError) ErrorName() string {
+	return "AcceptOrgInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrgInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrgInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrgInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrgInvitationRequestValidationError{}
+
+// Validate checks the field values on UpdateOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrgMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOrgMemberRequestMultiError, or nil if none found.
+func (m *UpdateOrgMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrgMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	// no validation rules for Role
+
+	// no validation rules for SpendLimit
+
+	if len(errors) > 0 {
+		return UpdateOrgMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrgMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateOrgMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateOrgMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrgMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrgMemberRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOrgMemberRequestValidationError is the validation error returned by
+// UpdateOrgMemberRequest.Validate if the designated constraints aren't met.
+type UpdateOrgMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrgMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrgMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrgMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrgMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrgMemberRequestValidationError) ErrorName() string {
+	return "UpdateOrgMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrgMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrgMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrgMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrgMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrgMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveOrgMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveOrgMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrgMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrgMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return RemoveOrgMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrgMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveOrgMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrgMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrgMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrgMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveOrgMemberRequestValidationError is the validation error returned by
+// RemoveOrgMemberRequest.Validate if the designated constraints aren't met.
+type RemoveOrgMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrgMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrgMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrgMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrgMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrgMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrgMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrgMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrgMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrgMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrgMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveOrgMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrgMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveOrgMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveOrgMemberReplyMultiError, or nil if none found.
+func (m *RemoveOrgMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrgMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RemoveOrgMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrgMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveOrgMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrgMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrgMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrgMemberReplyMultiError) AllErrors() []error { return m }
+
+// RemoveOrgMemberReplyValidationError is the validation error returned by
+// RemoveOrgMemberReply.Validate if the designated constraints aren't met.
+type RemoveOrgMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrgMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrgMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrgMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrgMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrgMemberReplyValidationError) ErrorName() string {
+	return "RemoveOrgMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrgMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrgMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrgMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrgMemberReplyValidationError{}
+
+// Validate checks the field values on Budget with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Budget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Budget with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BudgetMultiError, or nil if none found.
+func (m *Budget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Budget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Amount
+
+	// no validation rules for AlertPercent
+
+	// no validation rules for HardLimit
+
+	// no validation rules for Period
+
+	// no validation rules for Spent
+
+	// no validation rules for Alerted
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BudgetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BudgetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BudgetMultiError(errors)
+	}
+
+	return nil
+}
+
+// BudgetMultiError is an error wrapping multiple validation errors returned by
+// Budget.ValidateAll() if the designated constraints aren't met.
+type BudgetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BudgetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BudgetMultiError) AllErrors() []error { return m }
+
+// BudgetValidationError is the validation error returned by Budget.Validate if
+// the designated constraints aren't met.
+type BudgetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BudgetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BudgetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BudgetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BudgetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BudgetValidationError) ErrorName() string { return "BudgetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BudgetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBudget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BudgetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BudgetValidationError{}
+
+// Validate checks the field values on RateLimitRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimitRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitRuleMultiError, or
+// nil if none found.
+func (m *RateLimitRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for PerSecond
+
+	// no validation rules for PerDay
+
+	if len(errors) > 0 {
+		return RateLimitRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitRuleMultiError is an error wrapping multiple validation errors
+// returned by RateLimitRule.ValidateAll() if the designated constraints
+// aren't met.
+type RateLimitRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitRuleMultiError) AllErrors() []error { return m }
+
+// RateLimitRuleValidationError is the validation error returned by
+// RateLimitRule.Validate if the designated constraints aren't met.
+type RateLimitRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitRuleValidationError) ErrorName() string { return "RateLimitRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitRuleValidationError{}
+
+// Validate checks the field values on SetUserRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserRateLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUserRateLimitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetUserRateLimitRequestMultiError, or nil if none found.
+func (m *SetUserRateLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUserRateLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Plan
+
+	for idx, item := range m.GetOverrides() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetUserRateLimitRequestValidationError{
+						field:  fmt.Sprintf("Overrides[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetUserRateLimitRequestValidationError{
+						field:  fmt.Sprintf("Overrides[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetUserRateLimitRequestValidationError{
+					field:  fmt.Sprintf("Overrides[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetUserRateLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUserRateLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by SetUserRateLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetUserRateLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserRateLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUserRateLimitRequestMultiError) AllErrors() []error { return m }
+
+// SetUserRateLimitRequestValidationError is the validation error returned by
+// SetUserRateLimitRequest.Validate if the designated constraints aren't met.
+type SetUserRateLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUserRateLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUserRateLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUserRateLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUserRateLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUserRateLimitRequestValidationError) ErrorName() string {
+	return "SetUserRateLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetUserRateLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUserRateLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUserRateLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUserRateLimitRequestValidationError{}
+
+// Validate checks the field values on GetUserRateLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRateLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRateLimitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRateLimitRequestMultiError, or nil if none found.
+func (m *GetUserRateLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRateLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return GetUserRateLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRateLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserRateLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserRateLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRateLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRateLimitRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRateLimitRequestValidationError is the validation error returned by
+// GetUserRateLimitRequest.Validate if the designated constraints aren't met.
+type GetUserRateLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRateLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRateLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRateLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRateLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRateLimitRequestValidationError) ErrorName() string {
+	return "GetUserRateLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserRateLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRateLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRateLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRateLimitRequestValidationError{}
+
+// Validate checks the field values on UserRateLimitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserRateLimitReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserRateLimitReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserRateLimitReplyMultiError, or nil if none found.
+func (m *UserRateLimitReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserRateLimitReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Plan
+
+	for idx, item := range m.GetOverrides() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserRateLimitReplyValidationError{
+						field:  fmt.Sprintf("Overrides[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserRateLimitReplyValidationError{
+						field:  fmt.Sprintf("Overrides[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserRateLimitReplyValidationError{
+					field:  fmt.Sprintf("Overrides[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEffective() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserRateLimitReplyValidationError{
+						field:  fmt.Sprintf("Effective[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserRateLimitReplyValidationError{
+						field:  fmt.Sprintf("Effective[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserRateLimitReplyValidationError{
+					field:  fmt.Sprintf("Effective[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserRateLimitReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserRateLimitReplyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserRateLimitReplyValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserRateLimitReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserRateLimitReplyMultiError is an error wrapping multiple validation errors
+// returned by UserRateLimitReply.ValidateAll() if the designated constraints
+// aren't met.
+type UserRateLimitReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserRateLimitReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserRateLimitReplyMultiError) AllErrors() []error { return m }
+
+// UserRateLimitReplyValidationError is the validation error returned by
+// UserRateLimitReply.Validate if the designated constraints aren't met.
+type UserRateLimitReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserRateLimitReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserRateLimitReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserRateLimitReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserRateLimitReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserRateLimitReplyValidationError) ErrorName() string {
+	return "UserRateLimitReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserRateLimitReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserRateLimitReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserRateLimitReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserRateLimitReplyValidationError{}
+
+// Validate checks the field values on ServiceRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceRateMultiError, or
+// nil if none found.
+func (m *ServiceRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for ListPrice
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for Source
+
+	// no validation rules for RuleId
+
+	// no validation rules for ContractId
+
+	if len(errors) > 0 {
+		return ServiceRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceRateMultiError is an error wrapping multiple validation errors
+// returned by ServiceRate.ValidateAll() if the designated constraints aren't met.
+type ServiceRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceRateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceRateMultiError) AllErrors() []error { return m }
+
+// ServiceRateValidationError is the validation error returned by
+// ServiceRate.Validate if the designated constraints aren't met.
+type ServiceRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceRateValidationError) ErrorName() string { return "ServiceRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceRateValidationError{}
+
+// Validate checks the field values on PriceRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PriceRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriceRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PriceRuleMultiError, or nil
+// if none found.
+func (m *PriceRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriceRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for DiscountPercent
+
+	// no validation rules for ContractId
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceRuleValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceRuleValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PriceRuleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PriceRuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PriceRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceRuleMultiError is an error wrapping multiple validation errors returned
+// by PriceRule.ValidateAll() if the designated constraints aren't met.
+type PriceRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceRuleMultiError) AllErrors() []error { return m }
+
+// PriceRuleValidationError is the validation error returned by
+// PriceRule.Validate if the designated constraints aren't met.
+type PriceRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceRuleValidationError) ErrorName() string { return "PriceRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PriceRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriceRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceRuleValidationError{}
+
+// Validate checks the field values on CreatePriceRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePriceRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePriceRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePriceRuleRequestMultiError, or nil if none found.
+func (m *CreatePriceRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePriceRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for DiscountPercent
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePriceRuleRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePriceRuleRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePriceRuleRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePriceRuleRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePriceRuleRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePriceRuleRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePriceRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePriceRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePriceRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePriceRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePriceRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePriceRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreatePriceRuleRequestValidationError is the validation error returned by
+// CreatePriceRuleRequest.Validate if the designated constraints aren't met.
+type CreatePriceRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePriceRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePriceRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePriceRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePriceRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePriceRuleRequestValidationError) ErrorName() string {
+	return "CreatePriceRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePriceRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePriceRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePriceRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePriceRuleRequestValidationError{}
+
+// Validate checks the field values on ListPriceRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPriceRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPriceRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPriceRulesRequestMultiError, or nil if none found.
+func (m *ListPriceRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPriceRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for IncludeExpired
+
+	if len(errors) > 0 {
+		return ListPriceRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPriceRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPriceRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPriceRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPriceRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPriceRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListPriceRulesRequestValidationError is the validation error returned by
+// ListPriceRulesRequest.Validate if the designated constraints aren't met.
+type ListPriceRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPriceRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPriceRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPriceRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPriceRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPriceRulesRequestValidationError) ErrorName() string {
+	return "ListPriceRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPriceRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPriceRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPriceRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPriceRulesRequestValidationError{}
+
+// Validate checks the field values on ListPriceRulesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPriceRulesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPriceRulesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPriceRulesReplyMultiError, or nil if none found.
+func (m *ListPriceRulesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPriceRulesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPriceRulesReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPriceRulesReplyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPriceRulesReplyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEffective() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPriceRulesReplyValidationError{
+						field:  fmt.Sprintf("Effective[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPriceRulesReplyValidationError{
+						field:  fmt.Sprintf("Effective[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPriceRulesReplyValidationError{
+					field:  fmt.Sprintf("Effective[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPriceRulesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPriceRulesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPriceRulesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPriceRulesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPriceRulesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPriceRulesReplyMultiError) AllErrors() []error { return m }
+
+// ListPriceRulesReplyValidationError is the validation error returned by
+// ListPriceRulesReply.Validate if the designated constraints aren't met.
+type ListPriceRulesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPriceRulesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPriceRulesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPriceRulesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPriceRulesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPriceRulesReplyValidationError) ErrorName() string {
+	return "ListPriceRulesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPriceRulesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPriceRulesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPriceRulesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPriceRulesReplyValidationError{}
+
+// Validate checks the field values on DeletePriceRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePriceRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePriceRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePriceRuleRequestMultiError, or nil if none found.
+func (m *DeletePriceRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePriceRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for RuleId
+
+	if len(errors) > 0 {
+		return DeletePriceRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePriceRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePriceRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePriceRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePriceRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePriceRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeletePriceRuleRequestValidationError is the validation error returned by
+// DeletePriceRuleRequest.Validate if the designated constraints aren't met.
+type DeletePriceRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePriceRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePriceRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePriceRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePriceRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePriceRuleRequestValidationError) ErrorName() string {
+	return "DeletePriceRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePriceRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePriceRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePriceRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePriceRuleRequestValidationError{}
+
+// Validate checks the field values on DeletePriceRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePriceRuleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePriceRuleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePriceRuleReplyMultiError, or nil if none found.
+func (m *DeletePriceRuleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePriceRuleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePriceRuleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePriceRuleReplyMultiError is an error wrapping multiple validation
+// errors returned by DeletePriceRuleReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePriceRuleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePriceRuleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePriceRuleReplyMultiError) AllErrors() []error { return m }
+
+// DeletePriceRuleReplyValidationError is the validation error returned by
+// DeletePriceRuleReply.Validate if the designated constraints aren't met.
+type DeletePriceRuleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePriceRuleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePriceRuleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePriceRuleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePriceRuleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePriceRuleReplyValidationError) ErrorName() string {
+	return "DeletePriceRuleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePriceRuleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePriceRuleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePriceRuleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePriceRuleReplyValidationError{}
+
+// Validate checks the field values on ContractRate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContractRate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContractRate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContractRateMultiError, or
+// nil if none found.
+func (m *ContractRate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContractRate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for DiscountPercent
+
+	if len(errors) > 0 {
+		return ContractRateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContractRateMultiError is an error wrapping multiple validation errors
+// returned by ContractRate.ValidateAll() if the designated constraints aren't met.
+type ContractRateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContractRateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContractRateMultiError) AllErrors() []error { return m }
+
+// ContractRateValidationError is the validation error returned by
+// ContractRate.Validate if the designated constraints aren't met.
+type ContractRateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContractRateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContractRateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContractRateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContractRateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContractRateValidationError) ErrorName() string { return "ContractRateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContractRateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContractRate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContractRateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContractRateValidationError{}
+
+// Validate checks the field values on Contract with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Contract) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Contract with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContractMultiError, or nil
+// if none found.
+func (m *Contract) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Contract) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for CommitAmount
+
+	// no validation rules for DrawnAmount
+
+	// no validation rules for RemainingAmount
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContractValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContractValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for TrueUpAmount
+
+	// no validation rules for TrueUpCharged
+
+	if all {
+		switch v := interface{}(m.GetSettledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContractValidationError{
+				field:  "SettledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ContractValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ContractValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContractValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContractValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContractValidationError{
+					field:  fmt.Sprintf("Rates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ContractMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContractMultiError is an error wrapping multiple validation errors returned
+// by Contract.ValidateAll() if the designated constraints aren't met.
+type ContractMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContractMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContractMultiError) AllErrors() []error { return m }
+
+// ContractValidationError is the validation error returned by
+// Contract.Validate if the designated constraints aren't met.
+type ContractValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContractValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContractValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContractValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContractValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContractValidationError) ErrorName() string { return "ContractValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContractValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContract.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContractValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContractValidationError{}
+
+// Validate checks the field values on CreateContractRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateContractRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateContractRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateContractRequestMultiError, or nil if none found.
+func (m *CreateContractRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateContractRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CommitAmount
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateContractRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateContractRequestValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContractRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateContractRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateContractRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContractRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateContractRequestValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateContractRequestValidationError{
+						field:  fmt.Sprintf("Rates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateContractRequestValidationError{
+					field:  fmt.Sprintf("Rates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateContractRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateContractRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateContractRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateContractRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateContractRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateContractRequestMultiError) AllErrors() []error { return m }
+
+// CreateContractRequestValidationError is the validation error returned by
+// CreateContractRequest.Validate if the designated constraints aren't met.
+type CreateContractRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateContractRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateContractRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateContractRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateContractRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateContractRequestValidationError) ErrorName() string {
+	return "CreateContractRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateContractRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContractRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateContractRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateContractRequestValidationError{}
+
+// Validate checks the field values on ListContractsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContractsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListContractsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListContractsRequestMultiError, or nil if none found.
+func (m *ListContractsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContractsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for IncludeSettled
+
+	if len(errors) > 0 {
+		return ListContractsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContractsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListContractsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListContractsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContractsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContractsRequestMultiError) AllErrors() []error { return m }
+
+// ListContractsRequestValidationError is the validation error returned by
+// ListContractsRequest.Validate if the designated constraints aren't met.
+type ListContractsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContractsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContractsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContractsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContractsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContractsRequestValidationError) ErrorName() string {
+	return "ListContractsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContractsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContractsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContractsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContractsRequestValidationError{}
+
+// Validate checks the field values on ListContractsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContractsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListContractsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListContractsReplyMultiError, or nil if none found.
+func (m *ListContractsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContractsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContracts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListContractsReplyValidationError{
+						field:  fmt.Sprintf("Contracts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListContractsReplyValidationError{
+						field:  fmt.Sprintf("Contracts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListContractsReplyValidationError{
+					field:  fmt.Sprintf("Contracts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListContractsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContractsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListContractsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListContractsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContractsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContractsReplyMultiError) AllErrors() []error { return m }
+
+// ListContractsReplyValidationError is the validation error returned by
+// ListContractsReply.Validate if the designated constraints aren't met.
+type ListContractsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContractsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContractsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContractsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContractsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContractsReplyValidationError) ErrorName() string {
+	return "ListContractsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContractsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContractsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContractsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContractsReplyValidationError{}
+
+// Validate checks the field values on GetRevenueReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevenueReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevenueReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevenueReportRequestMultiError, or nil if none found.
+func (m *GetRevenueReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevenueReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevenueReportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevenueReportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Granularity
+
+	// no validation rules for ServiceName
+
+	// no validation rules for GroupByService
+
+	if len(errors) > 0 {
+		return GetRevenueReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevenueReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRevenueReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevenueReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevenueReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevenueReportRequestMultiError) AllErrors() []error { return m }
+
+// GetRevenueReportRequestValidationError is the validation error returned by
+// GetRevenueReportRequest.Validate if the designated constraints aren't met.
+type GetRevenueReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevenueReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevenueReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevenueReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevenueReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevenueReportRequestValidationError) ErrorName() string {
+	return "GetRevenueReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevenueReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevenueReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevenueReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevenueReportRequestValidationError{}
+
+// Validate checks the field values on RevenueItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevenueItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevenueItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevenueItemMultiError, or
+// nil if none found.
+func (m *RevenueItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevenueItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevenueItemValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevenueItemValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevenueItemValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Revenue
+
+	// no validation rules for TotalCount
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	if len(errors) > 0 {
+		return RevenueItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevenueItemMultiError is an error wrapping multiple validation errors
+// returned by RevenueItem.ValidateAll() if the designated constraints aren't met.
+type RevenueItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevenueItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevenueItemMultiError) AllErrors() []error { return m }
+
+// RevenueItemValidationError is the validation error returned by
+// RevenueItem.Validate if the designated constraints aren't met.
+type RevenueItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevenueItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevenueItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevenueItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevenueItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevenueItemValidationError) ErrorName() string { return "RevenueItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevenueItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevenueItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevenueItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevenueItemValidationError{}
+
+// Validate checks the field values on GetRevenueReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevenueReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevenueReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevenueReportReplyMultiError, or nil if none found.
+func (m *GetRevenueReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevenueReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Granularity
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRevenueReportReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRevenueReportReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRevenueReportReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalRevenue
+
+	// no validation rules for TotalCount
+
+	// no validation rules for FreeCount
+
+	// no validation rules for PaidCount
+
+	if len(errors) > 0 {
+		return GetRevenueReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevenueReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRevenueReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevenueReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevenueReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevenueReportReplyMultiError) AllErrors() []error { return m }
+
+// GetRevenueReportReplyValidationError is the validation error returned by
+// GetRevenueReportReply.Validate if the designated constraints aren't met.
+type GetRevenueReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevenueReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevenueReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevenueReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevenueReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevenueReportReplyValidationError) ErrorName() string {
+	return "GetRevenueReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevenueReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevenueReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevenueReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevenueReportReplyValidationError{}
+
+// Validate checks the field values on GetRechargeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRechargeReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRechargeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRechargeReportRequestMultiError, or nil if none found.
+func (m *GetRechargeReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRechargeReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRechargeReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRechargeReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRechargeReportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRechargeReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRechargeReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRechargeReportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Granularity
+
+	if len(errors) > 0 {
+		return GetRechargeReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRechargeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRechargeReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRechargeReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRechargeReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRechargeReportRequestMultiError) AllErrors() []error { return m }
+
+// GetRechargeReportRequestValidationError is the validation error returned by
+// GetRechargeReportRequest.Validate if the designated constraints aren't met.
+type GetRechargeReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRechargeReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRechargeReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRechargeReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRechargeReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRechargeReportRequestValidationError) ErrorName() string {
+	return "GetRechargeReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRechargeReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRechargeReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRechargeReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRechargeReportRequestValidationError{}
+
+// Validate checks the field values on RechargeItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RechargeItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RechargeItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RechargeItemMultiError, or
+// nil if none found.
+func (m *RechargeItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RechargeItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RechargeItemValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RechargeItemValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RechargeItemValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Amount
+
+	// no validation rules for OrderCount
+
+	// no validation rules for UserCount
+
+	if len(errors) > 0 {
+		return RechargeItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// RechargeItemMultiError is an error wrapping multiple validation errors
+// returned by RechargeItem.ValidateAll() if the designated constraints aren't met.
+type RechargeItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RechargeItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RechargeItemMultiError) AllErrors() []error { return m }
+
+// RechargeItemValidationError is the validation error returned by
+// RechargeItem.Validate if the designated constraints aren't met.
+type RechargeItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RechargeItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RechargeItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RechargeItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RechargeItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RechargeItemValidationError) ErrorName() string { return "RechargeItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RechargeItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRechargeItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RechargeItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RechargeItemValidationError{}
+
+// Validate checks the field values on GetRechargeReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRechargeReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRechargeReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRechargeReportReplyMultiError, or nil if none found.
+func (m *GetRechargeReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRechargeReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Granularity
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRechargeReportReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRechargeReportReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRechargeReportReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAmount
+
+	// no validation rules for TotalOrders
+
+	// no validation rules for TotalUsers
+
+	if len(errors) > 0 {
+		return GetRechargeReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRechargeReportReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRechargeReportReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRechargeReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRechargeReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRechargeReportReplyMultiError) AllErrors() []error { return m }
+
+// GetRechargeReportReplyValidationError is the validation error returned by
+// GetRechargeReportReply.Validate if the designated constraints aren't met.
+type GetRechargeReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRechargeReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRechargeReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRechargeReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRechargeReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRechargeReportReplyValidationError) ErrorName() string {
+	return "GetRechargeReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRechargeReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRechargeReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRechargeReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRechargeReportReplyValidationError{}
+
+// Validate checks the field values on GetUserActivityReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserActivityReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserActivityReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserActivityReportRequestMultiError, or nil if none found.
+func (m *GetUserActivityReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserActivityReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityReportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserActivityReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserActivityReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserActivityReportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ServiceName
+
+	if len(errors) > 0 {
+		return GetUserActivityReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserActivityReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserActivityReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserActivityReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserActivityReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserActivityReportRequestMultiError) AllErrors() []error { return m }
+
+// GetUserActivityReportRequestValidationError is the validation error returned
+// by GetUserActivityReportRequest.Validate if the designated constraints
+// aren't met.
+type GetUserActivityReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserActivityReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserActivityReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserActivityReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserActivityReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserActivityReportRequestValidationError) ErrorName() string {
+	return "GetUserActivityReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserActivityReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserActivityReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserActivityReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserActivityReportRequestValidationError{}
+
+// Validate checks the field values on GetUserActivityReportReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserActivityReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserActivityReportReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserActivityReportReplyMultiError, or nil if none found.
+func (m *GetUserActivityReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserActivityReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveUsers
+
+	// no validation rules for PayingUsers
+
+	// no validation rules for NewPayingUsers
+
+	// no validation rules for ConversionRate
+
+	if len(errors) > 0 {
+		return GetUserActivityReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserActivityReportReplyMultiError is an error wrapping multiple
+// validation errors returned by GetUserActivityReportReply.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserActivityReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserActivityReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserActivityReportReplyMultiError) AllErrors() []error { return m }
+
+// GetUserActivityReportReplyValidationError is the validation error returned
+// by GetUserActivityReportReply.Validate if the designated constraints aren't met.
+type GetUserActivityReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserActivityReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserActivityReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserActivityReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserActivityReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserActivityReportReplyValidationError) ErrorName() string {
+	return "GetUserActivityReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserActivityReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserActivityReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserActivityReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserActivityReportReplyValidationError{}
+
+// Validate checks the field values on ListTopConsumersRequest with the rules
 // defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTopConsumersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTopConsumersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatsMonthRequestMultiError, or nil if none found.
-func (m *GetStatsMonthRequest) ValidateAll() error {
+// ListTopConsumersRequestMultiError, or nil if none found.
+func (m *ListTopConsumersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatsMonthRequest) validate(all bool) error {
+func (m *ListTopConsumersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTopConsumersRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTopConsumersRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTopConsumersRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListTopConsumersRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListTopConsumersRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTopConsumersRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ServiceName
 
-	// no validation rules for UserId
+	// no validation rules for OrderBy
 
-	// no validation rules for ServiceName
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return GetStatsMonthRequestMultiError(errors)
+		return ListTopConsumersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatsMonthRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStatsMonthRequest.ValidateAll() if the designated
+// ListTopConsumersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTopConsumersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatsMonthRequestMultiError []error
+type ListTopConsumersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatsMonthRequestMultiError) Error() string {
+func (m ListTopConsumersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1773,11 +12713,11 @@ func (m GetStatsMonthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatsMonthRequestMultiError) AllErrors() []error { return m }
+func (m ListTopConsumersRequestMultiError) AllErrors() []error { return m }
 
-// GetStatsMonthRequestValidationError is the validation error returned by
-// GetStatsMonthRequest.Validate if the designated constraints aren't met.
-type GetStatsMonthRequestValidationError struct {
+// ListTopConsumersRequestValidationError is the validation error returned by
+// ListTopConsumersRequest.Validate if the designated constraints aren't met.
+type ListTopConsumersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1785,24 +12725,24 @@ type GetStatsMonthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatsMonthRequestValidationError) Field() string { return e.field }
+func (e ListTopConsumersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatsMonthRequestValidationError) Reason() string { return e.reason }
+func (e ListTopConsumersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatsMonthRequestValidationError) Cause() error { return e.cause }
+func (e ListTopConsumersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatsMonthRequestValidationError) Key() bool { return e.key }
+func (e ListTopConsumersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatsMonthRequestValidationError) ErrorName() string {
-	return "GetStatsMonthRequestValidationError"
+func (e ListTopConsumersRequestValidationError) ErrorName() string {
+	return "ListTopConsumersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatsMonthRequestValidationError) Error() string {
+func (e ListTopConsumersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1814,14 +12754,14 @@ func (e GetStatsMonthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatsMonthRequest.%s: %s%s",
+		"invalid %sListTopConsumersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatsMonthRequestValidationError{}
+var _ error = ListTopConsumersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1829,24 +12769,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatsMonthRequestValidationError{}
+} = ListTopConsumersRequestValidationError{}
 
-// Validate checks the field values on GetStatsSummaryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatsSummaryRequest) Validate() error {
+// Validate checks the field values on TopConsumer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TopConsumer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatsSummaryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetStatsSummaryRequestMultiError, or nil if none found.
-func (m *GetStatsSummaryRequest) ValidateAll() error {
+// ValidateAll checks the field values on TopConsumer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TopConsumerMultiError, or
+// nil if none found.
+func (m *TopConsumer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatsSummaryRequest) validate(all bool) error {
+func (m *TopConsumer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1855,20 +12795,25 @@ func (m *GetStatsSummaryRequest) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for Revenue
+
+	// no validation rules for TotalCount
+
+	// no validation rules for PaidCount
+
 	if len(errors) > 0 {
-		return GetStatsSummaryRequestMultiError(errors)
+		return TopConsumerMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatsSummaryRequestMultiError is an error wrapping multiple validation
-// errors returned by GetStatsSummaryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetStatsSummaryRequestMultiError []error
+// TopConsumerMultiError is an error wrapping multiple validation errors
+// returned by TopConsumer.ValidateAll() if the designated constraints aren't met.
+type TopConsumerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatsSummaryRequestMultiError) Error() string {
+func (m TopConsumerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1877,11 +12822,11 @@ func (m GetStatsSummaryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatsSummaryRequestMultiError) AllErrors() []error { return m }
+func (m TopConsumerMultiError) AllErrors() []error { return m }
 
-// GetStatsSummaryRequestValidationError is the validation error returned by
-// GetStatsSummaryRequest.Validate if the designated constraints aren't met.
-type GetStatsSummaryRequestValidationError struct {
+// TopConsumerValidationError is the validation error returned by
+// TopConsumer.Validate if the designated constraints aren't met.
+type TopConsumerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1889,24 +12834,22 @@ type GetStatsSummaryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatsSummaryRequestValidationError) Field() string { return e.field }
+func (e TopConsumerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatsSummaryRequestValidationError) Reason() string { return e.reason }
+func (e TopConsumerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatsSummaryRequestValidationError) Cause() error { return e.cause }
+func (e TopConsumerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatsSummaryRequestValidationError) Key() bool { return e.key }
+func (e TopConsumerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatsSummaryRequestValidationError) ErrorName() string {
-	return "GetStatsSummaryRequestValidationError"
-}
+func (e TopConsumerValidationError) ErrorName() string { return "TopConsumerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetStatsSummaryRequestValidationError) Error() string {
+func (e TopConsumerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1918,14 +12861,14 @@ func (e GetStatsSummaryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatsSummaryRequest.%s: %s%s",
+		"invalid %sTopConsumer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatsSummaryRequestValidationError{}
+var _ error = TopConsumerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1933,58 +12876,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatsSummaryRequestValidationError{}
+} = TopConsumerValidationError{}
 
-// Validate checks the field values on GetStatsReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetStatsReply) Validate() error {
+// Validate checks the field values on ListTopConsumersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTopConsumersReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatsReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetStatsReplyMultiError, or
-// nil if none found.
-func (m *GetStatsReply) ValidateAll() error {
+// ValidateAll checks the field values on ListTopConsumersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTopConsumersReplyMultiError, or nil if none found.
+func (m *ListTopConsumersReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatsReply) validate(all bool) error {
+func (m *ListTopConsumersReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for ServiceName
-
-	// no validation rules for TotalCount
-
-	// no validation rules for TotalCost
-
-	// no validation rules for FreeCount
+	for idx, item := range m.GetConsumers() {
+		_, _ = idx, item
 
-	// no validation rules for PaidCount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTopConsumersReplyValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTopConsumersReplyValidationError{
+						field:  fmt.Sprintf("Consumers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTopConsumersReplyValidationError{
+					field:  fmt.Sprintf("Consumers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Period
+	}
 
 	if len(errors) > 0 {
-		return GetStatsReplyMultiError(errors)
+		return ListTopConsumersReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatsReplyMultiError is an error wrapping multiple validation errors
-// returned by GetStatsReply.ValidateAll() if the designated constraints
-// aren't met.
-type GetStatsReplyMultiError []error
+// ListTopConsumersReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTopConsumersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTopConsumersReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatsReplyMultiError) Error() string {
+func (m ListTopConsumersReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1993,11 +12956,11 @@ func (m GetStatsReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatsReplyMultiError) AllErrors() []error { return m }
+func (m ListTopConsumersReplyMultiError) AllErrors() []error { return m }
 
-// GetStatsReplyValidationError is the validation error returned by
-// GetStatsReply.Validate if the designated constraints aren't met.
-type GetStatsReplyValidationError struct {
+// ListTopConsumersReplyValidationError is the validation error returned by
+// ListTopConsumersReply.Validate if the designated constraints aren't met.
+type ListTopConsumersReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2005,22 +12968,24 @@ type GetStatsReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatsReplyValidationError) Field() string { return e.field }
+func (e ListTopConsumersReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatsReplyValidationError) Reason() string { return e.reason }
+func (e ListTopConsumersReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatsReplyValidationError) Cause() error { return e.cause }
+func (e ListTopConsumersReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatsReplyValidationError) Key() bool { return e.key }
+func (e ListTopConsumersReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatsReplyValidationError) ErrorName() string { return "GetStatsReplyValidationError" }
+func (e ListTopConsumersReplyValidationError) ErrorName() string {
+	return "ListTopConsumersReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetStatsReplyValidationError) Error() string {
+func (e ListTopConsumersReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2032,14 +12997,14 @@ func (e GetStatsReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatsReply.%s: %s%s",
+		"invalid %sListTopConsumersReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatsReplyValidationError{}
+var _ error = ListTopConsumersReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2047,53 +13012,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatsReplyValidationError{}
+} = ListTopConsumersReplyValidationError{}
 
-// Validate checks the field values on ServiceStats with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ServiceStats) Validate() error {
+// Validate checks the field values on GetBalanceLiabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceLiabilityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ServiceStats with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ServiceStatsMultiError, or
-// nil if none found.
-func (m *ServiceStats) ValidateAll() error {
+// ValidateAll checks the field values on GetBalanceLiabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceLiabilityRequestMultiError, or nil if none found.
+func (m *GetBalanceLiabilityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ServiceStats) validate(all bool) error {
+func (m *GetBalanceLiabilityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceName
-
-	// no validation rules for TotalCount
-
-	// no validation rules for TotalCost
-
-	// no validation rules for FreeCount
-
-	// no validation rules for PaidCount
-
 	if len(errors) > 0 {
-		return ServiceStatsMultiError(errors)
+		return GetBalanceLiabilityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceStatsMultiError is an error wrapping multiple validation errors
-// returned by ServiceStats.ValidateAll() if the designated constraints aren't met.
-type ServiceStatsMultiError []error
+// GetBalanceLiabilityRequestMultiError is an error wrapping multiple
+// validation errors returned by GetBalanceLiabilityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetBalanceLiabilityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceStatsMultiError) Error() string {
+func (m GetBalanceLiabilityRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2102,11 +13058,11 @@ func (m ServiceStatsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceStatsMultiError) AllErrors() []error { return m }
+func (m GetBalanceLiabilityRequestMultiError) AllErrors() []error { return m }
 
-// ServiceStatsValidationError is the validation error returned by
-// ServiceStats.Validate if the designated constraints aren't met.
-type ServiceStatsValidationError struct {
+// GetBalanceLiabilityRequestValidationError is the validation error returned
+// by GetBalanceLiabilityRequest.Validate if the designated constraints aren't met.
+type GetBalanceLiabilityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2114,22 +13070,24 @@ type ServiceStatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceStatsValidationError) Field() string { return e.field }
+func (e GetBalanceLiabilityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceStatsValidationError) Reason() string { return e.reason }
+func (e GetBalanceLiabilityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceStatsValidationError) Cause() error { return e.cause }
+func (e GetBalanceLiabilityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceStatsValidationError) Key() bool { return e.key }
+func (e GetBalanceLiabilityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceStatsValidationError) ErrorName() string { return "ServiceStatsValidationError" }
+func (e GetBalanceLiabilityRequestValidationError) ErrorName() string {
+	return "GetBalanceLiabilityRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ServiceStatsValidationError) Error() string {
+func (e GetBalanceLiabilityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2141,14 +13099,14 @@ func (e ServiceStatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServiceStats.%s: %s%s",
+		"invalid %sGetBalanceLiabilityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceStatsValidationError{}
+var _ error = GetBalanceLiabilityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2156,84 +13114,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceStatsValidationError{}
+} = GetBalanceLiabilityRequestValidationError{}
 
-// Validate checks the field values on GetStatsSummaryReply with the rules
+// Validate checks the field values on GetBalanceLiabilityReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetStatsSummaryReply) Validate() error {
+func (m *GetBalanceLiabilityReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetStatsSummaryReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBalanceLiabilityReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetStatsSummaryReplyMultiError, or nil if none found.
-func (m *GetStatsSummaryReply) ValidateAll() error {
+// GetBalanceLiabilityReplyMultiError, or nil if none found.
+func (m *GetBalanceLiabilityReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetStatsSummaryReply) validate(all bool) error {
+func (m *GetBalanceLiabilityReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for TotalCount
+	// no validation rules for TotalBalance
 
-	// no validation rules for TotalCost
+	// no validation rules for Accounts
 
-	for idx, item := range m.GetServices() {
-		_, _ = idx, item
+	// no validation rules for FundedAccounts
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetStatsSummaryReplyValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetStatsSummaryReplyValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBalanceLiabilityReplyValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetStatsSummaryReplyValidationError{
-					field:  fmt.Sprintf("Services[%v]", idx),
+				errors = append(errors, GetBalanceLiabilityReplyValidationError{
+					field:  "AsOf",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBalanceLiabilityReplyValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetStatsSummaryReplyMultiError(errors)
+		return GetBalanceLiabilityReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetStatsSummaryReplyMultiError is an error wrapping multiple validation
-// errors returned by GetStatsSummaryReply.ValidateAll() if the designated
+// GetBalanceLiabilityReplyMultiError is an error wrapping multiple validation
+// errors returned by GetBalanceLiabilityReply.ValidateAll() if the designated
 // constraints aren't met.
-type GetStatsSummaryReplyMultiError []error
+type GetBalanceLiabilityReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetStatsSummaryReplyMultiError) Error() string {
+func (m GetBalanceLiabilityReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2242,11 +13195,11 @@ func (m GetStatsSummaryReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetStatsSummaryReplyMultiError) AllErrors() []error { return m }
+func (m GetBalanceLiabilityReplyMultiError) AllErrors() []error { return m }
 
-// GetStatsSummaryReplyValidationError is the validation error returned by
-// GetStatsSummaryReply.Validate if the designated constraints aren't met.
-type GetStatsSummaryReplyValidationError struct {
+// GetBalanceLiabilityReplyValidationError is the validation error returned by
+// GetBalanceLiabilityReply.Validate if the designated constraints aren't met.
+type GetBalanceLiabilityReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2254,24 +13207,24 @@ type GetStatsSummaryReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetStatsSummaryReplyValidationError) Field() string { return e.field }
+func (e GetBalanceLiabilityReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetStatsSummaryReplyValidationError) Reason() string { return e.reason }
+func (e GetBalanceLiabilityReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetStatsSummaryReplyValidationError) Cause() error { return e.cause }
+func (e GetBalanceLiabilityReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetStatsSummaryReplyValidationError) Key() bool { return e.key }
+func (e GetBalanceLiabilityReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetStatsSummaryReplyValidationError) ErrorName() string {
-	return "GetStatsSummaryReplyValidationError"
+func (e GetBalanceLiabilityReplyValidationError) ErrorName() string {
+	return "GetBalanceLiabilityReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetStatsSummaryReplyValidationError) Error() string {
+func (e GetBalanceLiabilityReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2283,14 +13236,14 @@ func (e GetStatsSummaryReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetStatsSummaryReply.%s: %s%s",
+		"invalid %sGetBalanceLiabilityReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetStatsSummaryReplyValidationError{}
+var _ error = GetBalanceLiabilityReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2298,4 +13251,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStatsSummaryReplyValidationError{}
+} = GetBalanceLiabilityReplyValidationError{}
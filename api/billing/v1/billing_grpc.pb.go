@@ -19,12 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BillingService_GetAccount_FullMethodName      = "/billing.v1.BillingService/GetAccount"
-	BillingService_Recharge_FullMethodName        = "/billing.v1.BillingService/Recharge"
-	BillingService_ListRecords_FullMethodName     = "/billing.v1.BillingService/ListRecords"
-	BillingService_GetStatsToday_FullMethodName   = "/billing.v1.BillingService/GetStatsToday"
-	BillingService_GetStatsMonth_FullMethodName   = "/billing.v1.BillingService/GetStatsMonth"
-	BillingService_GetStatsSummary_FullMethodName = "/billing.v1.BillingService/GetStatsSummary"
+	BillingService_GetAccount_FullMethodName          = "/billing.v1.BillingService/GetAccount"
+	BillingService_Recharge_FullMethodName            = "/billing.v1.BillingService/Recharge"
+	BillingService_ListRecords_FullMethodName         = "/billing.v1.BillingService/ListRecords"
+	BillingService_GetStatsToday_FullMethodName       = "/billing.v1.BillingService/GetStatsToday"
+	BillingService_GetStatsMonth_FullMethodName       = "/billing.v1.BillingService/GetStatsMonth"
+	BillingService_GetStatsSummary_FullMethodName     = "/billing.v1.BillingService/GetStatsSummary"
+	BillingService_GetUsageSeries_FullMethodName      = "/billing.v1.BillingService/GetUsageSeries"
+	BillingService_GetLiveUsage_FullMethodName        = "/billing.v1.BillingService/GetLiveUsage"
+	BillingService_CreateExport_FullMethodName        = "/billing.v1.BillingService/CreateExport"
+	BillingService_GetExport_FullMethodName           = "/billing.v1.BillingService/GetExport"
+	BillingService_SetBudget_FullMethodName           = "/billing.v1.BillingService/SetBudget"
+	BillingService_ListBudgets_FullMethodName         = "/billing.v1.BillingService/ListBudgets"
+	BillingService_DeleteBudget_FullMethodName        = "/billing.v1.BillingService/DeleteBudget"
+	BillingService_SetAccountTimezone_FullMethodName  = "/billing.v1.BillingService/SetAccountTimezone"
+	BillingService_ListPackageCatalog_FullMethodName  = "/billing.v1.BillingService/ListPackageCatalog"
+	BillingService_PurchasePackage_FullMethodName     = "/billing.v1.BillingService/PurchasePackage"
+	BillingService_ListUserPackages_FullMethodName    = "/billing.v1.BillingService/ListUserPackages"
+	BillingService_CreateOrganization_FullMethodName  = "/billing.v1.BillingService/CreateOrganization"
+	BillingService_GetOrganization_FullMethodName     = "/billing.v1.BillingService/GetOrganization"
+	BillingService_AddOrgMember_FullMethodName        = "/billing.v1.BillingService/AddOrgMember"
+	BillingService_AcceptOrgInvitation_FullMethodName = "/billing.v1.BillingService/AcceptOrgInvitation"
+	BillingService_UpdateOrgMember_FullMethodName     = "/billing.v1.BillingService/UpdateOrgMember"
+	BillingService_RemoveOrgMember_FullMethodName     = "/billing.v1.BillingService/RemoveOrgMember"
 )
 
 // BillingServiceClient is the client API for BillingService service.
@@ -46,6 +63,45 @@ type BillingServiceClient interface {
 	GetStatsMonth(ctx context.Context, in *GetStatsMonthRequest, opts ...grpc.CallOption) (*GetStatsReply, error)
 	// 获取汇总统计（所有服务）
 	GetStatsSummary(ctx context.Context, in *GetStatsSummaryRequest, opts ...grpc.CallOption) (*GetStatsSummaryReply, error)
+	// 获取用量时间序列（按小时/天/月分桶，支持指定时区，无数据的时间桶补零）
+	GetUsageSeries(ctx context.Context, in *GetUsageSeriesRequest, opts ...grpc.CallOption) (*GetUsageSeriesReply, error)
+	// 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待异步落库）
+	// 持续推送：GET /api/v1/billing/stats/live/stream（SSE，参数同本接口）
+	GetLiveUsage(ctx context.Context, in *GetLiveUsageRequest, opts ...grpc.CallOption) (*GetUsageSeriesReply, error)
+	// 创建账单导出任务（CSV / XLSX / PDF），数据量小时同步生成
+	// 文件通过 GetExport 返回的 downloadUrl 下载：GET /api/v1/billing/exports/{exportId}/download
+	CreateExport(ctx context.Context, in *CreateExportRequest, opts ...grpc.CallOption) (*CreateExportReply, error)
+	// 查询账单导出任务状态，完成后返回下载链接
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportReply, error)
+	// 设置消费预算（按服务或全部服务，按自然月统计余额消费），同一服务已存在时覆盖
+	// 达到提醒阈值时发送一次通知；硬性上限时超出预算的扣费被拒绝
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetReply, error)
+	// 获取消费预算及本月已消费金额
+	ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...grpc.CallOption) (*ListBudgetsReply, error)
+	// 删除消费预算
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetReply, error)
+	// 设置账户计费时区（IANA 名称，为空表示使用服务默认时区）
+	// 免费额度周期、消费预算月份、今日/本月统计与每日限流都按该时区的日期计算
+	SetAccountTimezone(ctx context.Context, in *SetAccountTimezoneRequest, opts ...grpc.CallOption) (*SetAccountTimezoneReply, error)
+	// 获取可购买的用量包目录
+	ListPackageCatalog(ctx context.Context, in *ListPackageCatalogRequest, opts ...grpc.CallOption) (*ListPackageCatalogReply, error)
+	// 购买用量包（返回支付链接），支付成功后发放到账户
+	// 扣费顺序：免费额度 → 用量包（先到期的先用）→ 余额
+	PurchasePackage(ctx context.Context, in *PurchasePackageRequest, opts ...grpc.CallOption) (*PurchasePackageReply, error)
+	// 获取账户持有的用量包（剩余用量与到期时间）
+	ListUserPackages(ctx context.Context, in *ListUserPackagesRequest, opts ...grpc.CallOption) (*ListUserPackagesReply, error)
+	// 创建组织，创建者为所有者；成员的用量由组织共享钱包支付
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*OrganizationReply, error)
+	// 获取组织及成员（含本月消费），普通成员只返回自己
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*OrganizationReply, error)
+	// 邀请组织成员（所有者/管理员），每个用户最多属于一个组织；被邀请的用户接受后才加入
+	AddOrgMember(ctx context.Context, in *AddOrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error)
+	// 接受组织邀请（被邀请的用户本人），拒绝邀请使用 RemoveOrgMember 移除自己
+	AcceptOrgInvitation(ctx context.Context, in *AcceptOrgInvitationRequest, opts ...grpc.CallOption) (*OrgMember, error)
+	// 更新组织成员的角色与每月消费上限
+	UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error)
+	// 移除组织成员（成员可自行退出，所有者不能被移除）
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*RemoveOrgMemberReply, error)
 }
 
 type billingServiceClient struct {
@@ -116,6 +172,176 @@ func (c *billingServiceClient) GetStatsSummary(ctx context.Context, in *GetStats
 	return out, nil
 }
 
+func (c *billingServiceClient) GetUsageSeries(ctx context.Context, in *GetUsageSeriesRequest, opts ...grpc.CallOption) (*GetUsageSeriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageSeriesReply)
+	err := c.cc.Invoke(ctx, BillingService_GetUsageSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetLiveUsage(ctx context.Context, in *GetLiveUsageRequest, opts ...grpc.CallOption) (*GetUsageSeriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageSeriesReply)
+	err := c.cc.Invoke(ctx, BillingService_GetLiveUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) CreateExport(ctx context.Context, in *CreateExportRequest, opts ...grpc.CallOption) (*CreateExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportReply)
+	err := c.cc.Invoke(ctx, BillingService_CreateExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportReply)
+	err := c.cc.Invoke(ctx, BillingService_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetReply)
+	err := c.cc.Invoke(ctx, BillingService_SetBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...grpc.CallOption) (*ListBudgetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBudgetsReply)
+	err := c.cc.Invoke(ctx, BillingService_ListBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBudgetReply)
+	err := c.cc.Invoke(ctx, BillingService_DeleteBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) SetAccountTimezone(ctx context.Context, in *SetAccountTimezoneRequest, opts ...grpc.CallOption) (*SetAccountTimezoneReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAccountTimezoneReply)
+	err := c.cc.Invoke(ctx, BillingService_SetAccountTimezone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) ListPackageCatalog(ctx context.Context, in *ListPackageCatalogRequest, opts ...grpc.CallOption) (*ListPackageCatalogReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackageCatalogReply)
+	err := c.cc.Invoke(ctx, BillingService_ListPackageCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) PurchasePackage(ctx context.Context, in *PurchasePackageRequest, opts ...grpc.CallOption) (*PurchasePackageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchasePackageReply)
+	err := c.cc.Invoke(ctx, BillingService_PurchasePackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) ListUserPackages(ctx context.Context, in *ListUserPackagesRequest, opts ...grpc.CallOption) (*ListUserPackagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPackagesReply)
+	err := c.cc.Invoke(ctx, BillingService_ListUserPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*OrganizationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationReply)
+	err := c.cc.Invoke(ctx, BillingService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*OrganizationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationReply)
+	err := c.cc.Invoke(ctx, BillingService_GetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) AddOrgMember(ctx context.Context, in *AddOrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgMember)
+	err := c.cc.Invoke(ctx, BillingService_AddOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) AcceptOrgInvitation(ctx context.Context, in *AcceptOrgInvitationRequest, opts ...grpc.CallOption) (*OrgMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgMember)
+	err := c.cc.Invoke(ctx, BillingService_AcceptOrgInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberRequest, opts ...grpc.CallOption) (*OrgMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgMember)
+	err := c.cc.Invoke(ctx, BillingService_UpdateOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*RemoveOrgMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrgMemberReply)
+	err := c.cc.Invoke(ctx, BillingService_RemoveOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BillingServiceServer is the server API for BillingService service.
 // All implementations must embed UnimplementedBillingServiceServer
 // for forward compatibility.
@@ -135,6 +361,45 @@ type BillingServiceServer interface {
 	GetStatsMonth(context.Context, *GetStatsMonthRequest) (*GetStatsReply, error)
 	// 获取汇总统计（所有服务）
 	GetStatsSummary(context.Context, *GetStatsSummaryRequest) (*GetStatsSummaryReply, error)
+	// 获取用量时间序列（按小时/天/月分桶，支持指定时区，无数据的时间桶补零）
+	GetUsageSeries(context.Context, *GetUsageSeriesRequest) (*GetUsageSeriesReply, error)
+	// 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待异步落库）
+	// 持续推送：GET /api/v1/billing/stats/live/stream（SSE，参数同本接口）
+	GetLiveUsage(context.Context, *GetLiveUsageRequest) (*GetUsageSeriesReply, error)
+	// 创建账单导出任务（CSV / XLSX / PDF），数据量小时同步生成
+	// 文件通过 GetExport 返回的 downloadUrl 下载：GET /api/v1/billing/exports/{exportId}/download
+	CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error)
+	// 查询账单导出任务状态，完成后返回下载链接
+	GetExport(context.Context, *GetExportRequest) (*GetExportReply, error)
+	// 设置消费预算（按服务或全部服务，按自然月统计余额消费），同一服务已存在时覆盖
+	// 达到提醒阈值时发送一次通知；硬性上限时超出预算的扣费被拒绝
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetReply, error)
+	// 获取消费预算及本月已消费金额
+	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsReply, error)
+	// 删除消费预算
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetReply, error)
+	// 设置账户计费时区（IANA 名称，为空表示使用服务默认时区）
+	// 免费额度周期、消费预算月份、今日/本月统计与每日限流都按该时区的日期计算
+	SetAccountTimezone(context.Context, *SetAccountTimezoneRequest) (*SetAccountTimezoneReply, error)
+	// 获取可购买的用量包目录
+	ListPackageCatalog(context.Context, *ListPackageCatalogRequest) (*ListPackageCatalogReply, error)
+	// 购买用量包（返回支付链接），支付成功后发放到账户
+	// 扣费顺序：免费额度 → 用量包（先到期的先用）→ 余额
+	PurchasePackage(context.Context, *PurchasePackageRequest) (*PurchasePackageReply, error)
+	// 获取账户持有的用量包（剩余用量与到期时间）
+	ListUserPackages(context.Context, *ListUserPackagesRequest) (*ListUserPackagesReply, error)
+	// 创建组织，创建者为所有者；成员的用量由组织共享钱包支付
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*OrganizationReply, error)
+	// 获取组织及成员（含本月消费），普通成员只返回自己
+	GetOrganization(context.Context, *GetOrganizationRequest) (*OrganizationReply, error)
+	// 邀请组织成员（所有者/管理员），每个用户最多属于一个组织；被邀请的用户接受后才加入
+	AddOrgMember(context.Context, *AddOrgMemberRequest) (*OrgMember, error)
+	// 接受组织邀请（被邀请的用户本人），拒绝邀请使用 RemoveOrgMember 移除自己
+	AcceptOrgInvitation(context.Context, *AcceptOrgInvitationRequest) (*OrgMember, error)
+	// 更新组织成员的角色与每月消费上限
+	UpdateOrgMember(context.Context, *UpdateOrgMemberRequest) (*OrgMember, error)
+	// 移除组织成员（成员可自行退出，所有者不能被移除）
+	RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*RemoveOrgMemberReply, error)
 	mustEmbedUnimplementedBillingServiceServer()
 }
 
@@ -163,6 +428,57 @@ func (UnimplementedBillingServiceServer) GetStatsMonth(context.Context, *GetStat
 func (UnimplementedBillingServiceServer) GetStatsSummary(context.Context, *GetStatsSummaryRequest) (*GetStatsSummaryReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStatsSummary not implemented")
 }
+func (UnimplementedBillingServiceServer) GetUsageSeries(context.Context, *GetUsageSeriesRequest) (*GetUsageSeriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsageSeries not implemented")
+}
+func (UnimplementedBillingServiceServer) GetLiveUsage(context.Context, *GetLiveUsageRequest) (*GetUsageSeriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLiveUsage not implemented")
+}
+func (UnimplementedBillingServiceServer) CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExport not implemented")
+}
+func (UnimplementedBillingServiceServer) GetExport(context.Context, *GetExportRequest) (*GetExportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedBillingServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedBillingServiceServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBudgets not implemented")
+}
+func (UnimplementedBillingServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedBillingServiceServer) SetAccountTimezone(context.Context, *SetAccountTimezoneRequest) (*SetAccountTimezoneReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAccountTimezone not implemented")
+}
+func (UnimplementedBillingServiceServer) ListPackageCatalog(context.Context, *ListPackageCatalogRequest) (*ListPackageCatalogReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPackageCatalog not implemented")
+}
+func (UnimplementedBillingServiceServer) PurchasePackage(context.Context, *PurchasePackageRequest) (*PurchasePackageReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurchasePackage not implemented")
+}
+func (UnimplementedBillingServiceServer) ListUserPackages(context.Context, *ListUserPackagesRequest) (*ListUserPackagesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserPackages not implemented")
+}
+func (UnimplementedBillingServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*OrganizationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedBillingServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*OrganizationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedBillingServiceServer) AddOrgMember(context.Context, *AddOrgMemberRequest) (*OrgMember, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddOrgMember not implemented")
+}
+func (UnimplementedBillingServiceServer) AcceptOrgInvitation(context.Context, *AcceptOrgInvitationRequest) (*OrgMember, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptOrgInvitation not implemented")
+}
+func (UnimplementedBillingServiceServer) UpdateOrgMember(context.Context, *UpdateOrgMemberRequest) (*OrgMember, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateOrgMember not implemented")
+}
+func (UnimplementedBillingServiceServer) RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*RemoveOrgMemberReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
 func (UnimplementedBillingServiceServer) mustEmbedUnimplementedBillingServiceServer() {}
 func (UnimplementedBillingServiceServer) testEmbeddedByValue()                        {}
 
@@ -292,227 +608,1386 @@ func _BillingService_GetStatsSummary_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// BillingService_ServiceDesc is the grpc.ServiceDesc for BillingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BillingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "billing.v1.BillingService",
-	HandlerType: (*BillingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAccount",
-			Handler:    _BillingService_GetAccount_Handler,
-		},
-		{
-			MethodName: "Recharge",
-			Handler:    _BillingService_Recharge_Handler,
-		},
-		{
-			MethodName: "ListRecords",
-			Handler:    _BillingService_ListRecords_Handler,
-		},
-		{
-			MethodName: "GetStatsToday",
-			Handler:    _BillingService_GetStatsToday_Handler,
-		},
-		{
-			MethodName: "GetStatsMonth",
-			Handler:    _BillingService_GetStatsMonth_Handler,
-		},
-		{
-			MethodName: "GetStatsSummary",
-			Handler:    _BillingService_GetStatsSummary_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "billing.proto",
-}
-
-const (
-	BillingInternalService_CheckQuota_FullMethodName       = "/billing.v1.BillingInternalService/CheckQuota"
-	BillingInternalService_DeductQuota_FullMethodName      = "/billing.v1.BillingInternalService/DeductQuota"
-	BillingInternalService_RechargeCallback_FullMethodName = "/billing.v1.BillingInternalService/RechargeCallback"
-)
-
-// BillingInternalServiceClient is the client API for BillingInternalService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// BillingInternalService 计费内部服务（内部接口）
-// 面向内部服务（Gateway/Payment）的接口
-type BillingInternalServiceClient interface {
-	// 检查并预扣费 (Check & Reserve)
-	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error)
-	// 确认扣费 (Commit)
-	DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...grpc.CallOption) (*DeductQuotaReply, error)
-	// 充值回调 (来自 Payment Service)
-	RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...grpc.CallOption) (*RechargeCallbackReply, error)
-}
-
-type billingInternalServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewBillingInternalServiceClient(cc grpc.ClientConnInterface) BillingInternalServiceClient {
-	return &billingInternalServiceClient{cc}
+func _BillingService_GetUsageSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetUsageSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetUsageSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetUsageSeries(ctx, req.(*GetUsageSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *billingInternalServiceClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CheckQuotaReply)
-	err := c.cc.Invoke(ctx, BillingInternalService_CheckQuota_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BillingService_GetLiveUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiveUsageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetLiveUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetLiveUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetLiveUsage(ctx, req.(*GetLiveUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *billingInternalServiceClient) DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...grpc.CallOption) (*DeductQuotaReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeductQuotaReply)
-	err := c.cc.Invoke(ctx, BillingInternalService_DeductQuota_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BillingService_CreateExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BillingServiceServer).CreateExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_CreateExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).CreateExport(ctx, req.(*CreateExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *billingInternalServiceClient) RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...grpc.CallOption) (*RechargeCallbackReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RechargeCallbackReply)
-	err := c.cc.Invoke(ctx, BillingInternalService_RechargeCallback_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BillingService_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetExport(ctx, req.(*GetExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// BillingInternalServiceServer is the server API for BillingInternalService service.
+func _BillingService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).ListBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_ListBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_SetAccountTimezone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountTimezoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).SetAccountTimezone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_SetAccountTimezone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).SetAccountTimezone(ctx, req.(*SetAccountTimezoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_ListPackageCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackageCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).ListPackageCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_ListPackageCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).ListPackageCatalog(ctx, req.(*ListPackageCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_PurchasePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchasePackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).PurchasePackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_PurchasePackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).PurchasePackage(ctx, req.(*PurchasePackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_ListUserPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).ListUserPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_ListUserPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).ListUserPackages(ctx, req.(*ListUserPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_AddOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).AddOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_AddOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).AddOrgMember(ctx, req.(*AddOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_AcceptOrgInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrgInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).AcceptOrgInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_AcceptOrgInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).AcceptOrgInvitation(ctx, req.(*AcceptOrgInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_UpdateOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).UpdateOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_UpdateOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).UpdateOrgMember(ctx, req.(*UpdateOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_RemoveOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BillingService_ServiceDesc is the grpc.ServiceDesc for BillingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BillingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.v1.BillingService",
+	HandlerType: (*BillingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _BillingService_GetAccount_Handler,
+		},
+		{
+			MethodName: "Recharge",
+			Handler:    _BillingService_Recharge_Handler,
+		},
+		{
+			MethodName: "ListRecords",
+			Handler:    _BillingService_ListRecords_Handler,
+		},
+		{
+			MethodName: "GetStatsToday",
+			Handler:    _BillingService_GetStatsToday_Handler,
+		},
+		{
+			MethodName: "GetStatsMonth",
+			Handler:    _BillingService_GetStatsMonth_Handler,
+		},
+		{
+			MethodName: "GetStatsSummary",
+			Handler:    _BillingService_GetStatsSummary_Handler,
+		},
+		{
+			MethodName: "GetUsageSeries",
+			Handler:    _BillingService_GetUsageSeries_Handler,
+		},
+		{
+			MethodName: "GetLiveUsage",
+			Handler:    _BillingService_GetLiveUsage_Handler,
+		},
+		{
+			MethodName: "CreateExport",
+			Handler:    _BillingService_CreateExport_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _BillingService_GetExport_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _BillingService_SetBudget_Handler,
+		},
+		{
+			MethodName: "ListBudgets",
+			Handler:    _BillingService_ListBudgets_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _BillingService_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "SetAccountTimezone",
+			Handler:    _BillingService_SetAccountTimezone_Handler,
+		},
+		{
+			MethodName: "ListPackageCatalog",
+			Handler:    _BillingService_ListPackageCatalog_Handler,
+		},
+		{
+			MethodName: "PurchasePackage",
+			Handler:    _BillingService_PurchasePackage_Handler,
+		},
+		{
+			MethodName: "ListUserPackages",
+			Handler:    _BillingService_ListUserPackages_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _BillingService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _BillingService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "AddOrgMember",
+			Handler:    _BillingService_AddOrgMember_Handler,
+		},
+		{
+			MethodName: "AcceptOrgInvitation",
+			Handler:    _BillingService_AcceptOrgInvitation_Handler,
+		},
+		{
+			MethodName: "UpdateOrgMember",
+			Handler:    _BillingService_UpdateOrgMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _BillingService_RemoveOrgMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "billing.proto",
+}
+
+const (
+	BillingInternalService_CheckQuota_FullMethodName       = "/billing.v1.BillingInternalService/CheckQuota"
+	BillingInternalService_DeductQuota_FullMethodName      = "/billing.v1.BillingInternalService/DeductQuota"
+	BillingInternalService_BatchCheckQuota_FullMethodName  = "/billing.v1.BillingInternalService/BatchCheckQuota"
+	BillingInternalService_BatchDeductQuota_FullMethodName = "/billing.v1.BillingInternalService/BatchDeductQuota"
+	BillingInternalService_RechargeCallback_FullMethodName = "/billing.v1.BillingInternalService/RechargeCallback"
+	BillingInternalService_StreamDeduct_FullMethodName     = "/billing.v1.BillingInternalService/StreamDeduct"
+	BillingInternalService_AcquireLease_FullMethodName     = "/billing.v1.BillingInternalService/AcquireLease"
+	BillingInternalService_ReportLeaseUsage_FullMethodName = "/billing.v1.BillingInternalService/ReportLeaseUsage"
+	BillingInternalService_ReleaseLease_FullMethodName     = "/billing.v1.BillingInternalService/ReleaseLease"
+)
+
+// BillingInternalServiceClient is the client API for BillingInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BillingInternalService 计费内部服务（内部接口）
+// 面向内部服务（Gateway/Payment）的接口
+type BillingInternalServiceClient interface {
+	// 检查并预扣费 (Check & Reserve)
+	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error)
+	// 确认扣费 (Commit)
+	DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...grpc.CallOption) (*DeductQuotaReply, error)
+	// 批量检查配额：同一用户一次检查多个服务，所有服务均可扣费时才放行
+	BatchCheckQuota(ctx context.Context, in *BatchCheckQuotaRequest, opts ...grpc.CallOption) (*BatchCheckQuotaReply, error)
+	// 批量扣费：同一用户一次扣减多个服务，在一个事务中完成，要么全部成功要么全部失败
+	BatchDeductQuota(ctx context.Context, in *BatchDeductQuotaRequest, opts ...grpc.CallOption) (*BatchDeductQuotaReply, error)
+	// 充值回调 (来自 Payment Service)
+	RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...grpc.CallOption) (*RechargeCallbackReply, error)
+	// 流式扣费：网关通过长连接发送带关联ID的扣费请求，服务端攒批处理后异步返回结果（仅 gRPC）
+	StreamDeduct(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamDeductRequest, StreamDeductReply], error)
+	// 申请额度租约：预留 N 次调用，网关在租约有效期内本地放行
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseReply, error)
+	// 上报租约用量（增量），可同时续期
+	ReportLeaseUsage(ctx context.Context, in *ReportLeaseUsageRequest, opts ...grpc.CallOption) (*ReportLeaseUsageReply, error)
+	// 释放租约：上报最终用量并归还未用部分
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseReply, error)
+}
+
+type billingInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBillingInternalServiceClient(cc grpc.ClientConnInterface) BillingInternalServiceClient {
+	return &billingInternalServiceClient{cc}
+}
+
+func (c *billingInternalServiceClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckQuotaReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_CheckQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...grpc.CallOption) (*DeductQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeductQuotaReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_DeductQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) BatchCheckQuota(ctx context.Context, in *BatchCheckQuotaRequest, opts ...grpc.CallOption) (*BatchCheckQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCheckQuotaReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_BatchCheckQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) BatchDeductQuota(ctx context.Context, in *BatchDeductQuotaRequest, opts ...grpc.CallOption) (*BatchDeductQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeductQuotaReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_BatchDeductQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...grpc.CallOption) (*RechargeCallbackReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RechargeCallbackReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_RechargeCallback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) StreamDeduct(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamDeductRequest, StreamDeductReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BillingInternalService_ServiceDesc.Streams[0], BillingInternalService_StreamDeduct_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDeductRequest, StreamDeductReply]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BillingInternalService_StreamDeductClient = grpc.BidiStreamingClient[StreamDeductRequest, StreamDeductReply]
+
+func (c *billingInternalServiceClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcquireLeaseReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_AcquireLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) ReportLeaseUsage(ctx context.Context, in *ReportLeaseUsageRequest, opts ...grpc.CallOption) (*ReportLeaseUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportLeaseUsageReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_ReportLeaseUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingInternalServiceClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLeaseReply)
+	err := c.cc.Invoke(ctx, BillingInternalService_ReleaseLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BillingInternalServiceServer is the server API for BillingInternalService service.
 // All implementations must embed UnimplementedBillingInternalServiceServer
 // for forward compatibility.
 //
-// BillingInternalService 计费内部服务（内部接口）
-// 面向内部服务（Gateway/Payment）的接口
-type BillingInternalServiceServer interface {
-	// 检查并预扣费 (Check & Reserve)
-	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
-	// 确认扣费 (Commit)
-	DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error)
-	// 充值回调 (来自 Payment Service)
-	RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error)
-	mustEmbedUnimplementedBillingInternalServiceServer()
+// BillingInternalService 计费内部服务（内部接口）
+// 面向内部服务（Gateway/Payment）的接口
+type BillingInternalServiceServer interface {
+	// 检查并预扣费 (Check & Reserve)
+	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
+	// 确认扣费 (Commit)
+	DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error)
+	// 批量检查配额：同一用户一次检查多个服务，所有服务均可扣费时才放行
+	BatchCheckQuota(context.Context, *BatchCheckQuotaRequest) (*BatchCheckQuotaReply, error)
+	// 批量扣费：同一用户一次扣减多个服务，在一个事务中完成，要么全部成功要么全部失败
+	BatchDeductQuota(context.Context, *BatchDeductQuotaRequest) (*BatchDeductQuotaReply, error)
+	// 充值回调 (来自 Payment Service)
+	RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error)
+	// 流式扣费：网关通过长连接发送带关联ID的扣费请求，服务端攒批处理后异步返回结果（仅 gRPC）
+	StreamDeduct(grpc.BidiStreamingServer[StreamDeductRequest, StreamDeductReply]) error
+	// 申请额度租约：预留 N 次调用，网关在租约有效期内本地放行
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseReply, error)
+	// 上报租约用量（增量），可同时续期
+	ReportLeaseUsage(context.Context, *ReportLeaseUsageRequest) (*ReportLeaseUsageReply, error)
+	// 释放租约：上报最终用量并归还未用部分
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseReply, error)
+	mustEmbedUnimplementedBillingInternalServiceServer()
+}
+
+// UnimplementedBillingInternalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBillingInternalServiceServer struct{}
+
+func (UnimplementedBillingInternalServiceServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckQuota not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeductQuota not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) BatchCheckQuota(context.Context, *BatchCheckQuotaRequest) (*BatchCheckQuotaReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCheckQuota not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) BatchDeductQuota(context.Context, *BatchDeductQuotaRequest) (*BatchDeductQuotaReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeductQuota not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RechargeCallback not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) StreamDeduct(grpc.BidiStreamingServer[StreamDeductRequest, StreamDeductReply]) error {
+	return status.Error(codes.Unimplemented, "method StreamDeduct not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) ReportLeaseUsage(context.Context, *ReportLeaseUsageRequest) (*ReportLeaseUsageReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportLeaseUsage not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedBillingInternalServiceServer) mustEmbedUnimplementedBillingInternalServiceServer() {
+}
+func (UnimplementedBillingInternalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBillingInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BillingInternalServiceServer will
+// result in compilation errors.
+type UnsafeBillingInternalServiceServer interface {
+	mustEmbedUnimplementedBillingInternalServiceServer()
+}
+
+func RegisterBillingInternalServiceServer(s grpc.ServiceRegistrar, srv BillingInternalServiceServer) {
+	// If the following call panics, it indicates UnimplementedBillingInternalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BillingInternalService_ServiceDesc, srv)
+}
+
+func _BillingInternalService_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_CheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_DeductQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeductQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).DeductQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_DeductQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).DeductQuota(ctx, req.(*DeductQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_BatchCheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).BatchCheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_BatchCheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).BatchCheckQuota(ctx, req.(*BatchCheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_BatchDeductQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeductQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).BatchDeductQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_BatchDeductQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).BatchDeductQuota(ctx, req.(*BatchDeductQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_RechargeCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RechargeCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).RechargeCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_RechargeCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).RechargeCallback(ctx, req.(*RechargeCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_StreamDeduct_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BillingInternalServiceServer).StreamDeduct(&grpc.GenericServerStream[StreamDeductRequest, StreamDeductReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BillingInternalService_StreamDeductServer = grpc.BidiStreamingServer[StreamDeductRequest, StreamDeductReply]
+
+func _BillingInternalService_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_AcquireLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_ReportLeaseUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLeaseUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).ReportLeaseUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_ReportLeaseUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).ReportLeaseUsage(ctx, req.(*ReportLeaseUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingInternalService_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingInternalServiceServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingInternalService_ReleaseLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingInternalServiceServer).ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BillingInternalService_ServiceDesc is the grpc.ServiceDesc for BillingInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BillingInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.v1.BillingInternalService",
+	HandlerType: (*BillingInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckQuota",
+			Handler:    _BillingInternalService_CheckQuota_Handler,
+		},
+		{
+			MethodName: "DeductQuota",
+			Handler:    _BillingInternalService_DeductQuota_Handler,
+		},
+		{
+			MethodName: "BatchCheckQuota",
+			Handler:    _BillingInternalService_BatchCheckQuota_Handler,
+		},
+		{
+			MethodName: "BatchDeductQuota",
+			Handler:    _BillingInternalService_BatchDeductQuota_Handler,
+		},
+		{
+			MethodName: "RechargeCallback",
+			Handler:    _BillingInternalService_RechargeCallback_Handler,
+		},
+		{
+			MethodName: "AcquireLease",
+			Handler:    _BillingInternalService_AcquireLease_Handler,
+		},
+		{
+			MethodName: "ReportLeaseUsage",
+			Handler:    _BillingInternalService_ReportLeaseUsage_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _BillingInternalService_ReleaseLease_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDeduct",
+			Handler:       _BillingInternalService_StreamDeduct_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "billing.proto",
+}
+
+const (
+	BillingAdminService_GetRevenueReport_FullMethodName      = "/billing.v1.BillingAdminService/GetRevenueReport"
+	BillingAdminService_GetRechargeReport_FullMethodName     = "/billing.v1.BillingAdminService/GetRechargeReport"
+	BillingAdminService_GetUserActivityReport_FullMethodName = "/billing.v1.BillingAdminService/GetUserActivityReport"
+	BillingAdminService_ListTopConsumers_FullMethodName      = "/billing.v1.BillingAdminService/ListTopConsumers"
+	BillingAdminService_GetBalanceLiability_FullMethodName   = "/billing.v1.BillingAdminService/GetBalanceLiability"
+	BillingAdminService_SetUserRateLimit_FullMethodName      = "/billing.v1.BillingAdminService/SetUserRateLimit"
+	BillingAdminService_GetUserRateLimit_FullMethodName      = "/billing.v1.BillingAdminService/GetUserRateLimit"
+	BillingAdminService_CreatePriceRule_FullMethodName       = "/billing.v1.BillingAdminService/CreatePriceRule"
+	BillingAdminService_ListPriceRules_FullMethodName        = "/billing.v1.BillingAdminService/ListPriceRules"
+	BillingAdminService_DeletePriceRule_FullMethodName       = "/billing.v1.BillingAdminService/DeletePriceRule"
+	BillingAdminService_CreateContract_FullMethodName        = "/billing.v1.BillingAdminService/CreateContract"
+	BillingAdminService_ListContracts_FullMethodName         = "/billing.v1.BillingAdminService/ListContracts"
+)
+
+// BillingAdminServiceClient is the client API for BillingAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BillingAdminService 计费管理服务（运营/财务接口）
+// 平台级统计报表，需管理员凭证（Authorization: Bearer <token>）
+type BillingAdminServiceClient interface {
+	// 收入报表：按 UTC 日/月统计余额扣费收入，可按服务拆分
+	GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*GetRevenueReportReply, error)
+	// 充值报表：按 UTC 日/月统计成功充值金额、笔数、充值用户数
+	GetRechargeReport(ctx context.Context, in *GetRechargeReportRequest, opts ...grpc.CallOption) (*GetRechargeReportReply, error)
+	// 用户活跃报表：活跃用户、付费用户、新增付费用户与付费转化率
+	GetUserActivityReport(ctx context.Context, in *GetUserActivityReportRequest, opts ...grpc.CallOption) (*GetUserActivityReportReply, error)
+	// 消费排行：按收入或调用次数排序的 Top N 用户
+	ListTopConsumers(ctx context.Context, in *ListTopConsumersRequest, opts ...grpc.CallOption) (*ListTopConsumersReply, error)
+	// 余额负债：当前全部用户的未消费余额
+	GetBalanceLiability(ctx context.Context, in *GetBalanceLiabilityRequest, opts ...grpc.CallOption) (*GetBalanceLiabilityReply, error)
+	// 设置用户限流：指定套餐及按服务覆盖的限流规则（整体覆盖之前的设置）
+	SetUserRateLimit(ctx context.Context, in *SetUserRateLimitRequest, opts ...grpc.CallOption) (*UserRateLimitReply, error)
+	// 查询用户限流设置及各服务生效的限流规则
+	GetUserRateLimit(ctx context.Context, in *GetUserRateLimitRequest, opts ...grpc.CallOption) (*UserRateLimitReply, error)
+	// 创建账户价格规则：按服务（或全部服务）的单价覆盖或折扣，可指定有效期
+	CreatePriceRule(ctx context.Context, in *CreatePriceRuleRequest, opts ...grpc.CallOption) (*PriceRule, error)
+	// 查询账户价格规则（含合同价）及各服务当前适用单价
+	ListPriceRules(ctx context.Context, in *ListPriceRulesRequest, opts ...grpc.CallOption) (*ListPriceRulesReply, error)
+	// 删除账户价格规则（合同价不能单独删除）
+	DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleReply, error)
+	// 创建承诺消费合同：承诺金额预付计入余额，合同期内按合同价计费，到期后未用完部分补差扣除
+	CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*Contract, error)
+	// 查询账户合同
+	ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ListContractsReply, error)
+}
+
+type billingAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBillingAdminServiceClient(cc grpc.ClientConnInterface) BillingAdminServiceClient {
+	return &billingAdminServiceClient{cc}
+}
+
+func (c *billingAdminServiceClient) GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*GetRevenueReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRevenueReportReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_GetRevenueReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) GetRechargeReport(ctx context.Context, in *GetRechargeReportRequest, opts ...grpc.CallOption) (*GetRechargeReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRechargeReportReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_GetRechargeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) GetUserActivityReport(ctx context.Context, in *GetUserActivityReportRequest, opts ...grpc.CallOption) (*GetUserActivityReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserActivityReportReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_GetUserActivityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) ListTopConsumers(ctx context.Context, in *ListTopConsumersRequest, opts ...grpc.CallOption) (*ListTopConsumersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTopConsumersReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_ListTopConsumers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) GetBalanceLiability(ctx context.Context, in *GetBalanceLiabilityRequest, opts ...grpc.CallOption) (*GetBalanceLiabilityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceLiabilityReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_GetBalanceLiability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) SetUserRateLimit(ctx context.Context, in *SetUserRateLimitRequest, opts ...grpc.CallOption) (*UserRateLimitReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRateLimitReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_SetUserRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) GetUserRateLimit(ctx context.Context, in *GetUserRateLimitRequest, opts ...grpc.CallOption) (*UserRateLimitReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserRateLimitReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_GetUserRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) CreatePriceRule(ctx context.Context, in *CreatePriceRuleRequest, opts ...grpc.CallOption) (*PriceRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceRule)
+	err := c.cc.Invoke(ctx, BillingAdminService_CreatePriceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) ListPriceRules(ctx context.Context, in *ListPriceRulesRequest, opts ...grpc.CallOption) (*ListPriceRulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceRulesReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_ListPriceRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePriceRuleReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_DeletePriceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*Contract, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contract)
+	err := c.cc.Invoke(ctx, BillingAdminService_CreateContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingAdminServiceClient) ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ListContractsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContractsReply)
+	err := c.cc.Invoke(ctx, BillingAdminService_ListContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BillingAdminServiceServer is the server API for BillingAdminService service.
+// All implementations must embed UnimplementedBillingAdminServiceServer
+// for forward compatibility.
+//
+// BillingAdminService 计费管理服务（运营/财务接口）
+// 平台级统计报表，需管理员凭证（Authorization: Bearer <token>）
+type BillingAdminServiceServer interface {
+	// 收入报表：按 UTC 日/月统计余额扣费收入，可按服务拆分
+	GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error)
+	// 充值报表：按 UTC 日/月统计成功充值金额、笔数、充值用户数
+	GetRechargeReport(context.Context, *GetRechargeReportRequest) (*GetRechargeReportReply, error)
+	// 用户活跃报表：活跃用户、付费用户、新增付费用户与付费转化率
+	GetUserActivityReport(context.Context, *GetUserActivityReportRequest) (*GetUserActivityReportReply, error)
+	// 消费排行：按收入或调用次数排序的 Top N 用户
+	ListTopConsumers(context.Context, *ListTopConsumersRequest) (*ListTopConsumersReply, error)
+	// 余额负债：当前全部用户的未消费余额
+	GetBalanceLiability(context.Context, *GetBalanceLiabilityRequest) (*GetBalanceLiabilityReply, error)
+	// 设置用户限流：指定套餐及按服务覆盖的限流规则（整体覆盖之前的设置）
+	SetUserRateLimit(context.Context, *SetUserRateLimitRequest) (*UserRateLimitReply, error)
+	// 查询用户限流设置及各服务生效的限流规则
+	GetUserRateLimit(context.Context, *GetUserRateLimitRequest) (*UserRateLimitReply, error)
+	// 创建账户价格规则：按服务（或全部服务）的单价覆盖或折扣，可指定有效期
+	CreatePriceRule(context.Context, *CreatePriceRuleRequest) (*PriceRule, error)
+	// 查询账户价格规则（含合同价）及各服务当前适用单价
+	ListPriceRules(context.Context, *ListPriceRulesRequest) (*ListPriceRulesReply, error)
+	// 删除账户价格规则（合同价不能单独删除）
+	DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleReply, error)
+	// 创建承诺消费合同：承诺金额预付计入余额，合同期内按合同价计费，到期后未用完部分补差扣除
+	CreateContract(context.Context, *CreateContractRequest) (*Contract, error)
+	// 查询账户合同
+	ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error)
+	mustEmbedUnimplementedBillingAdminServiceServer()
 }
 
-// UnimplementedBillingInternalServiceServer must be embedded to have
+// UnimplementedBillingAdminServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedBillingInternalServiceServer struct{}
+type UnimplementedBillingAdminServiceServer struct{}
 
-func (UnimplementedBillingInternalServiceServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method CheckQuota not implemented")
+func (UnimplementedBillingAdminServiceServer) GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRevenueReport not implemented")
 }
-func (UnimplementedBillingInternalServiceServer) DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeductQuota not implemented")
+func (UnimplementedBillingAdminServiceServer) GetRechargeReport(context.Context, *GetRechargeReportRequest) (*GetRechargeReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRechargeReport not implemented")
 }
-func (UnimplementedBillingInternalServiceServer) RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method RechargeCallback not implemented")
+func (UnimplementedBillingAdminServiceServer) GetUserActivityReport(context.Context, *GetUserActivityReportRequest) (*GetUserActivityReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserActivityReport not implemented")
 }
-func (UnimplementedBillingInternalServiceServer) mustEmbedUnimplementedBillingInternalServiceServer() {
+func (UnimplementedBillingAdminServiceServer) ListTopConsumers(context.Context, *ListTopConsumersRequest) (*ListTopConsumersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTopConsumers not implemented")
 }
-func (UnimplementedBillingInternalServiceServer) testEmbeddedByValue() {}
+func (UnimplementedBillingAdminServiceServer) GetBalanceLiability(context.Context, *GetBalanceLiabilityRequest) (*GetBalanceLiabilityReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalanceLiability not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) SetUserRateLimit(context.Context, *SetUserRateLimitRequest) (*UserRateLimitReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserRateLimit not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) GetUserRateLimit(context.Context, *GetUserRateLimitRequest) (*UserRateLimitReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserRateLimit not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) CreatePriceRule(context.Context, *CreatePriceRuleRequest) (*PriceRule, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePriceRule not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) ListPriceRules(context.Context, *ListPriceRulesRequest) (*ListPriceRulesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPriceRules not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePriceRule not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) CreateContract(context.Context, *CreateContractRequest) (*Contract, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListContracts not implemented")
+}
+func (UnimplementedBillingAdminServiceServer) mustEmbedUnimplementedBillingAdminServiceServer() {}
+func (UnimplementedBillingAdminServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeBillingInternalServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BillingInternalServiceServer will
+// UnsafeBillingAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BillingAdminServiceServer will
 // result in compilation errors.
-type UnsafeBillingInternalServiceServer interface {
-	mustEmbedUnimplementedBillingInternalServiceServer()
+type UnsafeBillingAdminServiceServer interface {
+	mustEmbedUnimplementedBillingAdminServiceServer()
 }
 
-func RegisterBillingInternalServiceServer(s grpc.ServiceRegistrar, srv BillingInternalServiceServer) {
-	// If the following call panics, it indicates UnimplementedBillingInternalServiceServer was
+func RegisterBillingAdminServiceServer(s grpc.ServiceRegistrar, srv BillingAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedBillingAdminServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&BillingInternalService_ServiceDesc, srv)
+	s.RegisterService(&BillingAdminService_ServiceDesc, srv)
 }
 
-func _BillingInternalService_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckQuotaRequest)
+func _BillingAdminService_GetRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BillingInternalServiceServer).CheckQuota(ctx, in)
+		return srv.(BillingAdminServiceServer).GetRevenueReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BillingInternalService_CheckQuota_FullMethodName,
+		FullMethod: BillingAdminService_GetRevenueReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BillingInternalServiceServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+		return srv.(BillingAdminServiceServer).GetRevenueReport(ctx, req.(*GetRevenueReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BillingInternalService_DeductQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeductQuotaRequest)
+func _BillingAdminService_GetRechargeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRechargeReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BillingInternalServiceServer).DeductQuota(ctx, in)
+		return srv.(BillingAdminServiceServer).GetRechargeReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BillingInternalService_DeductQuota_FullMethodName,
+		FullMethod: BillingAdminService_GetRechargeReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BillingInternalServiceServer).DeductQuota(ctx, req.(*DeductQuotaRequest))
+		return srv.(BillingAdminServiceServer).GetRechargeReport(ctx, req.(*GetRechargeReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BillingInternalService_RechargeCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RechargeCallbackRequest)
+func _BillingAdminService_GetUserActivityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserActivityReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BillingInternalServiceServer).RechargeCallback(ctx, in)
+		return srv.(BillingAdminServiceServer).GetUserActivityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BillingInternalService_RechargeCallback_FullMethodName,
+		FullMethod: BillingAdminService_GetUserActivityReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BillingInternalServiceServer).RechargeCallback(ctx, req.(*RechargeCallbackRequest))
+		return srv.(BillingAdminServiceServer).GetUserActivityReport(ctx, req.(*GetUserActivityReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// BillingInternalService_ServiceDesc is the grpc.ServiceDesc for BillingInternalService service.
+func _BillingAdminService_ListTopConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopConsumersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).ListTopConsumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_ListTopConsumers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).ListTopConsumers(ctx, req.(*ListTopConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_GetBalanceLiability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceLiabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).GetBalanceLiability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_GetBalanceLiability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).GetBalanceLiability(ctx, req.(*GetBalanceLiabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_SetUserRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).SetUserRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_SetUserRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).SetUserRateLimit(ctx, req.(*SetUserRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_GetUserRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).GetUserRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_GetUserRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).GetUserRateLimit(ctx, req.(*GetUserRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_CreatePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).CreatePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_CreatePriceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).CreatePriceRule(ctx, req.(*CreatePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_ListPriceRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).ListPriceRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_ListPriceRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).ListPriceRules(ctx, req.(*ListPriceRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_DeletePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).DeletePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_DeletePriceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).DeletePriceRule(ctx, req.(*DeletePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_CreateContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).CreateContract(ctx, req.(*CreateContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingAdminService_ListContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingAdminServiceServer).ListContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingAdminService_ListContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingAdminServiceServer).ListContracts(ctx, req.(*ListContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BillingAdminService_ServiceDesc is the grpc.ServiceDesc for BillingAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var BillingInternalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "billing.v1.BillingInternalService",
-	HandlerType: (*BillingInternalServiceServer)(nil),
+var BillingAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.v1.BillingAdminService",
+	HandlerType: (*BillingAdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CheckQuota",
-			Handler:    _BillingInternalService_CheckQuota_Handler,
+			MethodName: "GetRevenueReport",
+			Handler:    _BillingAdminService_GetRevenueReport_Handler,
 		},
 		{
-			MethodName: "DeductQuota",
-			Handler:    _BillingInternalService_DeductQuota_Handler,
+			MethodName: "GetRechargeReport",
+			Handler:    _BillingAdminService_GetRechargeReport_Handler,
 		},
 		{
-			MethodName: "RechargeCallback",
-			Handler:    _BillingInternalService_RechargeCallback_Handler,
+			MethodName: "GetUserActivityReport",
+			Handler:    _BillingAdminService_GetUserActivityReport_Handler,
+		},
+		{
+			MethodName: "ListTopConsumers",
+			Handler:    _BillingAdminService_ListTopConsumers_Handler,
+		},
+		{
+			MethodName: "GetBalanceLiability",
+			Handler:    _BillingAdminService_GetBalanceLiability_Handler,
+		},
+		{
+			MethodName: "SetUserRateLimit",
+			Handler:    _BillingAdminService_SetUserRateLimit_Handler,
+		},
+		{
+			MethodName: "GetUserRateLimit",
+			Handler:    _BillingAdminService_GetUserRateLimit_Handler,
+		},
+		{
+			MethodName: "CreatePriceRule",
+			Handler:    _BillingAdminService_CreatePriceRule_Handler,
+		},
+		{
+			MethodName: "ListPriceRules",
+			Handler:    _BillingAdminService_ListPriceRules_Handler,
+		},
+		{
+			MethodName: "DeletePriceRule",
+			Handler:    _BillingAdminService_DeletePriceRule_Handler,
+		},
+		{
+			MethodName: "CreateContract",
+			Handler:    _BillingAdminService_CreateContract_Handler,
+		},
+		{
+			MethodName: "ListContracts",
+			Handler:    _BillingAdminService_ListContracts_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -19,26 +19,82 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationBillingServiceAcceptOrgInvitation = "/billing.v1.BillingService/AcceptOrgInvitation"
+const OperationBillingServiceAddOrgMember = "/billing.v1.BillingService/AddOrgMember"
+const OperationBillingServiceCreateExport = "/billing.v1.BillingService/CreateExport"
+const OperationBillingServiceCreateOrganization = "/billing.v1.BillingService/CreateOrganization"
+const OperationBillingServiceDeleteBudget = "/billing.v1.BillingService/DeleteBudget"
 const OperationBillingServiceGetAccount = "/billing.v1.BillingService/GetAccount"
+const OperationBillingServiceGetExport = "/billing.v1.BillingService/GetExport"
+const OperationBillingServiceGetLiveUsage = "/billing.v1.BillingService/GetLiveUsage"
+const OperationBillingServiceGetOrganization = "/billing.v1.BillingService/GetOrganization"
 const OperationBillingServiceGetStatsMonth = "/billing.v1.BillingService/GetStatsMonth"
 const OperationBillingServiceGetStatsSummary = "/billing.v1.BillingService/GetStatsSummary"
 const OperationBillingServiceGetStatsToday = "/billing.v1.BillingService/GetStatsToday"
+const OperationBillingServiceGetUsageSeries = "/billing.v1.BillingService/GetUsageSeries"
+const OperationBillingServiceListBudgets = "/billing.v1.BillingService/ListBudgets"
+const OperationBillingServiceListPackageCatalog = "/billing.v1.BillingService/ListPackageCatalog"
 const OperationBillingServiceListRecords = "/billing.v1.BillingService/ListRecords"
+const OperationBillingServiceListUserPackages = "/billing.v1.BillingService/ListUserPackages"
+const OperationBillingServicePurchasePackage = "/billing.v1.BillingService/PurchasePackage"
 const OperationBillingServiceRecharge = "/billing.v1.BillingService/Recharge"
+const OperationBillingServiceRemoveOrgMember = "/billing.v1.BillingService/RemoveOrgMember"
+const OperationBillingServiceSetAccountTimezone = "/billing.v1.BillingService/SetAccountTimezone"
+const OperationBillingServiceSetBudget = "/billing.v1.BillingService/SetBudget"
+const OperationBillingServiceUpdateOrgMember = "/billing.v1.BillingService/UpdateOrgMember"
 
 type BillingServiceHTTPServer interface {
+	// AcceptOrgInvitation 接受组织邀请（被邀请的用户本人），拒绝邀请使用 RemoveOrgMember 移除自己
+	AcceptOrgInvitation(context.Context, *AcceptOrgInvitationRequest) (*OrgMember, error)
+	// AddOrgMember 邀请组织成员（所有者/管理员），每个用户最多属于一个组织；被邀请的用户接受后才加入
+	AddOrgMember(context.Context, *AddOrgMemberRequest) (*OrgMember, error)
+	// CreateExport 创建账单导出任务（CSV / XLSX / PDF），数据量小时同步生成
+	// 文件通过 GetExport 返回的 downloadUrl 下载：GET /api/v1/billing/exports/{exportId}/download
+	CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error)
+	// CreateOrganization 创建组织，创建者为所有者；成员的用量由组织共享钱包支付
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*OrganizationReply, error)
+	// DeleteBudget 删除消费预算
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetReply, error)
 	// GetAccount 获取账户资产信息 (余额 + 剩余配额)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountReply, error)
+	// GetExport 查询账单导出任务状态，完成后返回下载链接
+	GetExport(context.Context, *GetExportRequest) (*GetExportReply, error)
+	// GetLiveUsage 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待异步落库）
+	// 持续推送：GET /api/v1/billing/stats/live/stream（SSE，参数同本接口）
+	GetLiveUsage(context.Context, *GetLiveUsageRequest) (*GetUsageSeriesReply, error)
+	// GetOrganization 获取组织及成员（含本月消费），普通成员只返回自己
+	GetOrganization(context.Context, *GetOrganizationRequest) (*OrganizationReply, error)
 	// GetStatsMonth 获取本月调用统计
 	GetStatsMonth(context.Context, *GetStatsMonthRequest) (*GetStatsReply, error)
 	// GetStatsSummary 获取汇总统计（所有服务）
 	GetStatsSummary(context.Context, *GetStatsSummaryRequest) (*GetStatsSummaryReply, error)
 	// GetStatsToday 获取今日调用统计
 	GetStatsToday(context.Context, *GetStatsTodayRequest) (*GetStatsReply, error)
+	// GetUsageSeries 获取用量时间序列（按小时/天/月分桶，支持指定时区，无数据的时间桶补零）
+	GetUsageSeries(context.Context, *GetUsageSeriesRequest) (*GetUsageSeriesReply, error)
+	// ListBudgets 获取消费预算及本月已消费金额
+	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsReply, error)
+	// ListPackageCatalog 获取可购买的用量包目录
+	ListPackageCatalog(context.Context, *ListPackageCatalogRequest) (*ListPackageCatalogReply, error)
 	// ListRecords 获取消费流水
 	ListRecords(context.Context, *ListRecordsRequest) (*ListRecordsReply, error)
+	// ListUserPackages 获取账户持有的用量包（剩余用量与到期时间）
+	ListUserPackages(context.Context, *ListUserPackagesRequest) (*ListUserPackagesReply, error)
+	// PurchasePackage 购买用量包（返回支付链接），支付成功后发放到账户
+	// 扣费顺序：免费额度 → 用量包（先到期的先用）→ 余额
+	PurchasePackage(context.Context, *PurchasePackageRequest) (*PurchasePackageReply, error)
 	// Recharge 发起充值 (返回支付链接)
 	Recharge(context.Context, *RechargeRequest) (*RechargeReply, error)
+	// RemoveOrgMember 移除组织成员（成员可自行退出，所有者不能被移除）
+	RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*RemoveOrgMemberReply, error)
+	// SetAccountTimezone 设置账户计费时区（IANA 名称，为空表示使用服务默认时区）
+	// 免费额度周期、消费预算月份、今日/本月统计与每日限流都按该时区的日期计算
+	SetAccountTimezone(context.Context, *SetAccountTimezoneRequest) (*SetAccountTimezoneReply, error)
+	// SetBudget 设置消费预算（按服务或全部服务，按自然月统计余额消费），同一服务已存在时覆盖
+	// 达到提醒阈值时发送一次通知；硬性上限时超出预算的扣费被拒绝
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetReply, error)
+	// UpdateOrgMember 更新组织成员的角色与每月消费上限
+	UpdateOrgMember(context.Context, *UpdateOrgMemberRequest) (*OrgMember, error)
 }
 
 func RegisterBillingServiceHTTPServer(s *http.Server, srv BillingServiceHTTPServer) {
@@ -49,6 +105,23 @@ func RegisterBillingServiceHTTPServer(s *http.Server, srv BillingServiceHTTPServ
 	r.GET("/api/v1/billing/stats/today", _BillingService_GetStatsToday0_HTTP_Handler(srv))
 	r.GET("/api/v1/billing/stats/month", _BillingService_GetStatsMonth0_HTTP_Handler(srv))
 	r.GET("/api/v1/billing/stats/summary", _BillingService_GetStatsSummary0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/stats/series", _BillingService_GetUsageSeries0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/stats/live", _BillingService_GetLiveUsage0_HTTP_Handler(srv))
+	r.POST("/api/v1/billing/exports", _BillingService_CreateExport0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/exports/{exportId}", _BillingService_GetExport0_HTTP_Handler(srv))
+	r.PUT("/api/v1/billing/budgets", _BillingService_SetBudget0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/budgets", _BillingService_ListBudgets0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/billing/budgets", _BillingService_DeleteBudget0_HTTP_Handler(srv))
+	r.PUT("/api/v1/billing/account/timezone", _BillingService_SetAccountTimezone0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/packages/catalog", _BillingService_ListPackageCatalog0_HTTP_Handler(srv))
+	r.POST("/api/v1/billing/packages/purchase", _BillingService_PurchasePackage0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/packages", _BillingService_ListUserPackages0_HTTP_Handler(srv))
+	r.POST("/api/v1/billing/orgs", _BillingService_CreateOrganization0_HTTP_Handler(srv))
+	r.GET("/api/v1/billing/orgs/{orgId}", _BillingService_GetOrganization0_HTTP_Handler(srv))
+	r.POST("/api/v1/billing/orgs/{orgId}/members", _BillingService_AddOrgMember0_HTTP_Handler(srv))
+	r.POST("/api/v1/billing/orgs/{orgId}/invitation/accept", _BillingService_AcceptOrgInvitation0_HTTP_Handler(srv))
+	r.PUT("/api/v1/billing/orgs/{orgId}/members/{memberId}", _BillingService_UpdateOrgMember0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/billing/orgs/{orgId}/members/{memberId}", _BillingService_RemoveOrgMember0_HTTP_Handler(srv))
 }
 
 func _BillingService_GetAccount0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
@@ -168,222 +241,1470 @@ func _BillingService_GetStatsSummary0_HTTP_Handler(srv BillingServiceHTTPServer)
 	}
 }
 
+func _BillingService_GetUsageSeries0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUsageSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceGetUsageSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUsageSeries(ctx, req.(*GetUsageSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_GetLiveUsage0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLiveUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceGetLiveUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetLiveUsage(ctx, req.(*GetLiveUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUsageSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_CreateExport0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceCreateExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateExport(ctx, req.(*CreateExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_GetExport0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceGetExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExport(ctx, req.(*GetExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_SetBudget0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetBudgetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceSetBudget)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetBudget(ctx, req.(*SetBudgetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetBudgetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_ListBudgets0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBudgetsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceListBudgets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBudgets(ctx, req.(*ListBudgetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBudgetsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_DeleteBudget0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteBudgetRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceDeleteBudget)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteBudgetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_SetAccountTimezone0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetAccountTimezoneRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceSetAccountTimezone)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetAccountTimezone(ctx, req.(*SetAccountTimezoneRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetAccountTimezoneReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_ListPackageCatalog0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPackageCatalogRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceListPackageCatalog)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPackageCatalog(ctx, req.(*ListPackageCatalogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPackageCatalogReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_PurchasePackage0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurchasePackageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServicePurchasePackage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurchasePackage(ctx, req.(*PurchasePackageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurchasePackageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_ListUserPackages0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserPackagesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceListUserPackages)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserPackages(ctx, req.(*ListUserPackagesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserPackagesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_CreateOrganization0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOrganizationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceCreateOrganization)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrganizationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_GetOrganization0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOrganizationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceGetOrganization)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOrganization(ctx, req.(*GetOrganizationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrganizationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_AddOrgMember0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddOrgMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceAddOrgMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddOrgMember(ctx, req.(*AddOrgMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrgMember)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_AcceptOrgInvitation0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptOrgInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceAcceptOrgInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptOrgInvitation(ctx, req.(*AcceptOrgInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrgMember)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_UpdateOrgMember0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateOrgMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceUpdateOrgMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOrgMember(ctx, req.(*UpdateOrgMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OrgMember)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingService_RemoveOrgMember0_HTTP_Handler(srv BillingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveOrgMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingServiceRemoveOrgMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveOrgMember(ctx, req.(*RemoveOrgMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveOrgMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type BillingServiceHTTPClient interface {
+	// AcceptOrgInvitation 接受组织邀请（被邀请的用户本人），拒绝邀请使用 RemoveOrgMember 移除自己
+	AcceptOrgInvitation(ctx context.Context, req *AcceptOrgInvitationRequest, opts ...http.CallOption) (rsp *OrgMember, err error)
+	// AddOrgMember 邀请组织成员（所有者/管理员），每个用户最多属于一个组织；被邀请的用户接受后才加入
+	AddOrgMember(ctx context.Context, req *AddOrgMemberRequest, opts ...http.CallOption) (rsp *OrgMember, err error)
+	// CreateExport 创建账单导出任务（CSV / XLSX / PDF），数据量小时同步生成
+	// 文件通过 GetExport 返回的 downloadUrl 下载：GET /api/v1/billing/exports/{exportId}/download
+	CreateExport(ctx context.Context, req *CreateExportRequest, opts ...http.CallOption) (rsp *CreateExportReply, err error)
+	// CreateOrganization 创建组织，创建者为所有者；成员的用量由组织共享钱包支付
+	CreateOrganization(ctx context.Context, req *CreateOrganizationRequest, opts ...http.CallOption) (rsp *OrganizationReply, err error)
+	// DeleteBudget 删除消费预算
+	DeleteBudget(ctx context.Context, req *DeleteBudgetRequest, opts ...http.CallOption) (rsp *DeleteBudgetReply, err error)
 	// GetAccount 获取账户资产信息 (余额 + 剩余配额)
 	GetAccount(ctx context.Context, req *GetAccountRequest, opts ...http.CallOption) (rsp *GetAccountReply, err error)
+	// GetExport 查询账单导出任务状态，完成后返回下载链接
+	GetExport(ctx context.Context, req *GetExportRequest, opts ...http.CallOption) (rsp *GetExportReply, err error)
+	// GetLiveUsage 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待异步落库）
+	// 持续推送：GET /api/v1/billing/stats/live/stream（SSE，参数同本接口）
+	GetLiveUsage(ctx context.Context, req *GetLiveUsageRequest, opts ...http.CallOption) (rsp *GetUsageSeriesReply, err error)
+	// GetOrganization 获取组织及成员（含本月消费），普通成员只返回自己
+	GetOrganization(ctx context.Context, req *GetOrganizationRequest, opts ...http.CallOption) (rsp *OrganizationReply, err error)
 	// GetStatsMonth 获取本月调用统计
 	GetStatsMonth(ctx context.Context, req *GetStatsMonthRequest, opts ...http.CallOption) (rsp *GetStatsReply, err error)
 	// GetStatsSummary 获取汇总统计（所有服务）
 	GetStatsSummary(ctx context.Context, req *GetStatsSummaryRequest, opts ...http.CallOption) (rsp *GetStatsSummaryReply, err error)
 	// GetStatsToday 获取今日调用统计
 	GetStatsToday(ctx context.Context, req *GetStatsTodayRequest, opts ...http.CallOption) (rsp *GetStatsReply, err error)
+	// GetUsageSeries 获取用量时间序列（按小时/天/月分桶，支持指定时区，无数据的时间桶补零）
+	GetUsageSeries(ctx context.Context, req *GetUsageSeriesRequest, opts ...http.CallOption) (rsp *GetUsageSeriesReply, err error)
+	// ListBudgets 获取消费预算及本月已消费金额
+	ListBudgets(ctx context.Context, req *ListBudgetsRequest, opts ...http.CallOption) (rsp *ListBudgetsReply, err error)
+	// ListPackageCatalog 获取可购买的用量包目录
+	ListPackageCatalog(ctx context.Context, req *ListPackageCatalogRequest, opts ...http.CallOption) (rsp *ListPackageCatalogReply, err error)
 	// ListRecords 获取消费流水
 	ListRecords(ctx context.Context, req *ListRecordsRequest, opts ...http.CallOption) (rsp *ListRecordsReply, err error)
+	// ListUserPackages 获取账户持有的用量包（剩余用量与到期时间）
+	ListUserPackages(ctx context.Context, req *ListUserPackagesRequest, opts ...http.CallOption) (rsp *ListUserPackagesReply, err error)
+	// PurchasePackage 购买用量包（返回支付链接），支付成功后发放到账户
+	// 扣费顺序：免费额度 → 用量包（先到期的先用）→ 余额
+	PurchasePackage(ctx context.Context, req *PurchasePackageRequest, opts ...http.CallOption) (rsp *PurchasePackageReply, err error)
 	// Recharge 发起充值 (返回支付链接)
 	Recharge(ctx context.Context, req *RechargeRequest, opts ...http.CallOption) (rsp *RechargeReply, err error)
+	// RemoveOrgMember 移除组织成员（成员可自行退出，所有者不能被移除）
+	RemoveOrgMember(ctx context.Context, req *RemoveOrgMemberRequest, opts ...http.CallOption) (rsp *RemoveOrgMemberReply, err error)
+	// SetAccountTimezone 设置账户计费时区（IANA 名称，为空表示使用服务默认时区）
+	// 免费额度周期、消费预算月份、今日/本月统计与每日限流都按该时区的日期计算
+	SetAccountTimezone(ctx context.Context, req *SetAccountTimezoneRequest, opts ...http.CallOption) (rsp *SetAccountTimezoneReply, err error)
+	// SetBudget 设置消费预算（按服务或全部服务，按自然月统计余额消费），同一服务已存在时覆盖
+	// 达到提醒阈值时发送一次通知；硬性上限时超出预算的扣费被拒绝
+	SetBudget(ctx context.Context, req *SetBudgetRequest, opts ...http.CallOption) (rsp *SetBudgetReply, err error)
+	// UpdateOrgMember 更新组织成员的角色与每月消费上限
+	UpdateOrgMember(ctx context.Context, req *UpdateOrgMemberRequest, opts ...http.CallOption) (rsp *OrgMember, err error)
+}
+
+type BillingServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewBillingServiceHTTPClient(client *http.Client) BillingServiceHTTPClient {
+	return &BillingServiceHTTPClientImpl{client}
+}
+
+// AcceptOrgInvitation 接受组织邀请（被邀请的用户本人），拒绝邀请使用 RemoveOrgMember 移除自己
+func (c *BillingServiceHTTPClientImpl) AcceptOrgInvitation(ctx context.Context, in *AcceptOrgInvitationRequest, opts ...http.CallOption) (*OrgMember, error) {
+	var out OrgMember
+	pattern := "/api/v1/billing/orgs/{orgId}/invitation/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceAcceptOrgInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddOrgMember 邀请组织成员（所有者/管理员），每个用户最多属于一个组织；被邀请的用户接受后才加入
+func (c *BillingServiceHTTPClientImpl) AddOrgMember(ctx context.Context, in *AddOrgMemberRequest, opts ...http.CallOption) (*OrgMember, error) {
+	var out OrgMember
+	pattern := "/api/v1/billing/orgs/{orgId}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceAddOrgMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateExport 创建账单导出任务（CSV / XLSX / PDF），数据量小时同步生成
+// 文件通过 GetExport 返回的 downloadUrl 下载：GET /api/v1/billing/exports/{exportId}/download
+func (c *BillingServiceHTTPClientImpl) CreateExport(ctx context.Context, in *CreateExportRequest, opts ...http.CallOption) (*CreateExportReply, error) {
+	var out CreateExportReply
+	pattern := "/api/v1/billing/exports"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceCreateExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrganization 创建组织，创建者为所有者；成员的用量由组织共享钱包支付
+func (c *BillingServiceHTTPClientImpl) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...http.CallOption) (*OrganizationReply, error) {
+	var out OrganizationReply
+	pattern := "/api/v1/billing/orgs"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceCreateOrganization))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteBudget 删除消费预算
+func (c *BillingServiceHTTPClientImpl) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...http.CallOption) (*DeleteBudgetReply, error) {
+	var out DeleteBudgetReply
+	pattern := "/api/v1/billing/budgets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceDeleteBudget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAccount 获取账户资产信息 (余额 + 剩余配额)
+func (c *BillingServiceHTTPClientImpl) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...http.CallOption) (*GetAccountReply, error) {
+	var out GetAccountReply
+	pattern := "/api/v1/billing/account"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExport 查询账单导出任务状态，完成后返回下载链接
+func (c *BillingServiceHTTPClientImpl) GetExport(ctx context.Context, in *GetExportRequest, opts ...http.CallOption) (*GetExportReply, error) {
+	var out GetExportReply
+	pattern := "/api/v1/billing/exports/{exportId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLiveUsage 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待异步落库）
+// 持续推送：GET /api/v1/billing/stats/live/stream（SSE，参数同本接口）
+func (c *BillingServiceHTTPClientImpl) GetLiveUsage(ctx context.Context, in *GetLiveUsageRequest, opts ...http.CallOption) (*GetUsageSeriesReply, error) {
+	var out GetUsageSeriesReply
+	pattern := "/api/v1/billing/stats/live"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetLiveUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrganization 获取组织及成员（含本月消费），普通成员只返回自己
+func (c *BillingServiceHTTPClientImpl) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...http.CallOption) (*OrganizationReply, error) {
+	var out OrganizationReply
+	pattern := "/api/v1/billing/orgs/{orgId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetOrganization))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetStatsMonth 获取本月调用统计
+func (c *BillingServiceHTTPClientImpl) GetStatsMonth(ctx context.Context, in *GetStatsMonthRequest, opts ...http.CallOption) (*GetStatsReply, error) {
+	var out GetStatsReply
+	pattern := "/api/v1/billing/stats/month"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetStatsMonth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetStatsSummary 获取汇总统计（所有服务）
+func (c *BillingServiceHTTPClientImpl) GetStatsSummary(ctx context.Context, in *GetStatsSummaryRequest, opts ...http.CallOption) (*GetStatsSummaryReply, error) {
+	var out GetStatsSummaryReply
+	pattern := "/api/v1/billing/stats/summary"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetStatsSummary))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetStatsToday 获取今日调用统计
+func (c *BillingServiceHTTPClientImpl) GetStatsToday(ctx context.Context, in *GetStatsTodayRequest, opts ...http.CallOption) (*GetStatsReply, error) {
+	var out GetStatsReply
+	pattern := "/api/v1/billing/stats/today"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetStatsToday))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUsageSeries 获取用量时间序列（按小时/天/月分桶，支持指定时区，无数据的时间桶补零）
+func (c *BillingServiceHTTPClientImpl) GetUsageSeries(ctx context.Context, in *GetUsageSeriesRequest, opts ...http.CallOption) (*GetUsageSeriesReply, error) {
+	var out GetUsageSeriesReply
+	pattern := "/api/v1/billing/stats/series"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceGetUsageSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBudgets 获取消费预算及本月已消费金额
+func (c *BillingServiceHTTPClientImpl) ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...http.CallOption) (*ListBudgetsReply, error) {
+	var out ListBudgetsReply
+	pattern := "/api/v1/billing/budgets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceListBudgets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPackageCatalog 获取可购买的用量包目录
+func (c *BillingServiceHTTPClientImpl) ListPackageCatalog(ctx context.Context, in *ListPackageCatalogRequest, opts ...http.CallOption) (*ListPackageCatalogReply, error) {
+	var out ListPackageCatalogReply
+	pattern := "/api/v1/billing/packages/catalog"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceListPackageCatalog))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRecords 获取消费流水
+func (c *BillingServiceHTTPClientImpl) ListRecords(ctx context.Context, in *ListRecordsRequest, opts ...http.CallOption) (*ListRecordsReply, error) {
+	var out ListRecordsReply
+	pattern := "/api/v1/billing/records"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceListRecords))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUserPackages 获取账户持有的用量包（剩余用量与到期时间）
+func (c *BillingServiceHTTPClientImpl) ListUserPackages(ctx context.Context, in *ListUserPackagesRequest, opts ...http.CallOption) (*ListUserPackagesReply, error) {
+	var out ListUserPackagesReply
+	pattern := "/api/v1/billing/packages"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceListUserPackages))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PurchasePackage 购买用量包（返回支付链接），支付成功后发放到账户
+// 扣费顺序：免费额度 → 用量包（先到期的先用）→ 余额
+func (c *BillingServiceHTTPClientImpl) PurchasePackage(ctx context.Context, in *PurchasePackageRequest, opts ...http.CallOption) (*PurchasePackageReply, error) {
+	var out PurchasePackageReply
+	pattern := "/api/v1/billing/packages/purchase"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServicePurchasePackage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Recharge 发起充值 (返回支付链接)
+func (c *BillingServiceHTTPClientImpl) Recharge(ctx context.Context, in *RechargeRequest, opts ...http.CallOption) (*RechargeReply, error) {
+	var out RechargeReply
+	pattern := "/api/v1/billing/recharge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceRecharge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveOrgMember 移除组织成员（成员可自行退出，所有者不能被移除）
+func (c *BillingServiceHTTPClientImpl) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...http.CallOption) (*RemoveOrgMemberReply, error) {
+	var out RemoveOrgMemberReply
+	pattern := "/api/v1/billing/orgs/{orgId}/members/{memberId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingServiceRemoveOrgMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetAccountTimezone 设置账户计费时区（IANA 名称，为空表示使用服务默认时区）
+// 免费额度周期、消费预算月份、今日/本月统计与每日限流都按该时区的日期计算
+func (c *BillingServiceHTTPClientImpl) SetAccountTimezone(ctx context.Context, in *SetAccountTimezoneRequest, opts ...http.CallOption) (*SetAccountTimezoneReply, error) {
+	var out SetAccountTimezoneReply
+	pattern := "/api/v1/billing/account/timezone"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceSetAccountTimezone))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetBudget 设置消费预算（按服务或全部服务，按自然月统计余额消费），同一服务已存在时覆盖
+// 达到提醒阈值时发送一次通知；硬性上限时超出预算的扣费被拒绝
+func (c *BillingServiceHTTPClientImpl) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...http.CallOption) (*SetBudgetReply, error) {
+	var out SetBudgetReply
+	pattern := "/api/v1/billing/budgets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceSetBudget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateOrgMember 更新组织成员的角色与每月消费上限
+func (c *BillingServiceHTTPClientImpl) UpdateOrgMember(ctx context.Context, in *UpdateOrgMemberRequest, opts ...http.CallOption) (*OrgMember, error) {
+	var out OrgMember
+	pattern := "/api/v1/billing/orgs/{orgId}/members/{memberId}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingServiceUpdateOrgMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationBillingInternalServiceAcquireLease = "/billing.v1.BillingInternalService/AcquireLease"
+const OperationBillingInternalServiceBatchCheckQuota = "/billing.v1.BillingInternalService/BatchCheckQuota"
+const OperationBillingInternalServiceBatchDeductQuota = "/billing.v1.BillingInternalService/BatchDeductQuota"
+const OperationBillingInternalServiceCheckQuota = "/billing.v1.BillingInternalService/CheckQuota"
+const OperationBillingInternalServiceDeductQuota = "/billing.v1.BillingInternalService/DeductQuota"
+const OperationBillingInternalServiceRechargeCallback = "/billing.v1.BillingInternalService/RechargeCallback"
+const OperationBillingInternalServiceReleaseLease = "/billing.v1.BillingInternalService/ReleaseLease"
+const OperationBillingInternalServiceReportLeaseUsage = "/billing.v1.BillingInternalService/ReportLeaseUsage"
+
+type BillingInternalServiceHTTPServer interface {
+	// AcquireLease 申请额度租约：预留 N 次调用，网关在租约有效期内本地放行
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseReply, error)
+	// BatchCheckQuota 批量检查配额：同一用户一次检查多个服务，所有服务均可扣费时才放行
+	BatchCheckQuota(context.Context, *BatchCheckQuotaRequest) (*BatchCheckQuotaReply, error)
+	// BatchDeductQuota 批量扣费：同一用户一次扣减多个服务，在一个事务中完成，要么全部成功要么全部失败
+	BatchDeductQuota(context.Context, *BatchDeductQuotaRequest) (*BatchDeductQuotaReply, error)
+	// CheckQuota 检查并预扣费 (Check & Reserve)
+	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
+	// DeductQuota 确认扣费 (Commit)
+	DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error)
+	// RechargeCallback 充值回调 (来自 Payment Service)
+	RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error)
+	// ReleaseLease 释放租约：上报最终用量并归还未用部分
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseReply, error)
+	// ReportLeaseUsage 上报租约用量（增量），可同时续期
+	ReportLeaseUsage(context.Context, *ReportLeaseUsageRequest) (*ReportLeaseUsageReply, error)
+}
+
+func RegisterBillingInternalServiceHTTPServer(s *http.Server, srv BillingInternalServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/internal/v1/billing/check", _BillingInternalService_CheckQuota0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/deduct", _BillingInternalService_DeductQuota0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/check/batch", _BillingInternalService_BatchCheckQuota0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/deduct/batch", _BillingInternalService_BatchDeductQuota0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/callback", _BillingInternalService_RechargeCallback0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/lease/acquire", _BillingInternalService_AcquireLease0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/lease/report", _BillingInternalService_ReportLeaseUsage0_HTTP_Handler(srv))
+	r.POST("/internal/v1/billing/lease/release", _BillingInternalService_ReleaseLease0_HTTP_Handler(srv))
+}
+
+func _BillingInternalService_CheckQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceCheckQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckQuota(ctx, req.(*CheckQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_DeductQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeductQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceDeductQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeductQuota(ctx, req.(*DeductQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeductQuotaReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type BillingServiceHTTPClientImpl struct {
+func _BillingInternalService_BatchCheckQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchCheckQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceBatchCheckQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchCheckQuota(ctx, req.(*BatchCheckQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchCheckQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_BatchDeductQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDeductQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceBatchDeductQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDeductQuota(ctx, req.(*BatchDeductQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchDeductQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_RechargeCallback0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RechargeCallbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceRechargeCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RechargeCallback(ctx, req.(*RechargeCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RechargeCallbackReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_AcquireLease0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcquireLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceAcquireLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcquireLease(ctx, req.(*AcquireLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcquireLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_ReportLeaseUsage0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportLeaseUsageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceReportLeaseUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportLeaseUsage(ctx, req.(*ReportLeaseUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportLeaseUsageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingInternalService_ReleaseLease0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReleaseLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingInternalServiceReleaseLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReleaseLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type BillingInternalServiceHTTPClient interface {
+	// AcquireLease 申请额度租约：预留 N 次调用，网关在租约有效期内本地放行
+	AcquireLease(ctx context.Context, req *AcquireLeaseRequest, opts ...http.CallOption) (rsp *AcquireLeaseReply, err error)
+	// BatchCheckQuota 批量检查配额：同一用户一次检查多个服务，所有服务均可扣费时才放行
+	BatchCheckQuota(ctx context.Context, req *BatchCheckQuotaRequest, opts ...http.CallOption) (rsp *BatchCheckQuotaReply, err error)
+	// BatchDeductQuota 批量扣费：同一用户一次扣减多个服务，在一个事务中完成，要么全部成功要么全部失败
+	BatchDeductQuota(ctx context.Context, req *BatchDeductQuotaRequest, opts ...http.CallOption) (rsp *BatchDeductQuotaReply, err error)
+	// CheckQuota 检查并预扣费 (Check & Reserve)
+	CheckQuota(ctx context.Context, req *CheckQuotaRequest, opts ...http.CallOption) (rsp *CheckQuotaReply, err error)
+	// DeductQuota 确认扣费 (Commit)
+	DeductQuota(ctx context.Context, req *DeductQuotaRequest, opts ...http.CallOption) (rsp *DeductQuotaReply, err error)
+	// RechargeCallback 充值回调 (来自 Payment Service)
+	RechargeCallback(ctx context.Context, req *RechargeCallbackRequest, opts ...http.CallOption) (rsp *RechargeCallbackReply, err error)
+	// ReleaseLease 释放租约：上报最终用量并归还未用部分
+	ReleaseLease(ctx context.Context, req *ReleaseLeaseRequest, opts ...http.CallOption) (rsp *ReleaseLeaseReply, err error)
+	// ReportLeaseUsage 上报租约用量（增量），可同时续期
+	ReportLeaseUsage(ctx context.Context, req *ReportLeaseUsageRequest, opts ...http.CallOption) (rsp *ReportLeaseUsageReply, err error)
+}
+
+type BillingInternalServiceHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewBillingServiceHTTPClient(client *http.Client) BillingServiceHTTPClient {
-	return &BillingServiceHTTPClientImpl{client}
+func NewBillingInternalServiceHTTPClient(client *http.Client) BillingInternalServiceHTTPClient {
+	return &BillingInternalServiceHTTPClientImpl{client}
 }
 
-// GetAccount 获取账户资产信息 (余额 + 剩余配额)
-func (c *BillingServiceHTTPClientImpl) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...http.CallOption) (*GetAccountReply, error) {
-	var out GetAccountReply
-	pattern := "/api/v1/billing/account"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationBillingServiceGetAccount))
+// AcquireLease 申请额度租约：预留 N 次调用，网关在租约有效期内本地放行
+func (c *BillingInternalServiceHTTPClientImpl) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...http.CallOption) (*AcquireLeaseReply, error) {
+	var out AcquireLeaseReply
+	pattern := "/internal/v1/billing/lease/acquire"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceAcquireLease))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetStatsMonth 获取本月调用统计
-func (c *BillingServiceHTTPClientImpl) GetStatsMonth(ctx context.Context, in *GetStatsMonthRequest, opts ...http.CallOption) (*GetStatsReply, error) {
-	var out GetStatsReply
-	pattern := "/api/v1/billing/stats/month"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationBillingServiceGetStatsMonth))
+// BatchCheckQuota 批量检查配额：同一用户一次检查多个服务，所有服务均可扣费时才放行
+func (c *BillingInternalServiceHTTPClientImpl) BatchCheckQuota(ctx context.Context, in *BatchCheckQuotaRequest, opts ...http.CallOption) (*BatchCheckQuotaReply, error) {
+	var out BatchCheckQuotaReply
+	pattern := "/internal/v1/billing/check/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceBatchCheckQuota))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetStatsSummary 获取汇总统计（所有服务）
-func (c *BillingServiceHTTPClientImpl) GetStatsSummary(ctx context.Context, in *GetStatsSummaryRequest, opts ...http.CallOption) (*GetStatsSummaryReply, error) {
-	var out GetStatsSummaryReply
-	pattern := "/api/v1/billing/stats/summary"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationBillingServiceGetStatsSummary))
+// BatchDeductQuota 批量扣费：同一用户一次扣减多个服务，在一个事务中完成，要么全部成功要么全部失败
+func (c *BillingInternalServiceHTTPClientImpl) BatchDeductQuota(ctx context.Context, in *BatchDeductQuotaRequest, opts ...http.CallOption) (*BatchDeductQuotaReply, error) {
+	var out BatchDeductQuotaReply
+	pattern := "/internal/v1/billing/deduct/batch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceBatchDeductQuota))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-// GetStatsToday 获取今日调用统计
-func (c *BillingServiceHTTPClientImpl) GetStatsToday(ctx context.Context, in *GetStatsTodayRequest, opts ...http.CallOption) (*GetStatsReply, error) {
-	var out GetStatsReply
-	pattern := "/api/v1/billing/stats/today"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationBillingServiceGetStatsToday))
+// CheckQuota 检查并预扣费 (Check & Reserve)
+func (c *BillingInternalServiceHTTPClientImpl) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...http.CallOption) (*CheckQuotaReply, error) {
+	var out CheckQuotaReply
+	pattern := "/internal/v1/billing/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceCheckQuota))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeductQuota 确认扣费 (Commit)
+func (c *BillingInternalServiceHTTPClientImpl) DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...http.CallOption) (*DeductQuotaReply, error) {
+	var out DeductQuotaReply
+	pattern := "/internal/v1/billing/deduct"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceDeductQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RechargeCallback 充值回调 (来自 Payment Service)
+func (c *BillingInternalServiceHTTPClientImpl) RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...http.CallOption) (*RechargeCallbackReply, error) {
+	var out RechargeCallbackReply
+	pattern := "/internal/v1/billing/callback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceRechargeCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseLease 释放租约：上报最终用量并归还未用部分
+func (c *BillingInternalServiceHTTPClientImpl) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...http.CallOption) (*ReleaseLeaseReply, error) {
+	var out ReleaseLeaseReply
+	pattern := "/internal/v1/billing/lease/release"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceReleaseLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReportLeaseUsage 上报租约用量（增量），可同时续期
+func (c *BillingInternalServiceHTTPClientImpl) ReportLeaseUsage(ctx context.Context, in *ReportLeaseUsageRequest, opts ...http.CallOption) (*ReportLeaseUsageReply, error) {
+	var out ReportLeaseUsageReply
+	pattern := "/internal/v1/billing/lease/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBillingInternalServiceReportLeaseUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &out, nil
+	return &out, nil
+}
+
+const OperationBillingAdminServiceCreateContract = "/billing.v1.BillingAdminService/CreateContract"
+const OperationBillingAdminServiceCreatePriceRule = "/billing.v1.BillingAdminService/CreatePriceRule"
+const OperationBillingAdminServiceDeletePriceRule = "/billing.v1.BillingAdminService/DeletePriceRule"
+const OperationBillingAdminServiceGetBalanceLiability = "/billing.v1.BillingAdminService/GetBalanceLiability"
+const OperationBillingAdminServiceGetRechargeReport = "/billing.v1.BillingAdminService/GetRechargeReport"
+const OperationBillingAdminServiceGetRevenueReport = "/billing.v1.BillingAdminService/GetRevenueReport"
+const OperationBillingAdminServiceGetUserActivityReport = "/billing.v1.BillingAdminService/GetUserActivityReport"
+const OperationBillingAdminServiceGetUserRateLimit = "/billing.v1.BillingAdminService/GetUserRateLimit"
+const OperationBillingAdminServiceListContracts = "/billing.v1.BillingAdminService/ListContracts"
+const OperationBillingAdminServiceListPriceRules = "/billing.v1.BillingAdminService/ListPriceRules"
+const OperationBillingAdminServiceListTopConsumers = "/billing.v1.BillingAdminService/ListTopConsumers"
+const OperationBillingAdminServiceSetUserRateLimit = "/billing.v1.BillingAdminService/SetUserRateLimit"
+
+type BillingAdminServiceHTTPServer interface {
+	// CreateContract 创建承诺消费合同：承诺金额预付计入余额，合同期内按合同价计费，到期后未用完部分补差扣除
+	CreateContract(context.Context, *CreateContractRequest) (*Contract, error)
+	// CreatePriceRule 创建账户价格规则：按服务（或全部服务）的单价覆盖或折扣，可指定有效期
+	CreatePriceRule(context.Context, *CreatePriceRuleRequest) (*PriceRule, error)
+	// DeletePriceRule 删除账户价格规则（合同价不能单独删除）
+	DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleReply, error)
+	// GetBalanceLiability 余额负债：当前全部用户的未消费余额
+	GetBalanceLiability(context.Context, *GetBalanceLiabilityRequest) (*GetBalanceLiabilityReply, error)
+	// GetRechargeReport 充值报表：按 UTC 日/月统计成功充值金额、笔数、充值用户数
+	GetRechargeReport(context.Context, *GetRechargeReportRequest) (*GetRechargeReportReply, error)
+	// GetRevenueReport 收入报表：按 UTC 日/月统计余额扣费收入，可按服务拆分
+	GetRevenueReport(context.Context, *GetRevenueReportRequest) (*GetRevenueReportReply, error)
+	// GetUserActivityReport 用户活跃报表：活跃用户、付费用户、新增付费用户与付费转化率
+	GetUserActivityReport(context.Context, *GetUserActivityReportRequest) (*GetUserActivityReportReply, error)
+	// GetUserRateLimit 查询用户限流设置及各服务生效的限流规则
+	GetUserRateLimit(context.Context, *GetUserRateLimitRequest) (*UserRateLimitReply, error)
+	// ListContracts 查询账户合同
+	ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error)
+	// ListPriceRules 查询账户价格规则（含合同价）及各服务当前适用单价
+	ListPriceRules(context.Context, *ListPriceRulesRequest) (*ListPriceRulesReply, error)
+	// ListTopConsumers 消费排行：按收入或调用次数排序的 Top N 用户
+	ListTopConsumers(context.Context, *ListTopConsumersRequest) (*ListTopConsumersReply, error)
+	// SetUserRateLimit 设置用户限流：指定套餐及按服务覆盖的限流规则（整体覆盖之前的设置）
+	SetUserRateLimit(context.Context, *SetUserRateLimitRequest) (*UserRateLimitReply, error)
+}
+
+func RegisterBillingAdminServiceHTTPServer(s *http.Server, srv BillingAdminServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/admin/v1/billing/reports/revenue", _BillingAdminService_GetRevenueReport0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/reports/recharge", _BillingAdminService_GetRechargeReport0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/reports/users", _BillingAdminService_GetUserActivityReport0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/reports/top-consumers", _BillingAdminService_ListTopConsumers0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/reports/liability", _BillingAdminService_GetBalanceLiability0_HTTP_Handler(srv))
+	r.PUT("/admin/v1/billing/rate-limits/{userId}", _BillingAdminService_SetUserRateLimit0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/rate-limits/{userId}", _BillingAdminService_GetUserRateLimit0_HTTP_Handler(srv))
+	r.POST("/admin/v1/billing/price-rules/{userId}", _BillingAdminService_CreatePriceRule0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/price-rules/{userId}", _BillingAdminService_ListPriceRules0_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/billing/price-rules/{userId}/{ruleId}", _BillingAdminService_DeletePriceRule0_HTTP_Handler(srv))
+	r.POST("/admin/v1/billing/contracts/{userId}", _BillingAdminService_CreateContract0_HTTP_Handler(srv))
+	r.GET("/admin/v1/billing/contracts/{userId}", _BillingAdminService_ListContracts0_HTTP_Handler(srv))
+}
+
+func _BillingAdminService_GetRevenueReport0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRevenueReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceGetRevenueReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRevenueReport(ctx, req.(*GetRevenueReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRevenueReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingAdminService_GetRechargeReport0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRechargeReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceGetRechargeReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRechargeReport(ctx, req.(*GetRechargeReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRechargeReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingAdminService_GetUserActivityReport0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserActivityReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceGetUserActivityReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserActivityReport(ctx, req.(*GetUserActivityReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUserActivityReportReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-// ListRecords 获取消费流水
-func (c *BillingServiceHTTPClientImpl) ListRecords(ctx context.Context, in *ListRecordsRequest, opts ...http.CallOption) (*ListRecordsReply, error) {
-	var out ListRecordsReply
-	pattern := "/api/v1/billing/records"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationBillingServiceListRecords))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _BillingAdminService_ListTopConsumers0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTopConsumersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceListTopConsumers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTopConsumers(ctx, req.(*ListTopConsumersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTopConsumersReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-// Recharge 发起充值 (返回支付链接)
-func (c *BillingServiceHTTPClientImpl) Recharge(ctx context.Context, in *RechargeRequest, opts ...http.CallOption) (*RechargeReply, error) {
-	var out RechargeReply
-	pattern := "/api/v1/billing/recharge"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBillingServiceRecharge))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _BillingAdminService_GetBalanceLiability0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBalanceLiabilityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceGetBalanceLiability)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBalanceLiability(ctx, req.(*GetBalanceLiabilityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBalanceLiabilityReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-const OperationBillingInternalServiceCheckQuota = "/billing.v1.BillingInternalService/CheckQuota"
-const OperationBillingInternalServiceDeductQuota = "/billing.v1.BillingInternalService/DeductQuota"
-const OperationBillingInternalServiceRechargeCallback = "/billing.v1.BillingInternalService/RechargeCallback"
-
-type BillingInternalServiceHTTPServer interface {
-	// CheckQuota 检查并预扣费 (Check & Reserve)
-	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaReply, error)
-	// DeductQuota 确认扣费 (Commit)
-	DeductQuota(context.Context, *DeductQuotaRequest) (*DeductQuotaReply, error)
-	// RechargeCallback 充值回调 (来自 Payment Service)
-	RechargeCallback(context.Context, *RechargeCallbackRequest) (*RechargeCallbackReply, error)
+func _BillingAdminService_SetUserRateLimit0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetUserRateLimitRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceSetUserRateLimit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetUserRateLimit(ctx, req.(*SetUserRateLimitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserRateLimitReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func RegisterBillingInternalServiceHTTPServer(s *http.Server, srv BillingInternalServiceHTTPServer) {
-	r := s.Route("/")
-	r.POST("/internal/v1/billing/check", _BillingInternalService_CheckQuota0_HTTP_Handler(srv))
-	r.POST("/internal/v1/billing/deduct", _BillingInternalService_DeductQuota0_HTTP_Handler(srv))
-	r.POST("/internal/v1/billing/callback", _BillingInternalService_RechargeCallback0_HTTP_Handler(srv))
+func _BillingAdminService_GetUserRateLimit0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserRateLimitRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceGetUserRateLimit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserRateLimit(ctx, req.(*GetUserRateLimitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserRateLimitReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func _BillingInternalService_CheckQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+func _BillingAdminService_CreatePriceRule0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CheckQuotaRequest
+		var in CreatePriceRuleRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBillingInternalServiceCheckQuota)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceCreatePriceRule)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CheckQuota(ctx, req.(*CheckQuotaRequest))
+			return srv.CreatePriceRule(ctx, req.(*CreatePriceRuleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CheckQuotaReply)
+		reply := out.(*PriceRule)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _BillingInternalService_DeductQuota0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+func _BillingAdminService_ListPriceRules0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeductQuotaRequest
-		if err := ctx.Bind(&in); err != nil {
+		var in ListPriceRulesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceListPriceRules)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPriceRules(ctx, req.(*ListPriceRulesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
+		reply := out.(*ListPriceRulesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BillingAdminService_DeletePriceRule0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePriceRuleRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBillingInternalServiceDeductQuota)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceDeletePriceRule)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeductQuota(ctx, req.(*DeductQuotaRequest))
+			return srv.DeletePriceRule(ctx, req.(*DeletePriceRuleRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeductQuotaReply)
+		reply := out.(*DeletePriceRuleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _BillingInternalService_RechargeCallback0_HTTP_Handler(srv BillingInternalServiceHTTPServer) func(ctx http.Context) error {
+func _BillingAdminService_CreateContract0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in RechargeCallbackRequest
+		var in CreateContractRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBillingInternalServiceRechargeCallback)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceCreateContract)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.RechargeCallback(ctx, req.(*RechargeCallbackRequest))
+			return srv.CreateContract(ctx, req.(*CreateContractRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*RechargeCallbackReply)
+		reply := out.(*Contract)
 		return ctx.Result(200, reply)
 	}
 }
 
-type BillingInternalServiceHTTPClient interface {
-	// CheckQuota 检查并预扣费 (Check & Reserve)
-	CheckQuota(ctx context.Context, req *CheckQuotaRequest, opts ...http.CallOption) (rsp *CheckQuotaReply, err error)
-	// DeductQuota 确认扣费 (Commit)
-	DeductQuota(ctx context.Context, req *DeductQuotaRequest, opts ...http.CallOption) (rsp *DeductQuotaReply, err error)
-	// RechargeCallback 充值回调 (来自 Payment Service)
-	RechargeCallback(ctx context.Context, req *RechargeCallbackRequest, opts ...http.CallOption) (rsp *RechargeCallbackReply, err error)
+func _BillingAdminService_ListContracts0_HTTP_Handler(srv BillingAdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListContractsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBillingAdminServiceListContracts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListContracts(ctx, req.(*ListContractsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListContractsReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type BillingInternalServiceHTTPClientImpl struct {
+type BillingAdminServiceHTTPClient interface {
+	// CreateContract 创建承诺消费合同：承诺金额预付计入余额，合同期内按合同价计费，到期后未用完部分补差扣除
+	CreateContract(ctx context.Context, req *CreateContractRequest, opts ...http.CallOption) (rsp *Contract, err error)
+	// CreatePriceRule 创建账户价格规则：按服务（或全部服务）的单价覆盖或折扣，可指定有效期
+	CreatePriceRule(ctx context.Context, req *CreatePriceRuleRequest, opts ...http.CallOption) (rsp *PriceRule, err error)
+	// DeletePriceRule 删除账户价格规则（合同价不能单独删除）
+	DeletePriceRule(ctx context.Context, req *DeletePriceRuleRequest, opts ...http.CallOption) (rsp *DeletePriceRuleReply, err error)
+	// GetBalanceLiability 余额负债：当前全部用户的未消费余额
+	GetBalanceLiability(ctx context.Context, req *GetBalanceLiabilityRequest, opts ...http.CallOption) (rsp *GetBalanceLiabilityReply, err error)
+	// GetRechargeReport 充值报表：按 UTC 日/月统计成功充值金额、笔数、充值用户数
+	GetRechargeReport(ctx context.Context, req *GetRechargeReportRequest, opts ...http.CallOption) (rsp *GetRechargeReportReply, err error)
+	// GetRevenueReport 收入报表：按 UTC 日/月统计余额扣费收入，可按服务拆分
+	GetRevenueReport(ctx context.Context, req *GetRevenueReportRequest, opts ...http.CallOption) (rsp *GetRevenueReportReply, err error)
+	// GetUserActivityReport 用户活跃报表：活跃用户、付费用户、新增付费用户与付费转化率
+	GetUserActivityReport(ctx context.Context, req *GetUserActivityReportRequest, opts ...http.CallOption) (rsp *GetUserActivityReportReply, err error)
+	// GetUserRateLimit 查询用户限流设置及各服务生效的限流规则
+	GetUserRateLimit(ctx context.Context, req *GetUserRateLimitRequest, opts ...http.CallOption) (rsp *UserRateLimitReply, err error)
+	// ListContracts 查询账户合同
+	ListContracts(ctx context.Context, req *ListContractsRequest, opts ...http.CallOption) (rsp *ListContractsReply, err error)
+	// ListPriceRules 查询账户价格规则（含合同价）及各服务当前适用单价
+	ListPriceRules(ctx context.Context, req *ListPriceRulesRequest, opts ...http.CallOption) (rsp *ListPriceRulesReply, err error)
+	// ListTopConsumers 消费排行：按收入或调用次数排序的 Top N 用户
+	ListTopConsumers(ctx context.Context, req *ListTopConsumersRequest, opts ...http.CallOption) (rsp *ListTopConsumersReply, err error)
+	// SetUserRateLimit 设置用户限流：指定套餐及按服务覆盖的限流规则（整体覆盖之前的设置）
+	SetUserRateLimit(ctx context.Context, req *SetUserRateLimitRequest, opts ...http.CallOption) (rsp *UserRateLimitReply, err error)
+}
+
+type BillingAdminServiceHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewBillingInternalServiceHTTPClient(client *http.Client) BillingInternalServiceHTTPClient {
-	return &BillingInternalServiceHTTPClientImpl{client}
+func NewBillingAdminServiceHTTPClient(client *http.Client) BillingAdminServiceHTTPClient {
+	return &BillingAdminServiceHTTPClientImpl{client}
 }
 
-// CheckQuota 检查并预扣费 (Check & Reserve)
-func (c *BillingInternalServiceHTTPClientImpl) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...http.CallOption) (*CheckQuotaReply, error) {
-	var out CheckQuotaReply
-	pattern := "/internal/v1/billing/check"
+// CreateContract 创建承诺消费合同：承诺金额预付计入余额，合同期内按合同价计费，到期后未用完部分补差扣除
+func (c *BillingAdminServiceHTTPClientImpl) CreateContract(ctx context.Context, in *CreateContractRequest, opts ...http.CallOption) (*Contract, error) {
+	var out Contract
+	pattern := "/admin/v1/billing/contracts/{userId}"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBillingInternalServiceCheckQuota))
+	opts = append(opts, http.Operation(OperationBillingAdminServiceCreateContract))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -392,12 +1713,12 @@ func (c *BillingInternalServiceHTTPClientImpl) CheckQuota(ctx context.Context, i
 	return &out, nil
 }
 
-// DeductQuota 确认扣费 (Commit)
-func (c *BillingInternalServiceHTTPClientImpl) DeductQuota(ctx context.Context, in *DeductQuotaRequest, opts ...http.CallOption) (*DeductQuotaReply, error) {
-	var out DeductQuotaReply
-	pattern := "/internal/v1/billing/deduct"
+// CreatePriceRule 创建账户价格规则：按服务（或全部服务）的单价覆盖或折扣，可指定有效期
+func (c *BillingAdminServiceHTTPClientImpl) CreatePriceRule(ctx context.Context, in *CreatePriceRuleRequest, opts ...http.CallOption) (*PriceRule, error) {
+	var out PriceRule
+	pattern := "/admin/v1/billing/price-rules/{userId}"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBillingInternalServiceDeductQuota))
+	opts = append(opts, http.Operation(OperationBillingAdminServiceCreatePriceRule))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -406,14 +1727,140 @@ func (c *BillingInternalServiceHTTPClientImpl) DeductQuota(ctx context.Context,
 	return &out, nil
 }
 
-// RechargeCallback 充值回调 (来自 Payment Service)
-func (c *BillingInternalServiceHTTPClientImpl) RechargeCallback(ctx context.Context, in *RechargeCallbackRequest, opts ...http.CallOption) (*RechargeCallbackReply, error) {
-	var out RechargeCallbackReply
-	pattern := "/internal/v1/billing/callback"
+// DeletePriceRule 删除账户价格规则（合同价不能单独删除）
+func (c *BillingAdminServiceHTTPClientImpl) DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...http.CallOption) (*DeletePriceRuleReply, error) {
+	var out DeletePriceRuleReply
+	pattern := "/admin/v1/billing/price-rules/{userId}/{ruleId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceDeletePriceRule))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBalanceLiability 余额负债：当前全部用户的未消费余额
+func (c *BillingAdminServiceHTTPClientImpl) GetBalanceLiability(ctx context.Context, in *GetBalanceLiabilityRequest, opts ...http.CallOption) (*GetBalanceLiabilityReply, error) {
+	var out GetBalanceLiabilityReply
+	pattern := "/admin/v1/billing/reports/liability"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceGetBalanceLiability))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRechargeReport 充值报表：按 UTC 日/月统计成功充值金额、笔数、充值用户数
+func (c *BillingAdminServiceHTTPClientImpl) GetRechargeReport(ctx context.Context, in *GetRechargeReportRequest, opts ...http.CallOption) (*GetRechargeReportReply, error) {
+	var out GetRechargeReportReply
+	pattern := "/admin/v1/billing/reports/recharge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceGetRechargeReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRevenueReport 收入报表：按 UTC 日/月统计余额扣费收入，可按服务拆分
+func (c *BillingAdminServiceHTTPClientImpl) GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...http.CallOption) (*GetRevenueReportReply, error) {
+	var out GetRevenueReportReply
+	pattern := "/admin/v1/billing/reports/revenue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceGetRevenueReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserActivityReport 用户活跃报表：活跃用户、付费用户、新增付费用户与付费转化率
+func (c *BillingAdminServiceHTTPClientImpl) GetUserActivityReport(ctx context.Context, in *GetUserActivityReportRequest, opts ...http.CallOption) (*GetUserActivityReportReply, error) {
+	var out GetUserActivityReportReply
+	pattern := "/admin/v1/billing/reports/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceGetUserActivityReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserRateLimit 查询用户限流设置及各服务生效的限流规则
+func (c *BillingAdminServiceHTTPClientImpl) GetUserRateLimit(ctx context.Context, in *GetUserRateLimitRequest, opts ...http.CallOption) (*UserRateLimitReply, error) {
+	var out UserRateLimitReply
+	pattern := "/admin/v1/billing/rate-limits/{userId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceGetUserRateLimit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListContracts 查询账户合同
+func (c *BillingAdminServiceHTTPClientImpl) ListContracts(ctx context.Context, in *ListContractsRequest, opts ...http.CallOption) (*ListContractsReply, error) {
+	var out ListContractsReply
+	pattern := "/admin/v1/billing/contracts/{userId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceListContracts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPriceRules 查询账户价格规则（含合同价）及各服务当前适用单价
+func (c *BillingAdminServiceHTTPClientImpl) ListPriceRules(ctx context.Context, in *ListPriceRulesRequest, opts ...http.CallOption) (*ListPriceRulesReply, error) {
+	var out ListPriceRulesReply
+	pattern := "/admin/v1/billing/price-rules/{userId}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceListPriceRules))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTopConsumers 消费排行：按收入或调用次数排序的 Top N 用户
+func (c *BillingAdminServiceHTTPClientImpl) ListTopConsumers(ctx context.Context, in *ListTopConsumersRequest, opts ...http.CallOption) (*ListTopConsumersReply, error) {
+	var out ListTopConsumersReply
+	pattern := "/admin/v1/billing/reports/top-consumers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBillingAdminServiceListTopConsumers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetUserRateLimit 设置用户限流：指定套餐及按服务覆盖的限流规则（整体覆盖之前的设置）
+func (c *BillingAdminServiceHTTPClientImpl) SetUserRateLimit(ctx context.Context, in *SetUserRateLimitRequest, opts ...http.CallOption) (*UserRateLimitReply, error) {
+	var out UserRateLimitReply
+	pattern := "/admin/v1/billing/rate-limits/{userId}"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBillingInternalServiceRechargeCallback))
+	opts = append(opts, http.Operation(OperationBillingAdminServiceSetUserRateLimit))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -20,10 +21,18 @@ import (
 
 var (
 	flagconf string
+
+	// 用量汇总回填：-backfill-rollups -from 2026-01-01 -to 2026-02-01（UTC 日期，to 不含）
+	flagBackfillRollups bool
+	flagBackfillFrom    string
+	flagBackfillTo      string
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs/config.yaml", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&flagBackfillRollups, "backfill-rollups", false, "rebuild usage rollup tables from billing records and exit")
+	flag.StringVar(&flagBackfillFrom, "from", "", "backfill start date (UTC, inclusive), eg: -from 2026-01-01")
+	flag.StringVar(&flagBackfillTo, "to", "", "backfill end date (UTC, exclusive), default: tomorrow")
 }
 
 func main() {
@@ -77,11 +86,21 @@ func main() {
 	}
 	defer cleanup()
 
+	// 回填用量汇总后退出
+	if flagBackfillRollups {
+		if err := backfillRollups(app, logHelper); err != nil {
+			logHelper.Errorf("[BACKFILL] Failed: %v", err)
+			cleanup()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 创建定时任务调度器（支持秒级调度）
 	cronScheduler := cron.New(cron.WithSeconds())
 
-	// 免费额度重置 - 每月1日 00:00 执行
-	_, err = cronScheduler.AddFunc("0 0 0 1 * *", func() {
+	// 免费额度重置 - 每小时整点执行，为账户时区内当天开始新周期的用户创建额度
+	_, err = cronScheduler.AddFunc("0 0 * * * *", func() {
 		logHelper.Info("[CRON] Starting free quota reset...")
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 		defer cancel()
@@ -103,12 +122,30 @@ func main() {
 		logHelper.Errorf("Failed to add free quota reset job: %v", err)
 	}
 
+	// 合同结算 - 每小时 30 分执行，对到期超过宽限期的合同补差
+	_, err = cronScheduler.AddFunc("0 30 * * * *", func() {
+		logHelper.Info("[CRON] Starting contract settlement...")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+
+		settled, err := app.billingUsecase.SettleContracts(ctx)
+		if err != nil {
+			logHelper.Errorf("[CRON] Error settling contracts: %v", err)
+		} else {
+			logHelper.Infof("[CRON] Finished contract settlement: settled=%d", settled)
+		}
+	})
+	if err != nil {
+		logHelper.Errorf("Failed to add contract settlement job: %v", err)
+	}
+
 	// 启动定时任务
 	cronScheduler.Start()
 	logHelper.Info("========================================")
 	logHelper.Info("Cron jobs started successfully")
 	logHelper.Info("Scheduled jobs:")
-	logHelper.Info("  - Free quota reset: Every month on the 1st at 00:00")
+	logHelper.Info("  - Free quota reset: Every hour at minute 0 (users starting a new period in their account timezone)")
+	logHelper.Info("  - Contract settlement: Every hour at minute 30")
 	logHelper.Info("========================================")
 
 	// 优雅退出
@@ -127,3 +164,25 @@ func main() {
 		logHelper.Info("Cron jobs forced to stop after timeout")
 	}
 }
+
+// backfillRollups 从原始消费记录重建 [from, to) 内的用量小时/日汇总
+func backfillRollups(app *CronApp, logHelper *log.Helper) error {
+	from, err := time.Parse(time.DateOnly, flagBackfillFrom)
+	if err != nil {
+		return fmt.Errorf("invalid -from %q: %w", flagBackfillFrom, err)
+	}
+	to := time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	if flagBackfillTo != "" {
+		if to, err = time.Parse(time.DateOnly, flagBackfillTo); err != nil {
+			return fmt.Errorf("invalid -to %q: %w", flagBackfillTo, err)
+		}
+	}
+
+	logHelper.Infof("[BACKFILL] Rebuilding usage rollups: from=%s, to=%s", from.Format(time.DateOnly), to.Format(time.DateOnly))
+	users, err := app.billingUsecase.RebuildUsageRollups(context.Background(), from, to)
+	if err != nil {
+		return err
+	}
+	logHelper.Infof("[BACKFILL] Finished: users=%d", users)
+	return nil
+}
@@ -44,14 +44,51 @@ func wireApp(bootstrap *conf.Bootstrap) (*CronApp, func(), error) {
 	}
 	rechargeOrderUseCase := biz.NewRechargeOrderUseCase(rechargeOrderRepo, paymentServiceClient, billingConfig, logger)
 	statsRepo := data.NewStatsRepo(dataData, logger)
-	statsUseCase := biz.NewStatsUseCase(statsRepo, logger)
+	accountSettingRepo := data.NewAccountSettingRepo(dataData, billingConfig, logger)
+	rateLimitRepo := data.NewRateLimitRepo(dataData, billingConfig, logger)
+	rateLimitUseCase := biz.NewRateLimitUseCase(rateLimitRepo, billingConfig, logger)
+	periodUseCase := biz.NewPeriodUseCase(accountSettingRepo, rateLimitUseCase, billingConfig, logger)
+	statsUseCase := biz.NewStatsUseCase(statsRepo, periodUseCase, logger)
+	deferredChargeJournal, cleanup2, err := data.NewDeferredChargeJournal(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	degradationGuard, err := biz.NewDegradationGuard(billingConfig, deferredChargeJournal, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	redsync := data.NewRedSync(dataData)
 	billingRepo := data.NewBillingRepo(dataData, redsync, logger, userBalanceRepo, freeQuotaRepo, billingRecordRepo, rechargeOrderRepo, statsRepo)
-	billingUseCase := biz.NewBillingUseCase(userBalanceUseCase, freeQuotaUseCase, billingRecordUseCase, rechargeOrderUseCase, statsUseCase, billingRepo, billingConfig, logger)
+	leaseRepo := data.NewLeaseRepo(dataData, billingRepo, logger)
+	leaseUseCase := biz.NewLeaseUseCase(leaseRepo, billingConfig, logger)
+	exportRepo := data.NewExportRepo(dataData, logger)
+	exportStorage, err := data.NewExportStorage(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	exportUseCase := biz.NewExportUseCase(exportRepo, exportStorage, billingConfig, logger)
+	analyticsRepo := data.NewAnalyticsRepo(dataData, logger)
+	analyticsUseCase := biz.NewAnalyticsUseCase(analyticsRepo, logger)
+	budgetRepo := data.NewBudgetRepo(dataData, logger)
+	budgetNotifier := data.NewBudgetNotifier(billingConfig, logger)
+	budgetUseCase := biz.NewBudgetUseCase(budgetRepo, budgetNotifier, periodUseCase, billingConfig, logger)
+	packageRepo := data.NewPackageRepo(dataData, logger)
+	packageUseCase := biz.NewPackageUseCase(packageRepo, billingConfig, logger)
+	pricingRepo := data.NewPricingRepo(dataData, billingConfig, logger)
+	ratingUseCase := biz.NewRatingUseCase(pricingRepo, billingConfig, logger)
+	orgRepo := data.NewOrgRepo(dataData, billingConfig, logger)
+	orgUseCase := biz.NewOrgUseCase(orgRepo, periodUseCase, billingConfig, logger)
+	billingUseCase := biz.NewBillingUseCase(userBalanceUseCase, freeQuotaUseCase, billingRecordUseCase, rechargeOrderUseCase, statsUseCase, degradationGuard, leaseUseCase, exportUseCase, analyticsUseCase, budgetUseCase, rateLimitUseCase, periodUseCase, packageUseCase, ratingUseCase, orgUseCase, billingRepo, billingConfig, logger)
 	cronApp := &CronApp{
 		billingUsecase: billingUseCase,
 	}
 	return cronApp, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -32,7 +32,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, mq *server.MQConsumerServer) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, mq *server.MQConsumerServer, ds *server.DeferredSettlementServer, lr *server.LeaseReclaimServer, ew *server.ExportWorkerServer, ba *server.BudgetAlertServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +43,10 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, mq *server.MQCo
 			gs,
 			hs,
 			mq,
+			ds,
+			lr,
+			ew,
+			ba,
 		),
 	)
 }
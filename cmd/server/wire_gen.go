@@ -44,16 +44,64 @@ func wireApp(confServer *conf.Server, confData *conf.Data, bootstrap *conf.Boots
 	}
 	rechargeOrderUseCase := biz.NewRechargeOrderUseCase(rechargeOrderRepo, paymentServiceClient, billingConfig, logger)
 	statsRepo := data.NewStatsRepo(dataData, logger)
-	statsUseCase := biz.NewStatsUseCase(statsRepo, logger)
+	accountSettingRepo := data.NewAccountSettingRepo(dataData, billingConfig, logger)
+	rateLimitRepo := data.NewRateLimitRepo(dataData, billingConfig, logger)
+	rateLimitUseCase := biz.NewRateLimitUseCase(rateLimitRepo, billingConfig, logger)
+	periodUseCase := biz.NewPeriodUseCase(accountSettingRepo, rateLimitUseCase, billingConfig, logger)
+	statsUseCase := biz.NewStatsUseCase(statsRepo, periodUseCase, logger)
+	deferredChargeJournal, cleanup2, err := data.NewDeferredChargeJournal(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	degradationGuard, err := biz.NewDegradationGuard(billingConfig, deferredChargeJournal, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	redsync := data.NewRedSync(dataData)
 	billingRepo := data.NewBillingRepo(dataData, redsync, logger, userBalanceRepo, freeQuotaRepo, billingRecordRepo, rechargeOrderRepo, statsRepo)
-	billingUseCase := biz.NewBillingUseCase(userBalanceUseCase, freeQuotaUseCase, billingRecordUseCase, rechargeOrderUseCase, statsUseCase, billingRepo, billingConfig, logger)
-	billingService := service.NewBillingService(billingUseCase, logger)
-	grpcServer := server.NewGRPCServer(confServer, billingService, logger)
-	httpServer := server.NewHTTPServer(confServer, billingService, logger)
+	leaseRepo := data.NewLeaseRepo(dataData, billingRepo, logger)
+	leaseUseCase := biz.NewLeaseUseCase(leaseRepo, billingConfig, logger)
+	exportRepo := data.NewExportRepo(dataData, logger)
+	exportStorage, err := data.NewExportStorage(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	exportUseCase := biz.NewExportUseCase(exportRepo, exportStorage, billingConfig, logger)
+	analyticsRepo := data.NewAnalyticsRepo(dataData, logger)
+	analyticsUseCase := biz.NewAnalyticsUseCase(analyticsRepo, logger)
+	budgetRepo := data.NewBudgetRepo(dataData, logger)
+	budgetNotifier := data.NewBudgetNotifier(billingConfig, logger)
+	budgetUseCase := biz.NewBudgetUseCase(budgetRepo, budgetNotifier, periodUseCase, billingConfig, logger)
+	packageRepo := data.NewPackageRepo(dataData, logger)
+	packageUseCase := biz.NewPackageUseCase(packageRepo, billingConfig, logger)
+	pricingRepo := data.NewPricingRepo(dataData, billingConfig, logger)
+	ratingUseCase := biz.NewRatingUseCase(pricingRepo, billingConfig, logger)
+	orgRepo := data.NewOrgRepo(dataData, billingConfig, logger)
+	orgUseCase := biz.NewOrgUseCase(orgRepo, periodUseCase, billingConfig, logger)
+	billingUseCase := biz.NewBillingUseCase(userBalanceUseCase, freeQuotaUseCase, billingRecordUseCase, rechargeOrderUseCase, statsUseCase, degradationGuard, leaseUseCase, exportUseCase, analyticsUseCase, budgetUseCase, rateLimitUseCase, periodUseCase, packageUseCase, ratingUseCase, orgUseCase, billingRepo, billingConfig, logger)
+	billingService := service.NewBillingService(billingUseCase, billingConfig, logger)
+	adminService := service.NewAdminService(billingUseCase, logger)
+	authenticator, err := server.NewAuthenticator(confServer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, billingService, adminService, authenticator, logger)
+	httpServer := server.NewHTTPServer(confServer, billingService, adminService, authenticator, logger)
 	mqConsumerServer := server.NewMQConsumerServer(confData, billingRepo, logger)
-	app := newApp(logger, grpcServer, httpServer, mqConsumerServer)
+	deferredSettlementServer := server.NewDeferredSettlementServer(billingUseCase, billingConfig, logger)
+	leaseReclaimServer := server.NewLeaseReclaimServer(billingUseCase, billingConfig, logger)
+	exportWorkerServer := server.NewExportWorkerServer(billingUseCase, billingConfig, logger)
+	budgetAlertServer := server.NewBudgetAlertServer(billingUseCase, billingConfig, logger)
+	app := newApp(logger, grpcServer, httpServer, mqConsumerServer, deferredSettlementServer, leaseReclaimServer, exportWorkerServer, budgetAlertServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
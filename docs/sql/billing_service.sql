@@ -24,25 +24,70 @@ CREATE TABLE IF NOT EXISTS `free_quota` (
     `service_name` VARCHAR(32) NOT NULL COMMENT '服务名: passport/payment/asset',
     `total_quota` INT DEFAULT 0 COMMENT '总额度',
     `used_quota` INT DEFAULT 0 COMMENT '已用额度',
-    `reset_month` VARCHAR(7) NOT NULL COMMENT '重置月份: 2024-11',
+    `rollover_quota` INT NOT NULL DEFAULT 0 COMMENT '上期结转的额度（已计入总额度，优先使用，本周期结束时过期）',
+    `period` VARCHAR(16) NOT NULL COMMENT '周期标识: 2024-11 / D2024-11-05 / W2024-11-04 / A2024-11-15',
+    `cycle` VARCHAR(16) NOT NULL DEFAULT 'monthly' COMMENT '周期类型: daily / weekly / monthly / anniversary',
+    `period_start` DATETIME DEFAULT NULL COMMENT '周期开始时间（含）',
+    `period_end` DATETIME DEFAULT NULL COMMENT '周期结束时间（不含）',
     `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`free_quota_id`),
-    UNIQUE KEY `uk_user_service_month` (`uid`, `service_name`, `reset_month`) COMMENT '用户服务月度配额唯一索引'
+    UNIQUE KEY `uk_user_service_period` (`uid`, `service_name`, `period`) COMMENT '用户服务周期配额唯一索引'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='免费额度表';
 
+-- 已有库升级（已有记录均为自然月周期）：
+-- ALTER TABLE `free_quota`
+--     CHANGE `reset_month` `period` VARCHAR(16) NOT NULL COMMENT '周期标识: 2024-11 / D2024-11-05 / W2024-11-04 / A2024-11-15',
+--     ADD COLUMN `cycle` VARCHAR(16) NOT NULL DEFAULT 'monthly' COMMENT '周期类型: daily / weekly / monthly / anniversary' AFTER `period`,
+--     ADD COLUMN `period_start` DATETIME DEFAULT NULL COMMENT '周期开始时间（含）' AFTER `cycle`,
+--     ADD COLUMN `period_end` DATETIME DEFAULT NULL COMMENT '周期结束时间（不含）' AFTER `period_start`,
+--     RENAME INDEX `uk_user_service_month` TO `uk_user_service_period`;
+-- UPDATE `free_quota`
+--     SET `period_start` = STR_TO_DATE(CONCAT(`period`, '-01'), '%Y-%m-%d'),
+--         `period_end` = DATE_ADD(STR_TO_DATE(CONCAT(`period`, '-01'), '%Y-%m-%d'), INTERVAL 1 MONTH)
+--     WHERE `period_start` IS NULL;
+-- ALTER TABLE `free_quota` ADD COLUMN `rollover_quota` INT NOT NULL DEFAULT 0 COMMENT '上期结转的额度（已计入总额度，优先使用，本周期结束时过期）' AFTER `used_quota`;
+
 -- Table: billing_record
 CREATE TABLE IF NOT EXISTS `billing_record` (
     `billing_record_id` VARCHAR(36) NOT NULL COMMENT '主键ID',
     `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
     `service_name` VARCHAR(32) NOT NULL COMMENT '服务名',
-    `type` ENUM('free', 'balance') NOT NULL COMMENT 'free:免费额度, balance:余额扣费',
+    `type` ENUM('free', 'balance', 'package', 'true_up') NOT NULL COMMENT 'free:免费额度, balance:余额扣费, package:用量包, true_up:合同补差',
     `amount` DECIMAL(10, 4) DEFAULT 0.0000 COMMENT '扣费金额',
     `count` INT DEFAULT 1 COMMENT '调用次数',
+    `member_uid` VARCHAR(36) NOT NULL DEFAULT '' COMMENT '组织账户中实际使用的成员，个人账户为空',
     `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     PRIMARY KEY (`billing_record_id`),
-    INDEX `idx_uid_date` (`uid`, `created_at`) COMMENT '用户消费记录索引'
+    INDEX `idx_uid_date` (`uid`, `created_at`) COMMENT '用户消费记录索引',
+    INDEX `idx_uid_service_date` (`uid`, `service_name`, `created_at`) COMMENT '按服务过滤消费记录',
+    INDEX `idx_uid_type_date` (`uid`, `type`, `created_at`) COMMENT '按扣费类型过滤消费记录',
+    INDEX `idx_uid_member_date` (`uid`, `member_uid`, `created_at`) COMMENT '按组织成员查询消费记录'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消费流水表';
+-- 已有库升级：
+-- ALTER TABLE `billing_record`
+--     ADD INDEX `idx_uid_service_date` (`uid`, `service_name`, `created_at`),
+--     ADD INDEX `idx_uid_type_date` (`uid`, `type`, `created_at`);
+-- ALTER TABLE `billing_record` MODIFY COLUMN `type` ENUM('free', 'balance', 'package', 'true_up') NOT NULL COMMENT 'free:免费额度, balance:余额扣费, package:用量包, true_up:合同补差';
+-- ALTER TABLE `billing_record`
+--     ADD COLUMN `member_uid` VARCHAR(36) NOT NULL DEFAULT '' COMMENT '组织账户中实际使用的成员，个人账户为空' AFTER `count`,
+--     ADD INDEX `idx_uid_member_date` (`uid`, `member_uid`, `created_at`);
+
+-- Table: billing_record_metadata
+CREATE TABLE IF NOT EXISTS `billing_record_metadata` (
+    `billing_record_id` VARCHAR(36) NOT NULL COMMENT '消费记录ID（billing_record 主键）',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `request_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '调用方请求ID',
+    `api_key_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT 'API Key ID',
+    `app_id` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '应用ID',
+    `operation` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '接口/操作',
+    `labels` TEXT COMMENT '自定义标签（JSON 对象）',
+    `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间（与消费记录一致）',
+    PRIMARY KEY (`billing_record_id`),
+    INDEX `idx_uid_request` (`uid`, `request_id`) COMMENT '按请求ID查询消费记录',
+    INDEX `idx_app_id_date` (`app_id`, `created_at`) COMMENT '按应用统计',
+    INDEX `idx_uid_app_date` (`uid`, `app_id`, `created_at`) COMMENT '按应用过滤用户消费记录'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='消费流水来源信息表（扣费携带来源信息时写入）';
 
 -- Table: recharge_order
 CREATE TABLE IF NOT EXISTS `recharge_order` (
@@ -51,9 +96,181 @@ CREATE TABLE IF NOT EXISTS `recharge_order` (
     `amount` DECIMAL(10, 2) NOT NULL COMMENT '充值金额',
     `payment_id` VARCHAR(64) DEFAULT NULL COMMENT '支付流水号（payment-service返回的payment_id，用于关联payment-service的支付订单，有唯一索引保证幂等性）',
     `status` ENUM('pending', 'success', 'failed') NOT NULL DEFAULT 'pending' COMMENT '订单状态: pending-待支付, success-支付成功, failed-支付失败',
+    `order_type` ENUM('recharge', 'package', 'contract') NOT NULL DEFAULT 'recharge' COMMENT '订单类型: recharge-余额充值, package-购买用量包（不增加余额）, contract-合同预付（创建合同时计入余额）',
     `created_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `updated_at` TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`order_id`),
     UNIQUE KEY `uk_payment_id` (`payment_id`) COMMENT 'payment_id唯一索引（幂等性保证）',
-    INDEX `idx_uid` (`uid`) COMMENT '用户ID索引'
+    INDEX `idx_uid` (`uid`) COMMENT '用户ID索引',
+    INDEX `idx_status_updated` (`status`, `updated_at`) COMMENT '充值报表按状态和完成时间查询'
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='充值订单表（幂等性保证）';
+-- 已有库升级：
+-- ALTER TABLE `recharge_order` ADD INDEX `idx_status_updated` (`status`, `updated_at`);
+-- ALTER TABLE `recharge_order` ADD COLUMN `order_type` ENUM('recharge', 'package', 'contract') NOT NULL DEFAULT 'recharge' COMMENT '订单类型: recharge-余额充值, package-购买用量包（不增加余额）, contract-合同预付（创建合同时计入余额）' AFTER `status`;
+-- ALTER TABLE `recharge_order` MODIFY COLUMN `order_type` ENUM('recharge', 'package', 'contract') NOT NULL DEFAULT 'recharge' COMMENT '订单类型: recharge-余额充值, package-购买用量包（不增加余额）, contract-合同预付（创建合同时计入余额）';
+
+-- Table: billing_export_job
+CREATE TABLE IF NOT EXISTS `billing_export_job` (
+    `export_id` VARCHAR(36) NOT NULL COMMENT '导出任务ID',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `format` VARCHAR(8) NOT NULL COMMENT '导出格式: csv / xlsx / pdf',
+    `lang` VARCHAR(16) NOT NULL COMMENT '导出语言: zh-CN / en-US',
+    `start_time` DATETIME(3) NOT NULL COMMENT '账单开始时间（含）',
+    `end_time` DATETIME(3) NOT NULL COMMENT '账单结束时间（不含）',
+    `status` ENUM('pending', 'running', 'success', 'failed', 'expired') NOT NULL DEFAULT 'pending' COMMENT '任务状态: pending-待执行, running-执行中, success-已完成, failed-失败, expired-文件已过期',
+    `file_key` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '导出文件在存储中的 key',
+    `file_size` BIGINT NOT NULL DEFAULT 0 COMMENT '文件大小（字节）',
+    `row_count` INT NOT NULL DEFAULT 0 COMMENT '导出行数',
+    `error_message` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `started_at` DATETIME(3) DEFAULT NULL COMMENT '开始执行时间（用于重新领取执行超时的任务）',
+    `finished_at` DATETIME(3) DEFAULT NULL COMMENT '完成时间',
+    `expires_at` DATETIME(3) DEFAULT NULL COMMENT '文件过期时间',
+    PRIMARY KEY (`export_id`),
+    INDEX `idx_uid_created` (`uid`, `created_at`) COMMENT '用户导出任务查询',
+    INDEX `idx_status_started` (`status`, `started_at`) COMMENT '领取待执行/超时任务',
+    INDEX `idx_status_expires` (`status`, `expires_at`) COMMENT '清理过期文件'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='账单导出任务表';
+
+-- Table: billing_usage_hourly
+CREATE TABLE IF NOT EXISTS `billing_usage_hourly` (
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `service_name` VARCHAR(32) NOT NULL COMMENT '服务名称',
+    `bucket_start` DATETIME(3) NOT NULL COMMENT '小时起点（UTC 整点）',
+    `total_count` BIGINT NOT NULL DEFAULT 0 COMMENT '总调用量',
+    `free_count` BIGINT NOT NULL DEFAULT 0 COMMENT '免费额度使用量',
+    `paid_count` BIGINT NOT NULL DEFAULT 0 COMMENT '余额扣费使用量',
+    `total_cost` DECIMAL(16, 4) NOT NULL DEFAULT 0.0000 COMMENT '余额扣费金额',
+    PRIMARY KEY (`uid`, `service_name`, `bucket_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用量小时汇总表（与消费记录同事务增量维护）';
+
+-- Table: billing_usage_daily
+CREATE TABLE IF NOT EXISTS `billing_usage_daily` (
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `service_name` VARCHAR(32) NOT NULL COMMENT '服务名称',
+    `bucket_start` DATETIME(3) NOT NULL COMMENT '日期起点（UTC 零点）',
+    `total_count` BIGINT NOT NULL DEFAULT 0 COMMENT '总调用量',
+    `free_count` BIGINT NOT NULL DEFAULT 0 COMMENT '免费额度使用量',
+    `paid_count` BIGINT NOT NULL DEFAULT 0 COMMENT '余额扣费使用量',
+    `total_cost` DECIMAL(16, 4) NOT NULL DEFAULT 0.0000 COMMENT '余额扣费金额',
+    PRIMARY KEY (`uid`, `service_name`, `bucket_start`),
+    INDEX `idx_bucket` (`bucket_start`) COMMENT '管理报表按日期跨用户查询'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用量日汇总表（与消费记录同事务增量维护）';
+-- 已有库升级：
+-- ALTER TABLE `billing_usage_daily` ADD INDEX `idx_bucket` (`bucket_start`);
+
+-- Table: billing_budget
+CREATE TABLE IF NOT EXISTS `billing_budget` (
+    `budget_id` VARCHAR(36) NOT NULL COMMENT '预算ID',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `service_name` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '服务名称，为空表示全部服务合计',
+    `amount` DECIMAL(10, 4) NOT NULL COMMENT '每月预算金额（余额消费）',
+    `alert_percent` DECIMAL(5, 2) NOT NULL DEFAULT 0.00 COMMENT '提醒阈值（预算金额的百分比），0 表示不提醒',
+    `hard_limit` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否硬性上限：超出预算的扣费被拒绝',
+    `alerted_month` VARCHAR(7) NOT NULL DEFAULT '' COMMENT '最近一次发送提醒的月份',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`budget_id`),
+    UNIQUE INDEX `uk_user_service` (`uid`, `service_name`) COMMENT '每个用户每个服务一个预算'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户消费预算表';
+
+-- Table: billing_user_rate_limit
+CREATE TABLE IF NOT EXISTS `billing_user_rate_limit` (
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `plan` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '限流套餐，为空表示默认套餐',
+    `overrides` TEXT COMMENT '用户级限流规则（JSON：服务名 -> {perSecond, perDay}）',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`uid`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户限流设置表';
+
+-- Table: billing_account_setting
+CREATE TABLE IF NOT EXISTS `billing_account_setting` (
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `cycle_anchor` DATETIME NOT NULL COMMENT '周年周期锚点（账户最早的记录时间）',
+    `timezone` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '计费时区（IANA 名称），为空表示服务默认时区',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`uid`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='账户计费设置表';
+-- 已有库升级：
+-- ALTER TABLE `billing_account_setting` ADD COLUMN `timezone` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '计费时区（IANA 名称），为空表示服务默认时区' AFTER `cycle_anchor`;
+
+-- Table: billing_user_package
+CREATE TABLE IF NOT EXISTS `billing_user_package` (
+    `user_package_id` VARCHAR(36) NOT NULL COMMENT '主键ID',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `service_name` VARCHAR(32) NOT NULL COMMENT '服务名',
+    `package_id` VARCHAR(64) NOT NULL COMMENT '目录中的用量包ID',
+    `name` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '下单时的名称',
+    `order_id` VARCHAR(64) NOT NULL COMMENT '购买订单号（recharge_order.order_id）',
+    `total_units` BIGINT NOT NULL COMMENT '包含用量（按服务计量单位）',
+    `used_units` BIGINT NOT NULL DEFAULT 0 COMMENT '已用用量',
+    `valid_months` INT NOT NULL COMMENT '有效期（月）',
+    `expires_at` DATETIME(3) DEFAULT NULL COMMENT '到期时间（不含），支付成功后设置，未支付时为 NULL',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '下单时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`user_package_id`),
+    UNIQUE KEY `idx_billing_user_package_order_id` (`order_id`) COMMENT '一个订单对应一个用量包',
+    INDEX `idx_uid_service_expires` (`uid`, `service_name`, `expires_at`) COMMENT '按服务查询有效用量包'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户用量包表';
+
+-- Table: billing_price_rule
+CREATE TABLE IF NOT EXISTS `billing_price_rule` (
+    `price_rule_id` VARCHAR(36) NOT NULL COMMENT '主键ID',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `service_name` VARCHAR(32) NOT NULL COMMENT '服务名，* 表示全部服务',
+    `unit_price` DECIMAL(12, 6) NOT NULL DEFAULT 0 COMMENT '单价覆盖，大于 0 时生效',
+    `discount_percent` DECIMAL(5, 2) NOT NULL DEFAULT 0 COMMENT '目录价折扣（百分比），未设置单价覆盖时生效',
+    `contract_id` VARCHAR(36) NOT NULL DEFAULT '' COMMENT '合同价所属合同，单独设置的规则为空',
+    `starts_at` DATETIME(3) NOT NULL COMMENT '开始时间（含）',
+    `ends_at` DATETIME(3) DEFAULT NULL COMMENT '结束时间（不含），为 NULL 表示长期有效',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    PRIMARY KEY (`price_rule_id`),
+    INDEX `idx_uid_ends` (`uid`, `ends_at`) COMMENT '查询账户未到期规则',
+    INDEX `idx_contract` (`contract_id`) COMMENT '按合同查询合同价'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='账户价格规则表';
+
+-- Table: billing_contract
+CREATE TABLE IF NOT EXISTS `billing_contract` (
+    `contract_id` VARCHAR(36) NOT NULL COMMENT '主键ID',
+    `uid` VARCHAR(36) NOT NULL COMMENT '用户ID',
+    `commit_amount` DECIMAL(12, 2) NOT NULL COMMENT '承诺金额（创建时预付计入余额）',
+    `drawn_amount` DECIMAL(14, 4) NOT NULL DEFAULT 0 COMMENT '合同期内的余额消费',
+    `starts_at` DATETIME(3) NOT NULL COMMENT '开始时间（含）',
+    `ends_at` DATETIME(3) NOT NULL COMMENT '到期时间（不含）',
+    `status` ENUM('active', 'settled') NOT NULL DEFAULT 'active' COMMENT '状态: active-生效中（含未开始）, settled-已结算',
+    `true_up_amount` DECIMAL(12, 4) NOT NULL DEFAULT 0 COMMENT '应补差额',
+    `true_up_charged` DECIMAL(12, 4) NOT NULL DEFAULT 0 COMMENT '实际扣款（余额不足时小于应补差额）',
+    `settled_at` DATETIME(3) DEFAULT NULL COMMENT '结算时间',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`contract_id`),
+    INDEX `idx_uid_status` (`uid`, `status`) COMMENT '查询账户合同',
+    INDEX `idx_status_ends` (`status`, `ends_at`) COMMENT '查询待结算合同'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='承诺消费合同表';
+
+-- Table: billing_org
+CREATE TABLE IF NOT EXISTS `billing_org` (
+    `org_id` VARCHAR(36) NOT NULL COMMENT '组织ID，即共享钱包的账户ID',
+    `name` VARCHAR(64) NOT NULL COMMENT '组织名称',
+    `owner_uid` VARCHAR(36) NOT NULL COMMENT '所有者用户ID',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '创建时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`org_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='组织表';
+
+-- Table: billing_org_member
+CREATE TABLE IF NOT EXISTS `billing_org_member` (
+    `uid` VARCHAR(36) NOT NULL COMMENT '成员用户ID（每个用户最多属于一个组织，含未接受的邀请）',
+    `org_id` VARCHAR(36) NOT NULL COMMENT '组织ID',
+    `role` ENUM('owner', 'admin', 'member') NOT NULL DEFAULT 'member' COMMENT '角色: owner-所有者, admin-管理员, member-普通成员',
+    `status` ENUM('invited', 'active') NOT NULL DEFAULT 'active' COMMENT '状态: invited-已邀请（等待用户接受）, active-已加入',
+    `spend_limit` DECIMAL(12, 2) NOT NULL DEFAULT 0 COMMENT '每月余额消费上限，0 表示不限制',
+    `created_at` DATETIME(3) DEFAULT NULL COMMENT '加入（被邀请）时间',
+    `updated_at` DATETIME(3) DEFAULT NULL COMMENT '更新时间',
+    PRIMARY KEY (`uid`),
+    INDEX `idx_org` (`org_id`) COMMENT '查询组织成员'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='组织成员表';
+-- 已有库升级（已有成员均为已加入）：
+-- ALTER TABLE `billing_org_member` ADD COLUMN `status` ENUM('invited', 'active') NOT NULL DEFAULT 'active' COMMENT '状态: invited-已邀请（等待用户接受）, active-已加入' AFTER `role`;
@@ -0,0 +1,271 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// RevenueItem 收入统计（单个时间桶，按服务拆分时为单个时间桶内的单个服务）
+type RevenueItem struct {
+	PeriodStart time.Time
+	ServiceName string
+	Revenue     float64
+	TotalCount  int64
+	FreeCount   int64
+	PaidCount   int64
+}
+
+// RevenueReport 收入报表
+type RevenueReport struct {
+	Granularity  string
+	Items        []*RevenueItem
+	TotalRevenue float64
+	TotalCount   int64
+	FreeCount    int64
+	PaidCount    int64
+}
+
+// RechargeItem 充值统计（单个时间桶）
+type RechargeItem struct {
+	PeriodStart time.Time
+	Amount      float64
+	OrderCount  int64
+	UserCount   int64 // 充值用户数（去重）
+}
+
+// RechargeReport 充值报表
+type RechargeReport struct {
+	Granularity string
+	Items       []*RechargeItem
+	TotalAmount float64
+	TotalOrders int64
+	TotalUsers  int64 // 整个区间内的充值用户数（去重）
+}
+
+// UserActivity 用户活跃统计
+type UserActivity struct {
+	ActiveUsers    int64   // 有调用的用户数
+	PayingUsers    int64   // 有余额扣费的用户数
+	NewPayingUsers int64   // 首次余额扣费发生在区间内的用户数
+	ConversionRate float64 // PayingUsers / ActiveUsers
+}
+
+// TopConsumer 消费排行条目
+type TopConsumer struct {
+	UID        string
+	Revenue    float64
+	TotalCount int64
+	PaidCount  int64
+}
+
+// BalanceLiability 余额负债
+type BalanceLiability struct {
+	TotalBalance   float64
+	Accounts       int64
+	FundedAccounts int64 // 余额大于 0 的账户数
+	AsOf           time.Time
+}
+
+// AnalyticsRepo 平台级统计数据层接口（定义在 biz 层）
+// 时间范围均为 UTC 零点对齐的 [start, end)
+type AnalyticsRepo interface {
+	// ListDailyRevenue 按 UTC 日统计收入（来自日汇总表），groupByService 时按服务拆分，只返回有数据的行，按日期升序
+	ListDailyRevenue(ctx context.Context, start, end time.Time, serviceName string, groupByService bool) ([]*RevenueItem, error)
+	// SumRecharges 按时间桶（day / month，UTC）统计成功充值，只返回有数据的时间桶；granularity 为空时返回整个区间的合计（一行）
+	SumRecharges(ctx context.Context, start, end time.Time, granularity string) ([]*RechargeItem, error)
+	// GetUserActivity 统计活跃用户、付费用户与新增付费用户
+	GetUserActivity(ctx context.Context, start, end time.Time, serviceName string) (*UserActivity, error)
+	// ListTopConsumers 按 orderBy（revenue / count）降序返回前 limit 个用户
+	ListTopConsumers(ctx context.Context, start, end time.Time, serviceName, orderBy string, limit int) ([]*TopConsumer, error)
+	// GetBalanceLiability 统计当前全部用户余额
+	GetBalanceLiability(ctx context.Context) (*BalanceLiability, error)
+}
+
+// AnalyticsUseCase 平台级统计业务逻辑（管理报表）
+type AnalyticsUseCase struct {
+	repo AnalyticsRepo
+	log  *log.Helper
+}
+
+// NewAnalyticsUseCase 创建平台级统计 UseCase
+func NewAnalyticsUseCase(repo AnalyticsRepo, logger log.Logger) *AnalyticsUseCase {
+	return &AnalyticsUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetRevenueReport 收入报表（按 UTC 日/月分桶；不按服务拆分时无数据的时间桶补零）
+func (uc *AnalyticsUseCase) GetRevenueReport(ctx context.Context, start, end time.Time, granularity, serviceName string, groupByService bool) (*RevenueReport, error) {
+	granularity, bounds, err := uc.reportBounds(ctx, start, end, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.repo.ListDailyRevenue(ctx, bounds[0], bounds[len(bounds)-1], serviceName, groupByService)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RevenueReport{Granularity: granularity}
+	// 按时间桶（及服务）合并日数据，bucketItems[i] 按服务名索引第 i 个时间桶的条目
+	bucketItems := make([]map[string]*RevenueItem, len(bounds)-1)
+	for i := range bucketItems {
+		bucketItems[i] = make(map[string]*RevenueItem)
+		if !groupByService {
+			bucketItems[i][""] = &RevenueItem{PeriodStart: bounds[i]}
+		}
+	}
+	for _, row := range rows {
+		i := reportBucketIndex(bounds, row.PeriodStart)
+		if i < 0 {
+			continue
+		}
+		item, ok := bucketItems[i][row.ServiceName]
+		if !ok {
+			item = &RevenueItem{PeriodStart: bounds[i], ServiceName: row.ServiceName}
+			bucketItems[i][row.ServiceName] = item
+		}
+		item.Revenue += row.Revenue
+		item.TotalCount += row.TotalCount
+		item.FreeCount += row.FreeCount
+		item.PaidCount += row.PaidCount
+
+		report.TotalRevenue += row.Revenue
+		report.TotalCount += row.TotalCount
+		report.FreeCount += row.FreeCount
+		report.PaidCount += row.PaidCount
+	}
+	for _, items := range bucketItems {
+		bucket := make([]*RevenueItem, 0, len(items))
+		for _, item := range items {
+			bucket = append(bucket, item)
+		}
+		sort.Slice(bucket, func(a, b int) bool { return bucket[a].ServiceName < bucket[b].ServiceName })
+		report.Items = append(report.Items, bucket...)
+	}
+	return report, nil
+}
+
+// GetRechargeReport 充值报表（按 UTC 日/月分桶，无数据的时间桶补零）
+func (uc *AnalyticsUseCase) GetRechargeReport(ctx context.Context, start, end time.Time, granularity string) (*RechargeReport, error) {
+	granularity, bounds, err := uc.reportBounds(ctx, start, end, granularity)
+	if err != nil {
+		return nil, err
+	}
+	rangeStart, rangeEnd := bounds[0], bounds[len(bounds)-1]
+
+	rows, err := uc.repo.SumRecharges(ctx, rangeStart, rangeEnd, granularity)
+	if err != nil {
+		return nil, err
+	}
+	// 充值用户数不能按时间桶累加，单独统计整个区间的去重用户数
+	totals, err := uc.repo.SumRecharges(ctx, rangeStart, rangeEnd, "")
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RechargeReport{
+		Granularity: granularity,
+		Items:       make([]*RechargeItem, len(bounds)-1),
+	}
+	for i := range report.Items {
+		report.Items[i] = &RechargeItem{PeriodStart: bounds[i]}
+	}
+	for _, row := range rows {
+		i := reportBucketIndex(bounds, row.PeriodStart)
+		if i < 0 {
+			continue
+		}
+		report.Items[i].Amount = row.Amount
+		report.Items[i].OrderCount = row.OrderCount
+		report.Items[i].UserCount = row.UserCount
+	}
+	if len(totals) > 0 {
+		report.TotalAmount = totals[0].Amount
+		report.TotalOrders = totals[0].OrderCount
+		report.TotalUsers = totals[0].UserCount
+	}
+	return report, nil
+}
+
+// GetUserActivityReport 用户活跃报表（时间范围按 UTC 日对齐）
+func (uc *AnalyticsUseCase) GetUserActivityReport(ctx context.Context, start, end time.Time, serviceName string) (*UserActivity, error) {
+	_, bounds, err := uc.reportBounds(ctx, start, end, constants.UsageGranularityDay)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := uc.repo.GetUserActivity(ctx, bounds[0], bounds[len(bounds)-1], serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if activity.ActiveUsers > 0 {
+		activity.ConversionRate = float64(activity.PayingUsers) / float64(activity.ActiveUsers)
+	}
+	return activity, nil
+}
+
+// ListTopConsumers 消费排行（时间范围按 UTC 日对齐）
+func (uc *AnalyticsUseCase) ListTopConsumers(ctx context.Context, start, end time.Time, serviceName, orderBy string, limit int) ([]*TopConsumer, error) {
+	if orderBy == "" {
+		orderBy = constants.ReportOrderByRevenue
+	}
+	if limit == 0 {
+		limit = constants.DefaultTopConsumers
+	}
+	if (orderBy != constants.ReportOrderByRevenue && orderBy != constants.ReportOrderByCount) ||
+		limit < 0 || limit > constants.MaxTopConsumers {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidReportQuery)
+	}
+	_, bounds, err := uc.reportBounds(ctx, start, end, constants.UsageGranularityDay)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListTopConsumers(ctx, bounds[0], bounds[len(bounds)-1], serviceName, orderBy, limit)
+}
+
+// GetBalanceLiability 余额负债（全部用户当前余额合计）
+func (uc *AnalyticsUseCase) GetBalanceLiability(ctx context.Context) (*BalanceLiability, error) {
+	return uc.repo.GetBalanceLiability(ctx)
+}
+
+// reportBounds 校验报表时间范围并生成 UTC 时间桶边界
+// bounds[i] 为第 i 个桶的起点，最后一个元素为结束边界；第一个桶从 start 所在桶的起点开始
+func (uc *AnalyticsUseCase) reportBounds(ctx context.Context, start, end time.Time, granularity string) (string, []time.Time, error) {
+	if granularity == "" {
+		granularity = constants.UsageGranularityDay
+	}
+	if start.IsZero() || end.IsZero() || !end.After(start) ||
+		end.Sub(start) > constants.MaxReportRangeDays*24*time.Hour ||
+		(granularity != constants.UsageGranularityDay && granularity != constants.UsageGranularityMonth) {
+		uc.log.Warnf("invalid report query: start=%v, end=%v, granularity=%s", start, end, granularity)
+		return "", nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidReportQuery)
+	}
+
+	bounds := []time.Time{truncateUsageBucket(start.UTC(), granularity)}
+	for bounds[len(bounds)-1].Before(end) {
+		bounds = append(bounds, nextUsageBucket(bounds[len(bounds)-1], granularity))
+	}
+	return granularity, bounds, nil
+}
+
+// reportBucketIndex 返回 t 所在时间桶的下标，不在范围内时返回 -1
+func reportBucketIndex(bounds []time.Time, t time.Time) int {
+	if t.Before(bounds[0]) {
+		return -1
+	}
+	i := sort.Search(len(bounds)-1, func(i int) bool { return bounds[i+1].After(t) })
+	if i >= len(bounds)-1 {
+		return -1
+	}
+	return i
+}
@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeAnalyticsRepo 返回预设的日收入与充值统计，记录查询参数
+type fakeAnalyticsRepo struct {
+	AnalyticsRepo
+	revenue   []*RevenueItem
+	recharges []*RechargeItem
+	total     *RechargeItem
+	activity  UserActivity
+	start     time.Time
+	end       time.Time
+	orderBy   string
+	limit     int
+}
+
+func (r *fakeAnalyticsRepo) ListDailyRevenue(_ context.Context, start, end time.Time, _ string, _ bool) ([]*RevenueItem, error) {
+	r.start, r.end = start, end
+	return r.revenue, nil
+}
+
+func (r *fakeAnalyticsRepo) SumRecharges(_ context.Context, start, end time.Time, granularity string) ([]*RechargeItem, error) {
+	r.start, r.end = start, end
+	if granularity == "" {
+		return []*RechargeItem{r.total}, nil
+	}
+	return r.recharges, nil
+}
+
+func (r *fakeAnalyticsRepo) GetUserActivity(_ context.Context, start, end time.Time, _ string) (*UserActivity, error) {
+	r.start, r.end = start, end
+	activity := r.activity
+	return &activity, nil
+}
+
+func (r *fakeAnalyticsRepo) ListTopConsumers(_ context.Context, start, end time.Time, _, orderBy string, limit int) ([]*TopConsumer, error) {
+	r.start, r.end, r.orderBy, r.limit = start, end, orderBy, limit
+	return nil, nil
+}
+
+func analyticsDay(month, day int) time.Time {
+	return time.Date(2025, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// TestGetRevenueReportMonthly 日数据按 UTC 月合并，无数据的月补零；查询范围扩展到整月
+func TestGetRevenueReportMonthly(t *testing.T) {
+	repo := &fakeAnalyticsRepo{revenue: []*RevenueItem{
+		{PeriodStart: analyticsDay(10, 20), Revenue: 1, TotalCount: 2, PaidCount: 1, FreeCount: 1},
+		{PeriodStart: analyticsDay(10, 31), Revenue: 2, TotalCount: 2, PaidCount: 2},
+		{PeriodStart: analyticsDay(12, 1), Revenue: 4, TotalCount: 4, PaidCount: 4},
+	}}
+	uc := NewAnalyticsUseCase(repo, log.DefaultLogger)
+
+	report, err := uc.GetRevenueReport(context.Background(), analyticsDay(10, 15), analyticsDay(12, 2), constants.UsageGranularityMonth, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !repo.start.Equal(analyticsDay(10, 1)) || !repo.end.Equal(analyticsDay(12, 1).AddDate(0, 1, 0)) {
+		t.Errorf("query = [%s, %s), want whole months", repo.start, repo.end)
+	}
+	want := []RevenueItem{
+		{PeriodStart: analyticsDay(10, 1), Revenue: 3, TotalCount: 4, FreeCount: 1, PaidCount: 3},
+		{PeriodStart: analyticsDay(11, 1)},
+		{PeriodStart: analyticsDay(12, 1), Revenue: 4, TotalCount: 4, PaidCount: 4},
+	}
+	if len(report.Items) != len(want) {
+		t.Fatalf("items = %d, want %d", len(report.Items), len(want))
+	}
+	for i, item := range report.Items {
+		if *item != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, *item, want[i])
+		}
+	}
+	if report.TotalRevenue != 7 || report.TotalCount != 8 || report.FreeCount != 1 || report.PaidCount != 7 {
+		t.Errorf("totals = %+v", report)
+	}
+}
+
+// TestGetRevenueReportByService 按服务拆分时同一时间桶内按服务名排序，不补零
+func TestGetRevenueReportByService(t *testing.T) {
+	repo := &fakeAnalyticsRepo{revenue: []*RevenueItem{
+		{PeriodStart: analyticsDay(11, 1), ServiceName: "passport", Revenue: 1},
+		{PeriodStart: analyticsDay(11, 1), ServiceName: "asset", Revenue: 2},
+		{PeriodStart: analyticsDay(11, 3), ServiceName: "passport", Revenue: 4},
+	}}
+	uc := NewAnalyticsUseCase(repo, log.DefaultLogger)
+
+	report, err := uc.GetRevenueReport(context.Background(), analyticsDay(11, 1), analyticsDay(11, 4), "", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Granularity != constants.UsageGranularityDay || len(report.Items) != 3 {
+		t.Fatalf("report = %s with %d items, want day with 3", report.Granularity, len(report.Items))
+	}
+	for i, want := range []RevenueItem{
+		{PeriodStart: analyticsDay(11, 1), ServiceName: "asset", Revenue: 2},
+		{PeriodStart: analyticsDay(11, 1), ServiceName: "passport", Revenue: 1},
+		{PeriodStart: analyticsDay(11, 3), ServiceName: "passport", Revenue: 4},
+	} {
+		if *report.Items[i] != want {
+			t.Errorf("item %d = %+v, want %+v", i, *report.Items[i], want)
+		}
+	}
+}
+
+// TestGetRechargeReport 时间桶补零，合计的充值用户数取整个区间的去重统计而不是各桶累加
+func TestGetRechargeReport(t *testing.T) {
+	repo := &fakeAnalyticsRepo{
+		recharges: []*RechargeItem{
+			{PeriodStart: analyticsDay(11, 1), Amount: 100, OrderCount: 2, UserCount: 2},
+			{PeriodStart: analyticsDay(11, 3), Amount: 50, OrderCount: 1, UserCount: 1},
+		},
+		total: &RechargeItem{Amount: 150, OrderCount: 3, UserCount: 2},
+	}
+	uc := NewAnalyticsUseCase(repo, log.DefaultLogger)
+
+	report, err := uc.GetRechargeReport(context.Background(), analyticsDay(11, 1), analyticsDay(11, 4), constants.UsageGranularityDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 3 || report.Items[1].Amount != 0 || !report.Items[1].PeriodStart.Equal(analyticsDay(11, 2)) || report.Items[2].Amount != 50 {
+		t.Errorf("items = %+v, %+v, %+v", *report.Items[0], *report.Items[1], *report.Items[2])
+	}
+	if report.TotalAmount != 150 || report.TotalOrders != 3 || report.TotalUsers != 2 {
+		t.Errorf("totals = %v / %d / %d, want 150 / 3 / 2", report.TotalAmount, report.TotalOrders, report.TotalUsers)
+	}
+}
+
+// TestGetUserActivityReport 转化率为付费用户占活跃用户的比例，无活跃用户时为 0；时间范围按 UTC 日对齐
+func TestGetUserActivityReport(t *testing.T) {
+	repo := &fakeAnalyticsRepo{activity: UserActivity{ActiveUsers: 4, PayingUsers: 1, NewPayingUsers: 1}}
+	uc := NewAnalyticsUseCase(repo, log.DefaultLogger)
+
+	activity, err := uc.GetUserActivityReport(context.Background(), analyticsDay(11, 1).Add(10*time.Hour), analyticsDay(11, 2).Add(time.Hour), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if activity.ConversionRate != 0.25 {
+		t.Errorf("conversion rate = %v, want 0.25", activity.ConversionRate)
+	}
+	if !repo.start.Equal(analyticsDay(11, 1)) || !repo.end.Equal(analyticsDay(11, 3)) {
+		t.Errorf("query = [%s, %s), want whole days", repo.start, repo.end)
+	}
+
+	repo.activity = UserActivity{}
+	if activity, err = uc.GetUserActivityReport(context.Background(), analyticsDay(11, 1), analyticsDay(11, 2), ""); err != nil || activity.ConversionRate != 0 {
+		t.Errorf("no active users: activity = %+v, err = %v", activity, err)
+	}
+}
+
+// TestListTopConsumers 默认按收入取前 DefaultTopConsumers 个；排序字段或条数无效、时间范围过大时拒绝查询
+func TestListTopConsumers(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeAnalyticsRepo{}
+	uc := NewAnalyticsUseCase(repo, log.DefaultLogger)
+	start := analyticsDay(11, 1)
+
+	if _, err := uc.ListTopConsumers(ctx, start, start.AddDate(0, 0, 7), "", "", 0); err != nil {
+		t.Fatal(err)
+	}
+	if repo.orderBy != constants.ReportOrderByRevenue || repo.limit != constants.DefaultTopConsumers {
+		t.Errorf("defaults = %s / %d", repo.orderBy, repo.limit)
+	}
+
+	cases := []struct {
+		name    string
+		end     time.Time
+		orderBy string
+		limit   int
+	}{
+		{"unknown order", start.AddDate(0, 0, 1), "amount", 10},
+		{"negative limit", start.AddDate(0, 0, 1), constants.ReportOrderByCount, -1},
+		{"limit above max", start.AddDate(0, 0, 1), constants.ReportOrderByCount, constants.MaxTopConsumers + 1},
+		{"range too long", start.AddDate(0, 0, constants.MaxReportRangeDays+1), constants.ReportOrderByCount, 10},
+		{"empty range", start, constants.ReportOrderByCount, 10},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := uc.ListTopConsumers(ctx, start, tc.end, "", tc.orderBy, tc.limit)
+			assertErrCode(t, err, billingErrors.ErrCodeInvalidReportQuery)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// QuotaItem 批量检查/扣费的单个服务项
+type QuotaItem struct {
+	ServiceName string
+	Count       int     // 用量（按服务计量单位）
+	Unit        string  // 调用方声明的单位，可选
+	CallerCost  float64 // 调用方预计算的费用，可选（仅扣费时生效）
+}
+
+// validateQuotaItems 校验批量检查/扣费请求
+func (uc *BillingUseCase) validateQuotaItems(ctx context.Context, userID string, items []*QuotaItem) error {
+	if userID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if len(items) == 0 || len(items) > constants.MaxQuotaItems {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidQuotaItems)
+	}
+	for _, item := range items {
+		if item.ServiceName == "" {
+			return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+		}
+		if item.Count <= 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidQuotaItems)
+		}
+		if _, ok := uc.conf.Prices[item.ServiceName]; !ok {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+		}
+		if err := uc.checkUnit(ctx, item.ServiceName, item.Unit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchCheckQuota 批量检查配额：先检查各服务的请求频率限制，再由各服务先用扣费账户各自的免费额度和用量包，
+// 不足部分合计后与余额比较，全部可扣费时才放行。依赖故障时拒绝（批量扣费不支持降级放行）
+func (uc *BillingUseCase) BatchCheckQuota(ctx context.Context, userID string, items []*QuotaItem) (*QuotaCheck, error) {
+	if err := uc.validateQuotaItems(ctx, userID, items); err != nil {
+		return nil, err
+	}
+
+	// 同一服务的多个服务项合并计算
+	var services []string
+	counts := make(map[string]int)
+	for _, item := range items {
+		if _, ok := counts[item.ServiceName]; !ok {
+			services = append(services, item.ServiceName)
+		}
+		counts[item.ServiceName] += item.Count
+	}
+
+	// 每个服务计入一次请求，任一服务被限流时整批拒绝（不计入）
+	if limited := uc.checkRateLimit(ctx, userID, services); limited != nil {
+		return limited, nil
+	}
+	payer, err := uc.orgUseCase.Payer(ctx, userID)
+	if err != nil {
+		allowed, reason, err := uc.batchCheckFailed(ctx, userID, services, err)
+		if err != nil {
+			return nil, err
+		}
+		return &QuotaCheck{Allowed: allowed, Reason: reason}, nil
+	}
+	allowed, reason, err := uc.batchCheckQuota(ctx, payer, services, counts)
+	if err != nil {
+		return nil, err
+	}
+	return &QuotaCheck{Allowed: allowed, Reason: reason}, nil
+}
+
+// batchCheckQuota 检查合并后各服务的免费额度、用量包、硬性预算、成员消费上限与余额
+func (uc *BillingUseCase) batchCheckQuota(ctx context.Context, payer Payer, services []string, counts map[string]int) (bool, string, error) {
+	userID := payer.AccountID
+	now := time.Now()
+
+	// 1. 计算免费额度与用量包不足部分需要的余额（各服务按各自的额度周期）
+	var needed float64
+	var packageUsed bool
+	charges := make(map[string]float64, len(services))
+	for _, serviceName := range services {
+		period, err := uc.periodUseCase.Period(ctx, userID, serviceName, now)
+		if err != nil {
+			return uc.batchCheckFailed(ctx, userID, services, err)
+		}
+		quota, err := uc.getOrCreateQuota(ctx, userID, serviceName, period)
+		if err != nil {
+			return uc.batchCheckFailed(ctx, userID, services, err)
+		}
+		// 如果配额记录不存在且无法创建，说明配置中没有该服务
+		if quota == nil {
+			return false, "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+		}
+		remaining := quota.TotalQuota - quota.UsedQuota
+		uc.degradation.RememberQuota(userID, serviceName, period.Key, remaining)
+		paidCount := counts[serviceName] - max(remaining, 0)
+		if paidCount <= 0 {
+			continue
+		}
+		packageRemaining, err := uc.packageUseCase.Remaining(ctx, userID, serviceName)
+		if err != nil {
+			return uc.batchCheckFailed(ctx, userID, services, err)
+		}
+		if packageRemaining > 0 {
+			packageUsed = true
+			paidCount -= int(min(packageRemaining, int64(paidCount)))
+		}
+		if paidCount > 0 {
+			rate, err := uc.ratingUseCase.Rate(ctx, userID, serviceName, now)
+			if err != nil {
+				return uc.batchCheckFailed(ctx, userID, services, err)
+			}
+			charges[serviceName] = float64(paidCount) * rate.UnitPrice
+			needed += charges[serviceName]
+		}
+	}
+	if needed == 0 {
+		uc.recordBatchCheck(services, constants.QuotaCheckResultAllowed)
+		if packageUsed {
+			return true, constants.BillingMessagePackage, nil
+		}
+		return true, constants.BillingMessageFree, nil
+	}
+
+	// 2. 检查余额
+	balance, err := uc.userBalanceUseCase.GetBalance(ctx, userID)
+	if err != nil {
+		return uc.batchCheckFailed(ctx, userID, services, err)
+	}
+	if balance == nil {
+		balance = &UserBalance{UID: userID, Balance: 0}
+	}
+	uc.degradation.RememberBalance(userID, balance.Balance)
+
+	// 3. 检查硬性消费预算
+	exceeded, err := uc.budgetUseCase.ExceedsHardLimit(ctx, userID, now, charges)
+	if err != nil {
+		return uc.batchCheckFailed(ctx, userID, services, err)
+	}
+	if exceeded {
+		uc.recordBatchCheck(services, constants.QuotaCheckResultDenied)
+		return false, constants.BillingMessageBudgetExceeded, nil
+	}
+
+	// 4. 检查组织成员每月消费上限
+	exceeded, err = uc.orgUseCase.ExceedsSpendLimit(ctx, payer, now, needed)
+	if err != nil {
+		return uc.batchCheckFailed(ctx, userID, services, err)
+	}
+	if exceeded {
+		uc.recordBatchCheck(services, constants.QuotaCheckResultDenied)
+		return false, constants.BillingMessageMemberLimitExceeded, nil
+	}
+
+	if balance.Balance >= needed {
+		uc.recordBatchCheck(services, constants.QuotaCheckResultAllowed)
+		return true, constants.BillingMessageBalance, nil
+	}
+	uc.recordBatchCheck(services, constants.QuotaCheckResultDenied)
+	return false, constants.BillingMessageInsufficientBalance, nil
+}
+
+// batchCheckFailed 批量检查读取失败：依赖故障时拒绝，其他错误直接返回
+func (uc *BillingUseCase) batchCheckFailed(ctx context.Context, userID string, services []string, err error) (bool, string, error) {
+	if IsDependencyError(err) {
+		uc.log.Warnf("BatchCheckQuota degraded: user_id=%s, services=%v, error=%v", userID, services, err)
+		uc.recordBatchCheck(services, constants.QuotaCheckResultDenied)
+		return false, constants.BillingMessageDegraded, nil
+	}
+	uc.recordBatchCheck(services, constants.QuotaCheckResultError)
+	return false, "", err
+}
+
+func (uc *BillingUseCase) recordBatchCheck(services []string, result string) {
+	if uc.metrics == nil {
+		return
+	}
+	for _, serviceName := range services {
+		uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, result).Inc()
+	}
+}
+
+// BatchDeductQuota 批量扣费：同一用户的多个服务项在扣费账户的一个 DB 事务中扣费，要么全部成功要么全部失败
+// 返回的记录ID与 items 一一对应，meta 适用于所有服务项。延迟扣费按单条结算，无法保证原子性，因此依赖故障时不降级放行
+// 与 DeductQuota 一致不检查频率限制：调用方先 BatchCheckQuota（已计入请求）再扣费，扣费时再计入会重复计数，
+// 且此时调用已经发生，拒绝扣费只会漏计费
+func (uc *BillingUseCase) BatchDeductQuota(ctx context.Context, userID string, items []*QuotaItem, meta *DeductMetadata) ([]string, error) {
+	startTime := time.Now()
+	if err := uc.validateQuotaItems(ctx, userID, items); err != nil {
+		return nil, err
+	}
+	if err := validateDeductMetadata(ctx, meta); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	payer, err := uc.orgUseCase.Payer(ctx, userID)
+	if IsDependencyError(err) {
+		uc.log.Warnf("BatchDeductQuota degraded: user_id=%s, error=%v", userID, err)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductDegraded)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := make([]*DeductRequest, len(items))
+	for i, item := range items {
+		cost, err := uc.resolveCost(ctx, payer.AccountID, item.ServiceName, Usage{Quantity: item.Count, Unit: item.Unit, CallerCost: item.CallerCost}, now)
+		var periods DeductPeriods
+		if err == nil {
+			periods, err = uc.periodUseCase.DeductPeriods(ctx, payer.AccountID, item.ServiceName, now)
+		}
+		if IsDependencyError(err) {
+			uc.log.Warnf("BatchDeductQuota degraded: user_id=%s, error=%v", userID, err)
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductDegraded)
+		}
+		if err != nil {
+			return nil, err
+		}
+		reqs[i] = &DeductRequest{
+			UserID:      payer.AccountID,
+			MemberID:    payer.MemberID,
+			SpendLimit:  payer.SpendLimit,
+			ServiceName: item.ServiceName,
+			Count:       item.Count,
+			Cost:        cost,
+			Period:      periods.Quota.Key,
+			BudgetMonth: periods.BudgetMonth,
+			Metadata:    meta,
+		}
+	}
+
+	recordIDs, err := uc.repo.DeductQuotaAtomic(ctx, payer.AccountID, reqs)
+	if IsDependencyError(err) {
+		uc.log.Warnf("BatchDeductQuota degraded: user_id=%s, error=%v", userID, err)
+		err = pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductDegraded)
+	}
+
+	for _, req := range reqs {
+		uc.recordDeduct(req.ServiceName, constants.DeductTypeBatch, req.Cost, startTime, err)
+		uc.recordBudgetDenied(err, req.ServiceName)
+	}
+	return recordIDs, err
+}
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"billing-service/internal/constants"
@@ -10,6 +11,7 @@ import (
 	"billing-service/internal/metrics"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -21,17 +23,27 @@ type BillingRepo interface {
 	Recharge(ctx context.Context, userID string, amount float64) error
 
 	// 配额相关
-	GetFreeQuota(ctx context.Context, userID, serviceName, month string) (*FreeQuota, error)
+	GetFreeQuota(ctx context.Context, userID, serviceName, period string) (*FreeQuota, error)
 	CreateFreeQuota(ctx context.Context, quota *FreeQuota) error
 	UpdateFreeQuota(ctx context.Context, quota *FreeQuota) error
 
 	// 记录相关
 	CreateBillingRecord(ctx context.Context, record *BillingRecord) error
-	ListBillingRecords(ctx context.Context, userID string, page, pageSize int) ([]*BillingRecord, int64, error)
+	ListBillingRecords(ctx context.Context, userID string, filter *RecordFilter, after *RecordCursor, offset, limit int, withTotal bool) ([]*BillingRecord, int64, error)
 
 	// 事务操作
-	DeductQuota(ctx context.Context, userID, serviceName string, count int, cost float64, month string) (string, error)
+	// payer.MemberID 为组织账户中实际使用的成员（记录在消费记录中），payer.SpendLimit 为其每月消费上限，与硬性预算一同在扣减余额时检查
+	DeductQuota(ctx context.Context, payer Payer, serviceName string, count int, cost float64, period string, month BillingPeriod, meta *DeductMetadata) (string, error)
 	BatchDeductQuota(ctx context.Context, events []*DeductEvent) error
+	// DecodeDeductEvents 解析消息队列中的扣费事件（Consumer调用），contentType 为消息的 content type 属性
+	// 原子批量扣费的一条消息包含多个事件，需在同一批次中落库
+	DecodeDeductEvents(body []byte, contentType string) ([]*DeductEvent, error)
+	// DeadLetterDeductMessage 将无法解析或重试耗尽的扣费消息转入死信 topic（Consumer调用），reason 为失败原因
+	DeadLetterDeductMessage(ctx context.Context, body []byte, contentType, reason string) error
+	// DeductQuotaBatch 批量扣费（流式扣费），结果与 reqs 一一对应
+	DeductQuotaBatch(ctx context.Context, reqs []*DeductRequest) []*DeductResult
+	// DeductQuotaAtomic 同一用户多个服务项的原子扣费（一个事务），记录ID与 reqs 一一对应
+	DeductQuotaAtomic(ctx context.Context, userID string, reqs []*DeductRequest) ([]string, error)
 
 	// 订单相关（幂等性保证）
 	CreateRechargeOrder(ctx context.Context, orderID, userID string, amount float64) error
@@ -44,9 +56,8 @@ type BillingRepo interface {
 	GetAllUserIDs(ctx context.Context) ([]string, error)
 
 	// 统计相关
-	GetStatsToday(ctx context.Context, userID, serviceName string) (*Stats, error)
-	GetStatsMonth(ctx context.Context, userID, serviceName string) (*Stats, error)
-	GetStatsSummary(ctx context.Context, userID string) (*StatsSummary, error)
+	GetStats(ctx context.Context, userID, memberID, serviceName string, start, end time.Time) (*Stats, error)
+	GetStatsSummary(ctx context.Context, userID, memberID string, start, end time.Time) (*StatsSummary, error)
 }
 
 // BillingUseCase 计费业务逻辑（组合 UseCase）
@@ -57,6 +68,16 @@ type BillingUseCase struct {
 	billingRecordUseCase *BillingRecordUseCase
 	rechargeOrderUseCase *RechargeOrderUseCase
 	statsUseCase         *StatsUseCase
+	degradation          *DegradationGuard
+	leaseUseCase         *LeaseUseCase
+	exportUseCase        *ExportUseCase
+	analyticsUseCase     *AnalyticsUseCase
+	budgetUseCase        *BudgetUseCase
+	rateLimitUseCase     *RateLimitUseCase
+	periodUseCase        *PeriodUseCase
+	packageUseCase       *PackageUseCase
+	ratingUseCase        *RatingUseCase
+	orgUseCase           *OrgUseCase
 
 	repo    BillingRepo // 用于跨领域事务
 	conf    *BillingConfig
@@ -71,6 +92,16 @@ func NewBillingUseCase(
 	billingRecordUseCase *BillingRecordUseCase,
 	rechargeOrderUseCase *RechargeOrderUseCase,
 	statsUseCase *StatsUseCase,
+	degradation *DegradationGuard,
+	leaseUseCase *LeaseUseCase,
+	exportUseCase *ExportUseCase,
+	analyticsUseCase *AnalyticsUseCase,
+	budgetUseCase *BudgetUseCase,
+	rateLimitUseCase *RateLimitUseCase,
+	periodUseCase *PeriodUseCase,
+	packageUseCase *PackageUseCase,
+	ratingUseCase *RatingUseCase,
+	orgUseCase *OrgUseCase,
 	repo BillingRepo,
 	conf *BillingConfig,
 	logger log.Logger,
@@ -81,6 +112,16 @@ func NewBillingUseCase(
 		billingRecordUseCase: billingRecordUseCase,
 		rechargeOrderUseCase: rechargeOrderUseCase,
 		statsUseCase:         statsUseCase,
+		degradation:          degradation,
+		leaseUseCase:         leaseUseCase,
+		exportUseCase:        exportUseCase,
+		analyticsUseCase:     analyticsUseCase,
+		budgetUseCase:        budgetUseCase,
+		rateLimitUseCase:     rateLimitUseCase,
+		periodUseCase:        periodUseCase,
+		packageUseCase:       packageUseCase,
+		ratingUseCase:        ratingUseCase,
+		orgUseCase:           orgUseCase,
 		repo:                 repo,
 		conf:                 conf,
 		log:                  log.NewHelper(logger),
@@ -89,10 +130,10 @@ func NewBillingUseCase(
 }
 
 // getOrCreateQuota 获取或创建配额记录（如果不存在则创建）
-// 用于确保用户在当前月份有配额记录
-func (uc *BillingUseCase) getOrCreateQuota(ctx context.Context, userID, serviceName, month string) (*FreeQuota, error) {
+// 额度记录在周期内首次访问时创建，用于确保用户在当前周期有配额记录
+func (uc *BillingUseCase) getOrCreateQuota(ctx context.Context, userID, serviceName string, period BillingPeriod) (*FreeQuota, error) {
 	// 先尝试获取配额记录
-	quota, err := uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, month)
+	quota, err := uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, period.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -102,30 +143,22 @@ func (uc *BillingUseCase) getOrCreateQuota(ctx context.Context, userID, serviceN
 		return quota, nil
 	}
 
-	// 记录不存在，检查配置中是否有该服务
-	totalQuota, ok := uc.conf.FreeQuotas[serviceName]
-	if !ok {
-		// 配置中没有该服务，返回 nil（不创建记录）
-		return nil, nil
+	// 记录不存在，按配置创建（配置中没有该服务时返回 nil，不创建记录）
+	quota, err = uc.newQuota(ctx, userID, serviceName, period)
+	if err != nil || quota == nil {
+		return nil, err
 	}
 
-	// 创建并保存配额记录
-	quota = &FreeQuota{
-		UID:         userID,
-		ServiceName: serviceName,
-		TotalQuota:  int(totalQuota),
-		UsedQuota:   0,
-		ResetMonth:  month,
-	}
+	// 保存配额记录
 	if err := uc.freeQuotaUseCase.CreateQuota(ctx, quota); err != nil {
 		// 创建失败可能是并发导致的重复创建，尝试重新获取
-		quota, err = uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, month)
+		quota, err = uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, period.Key)
 		if err != nil {
 			return nil, err
 		}
 		if quota == nil {
 			// 重新获取后仍然为 nil，说明创建失败且无法获取
-			uc.log.Warnf("Failed to create/get quota for user=%s, service=%s, month=%s", userID, serviceName, month)
+			uc.log.Warnf("Failed to create/get quota for user=%s, service=%s, period=%s", userID, serviceName, period.Key)
 			return nil, nil
 		}
 	}
@@ -133,6 +166,53 @@ func (uc *BillingUseCase) getOrCreateQuota(ctx context.Context, userID, serviceN
 	return quota, nil
 }
 
+// newQuota 构造周期的免费额度记录（未保存），配置中没有该服务时返回 nil
+// 组织账户的额度按创建时的成员数汇总为共享额度，周期内成员变动不调整；
+// 用户套餐配置了结转时，上一个周期发放部分的未用额度按比例结转到本周期，
+// 上一个周期的记录不存在（未使用过或周期类型已变更）时不结转
+func (uc *BillingUseCase) newQuota(ctx context.Context, userID, serviceName string, period BillingPeriod) (*FreeQuota, error) {
+	totalQuota, ok := uc.conf.FreeQuotas[serviceName]
+	if !ok {
+		return nil, nil
+	}
+	members, err := uc.orgUseCase.PooledQuotaMultiplier(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	quota := &FreeQuota{
+		UID:         userID,
+		ServiceName: serviceName,
+		TotalQuota:  int(totalQuota) * members,
+		UsedQuota:   0,
+		Period:      period.Key,
+		Cycle:       period.Cycle,
+		PeriodStart: period.Start,
+		PeriodEnd:   period.End,
+	}
+
+	percent, err := uc.periodUseCase.RolloverPercent(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if percent <= 0 {
+		return quota, nil
+	}
+	previous, err := uc.periodUseCase.Period(ctx, userID, serviceName, period.Start.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	// 已用额度包含尚未落库的在途扣费
+	last, err := uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, previous.Key)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		quota.RolloverQuota = last.RolloverEligible(percent)
+		quota.TotalQuota += quota.RolloverQuota
+	}
+	return quota, nil
+}
+
 // GetAccount 获取账户信息（组合多个领域）
 func (uc *BillingUseCase) GetAccount(ctx context.Context, userID string) (*UserBalance, []*FreeQuota, error) {
 	if userID == "" {
@@ -148,27 +228,85 @@ func (uc *BillingUseCase) GetAccount(ctx context.Context, userID string) (*UserB
 	if balance == nil {
 		balance = &UserBalance{UID: userID, Balance: 0}
 	}
+	uc.degradation.RememberBalance(userID, balance.Balance)
 
-	month := time.Now().Format(constants.TimeFormatMonth)
+	now := time.Now()
 	var quotas []*FreeQuota
 	for service := range uc.conf.FreeQuotas {
-		q, err := uc.getOrCreateQuota(ctx, userID, service, month)
+		period, err := uc.periodUseCase.Period(ctx, userID, service, now)
 		if err != nil {
-			uc.log.Warnf("Failed to get or create quota for user=%s, service=%s, month=%s: %v", userID, service, month, err)
+			uc.log.Warnf("Failed to get quota period for user=%s, service=%s: %v", userID, service, err)
+			continue
+		}
+		q, err := uc.getOrCreateQuota(ctx, userID, service, period)
+		if err != nil {
+			uc.log.Warnf("Failed to get or create quota for user=%s, service=%s, period=%s: %v", userID, service, period.Key, err)
 			continue // 忽略错误，继续处理其他服务
 		}
 		if q == nil {
 			// 配置中没有该服务或创建失败，跳过
 			continue
 		}
+		uc.degradation.RememberQuota(userID, service, period.Key, q.TotalQuota-q.UsedQuota)
 		quotas = append(quotas, q)
 	}
 
 	return balance, quotas, nil
 }
 
-// CheckQuota 检查配额（跨领域逻辑）
-func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName string, count int) (bool, string, error) {
+// QuotaCheck 配额检查结果
+type QuotaCheck struct {
+	Allowed    bool
+	Reason     string
+	RetryAfter time.Duration // 被限流时建议的重试等待时间
+}
+
+// CheckQuota 检查配额：先检查请求频率限制（每次检查计入一次请求，按用户本人），
+// 再检查扣费账户（组织成员为组织）的免费额度、用量包、预算、成员消费上限与余额
+func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName string, count int) (*QuotaCheck, error) {
+	if limited := uc.checkRateLimit(ctx, userID, []string{serviceName}); limited != nil {
+		return limited, nil
+	}
+	payer, err := uc.orgUseCase.Payer(ctx, userID)
+	if IsDependencyError(err) {
+		allowed, reason, err := uc.checkQuotaDegraded(ctx, userID, serviceName, "", count, err)
+		if err != nil {
+			return nil, err
+		}
+		return &QuotaCheck{Allowed: allowed, Reason: reason}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowed, reason, err := uc.checkQuota(ctx, payer, serviceName, count)
+	if err != nil {
+		return nil, err
+	}
+	return &QuotaCheck{Allowed: allowed, Reason: reason}, nil
+}
+
+// checkRateLimit 检查请求频率限制，被限流时返回拒绝结果
+// 每日限额按账户时区的自然日计算，读取账户时区失败时按服务器时区计算
+func (uc *BillingUseCase) checkRateLimit(ctx context.Context, userID string, services []string) *QuotaCheck {
+	now := time.Now()
+	if loc, err := uc.periodUseCase.Location(ctx, userID); err != nil {
+		uc.log.Warnf("Get account timezone failed, rate limit by server timezone: user_id=%s, error=%v", userID, err)
+	} else {
+		now = now.In(loc)
+	}
+	decision := uc.rateLimitUseCase.Check(ctx, userID, services, now)
+	if decision.Allowed {
+		return nil
+	}
+	if uc.metrics != nil {
+		uc.metrics.QuotaCheckTotal.WithLabelValues(decision.ServiceName, constants.QuotaCheckResultDenied).Inc()
+	}
+	return &QuotaCheck{Reason: constants.BillingMessageRateLimited, RetryAfter: decision.RetryAfter}
+}
+
+// checkQuota 检查免费额度、用量包、硬性预算、成员消费上限与余额（跨领域逻辑）
+func (uc *BillingUseCase) checkQuota(ctx context.Context, payer Payer, serviceName string, count int) (bool, string, error) {
+	userID := payer.AccountID
 	startTime := time.Now()
 	defer func() {
 		// 记录配额检查耗时
@@ -177,11 +315,18 @@ func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName st
 		}
 	}()
 
-	month := time.Now().Format(constants.TimeFormatMonth)
+	now := time.Now()
 
-	// 1. 检查免费额度（如果不存在则自动创建）
-	quota, err := uc.getOrCreateQuota(ctx, userID, serviceName, month)
+	// 1. 检查当前周期的免费额度（如果不存在则自动创建）
+	var quota *FreeQuota
+	period, err := uc.periodUseCase.Period(ctx, userID, serviceName, now)
+	if err == nil {
+		quota, err = uc.getOrCreateQuota(ctx, userID, serviceName, period)
+	}
 	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
 		if uc.metrics != nil {
 			uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, constants.QuotaCheckResultError).Inc()
 		}
@@ -192,6 +337,7 @@ func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName st
 	if quota == nil {
 		return false, "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
 	}
+	uc.degradation.RememberQuota(userID, serviceName, period.Key, quota.TotalQuota-quota.UsedQuota)
 
 	// 检查免费额度是否充足
 	if quota.TotalQuota-quota.UsedQuota >= count {
@@ -209,9 +355,29 @@ func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName st
 		return true, constants.BillingMessageFree, nil
 	}
 
-	// 2. 检查余额
+	// 2. 免费额度不足部分先用用量包
+	paidCount := count - max(quota.TotalQuota-quota.UsedQuota, 0)
+	packageRemaining, err := uc.packageUseCase.Remaining(ctx, userID, serviceName)
+	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
+		return false, "", err
+	}
+	paidCount -= int(min(packageRemaining, int64(paidCount)))
+	if paidCount == 0 {
+		if uc.metrics != nil {
+			uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, constants.QuotaCheckResultAllowed).Inc()
+		}
+		return true, constants.BillingMessagePackage, nil
+	}
+
+	// 3. 检查余额
 	balance, err := uc.userBalanceUseCase.GetBalance(ctx, userID)
 	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
 		return false, "", err
 	}
 
@@ -224,13 +390,49 @@ func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName st
 		// 注意：这里不创建记录，只是用于检查
 		// 实际创建会在 DeductQuota 或 Recharge 时进行
 	}
+	uc.degradation.RememberBalance(userID, balance.Balance)
 
-	price, ok := uc.conf.Prices[serviceName]
-	if !ok {
-		return false, "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+	// 免费额度与用量包都不足的部分按账户适用单价扣余额
+	rate, err := uc.ratingUseCase.Rate(ctx, userID, serviceName, now)
+	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
+		return false, "", err
+	}
+	cost := rate.UnitPrice * float64(paidCount)
+
+	// 4. 检查硬性消费预算
+	// 消费预算按自然月统计，与免费额度周期无关
+	exceeded, err := uc.budgetUseCase.ExceedsHardLimit(ctx, userID, now, map[string]float64{serviceName: cost})
+	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
+		return false, "", err
+	}
+	if exceeded {
+		if uc.metrics != nil {
+			uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, constants.QuotaCheckResultDenied).Inc()
+		}
+		return false, constants.BillingMessageBudgetExceeded, nil
+	}
+
+	// 5. 检查组织成员每月消费上限
+	exceeded, err = uc.orgUseCase.ExceedsSpendLimit(ctx, payer, now, cost)
+	if err != nil {
+		if IsDependencyError(err) {
+			return uc.checkQuotaDegraded(ctx, userID, serviceName, period.Key, count, err)
+		}
+		return false, "", err
+	}
+	if exceeded {
+		if uc.metrics != nil {
+			uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, constants.QuotaCheckResultDenied).Inc()
+		}
+		return false, constants.BillingMessageMemberLimitExceeded, nil
 	}
 
-	cost := price * float64(count)
 	if balance.Balance >= cost {
 		// 记录配额检查成功（使用余额）
 		if uc.metrics != nil {
@@ -254,39 +456,105 @@ func (uc *BillingUseCase) CheckQuota(ctx context.Context, userID, serviceName st
 }
 
 // DeductQuota 扣减配额（跨领域事务）
-func (uc *BillingUseCase) DeductQuota(ctx context.Context, userID, serviceName string, count int) (string, error) {
+// usage.Quantity 按服务计量单位计算，同样从免费额度中扣减；meta 为可选的扣费来源信息
+// 组织成员的用量从组织账户扣费，消费记录保留实际使用的成员
+func (uc *BillingUseCase) DeductQuota(ctx context.Context, userID, serviceName string, usage Usage, meta *DeductMetadata) (string, error) {
 	startTime := time.Now()
-	price := uc.conf.Prices[serviceName]
-	cost := price * float64(count)
-	month := time.Now().Format(constants.TimeFormatMonth)
-
-	recordID, err := uc.repo.DeductQuota(ctx, userID, serviceName, count, cost, month)
+	if err := validateDeductMetadata(ctx, meta); err != nil {
+		return "", err
+	}
+	payer, err := uc.orgUseCase.Payer(ctx, userID)
+	if IsDependencyError(err) {
+		recordID, err := uc.deductUnresolvedDegraded(ctx, userID, serviceName, usage, meta, err)
+		uc.recordDeduct(serviceName, constants.DeductTypeDeferred, 0, startTime, err)
+		return recordID, err
+	}
+	if err != nil {
+		return "", err
+	}
+	count := usage.Quantity
+	cost, err := uc.resolveCost(ctx, payer.AccountID, serviceName, usage, startTime)
+	if IsDependencyError(err) {
+		recordID, err := uc.deductUnratedDegraded(ctx, payer, serviceName, usage, meta, err)
+		uc.recordDeduct(serviceName, constants.DeductTypeDeferred, 0, startTime, err)
+		return recordID, err
+	}
+	if err != nil {
+		return "", err
+	}
 
-	// 记录扣费指标
-	if uc.metrics != nil {
-		duration := time.Since(startTime).Seconds()
-		uc.metrics.DeductQuotaDuration.WithLabelValues(serviceName).Observe(duration)
-
-		if err == nil {
-			// 根据扣费类型记录（这里简化处理，实际应该从 repo 返回扣费类型）
-			// 由于 DeductQuota 返回的是 recordID，我们需要推断扣费类型
-			// 为了简化，这里先记录为 "mixed"，实际应该从业务逻辑中获取
-			uc.metrics.DeductQuotaTotal.WithLabelValues(serviceName, constants.DeductTypeMixed).Inc()
-			uc.metrics.DeductQuotaAmount.WithLabelValues(serviceName, constants.BillingTypeBalance).Add(cost)
-		}
+	deductType := constants.DeductTypeMixed
+	var recordID string
+	periods, err := uc.periodUseCase.DeductPeriods(ctx, payer.AccountID, serviceName, startTime)
+	if err == nil {
+		recordID, err = uc.repo.DeductQuota(ctx, payer, serviceName, count, cost, periods.Quota.Key, periods.BudgetMonth, meta)
+	}
+	if IsDependencyError(err) {
+		// 周期未确定时（如读取账户设置失败）由结算时按扣费时间重新计算
+		deductType = constants.DeductTypeDeferred
+		recordID, err = uc.deductQuotaDegraded(ctx, payer, serviceName, periods.Quota.Key, count, cost, meta, err)
 	}
 
+	uc.recordDeduct(serviceName, deductType, cost, startTime, err)
+	uc.recordBudgetDenied(err, serviceName)
+
 	return recordID, err
 }
 
-// ListRecords 获取消费记录
-func (uc *BillingUseCase) ListRecords(ctx context.Context, userID string, page, pageSize int) ([]*BillingRecord, int64, error) {
-	return uc.billingRecordUseCase.ListRecords(ctx, userID, page, pageSize)
+// recordBudgetDenied 记录超出硬性预算被拒绝的扣费
+func (uc *BillingUseCase) recordBudgetDenied(err error, serviceNames ...string) {
+	if uc.metrics == nil || kratosErrors.FromError(err).Code != billingErrors.ErrCodeBudgetExceeded {
+		return
+	}
+	for _, serviceName := range serviceNames {
+		uc.metrics.BudgetDeniedTotal.WithLabelValues(serviceName, constants.BudgetOperationDeduct).Inc()
+	}
+}
+
+// recordDeduct 记录扣费指标
+func (uc *BillingUseCase) recordDeduct(serviceName, deductType string, cost float64, startTime time.Time, err error) {
+	if uc.metrics == nil {
+		return
+	}
+	duration := time.Since(startTime).Seconds()
+	uc.metrics.DeductQuotaDuration.WithLabelValues(serviceName).Observe(duration)
+
+	if err == nil {
+		// 根据扣费类型记录（这里简化处理，实际应该从 repo 返回扣费类型）
+		// 由于 DeductQuota 返回的是 recordID，我们需要推断扣费类型
+		// 为了简化，这里先记录为 "mixed"，实际应该从业务逻辑中获取
+		uc.metrics.DeductQuotaTotal.WithLabelValues(serviceName, deductType).Inc()
+		uc.metrics.DeductQuotaAmount.WithLabelValues(serviceName, constants.BillingTypeBalance).Add(cost)
+	}
+}
+
+// ListRecords 获取查询范围内的消费记录，按成员查询时只返回该成员的记录
+// requestID 非空时返回该请求产生的消费记录（忽略分页和过滤条件）
+func (uc *BillingUseCase) ListRecords(ctx context.Context, scope *AccountScope, requestID string, query *RecordQuery) (*RecordPage, error) {
+	if requestID != "" {
+		records, err := uc.billingRecordUseCase.ListRecordsByRequestID(ctx, scope.AccountID, requestID)
+		if err != nil {
+			return nil, err
+		}
+		if scope.MemberID != "" {
+			records = slices.DeleteFunc(records, func(r *BillingRecord) bool { return r.MemberID != scope.MemberID })
+		}
+		return &RecordPage{Records: records, Total: int64(len(records))}, nil
+	}
+	query.Filter.MemberID = scope.MemberID
+	return uc.billingRecordUseCase.ListRecords(ctx, scope.AccountID, query)
 }
 
-// Recharge 充值
-func (uc *BillingUseCase) Recharge(ctx context.Context, userID string, amount float64, method int32, currency, returnURL, notifyURL string) (string, string, error) {
-	return uc.rechargeOrderUseCase.CreateRecharge(ctx, userID, amount, method, currency, returnURL, notifyURL)
+// Recharge 充值，orgID 非空时为组织钱包充值（调用者须为组织所有者或管理员）
+func (uc *BillingUseCase) Recharge(ctx context.Context, userID, orgID string, amount float64, method int32, currency, returnURL, notifyURL string) (string, string, error) {
+	accountID := userID
+	if orgID != "" {
+		if err := uc.orgUseCase.CheckManager(ctx, userID, orgID); err != nil {
+			return "", "", err
+		}
+		accountID = orgID
+	}
+	return uc.rechargeOrderUseCase.CreateRecharge(ctx, accountID, amount, method, currency, returnURL, notifyURL)
 }
 
 // RechargeCallback 充值回调
@@ -294,11 +562,10 @@ func (uc *BillingUseCase) RechargeCallback(ctx context.Context, orderID string,
 	return uc.rechargeOrderUseCase.RechargeCallback(ctx, orderID, amount)
 }
 
-// ResetFreeQuotas 重置所有用户的免费额度（每月1日执行）
-// 为所有用户创建下个月的免费额度记录
+// ResetFreeQuotas 重置所有用户的免费额度（每小时整点执行）
+// 为 24 小时内（账户时区）开始新周期的用户服务创建额度记录，其余周期在首次访问时创建
 func (uc *BillingUseCase) ResetFreeQuotas(ctx context.Context) (int, []string, error) {
-	// 获取下个月
-	nextMonth := time.Now().AddDate(0, 1, 0).Format(constants.TimeFormatMonth)
+	now := time.Now()
 
 	// 获取所有用户ID
 	userIDs, err := uc.statsUseCase.GetAllUserIDs(ctx)
@@ -314,14 +581,23 @@ func (uc *BillingUseCase) ResetFreeQuotas(ctx context.Context) (int, []string, e
 	successCount := 0
 	successUserIDs := []string{}
 
-	// 为每个用户创建下个月的免费额度
+	// 为每个用户创建账户时区内最近一天开始的周期的免费额度（每小时执行，各时区在当地零点后创建）
 	for _, userID := range userIDs {
-		for serviceName, totalQuota := range uc.conf.FreeQuotas {
-			// 检查是否已存在下个月的记录
-			existing, err := uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, nextMonth)
+		for serviceName := range uc.conf.FreeQuotas {
+			period, err := uc.periodUseCase.Period(ctx, userID, serviceName, now)
 			if err != nil {
-				uc.log.Warnf("GetFreeQuota failed for user=%s, service=%s, month=%s: %v",
-					userID, serviceName, nextMonth, err)
+				uc.log.Warnf("Get quota period failed for user=%s, service=%s: %v", userID, serviceName, err)
+				continue
+			}
+			if now.Sub(period.Start) >= 24*time.Hour {
+				continue
+			}
+
+			// 检查是否已存在当前周期的记录
+			existing, err := uc.freeQuotaUseCase.GetQuota(ctx, userID, serviceName, period.Key)
+			if err != nil {
+				uc.log.Warnf("GetFreeQuota failed for user=%s, service=%s, period=%s: %v",
+					userID, serviceName, period.Key, err)
 				continue
 			}
 
@@ -330,18 +606,17 @@ func (uc *BillingUseCase) ResetFreeQuotas(ctx context.Context) (int, []string, e
 				continue
 			}
 
-			// 创建新的免费额度记录
-			quota := &FreeQuota{
-				UID:         userID,
-				ServiceName: serviceName,
-				TotalQuota:  int(totalQuota),
-				UsedQuota:   0,
-				ResetMonth:  nextMonth,
+			// 创建新的免费额度记录（含上期结转的额度）
+			quota, err := uc.newQuota(ctx, userID, serviceName, period)
+			if err != nil {
+				uc.log.Warnf("Build free quota failed for user=%s, service=%s, period=%s: %v",
+					userID, serviceName, period.Key, err)
+				continue
 			}
 
 			if err := uc.freeQuotaUseCase.CreateQuota(ctx, quota); err != nil {
-				uc.log.Warnf("CreateFreeQuota failed for user=%s, service=%s, month=%s: %v",
-					userID, serviceName, nextMonth, err)
+				uc.log.Warnf("CreateFreeQuota failed for user=%s, service=%s, period=%s: %v",
+					userID, serviceName, period.Key, err)
 				continue
 			}
 
@@ -352,8 +627,8 @@ func (uc *BillingUseCase) ResetFreeQuotas(ctx context.Context) (int, []string, e
 		}
 	}
 
-	uc.log.Infof("Reset free quotas completed: nextMonth=%s, totalUsers=%d, successUsers=%d",
-		nextMonth, len(userIDs), len(successUserIDs))
+	uc.log.Infof("Reset free quotas completed: time=%s, totalUsers=%d, successUsers=%d",
+		now.Format(time.RFC3339), len(userIDs), len(successUserIDs))
 
 	return successCount, successUserIDs, nil
 }
@@ -368,17 +643,61 @@ func contains(slice []string, item string) bool {
 	return false
 }
 
-// GetStatsToday 获取今日调用统计
-func (uc *BillingUseCase) GetStatsToday(ctx context.Context, userID, serviceName string) (*Stats, error) {
-	return uc.statsUseCase.GetStatsToday(ctx, userID, serviceName)
+// GetStatsToday 获取查询范围内的今日调用统计
+func (uc *BillingUseCase) GetStatsToday(ctx context.Context, scope *AccountScope, serviceName string) (*Stats, error) {
+	return uc.statsUseCase.GetStatsToday(ctx, scope.AccountID, scope.MemberID, serviceName)
+}
+
+// GetStatsMonth 获取查询范围内的本月调用统计
+func (uc *BillingUseCase) GetStatsMonth(ctx context.Context, scope *AccountScope, serviceName string) (*Stats, error) {
+	return uc.statsUseCase.GetStatsMonth(ctx, scope.AccountID, scope.MemberID, serviceName)
+}
+
+// GetStatsSummary 获取查询范围内的汇总统计（所有服务）
+func (uc *BillingUseCase) GetStatsSummary(ctx context.Context, scope *AccountScope) (*StatsSummary, error) {
+	return uc.statsUseCase.GetStatsSummary(ctx, scope.AccountID, scope.MemberID)
+}
+
+// GetUsageSeries 获取查询范围内的用量时间序列（按小时/天/月分桶，无数据的时间桶补零）
+func (uc *BillingUseCase) GetUsageSeries(ctx context.Context, scope *AccountScope, serviceName string, start, end time.Time, granularity, timezone string) (*UsageSeries, error) {
+	return uc.statsUseCase.GetUsageSeries(ctx, scope.AccountID, scope.MemberID, serviceName, start, end, granularity, timezone)
+}
+
+// GetLiveUsage 获取实时用量（按分钟/小时，来自 Redis 计数）
+func (uc *BillingUseCase) GetLiveUsage(ctx context.Context, userID, serviceName, granularity string, points int) (*UsageSeries, error) {
+	if userID == "" {
+		uc.log.Warnf("GetLiveUsage: userID is empty")
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.statsUseCase.GetLiveUsage(ctx, userID, serviceName, granularity, points)
+}
+
+// RebuildUsageRollups 从原始消费记录重建用量汇总（回填命令调用）
+func (uc *BillingUseCase) RebuildUsageRollups(ctx context.Context, start, end time.Time) (int, error) {
+	return uc.statsUseCase.RebuildUsageRollups(ctx, start, end)
+}
+
+// GetRevenueReport 平台收入报表（管理接口）
+func (uc *BillingUseCase) GetRevenueReport(ctx context.Context, start, end time.Time, granularity, serviceName string, groupByService bool) (*RevenueReport, error) {
+	return uc.analyticsUseCase.GetRevenueReport(ctx, start, end, granularity, serviceName, groupByService)
+}
+
+// GetRechargeReport 平台充值报表（管理接口）
+func (uc *BillingUseCase) GetRechargeReport(ctx context.Context, start, end time.Time, granularity string) (*RechargeReport, error) {
+	return uc.analyticsUseCase.GetRechargeReport(ctx, start, end, granularity)
+}
+
+// GetUserActivityReport 用户活跃报表（管理接口）
+func (uc *BillingUseCase) GetUserActivityReport(ctx context.Context, start, end time.Time, serviceName string) (*UserActivity, error) {
+	return uc.analyticsUseCase.GetUserActivityReport(ctx, start, end, serviceName)
 }
 
-// GetStatsMonth 获取本月调用统计
-func (uc *BillingUseCase) GetStatsMonth(ctx context.Context, userID, serviceName string) (*Stats, error) {
-	return uc.statsUseCase.GetStatsMonth(ctx, userID, serviceName)
+// ListTopConsumers 消费排行（管理接口）
+func (uc *BillingUseCase) ListTopConsumers(ctx context.Context, start, end time.Time, serviceName, orderBy string, limit int) ([]*TopConsumer, error) {
+	return uc.analyticsUseCase.ListTopConsumers(ctx, start, end, serviceName, orderBy, limit)
 }
 
-// GetStatsSummary 获取汇总统计（所有服务）
-func (uc *BillingUseCase) GetStatsSummary(ctx context.Context, userID string) (*StatsSummary, error) {
-	return uc.statsUseCase.GetStatsSummary(ctx, userID)
+// GetBalanceLiability 余额负债（管理接口）
+func (uc *BillingUseCase) GetBalanceLiability(ctx context.Context) (*BalanceLiability, error) {
+	return uc.analyticsUseCase.GetBalanceLiability(ctx)
 }
@@ -1,24 +1,113 @@
 package biz
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"billing-service/internal/conf"
+	"billing-service/internal/constants"
 )
 
 // BillingConfig 计费配置
 type BillingConfig struct {
 	Prices                   map[string]float64
 	FreeQuotas               map[string]int32
-	BalanceLowThreshold      float64 // 余额低阈值（单位：元）
-	QuotaLowPercentThreshold float64 // 配额低阈值（百分比）
+	BalanceLowThreshold      float64                      // 余额低阈值（单位：元）
+	QuotaLowPercentThreshold float64                      // 配额低阈值（百分比）
+	Degradation              map[string]DegradationPolicy // 各服务依赖故障时的降级策略
+	DeferredSettleInterval   time.Duration                // 延迟扣费结算间隔
+	MaxDeferredCharges       int                          // 单实例最多待结算的延迟扣费数
+	Lease                    LeaseConfig                  // 网关额度租约配置
+	StreamDeduct             StreamDeductConfig           // 流式扣费配置
+	Pricing                  map[string]ServicePricing    // 各服务计量单位与调用方费用策略
+	Export                   ExportConfig                 // 账单导出配置
+	LiveStats                LiveStatsConfig              // 实时用量推送配置
+	Budget                   BudgetConfig                 // 消费预算配置
+	RateLimit                RateLimitConfig              // 请求频率限制配置
+	QuotaPeriod              QuotaPeriodConfig            // 免费额度周期配置
+	Location                 *time.Location               // 默认计费时区，账户未设置时区时使用
+	Packages                 []*UsagePackage              // 可购买的用量包目录
+	AccountPricing           AccountPricingConfig         // 账户价格规则与合同配置
+	Organization             OrganizationConfig           // 组织配置
+}
+
+// ServicePricing 服务计价配置
+type ServicePricing struct {
+	Unit            string  // 计量单位：call / token / mb / second
+	AllowCallerCost bool    // 是否允许调用方传入预计算费用
+	MinCost         float64 // 调用方费用下限（单次请求）
+	MaxCost         float64 // 调用方费用上限（单次请求），0 表示不超过 单价 × 用量
+}
+
+// Unit 获取服务的计量单位，未配置时按次计费
+func (c *BillingConfig) Unit(serviceName string) string {
+	if p, ok := c.Pricing[serviceName]; ok && p.Unit != "" {
+		return p.Unit
+	}
+	return constants.UsageUnitCall
 }
 
 // NewBillingConfig 从配置创建 BillingConfig
 func NewBillingConfig(c *conf.Bootstrap) *BillingConfig {
 	config := &BillingConfig{
-		Prices:                   make(map[string]float64),
-		FreeQuotas:               make(map[string]int32),
-		BalanceLowThreshold:      10.0,  // 默认值
-		QuotaLowPercentThreshold: 20.0,  // 默认值
+		Prices:                 make(map[string]float64),
+		FreeQuotas:             make(map[string]int32),
+		Degradation:            make(map[string]DegradationPolicy),
+		Pricing:                make(map[string]ServicePricing),
+		DeferredSettleInterval: 10 * time.Second, // 默认值
+		MaxDeferredCharges:     100000,           // 默认值
+		Lease: LeaseConfig{ // 默认值
+			MaxCount:        10000,
+			DefaultTTL:      30 * time.Second,
+			MaxTTL:          5 * time.Minute,
+			ReclaimGrace:    10 * time.Second,
+			ReclaimInterval: 5 * time.Second,
+		},
+		StreamDeduct: StreamDeductConfig{ // 默认值
+			MaxBatchSize: 100,
+			MaxBatchWait: 2 * time.Millisecond,
+			MaxInFlight:  1000,
+		},
+		Export: ExportConfig{ // 默认值
+			MaxRange:     366 * 24 * time.Hour,
+			MaxRows:      1000000,
+			SyncMaxRows:  1000,
+			LinkTTL:      15 * time.Minute,
+			Retention:    24 * time.Hour,
+			PollInterval: 5 * time.Second,
+			JobTimeout:   10 * time.Minute,
+		},
+		LiveStats: LiveStatsConfig{ // 默认值
+			StreamInterval:    2 * time.Second,
+			StreamMaxDuration: 10 * time.Minute,
+		},
+		Budget: BudgetConfig{ // 默认值
+			AlertInterval: time.Minute,
+			NotifyTimeout: 3 * time.Second,
+		},
+		RateLimit: RateLimitConfig{ // 默认值
+			Plans:        make(map[string]map[string]RateLimitRule),
+			UserCacheTTL: time.Minute,
+		},
+		QuotaPeriod: QuotaPeriodConfig{ // 默认值
+			DefaultCycle:    constants.QuotaCycleMonthly,
+			Services:        make(map[string]string),
+			Plans:           make(map[string]map[string]string),
+			Rollover:        make(map[string]float64),
+			AccountCacheTTL: 10 * time.Minute,
+		},
+		AccountPricing: AccountPricingConfig{ // 默认值
+			RuleCacheTTL: time.Minute,
+			SettleGrace:  time.Hour,
+		},
+		Organization: OrganizationConfig{ // 默认值
+			MaxMembers:         100,
+			MembershipCacheTTL: time.Minute,
+		},
+		Location:                 time.Local,
+		BalanceLowThreshold:      10.0, // 默认值
+		QuotaLowPercentThreshold: 20.0, // 默认值
 	}
 	if c.Billing != nil {
 		for k, v := range c.Billing.Prices {
@@ -34,7 +123,172 @@ func NewBillingConfig(c *conf.Bootstrap) *BillingConfig {
 		if c.Billing.QuotaLowPercentThreshold > 0 {
 			config.QuotaLowPercentThreshold = c.Billing.QuotaLowPercentThreshold
 		}
+		for k, v := range c.Billing.Degradation {
+			config.Degradation[k] = DegradationPolicy{
+				Policy:           v.Policy,
+				UserSpendCeiling: v.UserSpendCeiling,
+			}
+		}
+		if c.Billing.DeferredSettleInterval.AsDuration() > 0 {
+			config.DeferredSettleInterval = c.Billing.DeferredSettleInterval.AsDuration()
+		}
+		if c.Billing.MaxDeferredCharges > 0 {
+			config.MaxDeferredCharges = int(c.Billing.MaxDeferredCharges)
+		}
+		if lease := c.Billing.Lease; lease != nil {
+			if lease.MaxCount > 0 {
+				config.Lease.MaxCount = int(lease.MaxCount)
+			}
+			if lease.DefaultTtl.AsDuration() > 0 {
+				config.Lease.DefaultTTL = lease.DefaultTtl.AsDuration()
+			}
+			if lease.MaxTtl.AsDuration() > 0 {
+				config.Lease.MaxTTL = lease.MaxTtl.AsDuration()
+			}
+			if lease.ReclaimGrace.AsDuration() > 0 {
+				config.Lease.ReclaimGrace = lease.ReclaimGrace.AsDuration()
+			}
+			if lease.ReclaimInterval.AsDuration() > 0 {
+				config.Lease.ReclaimInterval = lease.ReclaimInterval.AsDuration()
+			}
+		}
+		for k, v := range c.Billing.Pricing {
+			config.Pricing[k] = ServicePricing{
+				Unit:            strings.ToLower(v.Unit),
+				AllowCallerCost: v.AllowCallerCost,
+				MinCost:         v.MinCost,
+				MaxCost:         v.MaxCost,
+			}
+		}
+		if stream := c.Billing.StreamDeduct; stream != nil {
+			if stream.MaxBatchSize > 0 {
+				config.StreamDeduct.MaxBatchSize = int(stream.MaxBatchSize)
+			}
+			if stream.MaxBatchWait.AsDuration() > 0 {
+				config.StreamDeduct.MaxBatchWait = stream.MaxBatchWait.AsDuration()
+			}
+			if stream.MaxInFlight > 0 {
+				config.StreamDeduct.MaxInFlight = int(stream.MaxInFlight)
+			}
+		}
+		if live := c.Billing.LiveStats; live != nil {
+			if live.StreamInterval.AsDuration() > 0 {
+				config.LiveStats.StreamInterval = live.StreamInterval.AsDuration()
+			}
+			if live.StreamMaxDuration.AsDuration() > 0 {
+				config.LiveStats.StreamMaxDuration = live.StreamMaxDuration.AsDuration()
+			}
+		}
+		if budget := c.Billing.Budget; budget != nil {
+			if budget.AlertInterval.AsDuration() > 0 {
+				config.Budget.AlertInterval = budget.AlertInterval.AsDuration()
+			}
+			if budget.NotifyTimeout.AsDuration() > 0 {
+				config.Budget.NotifyTimeout = budget.NotifyTimeout.AsDuration()
+			}
+			config.Budget.NotifyURL = budget.NotifyUrl
+		}
+		if rl := c.Billing.RateLimit; rl != nil {
+			config.RateLimit.DefaultPlan = rl.DefaultPlan
+			for name, plan := range rl.Plans {
+				rules := make(map[string]RateLimitRule, len(plan.GetServices()))
+				for serviceName, rule := range plan.GetServices() {
+					rules[serviceName] = RateLimitRule{PerSecond: rule.PerSecond, PerDay: rule.PerDay}
+				}
+				config.RateLimit.Plans[name] = rules
+			}
+			if rl.UserCacheTtl.AsDuration() > 0 {
+				config.RateLimit.UserCacheTTL = rl.UserCacheTtl.AsDuration()
+			}
+		}
+		if c.Billing.Timezone != "" {
+			loc, err := time.LoadLocation(c.Billing.Timezone)
+			if err != nil {
+				panic(fmt.Sprintf("invalid billing.timezone %q: %v", c.Billing.Timezone, err))
+			}
+			config.Location = loc
+		}
+		for _, p := range c.Billing.Packages {
+			// 缺少ID、用量或适用服务的目录项无法购买，忽略
+			if p.Id == "" || p.Units <= 0 || p.ServiceName == "" {
+				continue
+			}
+			pkg := &UsagePackage{
+				ID:          p.Id,
+				Name:        p.Name,
+				ServiceName: p.ServiceName,
+				Units:       p.Units,
+				Price:       p.Price,
+				ValidMonths: int(p.ValidMonths),
+			}
+			if pkg.ValidMonths <= 0 {
+				pkg.ValidMonths = 12 // 默认值
+			}
+			config.Packages = append(config.Packages, pkg)
+		}
+		if ap := c.Billing.AccountPricing; ap != nil {
+			if ap.RuleCacheTtl.AsDuration() > 0 {
+				config.AccountPricing.RuleCacheTTL = ap.RuleCacheTtl.AsDuration()
+			}
+			if ap.SettleGrace.AsDuration() > 0 {
+				config.AccountPricing.SettleGrace = ap.SettleGrace.AsDuration()
+			}
+		}
+		if org := c.Billing.Organization; org != nil {
+			if org.MaxMembers > 0 {
+				config.Organization.MaxMembers = int(org.MaxMembers)
+			}
+			if org.MembershipCacheTtl.AsDuration() > 0 {
+				config.Organization.MembershipCacheTTL = org.MembershipCacheTtl.AsDuration()
+			}
+		}
+		if qp := c.Billing.QuotaPeriod; qp != nil {
+			if qp.DefaultCycle != "" {
+				config.QuotaPeriod.DefaultCycle = strings.ToLower(qp.DefaultCycle)
+			}
+			for serviceName, cycle := range qp.Services {
+				config.QuotaPeriod.Services[serviceName] = strings.ToLower(cycle)
+			}
+			for name, plan := range qp.Plans {
+				cycles := make(map[string]string, len(plan.GetServices()))
+				for serviceName, cycle := range plan.GetServices() {
+					cycles[serviceName] = strings.ToLower(cycle)
+				}
+				config.QuotaPeriod.Plans[name] = cycles
+				if percent := plan.GetRolloverPercent(); percent > 0 {
+					config.QuotaPeriod.Rollover[name] = min(percent, 100)
+				}
+			}
+			if qp.AccountCacheTtl.AsDuration() > 0 {
+				config.QuotaPeriod.AccountCacheTTL = qp.AccountCacheTtl.AsDuration()
+			}
+		}
+		if export := c.Billing.Export; export != nil {
+			if export.MaxRange.AsDuration() > 0 {
+				config.Export.MaxRange = export.MaxRange.AsDuration()
+			}
+			if export.MaxRows > 0 {
+				config.Export.MaxRows = int(export.MaxRows)
+			}
+			if export.SyncMaxRows > 0 {
+				config.Export.SyncMaxRows = int(export.SyncMaxRows)
+			}
+			if export.LinkTtl.AsDuration() > 0 {
+				config.Export.LinkTTL = export.LinkTtl.AsDuration()
+			}
+			if export.Retention.AsDuration() > 0 {
+				config.Export.Retention = export.Retention.AsDuration()
+			}
+			if export.PollInterval.AsDuration() > 0 {
+				config.Export.PollInterval = export.PollInterval.AsDuration()
+			}
+			if export.JobTimeout.AsDuration() > 0 {
+				config.Export.JobTimeout = export.JobTimeout.AsDuration()
+			}
+			config.Export.PublicBaseURL = strings.TrimRight(export.PublicBaseUrl, "/")
+			config.Export.SignSecret = export.SignSecret
+			config.Export.PDFFont = export.PdfFont
+		}
 	}
 	return config
 }
-
@@ -4,14 +4,17 @@ import "time"
 
 // DeductEvent is the message sent to RocketMQ for asynchronous batch processing
 type DeductEvent struct {
-	RecordID        string    `json:"record_id"`
-	UserID          string    `json:"user_id"`
-	ServiceName     string    `json:"service_name"`
-	Count           int       `json:"count"`
-	Cost            float64   `json:"cost"`
-	FreeCount       int       `json:"free_count"`
-	PaidCount       int       `json:"paid_count"`
-	BalanceDeducted float64   `json:"balance_deducted"`
-	DeductTime      time.Time `json:"deduct_time"`
-	Month           string    `json:"month"` // Used to identify which month's quota/record this belongs to
+	RecordID        string          `json:"record_id"`
+	UserID          string          `json:"user_id"`
+	ServiceName     string          `json:"service_name"`
+	Count           int             `json:"count"`
+	Cost            float64         `json:"cost"`
+	FreeCount       int             `json:"free_count"`
+	PaidCount       int             `json:"paid_count"`
+	BalanceDeducted float64         `json:"balance_deducted"`
+	DeductTime      time.Time       `json:"deduct_time"`
+	Period          string          `json:"month"`                   // 额度周期标识（见 QuotaPeriod.Key），字段名沿用 month 以兼容升级前的事件
+	Metadata        *DeductMetadata `json:"metadata,omitempty"`      // 扣费来源信息（可选）
+	PackageCount    int             `json:"package_count,omitempty"` // 用量包扣减量，PaidCount 只含余额扣费部分
+	MemberID        string          `json:"member_id,omitempty"`     // 组织账户中实际使用的成员，UserID 为组织ID
 }
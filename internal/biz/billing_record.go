@@ -2,8 +2,15 @@ package biz
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
 	"time"
 
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -12,16 +19,57 @@ type BillingRecord struct {
 	ID          string
 	UID         string
 	ServiceName string
-	Type        string // "free": 免费额度, "balance": 余额扣费
+	Type        string // "free": 免费额度, "balance": 余额扣费, "package": 用量包, "true_up": 合同补差
 	Amount      float64
 	Count       int
 	CreatedAt   time.Time
+	Metadata    *DeductMetadata // 扣费来源信息，扣费时未传则为 nil
+	MemberID    string          // 组织账户中实际使用的成员，个人账户为空
+}
+
+// RecordFilter 消费记录过滤条件，零值字段不过滤
+type RecordFilter struct {
+	ServiceName string
+	Type        string    // "free" / "balance" / "package" / "true_up"
+	StartTime   time.Time // 起始时间（含）
+	EndTime     time.Time // 结束时间（不含）
+	MinAmount   float64   // 最小扣费金额（含）
+	AppID       string    // 扣费来源信息中的应用ID
+	MemberID    string    // 组织账户中实际使用的成员
+}
+
+// RecordCursor 消费记录游标，记录按 (created_at, id) 倒序排列
+type RecordCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// RecordQuery 消费记录分页查询
+// Cursor 非空或 Page 未传时按游标分页，否则按页码分页（兼容旧客户端，始终统计总数）
+type RecordQuery struct {
+	Filter    RecordFilter
+	Cursor    string // 上一页返回的 NextCursor
+	Page      int
+	PageSize  int
+	WithTotal bool // 游标分页时是否统计总数
+}
+
+// RecordPage 消费记录分页结果
+type RecordPage struct {
+	Records    []*BillingRecord
+	Total      int64 // 未统计时为 0
+	NextCursor string
+	HasMore    bool
 }
 
 // BillingRecordRepo 消费记录数据层接口（定义在 biz 层）
 type BillingRecordRepo interface {
 	CreateBillingRecord(ctx context.Context, record *BillingRecord) error
-	ListBillingRecords(ctx context.Context, userID string, page, pageSize int) ([]*BillingRecord, int64, error)
+	// ListBillingRecords 按条件倒序查询消费记录：after 非空时返回排在游标之后的记录，否则跳过 offset 条；
+	// withTotal 为 true 时统计满足过滤条件的总数
+	ListBillingRecords(ctx context.Context, userID string, filter *RecordFilter, after *RecordCursor, offset, limit int, withTotal bool) ([]*BillingRecord, int64, error)
+	// ListBillingRecordsByRequestID 获取某个调用方请求产生的消费记录
+	ListBillingRecordsByRequestID(ctx context.Context, userID, requestID string) ([]*BillingRecord, error)
 }
 
 // BillingRecordUseCase 消费记录业务逻辑
@@ -44,6 +92,81 @@ func (uc *BillingRecordUseCase) CreateRecord(ctx context.Context, record *Billin
 }
 
 // ListRecords 获取消费记录列表
-func (uc *BillingRecordUseCase) ListRecords(ctx context.Context, userID string, page, pageSize int) ([]*BillingRecord, int64, error) {
-	return uc.repo.ListBillingRecords(ctx, userID, page, pageSize)
+func (uc *BillingRecordUseCase) ListRecords(ctx context.Context, userID string, query *RecordQuery) (*RecordPage, error) {
+	if err := validateRecordFilter(ctx, &query.Filter); err != nil {
+		return nil, err
+	}
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = constants.DefaultRecordPageSize
+	} else if pageSize > constants.MaxRecordPageSize {
+		pageSize = constants.MaxRecordPageSize
+	}
+
+	var after *RecordCursor
+	offset := 0
+	withTotal := query.WithTotal
+	if query.Cursor != "" {
+		cursor, ok := DecodeRecordCursor(query.Cursor)
+		if !ok {
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidRecordQuery)
+		}
+		after = cursor
+	} else if query.Page > 0 {
+		// 页码分页（兼容旧客户端）
+		offset = (query.Page - 1) * pageSize
+		withTotal = true
+	}
+
+	// 多取一条用于判断是否还有下一页
+	records, total, err := uc.repo.ListBillingRecords(ctx, userID, &query.Filter, after, offset, pageSize+1, withTotal)
+	if err != nil {
+		return nil, err
+	}
+	page := &RecordPage{Records: records, Total: total}
+	if len(records) > pageSize {
+		page.Records = records[:pageSize]
+		page.HasMore = true
+		last := page.Records[pageSize-1]
+		page.NextCursor = EncodeRecordCursor(&RecordCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return page, nil
+}
+
+// validateRecordFilter 校验过滤条件
+func validateRecordFilter(ctx context.Context, f *RecordFilter) error {
+	if (f.Type != "" && f.Type != constants.BillingTypeFree && f.Type != constants.BillingTypeBalance && f.Type != constants.BillingTypePackage && f.Type != constants.BillingTypeTrueUp) ||
+		f.MinAmount < 0 ||
+		(!f.StartTime.IsZero() && !f.EndTime.IsZero() && !f.EndTime.After(f.StartTime)) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidRecordQuery)
+	}
+	return nil
+}
+
+// EncodeRecordCursor 编码游标（对客户端不透明）
+func EncodeRecordCursor(c *RecordCursor) string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeRecordCursor 解码游标（时间为 UTC），格式无效时返回 false
+func DecodeRecordCursor(s string) (*RecordCursor, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, false
+	}
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &RecordCursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: id}, true
+}
+
+// ListRecordsByRequestID 按调用方请求ID获取消费记录
+func (uc *BillingRecordUseCase) ListRecordsByRequestID(ctx context.Context, userID, requestID string) ([]*BillingRecord, error) {
+	return uc.repo.ListBillingRecordsByRequestID(ctx, userID, requestID)
 }
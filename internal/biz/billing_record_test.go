@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeBillingRecordRepo 返回 count 条按时间倒序的记录，记录 ListBillingRecords 的分页参数
+type fakeBillingRecordRepo struct {
+	BillingRecordRepo
+	count     int
+	after     *RecordCursor
+	offset    int
+	limit     int
+	withTotal bool
+}
+
+func (r *fakeBillingRecordRepo) ListBillingRecords(_ context.Context, _ string, _ *RecordFilter, after *RecordCursor, offset, limit int, withTotal bool) ([]*BillingRecord, int64, error) {
+	r.after, r.offset, r.limit, r.withTotal = after, offset, limit, withTotal
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	var records []*BillingRecord
+	for i := 0; i < r.count && i < limit; i++ {
+		records = append(records, &BillingRecord{ID: fmt.Sprintf("r%03d", r.count-i), CreatedAt: base.Add(-time.Duration(i) * time.Second)})
+	}
+	var total int64
+	if withTotal {
+		total = int64(r.count)
+	}
+	return records, total, nil
+}
+
+func TestRecordCursorRoundTrip(t *testing.T) {
+	for _, c := range []*RecordCursor{
+		{CreatedAt: time.Date(2025, 11, 5, 10, 0, 0, 123456789, time.UTC), ID: "0b6e5c1e-8f1a-4c4e-9d7a-2f1e3c4b5a69"},
+		{CreatedAt: time.Unix(0, 0), ID: "a|b"}, // ID 中的分隔符属于 ID
+		{CreatedAt: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), ID: "neg"},
+	} {
+		got, ok := DecodeRecordCursor(EncodeRecordCursor(c))
+		if !ok || !got.CreatedAt.Equal(c.CreatedAt) || got.ID != c.ID {
+			t.Errorf("round trip %+v = %+v, %v", c, got, ok)
+		}
+	}
+}
+
+func TestDecodeRecordCursorMalformed(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for name, s := range map[string]string{
+		"not base64":      "!!!",
+		"padded base64":   base64.URLEncoding.EncodeToString([]byte("1|ab")),
+		"no separator":    encode("1762336800000000000"),
+		"empty id":        encode("1762336800000000000|"),
+		"empty timestamp": encode("|r1"),
+		"bad timestamp":   encode("yesterday|r1"),
+		"overflow":        encode("99999999999999999999|r1"),
+	} {
+		if c, ok := DecodeRecordCursor(s); ok {
+			t.Errorf("%s: decoded %q as %+v", name, s, c)
+		}
+	}
+}
+
+// TestListRecordsPagination 游标分页多取一条判断下一页，下一页游标指向本页最后一条；页码分页始终统计总数；无效游标拒绝查询
+func TestListRecordsPagination(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &fakeBillingRecordRepo{count: 3}
+	uc := NewBillingRecordUseCase(repo, log.DefaultLogger)
+	page, err := uc.ListRecords(ctx, "u1", &RecordQuery{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Records) != 2 || !page.HasMore || repo.limit != 3 || repo.withTotal || page.Total != 0 {
+		t.Fatalf("first page = %+v, limit = %d, want 2 records with more", page, repo.limit)
+	}
+	last := page.Records[1]
+	if c, ok := DecodeRecordCursor(page.NextCursor); !ok || c.ID != last.ID || !c.CreatedAt.Equal(last.CreatedAt) {
+		t.Errorf("next cursor = %+v, want last record %s", c, last.ID)
+	}
+
+	if _, err := uc.ListRecords(ctx, "u1", &RecordQuery{Cursor: page.NextCursor, Page: 5, PageSize: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if repo.after == nil || repo.after.ID != last.ID || repo.offset != 0 {
+		t.Errorf("cursor takes precedence over page: after = %+v, offset = %d", repo.after, repo.offset)
+	}
+
+	page, err = uc.ListRecords(ctx, "u1", &RecordQuery{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if repo.offset != 2 || !repo.withTotal || page.Total != 3 {
+		t.Errorf("page 2: offset = %d, total = %d, want 2, 3", repo.offset, page.Total)
+	}
+
+	repo = &fakeBillingRecordRepo{count: 2}
+	uc = NewBillingRecordUseCase(repo, log.DefaultLogger)
+	page, err = uc.ListRecords(ctx, "u1", &RecordQuery{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.HasMore || page.NextCursor != "" {
+		t.Errorf("last page = %+v, want no next cursor", page)
+	}
+
+	for pageSize, want := range map[int]int{0: constants.DefaultRecordPageSize, constants.MaxRecordPageSize + 1: constants.MaxRecordPageSize} {
+		if _, err := uc.ListRecords(ctx, "u1", &RecordQuery{PageSize: pageSize}); err != nil {
+			t.Fatal(err)
+		}
+		if repo.limit != want+1 {
+			t.Errorf("page size %d: limit = %d, want %d", pageSize, repo.limit, want+1)
+		}
+	}
+
+	_, err = uc.ListRecords(ctx, "u1", &RecordQuery{Cursor: "garbage"})
+	assertErrCode(t, err, billingErrors.ErrCodeInvalidRecordQuery)
+}
@@ -10,6 +10,16 @@ var ProviderSet = wire.NewSet(
 	NewBillingRecordUseCase,
 	NewRechargeOrderUseCase,
 	NewStatsUseCase,
+	NewDegradationGuard,
+	NewLeaseUseCase,
+	NewExportUseCase,
+	NewAnalyticsUseCase,
+	NewBudgetUseCase,
+	NewRateLimitUseCase,
+	NewPeriodUseCase,
+	NewPackageUseCase,
+	NewRatingUseCase,
+	NewOrgUseCase,
 	NewBillingUseCase, // 组合 UseCase
 )
 
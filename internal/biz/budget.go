@@ -0,0 +1,301 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Budget 用户消费预算领域对象
+// 按账户时区的自然月统计余额消费（免费额度不计入），ServiceName 为空表示全部服务合计
+type Budget struct {
+	BudgetID     string
+	UserID       string
+	ServiceName  string
+	Amount       float64 // 每月预算金额
+	AlertPercent float64 // 提醒阈值（预算金额的百分比），0 表示不提醒
+	HardLimit    bool    // 硬性上限：超出预算的扣费被拒绝
+	AlertedMonth string  // 最近一次发送提醒的月份
+	UpdatedAt    time.Time
+	Period       string  // 当前统计周期（查询时填充）
+	Spent        float64 // 本周期已消费金额（查询时填充）
+}
+
+// AlertThreshold 触发提醒的消费金额
+func (b *Budget) AlertThreshold() float64 {
+	return b.Amount * b.AlertPercent / 100
+}
+
+// BudgetAlert 预算提醒通知内容
+type BudgetAlert struct {
+	UserID       string  `json:"userId"`
+	ServiceName  string  `json:"serviceName"` // 为空表示全部服务合计
+	Period       string  `json:"period"`
+	Amount       float64 `json:"amount"`
+	AlertPercent float64 `json:"alertPercent"`
+	HardLimit    bool    `json:"hardLimit"`
+	Spent        float64 `json:"spent"`
+}
+
+// BudgetRepo 消费预算数据层接口（定义在 biz 层）
+type BudgetRepo interface {
+	// SaveBudget 创建或覆盖同一用户同一服务的预算，金额或阈值变化时重置本月提醒状态
+	// budget.Period 为当前预算月份，用于删除该月的消费缓存
+	SaveBudget(ctx context.Context, budget *Budget) error
+	// DeleteBudget 删除预算，不存在时返回 false，month 为当前预算月份
+	DeleteBudget(ctx context.Context, userID, serviceName, month string) (bool, error)
+	ListBudgets(ctx context.Context, userID string) ([]*Budget, error)
+	// GetMonthSpent 预算月份内的余额消费（已落库 + 在途扣费），serviceName 为空表示全部服务
+	GetMonthSpent(ctx context.Context, userID, serviceName string, month BillingPeriod) (float64, error)
+	// ExceedsHardLimit 按服务计费金额判断是否超出硬性预算（服务预算及全部服务预算）
+	ExceedsHardLimit(ctx context.Context, userID string, month BillingPeriod, charges map[string]float64) (bool, error)
+	// ListAlertCandidates 获取设置了提醒阈值且提醒月份早于 month 的预算，按 BudgetID 分页
+	ListAlertCandidates(ctx context.Context, month, afterID string, limit int) ([]*Budget, error)
+	// MarkBudgetAlerted 标记本月已提醒，已被其他实例标记时返回 false
+	MarkBudgetAlerted(ctx context.Context, budgetID, month string) (bool, error)
+	// UnmarkBudgetAlerted 通知失败时撤销标记，下一轮重试
+	UnmarkBudgetAlerted(ctx context.Context, budgetID, month string) error
+}
+
+// BudgetNotifier 预算提醒通知（由通知服务发送邮件等）
+type BudgetNotifier interface {
+	NotifyBudgetAlert(ctx context.Context, alert *BudgetAlert) error
+}
+
+// BudgetConfig 消费预算配置
+type BudgetConfig struct {
+	AlertInterval time.Duration // 预算提醒扫描间隔
+	NotifyURL     string        // 提醒通知地址，为空时只记录日志
+	NotifyTimeout time.Duration // 通知请求超时
+}
+
+// latestZone 最早进入新一天的时区（UTC+14），该时区进入新月份时可能已有账户开始新的预算月份
+var latestZone = time.FixedZone("UTC+14", 14*60*60)
+
+// BudgetUseCase 消费预算业务逻辑
+type BudgetUseCase struct {
+	repo          BudgetRepo
+	notifier      BudgetNotifier
+	periodUseCase *PeriodUseCase
+	conf          *BillingConfig
+	log           *log.Helper
+	metrics       *metrics.BillingMetrics
+}
+
+// NewBudgetUseCase 创建消费预算 UseCase
+func NewBudgetUseCase(repo BudgetRepo, notifier BudgetNotifier, periodUseCase *PeriodUseCase, conf *BillingConfig, logger log.Logger) *BudgetUseCase {
+	return &BudgetUseCase{
+		repo:          repo,
+		notifier:      notifier,
+		periodUseCase: periodUseCase,
+		conf:          conf,
+		log:           log.NewHelper(logger),
+		metrics:       metrics.GetMetrics(),
+	}
+}
+
+// SetBudget 设置消费预算
+func (uc *BudgetUseCase) SetBudget(ctx context.Context, budget *Budget) (*Budget, error) {
+	if budget.UserID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if err := uc.validateBudget(ctx, budget); err != nil {
+		return nil, err
+	}
+	month, err := uc.periodUseCase.Month(ctx, budget.UserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	budget.Period = month.Key
+	if err := uc.repo.SaveBudget(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	spent, err := uc.repo.GetMonthSpent(ctx, budget.UserID, budget.ServiceName, month)
+	if err != nil {
+		return nil, err
+	}
+	budget.Spent = spent
+	return budget, nil
+}
+
+// validateBudget 校验服务名称（为空或已配置单价的服务）、金额与提醒阈值，至少需要提醒阈值或硬性上限之一
+func (uc *BudgetUseCase) validateBudget(ctx context.Context, budget *Budget) error {
+	if budget.ServiceName != "" {
+		if _, ok := uc.conf.Prices[budget.ServiceName]; !ok {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+		}
+	}
+	if math.IsNaN(budget.Amount) || math.IsInf(budget.Amount, 0) || budget.Amount <= 0 ||
+		math.IsNaN(budget.AlertPercent) || budget.AlertPercent < 0 || budget.AlertPercent > constants.MaxBudgetAlertPercent ||
+		(budget.AlertPercent == 0 && !budget.HardLimit) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidBudget)
+	}
+	return nil
+}
+
+// ListBudgets 获取用户的消费预算及本月已消费金额
+func (uc *BudgetUseCase) ListBudgets(ctx context.Context, userID string) ([]*Budget, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	month, err := uc.periodUseCase.Month(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := uc.repo.ListBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, budget := range budgets {
+		budget.Period = month.Key
+		if budget.Spent, err = uc.repo.GetMonthSpent(ctx, userID, budget.ServiceName, month); err != nil {
+			return nil, err
+		}
+	}
+	return budgets, nil
+}
+
+// DeleteBudget 删除消费预算
+func (uc *BudgetUseCase) DeleteBudget(ctx context.Context, userID, serviceName string) error {
+	if userID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	month, err := uc.periodUseCase.Month(ctx, userID, time.Now())
+	if err != nil {
+		return err
+	}
+	deleted, err := uc.repo.DeleteBudget(ctx, userID, serviceName, month.Key)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetNotFound)
+	}
+	return nil
+}
+
+// ExceedsHardLimit 检查本次按服务计费金额是否超出 now 所在预算月份的硬性预算（CheckQuota / BatchCheckQuota 使用）
+// 扣费时由数据层在 Lua 脚本与 DB 事务中原子检查
+func (uc *BudgetUseCase) ExceedsHardLimit(ctx context.Context, userID string, now time.Time, charges map[string]float64) (bool, error) {
+	month, err := uc.periodUseCase.Month(ctx, userID, now)
+	if err != nil {
+		return false, err
+	}
+	exceeded, err := uc.repo.ExceedsHardLimit(ctx, userID, month, charges)
+	if err != nil {
+		return false, err
+	}
+	if exceeded && uc.metrics != nil {
+		for serviceName := range charges {
+			uc.metrics.BudgetDeniedTotal.WithLabelValues(serviceName, constants.BudgetOperationCheck).Inc()
+		}
+	}
+	return exceeded, nil
+}
+
+// SendBudgetAlerts 扫描本月消费达到提醒阈值的预算并发送通知，每个预算每月最多提醒一次（按账户时区的自然月）
+// 先标记再通知，多实例同时扫描时只有标记成功的实例发送；通知失败时撤销标记，下一轮重试
+func (uc *BudgetUseCase) SendBudgetAlerts(ctx context.Context) (int, error) {
+	now := time.Now()
+	// 各账户时区的当前月份不晚于 UTC+14 的月份，提醒月份更早的预算才可能需要提醒
+	latestMonth := now.In(latestZone).Format(constants.TimeFormatMonth)
+	sent := 0
+	afterID := ""
+	for {
+		budgets, err := uc.repo.ListAlertCandidates(ctx, latestMonth, afterID, constants.BudgetAlertBatchSize)
+		if err != nil {
+			return sent, err
+		}
+		for _, budget := range budgets {
+			afterID = budget.BudgetID
+			month, err := uc.periodUseCase.Month(ctx, budget.UserID, now)
+			if err != nil {
+				return sent, err
+			}
+			if budget.AlertedMonth == month.Key {
+				continue
+			}
+			spent, err := uc.repo.GetMonthSpent(ctx, budget.UserID, budget.ServiceName, month)
+			if err != nil {
+				return sent, err
+			}
+			if spent < budget.AlertThreshold() {
+				continue
+			}
+			if uc.sendBudgetAlert(ctx, budget, month.Key, spent) {
+				sent++
+			}
+		}
+		if len(budgets) < constants.BudgetAlertBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// sendBudgetAlert 标记并发送一个预算提醒，返回是否由本实例发送成功
+func (uc *BudgetUseCase) sendBudgetAlert(ctx context.Context, budget *Budget, month string, spent float64) bool {
+	marked, err := uc.repo.MarkBudgetAlerted(ctx, budget.BudgetID, month)
+	if err != nil {
+		uc.log.Errorf("Mark budget alerted failed: budget_id=%s, error=%v", budget.BudgetID, err)
+		return false
+	}
+	if !marked {
+		return false
+	}
+
+	alert := &BudgetAlert{
+		UserID:       budget.UserID,
+		ServiceName:  budget.ServiceName,
+		Period:       month,
+		Amount:       budget.Amount,
+		AlertPercent: budget.AlertPercent,
+		HardLimit:    budget.HardLimit,
+		Spent:        spent,
+	}
+	if err := uc.notifier.NotifyBudgetAlert(ctx, alert); err != nil {
+		uc.log.Errorf("Send budget alert failed: budget_id=%s, user_id=%s, error=%v", budget.BudgetID, budget.UserID, err)
+		if err := uc.repo.UnmarkBudgetAlerted(ctx, budget.BudgetID, month); err != nil {
+			uc.log.Errorf("Unmark budget alerted failed: budget_id=%s, error=%v", budget.BudgetID, err)
+		}
+		uc.recordBudgetAlert(constants.OrderStatusFailed)
+		return false
+	}
+	uc.log.Infof("Budget alert sent: user_id=%s, service=%s, period=%s, spent=%.4f, amount=%.4f",
+		budget.UserID, budget.ServiceName, month, spent, budget.Amount)
+	uc.recordBudgetAlert(constants.OrderStatusSuccess)
+	return true
+}
+
+func (uc *BudgetUseCase) recordBudgetAlert(result string) {
+	if uc.metrics != nil {
+		uc.metrics.BudgetAlertTotal.WithLabelValues(result).Inc()
+	}
+}
+
+// SetBudget 设置消费预算
+func (uc *BillingUseCase) SetBudget(ctx context.Context, budget *Budget) (*Budget, error) {
+	return uc.budgetUseCase.SetBudget(ctx, budget)
+}
+
+// ListBudgets 获取消费预算及本月已消费金额
+func (uc *BillingUseCase) ListBudgets(ctx context.Context, userID string) ([]*Budget, error) {
+	return uc.budgetUseCase.ListBudgets(ctx, userID)
+}
+
+// DeleteBudget 删除消费预算
+func (uc *BillingUseCase) DeleteBudget(ctx context.Context, userID, serviceName string) error {
+	return uc.budgetUseCase.DeleteBudget(ctx, userID, serviceName)
+}
+
+// SendBudgetAlerts 发送预算提醒（预算提醒服务定时调用）
+func (uc *BillingUseCase) SendBudgetAlerts(ctx context.Context) (int, error) {
+	return uc.budgetUseCase.SendBudgetAlerts(ctx)
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"context"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// DeductMetadata 扣费来源信息，随扣费事件落库到 billing_record_metadata，用于将消费记录关联到 API 请求
+type DeductMetadata struct {
+	RequestID string            // 调用方请求ID
+	APIKeyID  string            // API Key ID
+	AppID     string            // 应用ID
+	Operation string            // 接口/操作
+	Labels    map[string]string // 自定义标签
+}
+
+// IsEmpty 是否未携带任何来源信息（为空时不写入 billing_record_metadata）
+func (m *DeductMetadata) IsEmpty() bool {
+	return m == nil || (m.RequestID == "" && m.APIKeyID == "" && m.AppID == "" && m.Operation == "" && len(m.Labels) == 0)
+}
+
+// validateDeductMetadata 校验来源信息长度与个数限制
+func validateDeductMetadata(ctx context.Context, m *DeductMetadata) error {
+	if m.IsEmpty() {
+		return nil
+	}
+	if len(m.RequestID) > constants.MaxMetadataIDLength ||
+		len(m.APIKeyID) > constants.MaxMetadataIDLength ||
+		len(m.AppID) > constants.MaxMetadataIDLength ||
+		len(m.Operation) > constants.MaxMetadataOperationLength ||
+		len(m.Labels) > constants.MaxMetadataLabels {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidDeductMetadata)
+	}
+	for k, v := range m.Labels {
+		if k == "" || len(k) > constants.MaxMetadataLabelKeyLength || len(v) > constants.MaxMetadataLabelValueLength {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidDeductMetadata)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+)
+
+// TestValidateDeductMetadata 各字段长度与标签个数以配置的上限为界，上限本身允许
+func TestValidateDeductMetadata(t *testing.T) {
+	labels := func(n int) map[string]string {
+		m := make(map[string]string, n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("k%d", i)] = "v"
+		}
+		return m
+	}
+	id := strings.Repeat("a", constants.MaxMetadataIDLength)
+
+	cases := []struct {
+		name     string
+		meta     *DeductMetadata
+		wantCode int
+	}{
+		{name: "nil", meta: nil},
+		{name: "empty", meta: &DeductMetadata{}},
+		{name: "at limits", meta: &DeductMetadata{
+			RequestID: id, APIKeyID: id, AppID: id,
+			Operation: strings.Repeat("o", constants.MaxMetadataOperationLength),
+			Labels: map[string]string{
+				strings.Repeat("k", constants.MaxMetadataLabelKeyLength): strings.Repeat("v", constants.MaxMetadataLabelValueLength),
+			},
+		}},
+		{name: "max labels", meta: &DeductMetadata{Labels: labels(constants.MaxMetadataLabels)}},
+		{name: "request id too long", meta: &DeductMetadata{RequestID: id + "a"}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "api key id too long", meta: &DeductMetadata{APIKeyID: id + "a"}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "app id too long", meta: &DeductMetadata{AppID: id + "a"}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "operation too long", meta: &DeductMetadata{Operation: strings.Repeat("o", constants.MaxMetadataOperationLength+1)}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "too many labels", meta: &DeductMetadata{Labels: labels(constants.MaxMetadataLabels + 1)}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "empty label key", meta: &DeductMetadata{Labels: map[string]string{"": "v"}}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "label key too long", meta: &DeductMetadata{Labels: map[string]string{strings.Repeat("k", constants.MaxMetadataLabelKeyLength+1): "v"}}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+		{name: "label value too long", meta: &DeductMetadata{Labels: map[string]string{"k": strings.Repeat("v", constants.MaxMetadataLabelValueLength+1)}}, wantCode: billingErrors.ErrCodeInvalidDeductMetadata},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assertErrCode(t, validateDeductMetadata(context.Background(), tc.meta), tc.wantCode)
+		})
+	}
+}
+
+func TestDeductMetadataIsEmpty(t *testing.T) {
+	for _, m := range []*DeductMetadata{nil, {}, {Labels: map[string]string{}}} {
+		if !m.IsEmpty() {
+			t.Errorf("%+v: IsEmpty = false", m)
+		}
+	}
+	for _, m := range []*DeductMetadata{{RequestID: "r"}, {APIKeyID: "k"}, {AppID: "a"}, {Operation: "o"}, {Labels: map[string]string{"k": ""}}} {
+		if m.IsEmpty() {
+			t.Errorf("%+v: IsEmpty = true", m)
+		}
+	}
+}
@@ -0,0 +1,452 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// maxAccountSnapshots 本地快照最多保留的用户数，超出后不再记录新用户
+const maxAccountSnapshots = 100000
+
+// DegradationPolicy 单个服务的降级策略
+type DegradationPolicy struct {
+	Policy           string  // fail_closed / fail_open / snapshot
+	UserSpendCeiling float64 // 单用户故障期间允许的最大延迟扣费金额（元），0 表示不限制（仅 snapshot）
+}
+
+// DeferredCharge 降级期间放行的扣费，依赖恢复后结算
+type DeferredCharge struct {
+	RecordID    string
+	UserID      string
+	ServiceName string
+	Count       int
+	Cost        float64
+	Period      string // 额度周期标识，为空时结算时按 CreatedAt 重新计算
+	Metadata    *DeductMetadata
+	CreatedAt   time.Time
+	Unrated     bool    // 放行时未能读取账户价格：Cost 为目录价估算，结算时按 CreatedAt 的适用单价重新计价
+	CallerCost  float64 // 调用方预计算的费用（仅 Unrated 时用于重新计价）
+	MemberID    string  // 组织账户中实际使用的成员
+	Unresolved  bool    // 放行时未能读取组织成员身份：UserID 为用户本人，结算时确定扣费账户（同时为 Unrated）
+}
+
+// accountSnapshot 最近一次成功读取的余额/剩余免费额度
+type accountSnapshot struct {
+	balance   float64
+	quotas    map[string]int // service:period -> 剩余免费额度
+	updatedAt time.Time
+}
+
+// degradedUsage 单用户未结算的延迟扣费
+type degradedUsage struct {
+	cost   float64        // 按单价计算的总费用（不区分免费额度，保守）
+	counts map[string]int // service:period -> 调用次数
+}
+
+// DeferredChargeJournal 延迟扣费日志（本地持久化）
+// 放行的延迟扣费写入日志后才返回给调用方，进程重启后重放未结算的记录
+type DeferredChargeJournal interface {
+	// Load 读取未结算的延迟扣费（按记录顺序）
+	Load() ([]*DeferredCharge, error)
+	// Append 追加延迟扣费，返回前落盘
+	Append(charge *DeferredCharge) error
+	// MarkSettled 标记延迟扣费已处理（结算成功或放弃）
+	MarkSettled(recordID string) error
+	// Compact 以未结算的记录重写日志
+	Compact(pending []*DeferredCharge) error
+}
+
+// DegradationGuard 依赖（Redis/MySQL）故障时的降级处理
+// 按服务策略决定放行或拒绝，放行的扣费记录为延迟扣费，依赖恢复后由 SettleDeferredCharges 结算
+// 延迟扣费先写入本地日志再返回，重启后重放；待结算数超过 MaxDeferredCharges 时不再放行
+type DegradationGuard struct {
+	mu         sync.Mutex
+	policies   map[string]DegradationPolicy
+	snapshots  map[string]*accountSnapshot
+	usage      map[string]*degradedUsage
+	deferred   []*DeferredCharge
+	journal    DeferredChargeJournal
+	maxPending int
+
+	log     *log.Helper
+	metrics *metrics.BillingMetrics
+}
+
+// NewDegradationGuard 创建降级处理器，并重放日志中未结算的延迟扣费
+func NewDegradationGuard(conf *BillingConfig, journal DeferredChargeJournal, logger log.Logger) (*DegradationGuard, error) {
+	g := &DegradationGuard{
+		policies:   conf.Degradation,
+		snapshots:  make(map[string]*accountSnapshot),
+		usage:      make(map[string]*degradedUsage),
+		journal:    journal,
+		maxPending: conf.MaxDeferredCharges,
+		log:        log.NewHelper(logger),
+		metrics:    metrics.GetMetrics(),
+	}
+	pending, err := journal.Load()
+	if err != nil {
+		return nil, fmt.Errorf("load deferred charge journal: %w", err)
+	}
+	for _, charge := range pending {
+		g.addLocked(charge)
+	}
+	if len(pending) > 0 {
+		g.log.Warnf("Replayed %d unsettled deferred charges from journal", len(pending))
+	}
+	if g.metrics != nil {
+		g.metrics.DeferredChargePending.Set(float64(len(g.deferred)))
+	}
+	return g, nil
+}
+
+// IsDependencyError 判断错误是否由依赖故障引起（Redis/MySQL 不可用、熔断等）
+// 余额不足、未知服务等业务拒绝不属于依赖故障，不走降级策略
+func IsDependencyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch kratosErrors.FromError(err).Code {
+	case billingErrors.ErrCodeInsufficientBalance,
+		billingErrors.ErrCodeInsufficientQuota,
+		billingErrors.ErrCodeUnknownService,
+		billingErrors.ErrCodeDeductDegraded,
+		billingErrors.ErrCodeBudgetExceeded,
+		billingErrors.ErrCodeUsageUnitMismatch,
+		billingErrors.ErrCodeCallerCostOutOfBounds,
+		billingErrors.ErrCodeMemberSpendLimitExceeded,
+		pkgErrors.ErrCodeMissingRequiredField:
+		return false
+	}
+	return !errors.Is(err, context.Canceled)
+}
+
+// Policy 获取服务的降级策略，未配置时 fail_closed
+func (g *DegradationGuard) Policy(serviceName string) DegradationPolicy {
+	if p, ok := g.policies[serviceName]; ok && p.Policy != "" {
+		return p
+	}
+	return DegradationPolicy{Policy: constants.DegradationPolicyFailClosed}
+}
+
+// RememberBalance 记录余额快照
+func (g *DegradationGuard) RememberBalance(userID string, balance float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if s := g.snapshotLocked(userID); s != nil {
+		s.balance = balance
+		s.updatedAt = time.Now()
+	}
+}
+
+// RememberQuota 记录剩余免费额度快照
+func (g *DegradationGuard) RememberQuota(userID, serviceName, period string, remaining int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if s := g.snapshotLocked(userID); s != nil {
+		s.quotas[usageKey(serviceName, period)] = remaining
+		s.updatedAt = time.Now()
+	}
+}
+
+func (g *DegradationGuard) snapshotLocked(userID string) *accountSnapshot {
+	s, ok := g.snapshots[userID]
+	if !ok {
+		if len(g.snapshots) >= maxAccountSnapshots {
+			return nil
+		}
+		s = &accountSnapshot{quotas: make(map[string]int)}
+		g.snapshots[userID] = s
+	}
+	return s
+}
+
+// Admit 依赖故障时判断是否放行
+// cost 为本次调用按单价计算的费用，unitPrice 用于 snapshot 策略计算免费额度不足部分的费用
+func (g *DegradationGuard) Admit(userID, serviceName, period string, count int, cost, unitPrice float64) bool {
+	policy := g.Policy(serviceName)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	allowed := g.admitLocked(policy, userID, serviceName, period, count, cost, unitPrice)
+
+	if g.metrics != nil {
+		result := constants.QuotaCheckResultDenied
+		if allowed {
+			result = constants.QuotaCheckResultAllowed
+		}
+		g.metrics.DegradedRequestTotal.WithLabelValues(serviceName, policy.Policy, result).Inc()
+	}
+	return allowed
+}
+
+func (g *DegradationGuard) admitLocked(policy DegradationPolicy, userID, serviceName, period string, count int, cost, unitPrice float64) bool {
+	usage := g.usage[userID]
+	if usage == nil {
+		usage = &degradedUsage{}
+	}
+	withinCeiling := policy.UserSpendCeiling <= 0 || usage.cost+cost <= policy.UserSpendCeiling
+
+	switch policy.Policy {
+	case constants.DegradationPolicyFailOpen:
+		// fail_open 必须配置消费上限，否则等同 fail_closed
+		return policy.UserSpendCeiling > 0 && withinCeiling
+	case constants.DegradationPolicySnapshot:
+		s, ok := g.snapshots[userID]
+		if !ok || !withinCeiling {
+			return false
+		}
+		key := usageKey(serviceName, period)
+		freeRemaining := 0
+		if remaining, ok := s.quotas[key]; ok {
+			freeRemaining = max(remaining-usage.counts[key], 0)
+		}
+		if freeRemaining >= count {
+			return true
+		}
+		needed := float64(count-freeRemaining) * unitPrice
+		return s.balance-usage.cost >= needed
+	default:
+		return false
+	}
+}
+
+// Defer 记录延迟扣费：写入日志成功后才计入，待结算数已达上限或写入失败时返回错误（调用方拒绝本次扣费）
+func (g *DegradationGuard) Defer(charge *DeferredCharge) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.maxPending > 0 && len(g.deferred) >= g.maxPending {
+		g.rejectLocked(constants.DeferredRejectQueueFull)
+		return fmt.Errorf("deferred charges reached limit %d", g.maxPending)
+	}
+	if err := g.journal.Append(charge); err != nil {
+		g.rejectLocked(constants.DeferredRejectJournalError)
+		return fmt.Errorf("append deferred charge journal: %w", err)
+	}
+	g.addLocked(charge)
+
+	if g.metrics != nil {
+		g.metrics.DeferredChargePending.Set(float64(len(g.deferred)))
+	}
+	return nil
+}
+
+func (g *DegradationGuard) addLocked(charge *DeferredCharge) {
+	usage := g.usage[charge.UserID]
+	if usage == nil {
+		usage = &degradedUsage{counts: make(map[string]int)}
+		g.usage[charge.UserID] = usage
+	}
+	usage.cost += charge.Cost
+	usage.counts[usageKey(charge.ServiceName, charge.Period)] += charge.Count
+	g.deferred = append(g.deferred, charge)
+}
+
+func (g *DegradationGuard) rejectLocked(reason string) {
+	if g.metrics != nil {
+		g.metrics.DeferredChargeRejectedTotal.WithLabelValues(reason).Inc()
+	}
+}
+
+// Settle 按记录顺序结算延迟扣费
+// settle 返回依赖故障时停止本轮结算，剩余记录下轮重试；返回业务错误（如余额不足）时放弃该记录
+func (g *DegradationGuard) Settle(ctx context.Context, settle func(ctx context.Context, charge *DeferredCharge) error) int {
+	g.mu.Lock()
+	pending := make([]*DeferredCharge, len(g.deferred))
+	copy(pending, g.deferred)
+	g.mu.Unlock()
+
+	done := 0
+	for _, charge := range pending {
+		err := settle(ctx, charge)
+		if err != nil && IsDependencyError(err) {
+			g.log.Warnf("Settle deferred charges paused, dependency still unavailable: %v", err)
+			break
+		}
+
+		result := constants.OrderStatusSuccess
+		if err != nil {
+			// 降级期间的消费超出了用户实际余额，损失受 UserSpendCeiling 约束
+			result = constants.OrderStatusFailed
+			g.log.Errorf("Drop deferred charge: record_id=%s, user_id=%s, service=%s, count=%d, cost=%.4f, error=%v",
+				charge.RecordID, charge.UserID, charge.ServiceName, charge.Count, charge.Cost, err)
+		}
+		if g.metrics != nil {
+			g.metrics.DeferredChargeSettledTotal.WithLabelValues(result).Inc()
+		}
+		// 立即标记，避免重写日志前进程退出导致重启后重复结算
+		if err := g.journal.MarkSettled(charge.RecordID); err != nil {
+			g.log.Errorf("Mark deferred charge settled failed: record_id=%s, error=%v", charge.RecordID, err)
+		}
+		done++
+	}
+
+	if done > 0 {
+		g.mu.Lock()
+		for _, charge := range g.deferred[:done] {
+			g.releaseLocked(charge)
+		}
+		g.deferred = g.deferred[done:]
+		if err := g.journal.Compact(g.deferred); err != nil {
+			// 已处理的记录在日志中有标记，重写失败只影响日志大小
+			g.log.Warnf("Compact deferred charge journal failed: %v", err)
+		}
+		if g.metrics != nil {
+			g.metrics.DeferredChargePending.Set(float64(len(g.deferred)))
+		}
+		g.mu.Unlock()
+	}
+	return done
+}
+
+func (g *DegradationGuard) releaseLocked(charge *DeferredCharge) {
+	usage := g.usage[charge.UserID]
+	if usage == nil {
+		return
+	}
+	usage.cost -= charge.Cost
+	key := usageKey(charge.ServiceName, charge.Period)
+	if usage.counts[key] -= charge.Count; usage.counts[key] <= 0 {
+		delete(usage.counts, key)
+	}
+	if len(usage.counts) == 0 {
+		delete(g.usage, charge.UserID)
+	}
+	// 结算后快照已过期，等待下次成功读取时重新记录
+	delete(g.snapshots, charge.UserID)
+}
+
+func usageKey(serviceName, period string) string {
+	return fmt.Sprintf("%s:%s", serviceName, period)
+}
+
+// checkQuotaDegraded 依赖故障时按降级策略检查配额
+func (uc *BillingUseCase) checkQuotaDegraded(ctx context.Context, userID, serviceName, period string, count int, cause error) (bool, string, error) {
+	uc.log.Warnf("CheckQuota degraded: user_id=%s, service=%s, error=%v", userID, serviceName, cause)
+
+	price, ok := uc.conf.Prices[serviceName]
+	if !ok {
+		return false, "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+	}
+
+	allowed := uc.degradation.Admit(userID, serviceName, period, count, price*float64(count), price)
+	if uc.metrics != nil {
+		result := constants.QuotaCheckResultDenied
+		if allowed {
+			result = constants.QuotaCheckResultDegraded
+		}
+		uc.metrics.QuotaCheckTotal.WithLabelValues(serviceName, result).Inc()
+	}
+	return allowed, constants.BillingMessageDegraded, nil
+}
+
+// deductQuotaDegraded 依赖故障时按降级策略扣费：放行的记录为延迟扣费
+func (uc *BillingUseCase) deductQuotaDegraded(ctx context.Context, payer Payer, serviceName, period string, count int, cost float64, meta *DeductMetadata, cause error) (string, error) {
+	return uc.deferCharge(ctx, &DeferredCharge{
+		UserID:      payer.AccountID,
+		MemberID:    payer.MemberID,
+		ServiceName: serviceName,
+		Count:       count,
+		Cost:        cost,
+		Period:      period,
+		Metadata:    meta,
+	}, cause)
+}
+
+// deductUnratedDegraded 读取账户价格时依赖故障：按目录价估算费用判断是否放行，结算时按扣费时间的适用单价重新计价
+func (uc *BillingUseCase) deductUnratedDegraded(ctx context.Context, payer Payer, serviceName string, usage Usage, meta *DeductMetadata, cause error) (string, error) {
+	return uc.deferCharge(ctx, &DeferredCharge{
+		UserID:      payer.AccountID,
+		MemberID:    payer.MemberID,
+		ServiceName: serviceName,
+		Count:       usage.Quantity,
+		Cost:        uc.conf.Prices[serviceName] * float64(usage.Quantity),
+		Metadata:    meta,
+		Unrated:     true,
+		CallerCost:  usage.CallerCost,
+	}, cause)
+}
+
+// deductUnresolvedDegraded 读取组织成员身份时依赖故障：按用户本人的降级占用判断是否放行，
+// 结算时确定扣费账户并按扣费时间的适用单价计价
+func (uc *BillingUseCase) deductUnresolvedDegraded(ctx context.Context, userID, serviceName string, usage Usage, meta *DeductMetadata, cause error) (string, error) {
+	return uc.deferCharge(ctx, &DeferredCharge{
+		UserID:      userID,
+		ServiceName: serviceName,
+		Count:       usage.Quantity,
+		Cost:        uc.conf.Prices[serviceName] * float64(usage.Quantity),
+		Metadata:    meta,
+		Unrated:     true,
+		CallerCost:  usage.CallerCost,
+		Unresolved:  true,
+	}, cause)
+}
+
+// deferCharge 按降级策略判断是否放行，放行时记录为延迟扣费
+func (uc *BillingUseCase) deferCharge(ctx context.Context, charge *DeferredCharge, cause error) (string, error) {
+	uc.log.Warnf("DeductQuota degraded: user_id=%s, service=%s, error=%v", charge.UserID, charge.ServiceName, cause)
+
+	if !uc.degradation.Admit(charge.UserID, charge.ServiceName, charge.Period, charge.Count, charge.Cost, uc.conf.Prices[charge.ServiceName]) {
+		return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductDegraded)
+	}
+
+	charge.RecordID = uuid.New().String()
+	charge.CreatedAt = time.Now()
+	if err := uc.degradation.Defer(charge); err != nil {
+		uc.log.Errorf("Defer charge failed: user_id=%s, service=%s, error=%v", charge.UserID, charge.ServiceName, err)
+		return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductDegraded)
+	}
+	return charge.RecordID, nil
+}
+
+// SettleDeferredCharges 依赖恢复后结算延迟扣费，返回本轮处理的记录数
+// 由 DeferredSettlementServer 定时调用
+func (uc *BillingUseCase) SettleDeferredCharges(ctx context.Context) int {
+	return uc.degradation.Settle(ctx, func(ctx context.Context, charge *DeferredCharge) error {
+		// 不修改 charge.UserID：释放降级占用时仍按放行时的用户
+		payer := Payer{AccountID: charge.UserID, MemberID: charge.MemberID}
+		if charge.Unresolved {
+			var err error
+			if payer, err = uc.orgUseCase.Payer(ctx, charge.UserID); err != nil {
+				return err
+			}
+		}
+		// 预算月份按扣费时间计算
+		periods, err := uc.periodUseCase.DeductPeriods(ctx, payer.AccountID, charge.ServiceName, charge.CreatedAt)
+		if err != nil {
+			return err
+		}
+		// 不修改 charge.Period：释放降级占用时仍按放行时的周期
+		period := charge.Period
+		if period == "" {
+			period = periods.Quota.Key
+		}
+		// 重新计价不修改 charge.Cost：释放降级占用时仍按放行时的估算值
+		cost := charge.Cost
+		if charge.Unrated {
+			usage := Usage{Quantity: charge.Count, CallerCost: charge.CallerCost}
+			if cost, err = uc.resolveCost(ctx, payer.AccountID, charge.ServiceName, usage, charge.CreatedAt); err != nil {
+				return err
+			}
+		}
+		// 延迟扣费的调用已经放行，结算时不再检查成员消费上限
+		payer.SpendLimit = 0
+		recordID, err := uc.repo.DeductQuota(ctx, payer, charge.ServiceName, charge.Count, cost, period, periods.BudgetMonth, charge.Metadata)
+		if err == nil {
+			uc.log.Infof("Deferred charge settled: deferred_record_id=%s, record_id=%s", charge.RecordID, recordID)
+		}
+		return err
+	})
+}
@@ -0,0 +1,90 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeDeferredJournal 内存中的延迟扣费日志
+type fakeDeferredJournal struct {
+	charges []*DeferredCharge
+}
+
+func (j *fakeDeferredJournal) Load() ([]*DeferredCharge, error) { return j.charges, nil }
+
+func (j *fakeDeferredJournal) Append(charge *DeferredCharge) error {
+	j.charges = append(j.charges, charge)
+	return nil
+}
+
+func (j *fakeDeferredJournal) MarkSettled(recordID string) error { return nil }
+
+func (j *fakeDeferredJournal) Compact(pending []*DeferredCharge) error {
+	j.charges = pending
+	return nil
+}
+
+// fakeDeductRepo 记录结算时的扣费周期
+type fakeDeductRepo struct {
+	BillingRepo
+	periods []string
+}
+
+func (f *fakeDeductRepo) DeductQuota(ctx context.Context, payer Payer, serviceName string, count int, cost float64, period string, month BillingPeriod, meta *DeductMetadata) (string, error) {
+	f.periods = append(f.periods, period)
+	return "r1", nil
+}
+
+// TestSettleDeferredChargesReleasesUsage 未确定周期的延迟扣费结算时按扣费时间计算周期，
+// 释放降级占用仍按放行时的 key，结算后不残留占用
+func TestSettleDeferredChargesReleasesUsage(t *testing.T) {
+	ctx := context.Background()
+	conf := &BillingConfig{
+		Prices:      map[string]float64{"passport": 1},
+		Location:    time.UTC,
+		QuotaPeriod: QuotaPeriodConfig{DefaultCycle: constants.QuotaCycleMonthly},
+	}
+	guard, err := NewDegradationGuard(conf, &fakeDeferredJournal{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &fakeDeductRepo{}
+	uc := &BillingUseCase{
+		degradation:   guard,
+		periodUseCase: NewPeriodUseCase(&fakeAccountSettingRepo{}, nil, conf, log.DefaultLogger),
+		ratingUseCase: newTestRatingUseCase(&fakePricingRepo{}),
+		repo:          repo,
+		conf:          conf,
+		log:           log.NewHelper(log.DefaultLogger),
+	}
+
+	createdAt := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	charges := []*DeferredCharge{
+		// 读取账户价格失败时放行：未确定周期
+		{RecordID: "d1", UserID: "u_10001", ServiceName: "passport", Count: 2, Cost: 2, Unrated: true, CreatedAt: createdAt},
+		{RecordID: "d2", UserID: "u_10001", ServiceName: "passport", Count: 1, Cost: 1, Period: "2025-11", CreatedAt: createdAt},
+	}
+	for _, charge := range charges {
+		if err := guard.Defer(charge); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if done := uc.SettleDeferredCharges(ctx); done != len(charges) {
+		t.Fatalf("settled = %d, want %d", done, len(charges))
+	}
+	if len(repo.periods) != 2 || repo.periods[0] != "2025-11" || repo.periods[1] != "2025-11" {
+		t.Errorf("deduct periods = %v, want [2025-11 2025-11]", repo.periods)
+	}
+	if charges[0].Period != "" {
+		t.Errorf("charge period = %q, want unchanged", charges[0].Period)
+	}
+	if len(guard.usage) != 0 || len(guard.deferred) != 0 {
+		t.Errorf("usage = %v, deferred = %d, want empty", guard.usage, len(guard.deferred))
+	}
+}
@@ -0,0 +1,433 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/i18n"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// exportScanBatchSize 生成导出文件时每批读取的消费记录数
+	exportScanBatchSize = 1000
+	// exportClaimBatchSize 每轮最多领取的导出任务数
+	exportClaimBatchSize = 10
+	// exportCleanupBatchSize 每轮最多清理的过期导出文件数
+	exportCleanupBatchSize = 100
+	// exportMaxPDFRows PDF 在内存中生成，行数上限低于其他格式
+	exportMaxPDFRows = 50000
+	// exportMaxErrorLength 任务失败原因最大长度（与 billing_export_job.error_message 一致）
+	exportMaxErrorLength = 255
+)
+
+// ExportJob 账单导出任务领域对象
+type ExportJob struct {
+	ExportID     string
+	UserID       string
+	Format       string // csv / xlsx / pdf
+	Lang         string // 导出语言，创建任务时取请求语言（zh-CN / en-US）
+	StartTime    time.Time
+	EndTime      time.Time
+	Status       string // pending / running / success / failed / expired
+	FileKey      string // 存储中的文件 key
+	FileSize     int64
+	RowCount     int
+	ErrorMessage string
+	CreatedAt    time.Time
+	StartedAt    time.Time // 开始执行时间，用于识别执行超时的任务
+	FinishedAt   time.Time
+	ExpiresAt    time.Time // 文件过期时间
+}
+
+// FileName 下载文件名
+func (j *ExportJob) FileName() string {
+	return fmt.Sprintf("statement_%s_%s.%s", j.StartTime.Format("20060102"), j.EndTime.Format("20060102"), j.Format)
+}
+
+// ExportRepo 账单导出数据层接口（定义在 biz 层）
+type ExportRepo interface {
+	CreateExportJob(ctx context.Context, job *ExportJob) error
+	// GetExportJob 获取导出任务，不存在时返回 nil
+	GetExportJob(ctx context.Context, exportID string) (*ExportJob, error)
+	UpdateExportJob(ctx context.Context, job *ExportJob) error
+	// ClaimExportJobs 领取待执行的任务及开始时间早于 staleBefore 的执行中任务，领取后状态为 running
+	ClaimExportJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*ExportJob, error)
+	// ListExpiredExportJobs 获取文件过期时间早于 before 的已完成任务
+	ListExpiredExportJobs(ctx context.Context, before time.Time, limit int) ([]*ExportJob, error)
+	// CountStatementRows 统计时间范围内的消费记录与成功充值订单数
+	CountStatementRows(ctx context.Context, userID string, start, end time.Time) (int64, error)
+	// ScanStatementRecords 按时间正序分批读取时间范围内的消费记录
+	ScanStatementRecords(ctx context.Context, userID string, start, end time.Time, batchSize int, fn func([]*BillingRecord) error) error
+	// ListStatementRecharges 获取时间范围内成功的充值订单，按到账时间（UpdatedAt）正序
+	ListStatementRecharges(ctx context.Context, userID string, start, end time.Time) ([]*RechargeOrder, error)
+}
+
+// ExportStorage 导出文件存储，默认本地文件，后续可替换为对象存储
+type ExportStorage interface {
+	// Save 保存文件，返回文件大小
+	Save(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除文件，文件不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// DownloadURL 存储可直接提供下载地址时返回（如对象存储预签名 URL），否则返回空，由服务生成签名下载链接
+	DownloadURL(ctx context.Context, key string, expiresAt time.Time) (string, error)
+}
+
+// ExportConfig 账单导出配置
+type ExportConfig struct {
+	MaxRange      time.Duration // 单次导出的最大时间范围
+	MaxRows       int           // 单次导出的最大行数
+	SyncMaxRows   int           // 行数不超过此值时同步生成
+	LinkTTL       time.Duration // 下载链接有效期
+	Retention     time.Duration // 导出文件保留时长
+	PollInterval  time.Duration // 异步任务扫描间隔
+	JobTimeout    time.Duration // 任务执行超时
+	PublicBaseURL string        // 下载链接对外地址
+	SignSecret    string        // 下载链接签名密钥
+	PDFFont       string        // PDF TrueType 字体路径
+}
+
+// ExportUseCase 账单导出业务逻辑
+type ExportUseCase struct {
+	repo    ExportRepo
+	storage ExportStorage
+	conf    *BillingConfig
+	secret  []byte
+	log     *log.Helper
+	metrics *metrics.BillingMetrics
+}
+
+// NewExportUseCase 创建账单导出 UseCase
+func NewExportUseCase(repo ExportRepo, storage ExportStorage, conf *BillingConfig, logger log.Logger) *ExportUseCase {
+	uc := &ExportUseCase{
+		repo:    repo,
+		storage: storage,
+		conf:    conf,
+		secret:  []byte(conf.Export.SignSecret),
+		log:     log.NewHelper(logger),
+		metrics: metrics.GetMetrics(),
+	}
+	if len(uc.secret) == 0 {
+		// 未配置密钥时使用随机密钥，下载链接只在生成它的实例上有效
+		uc.secret = make([]byte, 32)
+		_, _ = rand.Read(uc.secret)
+		uc.log.Warn("billing.export.sign_secret is not configured, download links are only valid on this instance")
+	}
+	return uc
+}
+
+// Create 创建导出任务
+// 行数不超过 sync_max_rows 时在请求中直接生成，否则创建异步任务由 ExportWorkerServer 执行
+func (uc *ExportUseCase) Create(ctx context.Context, userID, format string, start, end time.Time) (*ExportJob, error) {
+	format = strings.ToLower(format)
+	if format != constants.ExportFormatCSV && format != constants.ExportFormatXLSX && format != constants.ExportFormatPDF {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidExportFormat)
+	}
+	if start.IsZero() || !end.After(start) || end.Sub(start) > uc.conf.Export.MaxRange {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidExportRange)
+	}
+
+	rows, err := uc.repo.CountStatementRows(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	maxRows := uc.conf.Export.MaxRows
+	if format == constants.ExportFormatPDF {
+		maxRows = min(maxRows, exportMaxPDFRows)
+	}
+	if rows > int64(maxRows) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeExportTooLarge)
+	}
+
+	now := time.Now()
+	job := &ExportJob{
+		ExportID:  uuid.New().String(),
+		UserID:    userID,
+		Format:    format,
+		Lang:      i18n.Language(ctx),
+		StartTime: start,
+		EndTime:   end,
+		Status:    constants.ExportStatusPending,
+		CreatedAt: now,
+	}
+	sync := rows <= int64(uc.conf.Export.SyncMaxRows)
+	if sync {
+		job.Status = constants.ExportStatusRunning
+		job.StartedAt = now
+	}
+	if err := uc.repo.CreateExportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	if sync {
+		uc.runJob(ctx, job)
+	}
+	return job, nil
+}
+
+// Get 获取导出任务，已完成时返回下载链接及其过期时间
+func (uc *ExportUseCase) Get(ctx context.Context, userID, exportID string) (*ExportJob, string, time.Time, error) {
+	job, err := uc.repo.GetExportJob(ctx, exportID)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+	if job == nil || job.UserID != userID {
+		return nil, "", time.Time{}, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeExportNotFound)
+	}
+	if job.Status != constants.ExportStatusSuccess {
+		return job, "", time.Time{}, nil
+	}
+	url, expiresAt, err := uc.downloadURL(ctx, job)
+	if err != nil {
+		return nil, "", time.Time{}, err
+	}
+	return job, url, expiresAt, nil
+}
+
+// downloadURL 生成下载链接，有效期不超过文件过期时间
+func (uc *ExportUseCase) downloadURL(ctx context.Context, job *ExportJob) (string, time.Time, error) {
+	expiresAt := time.Now().Add(uc.conf.Export.LinkTTL)
+	if job.ExpiresAt.Before(expiresAt) {
+		expiresAt = job.ExpiresAt
+	}
+	url, err := uc.storage.DownloadURL(ctx, job.FileKey, expiresAt)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if url == "" {
+		url = fmt.Sprintf("%s/api/v1/billing/exports/%s/download?expires=%d&signature=%s",
+			uc.conf.Export.PublicBaseURL, job.ExportID, expiresAt.Unix(), uc.sign(job.ExportID, expiresAt.Unix()))
+	}
+	return url, expiresAt, nil
+}
+
+// sign 下载链接签名：HMAC-SHA256(exportID|expires)
+func (uc *ExportUseCase) sign(exportID string, expires int64) string {
+	mac := hmac.New(sha256.New, uc.secret)
+	fmt.Fprintf(mac, "%s|%d", exportID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Open 校验签名下载链接并打开导出文件，调用方负责关闭
+func (uc *ExportUseCase) Open(ctx context.Context, exportID string, expires int64, signature string) (*ExportJob, io.ReadCloser, error) {
+	if time.Now().Unix() > expires || !hmac.Equal([]byte(signature), []byte(uc.sign(exportID, expires))) {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeExportLinkInvalid)
+	}
+	job, err := uc.repo.GetExportJob(ctx, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job == nil {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeExportNotFound)
+	}
+	if job.Status != constants.ExportStatusSuccess {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeExportNotReady)
+	}
+	file, err := uc.storage.Open(ctx, job.FileKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return job, file, nil
+}
+
+// ProcessPending 领取并执行待处理的导出任务，返回执行的任务数
+func (uc *ExportUseCase) ProcessPending(ctx context.Context) (int, error) {
+	jobs, err := uc.repo.ClaimExportJobs(ctx, time.Now().Add(-uc.conf.Export.JobTimeout), exportClaimBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			// 退出时未执行的任务在超时后由其他实例重新领取
+			break
+		}
+		uc.runJob(ctx, job)
+	}
+	return len(jobs), nil
+}
+
+// CleanupExpired 删除过期的导出文件，返回清理的任务数
+func (uc *ExportUseCase) CleanupExpired(ctx context.Context) (int, error) {
+	jobs, err := uc.repo.ListExpiredExportJobs(ctx, time.Now(), exportCleanupBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	cleaned := 0
+	for _, job := range jobs {
+		if err := uc.storage.Delete(ctx, job.FileKey); err != nil {
+			uc.log.Errorf("Delete export file failed: export_id=%s, key=%s, error=%v", job.ExportID, job.FileKey, err)
+			continue
+		}
+		job.Status = constants.ExportStatusExpired
+		if err := uc.repo.UpdateExportJob(ctx, job); err != nil {
+			uc.log.Errorf("Update expired export job failed: export_id=%s, error=%v", job.ExportID, err)
+			continue
+		}
+		cleaned++
+	}
+	return cleaned, nil
+}
+
+// runJob 生成导出文件并保存，结果写回任务
+func (uc *ExportUseCase) runJob(ctx context.Context, job *ExportJob) {
+	startTime := time.Now()
+	rows, size, err := uc.generate(ctx, job)
+
+	job.FinishedAt = time.Now()
+	if err != nil {
+		uc.log.Errorf("Export job failed: export_id=%s, user_id=%s, format=%s, error=%v", job.ExportID, job.UserID, job.Format, err)
+		job.Status = constants.ExportStatusFailed
+		job.ErrorMessage = err.Error()
+		if len(job.ErrorMessage) > exportMaxErrorLength {
+			job.ErrorMessage = job.ErrorMessage[:exportMaxErrorLength]
+		}
+	} else {
+		job.Status = constants.ExportStatusSuccess
+		job.RowCount = rows
+		job.FileSize = size
+		job.ExpiresAt = job.FinishedAt.Add(uc.conf.Export.Retention)
+	}
+	if uc.metrics != nil {
+		uc.metrics.ExportJobTotal.WithLabelValues(job.Format, job.Status).Inc()
+		if err == nil {
+			uc.metrics.ExportJobDuration.WithLabelValues(job.Format).Observe(time.Since(startTime).Seconds())
+			uc.metrics.ExportRowCount.Observe(float64(rows))
+		}
+	}
+
+	// 请求取消（同步导出）或服务退出时仍需写回结果
+	if err := uc.repo.UpdateExportJob(context.WithoutCancel(ctx), job); err != nil {
+		uc.log.Errorf("Update export job failed: export_id=%s, error=%v", job.ExportID, err)
+	}
+}
+
+// generate 渲染账单到临时文件后写入存储，返回行数和文件大小
+func (uc *ExportUseCase) generate(ctx context.Context, job *ExportJob) (int, int64, error) {
+	tmp, err := os.CreateTemp("", "billing-export-*")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	rows, err := uc.render(ctx, job, tmp)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+	job.FileKey = fmt.Sprintf("%s/%s.%s", job.UserID, job.ExportID, job.Format)
+	size, err := uc.storage.Save(ctx, job.FileKey, tmp)
+	if err != nil {
+		return 0, 0, err
+	}
+	return rows, size, nil
+}
+
+// render 按时间正序合并消费记录与充值订单，写出账单明细和汇总
+func (uc *ExportUseCase) render(ctx context.Context, job *ExportJob, w io.Writer) (int, error) {
+	labels := loadExportLabels(job.Lang)
+	if job.Format == constants.ExportFormatPDF && uc.conf.Export.PDFFont == "" && job.Lang != exportLangEnglish {
+		// 内置字体不支持中文
+		uc.log.Warnf("billing.export.pdf_font is not configured, export %s uses %s template", job.ExportID, exportLangEnglish)
+		labels = loadExportLabels(exportLangEnglish)
+	}
+	writer, err := newStatementWriter(job.Format, w, labels, uc.conf.Export.PDFFont)
+	if err != nil {
+		return 0, err
+	}
+	if err := writer.WriteHeader(&statementHeader{
+		UserID:      job.UserID,
+		StartTime:   job.StartTime,
+		EndTime:     job.EndTime,
+		GeneratedAt: time.Now(),
+	}); err != nil {
+		return 0, err
+	}
+
+	recharges, err := uc.repo.ListStatementRecharges(ctx, job.UserID, job.StartTime, job.EndTime)
+	if err != nil {
+		return 0, err
+	}
+	summary := &statementSummary{}
+	rows := 0
+	writeLine := func(line *statementLine) error {
+		summary.add(line)
+		rows++
+		return writer.WriteLine(line)
+	}
+	next := 0
+	err = uc.repo.ScanStatementRecords(ctx, job.UserID, job.StartTime, job.EndTime, exportScanBatchSize, func(records []*BillingRecord) error {
+		for _, record := range records {
+			for ; next < len(recharges) && recharges[next].UpdatedAt.Before(record.CreatedAt); next++ {
+				if err := writeLine(rechargeLine(recharges[next])); err != nil {
+					return err
+				}
+			}
+			if err := writeLine(recordLine(record)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for ; next < len(recharges); next++ {
+		if err := writeLine(rechargeLine(recharges[next])); err != nil {
+			return 0, err
+		}
+	}
+	if err := writer.Close(summary); err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
+
+// CreateExport 创建账单导出任务
+func (uc *BillingUseCase) CreateExport(ctx context.Context, userID, format string, start, end time.Time) (*ExportJob, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.exportUseCase.Create(ctx, userID, format, start, end)
+}
+
+// GetExport 获取账单导出任务及下载链接
+func (uc *BillingUseCase) GetExport(ctx context.Context, userID, exportID string) (*ExportJob, string, time.Time, error) {
+	if userID == "" || exportID == "" {
+		return nil, "", time.Time{}, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.exportUseCase.Get(ctx, userID, exportID)
+}
+
+// OpenExport 通过签名下载链接打开导出文件
+func (uc *BillingUseCase) OpenExport(ctx context.Context, exportID string, expires int64, signature string) (*ExportJob, io.ReadCloser, error) {
+	return uc.exportUseCase.Open(ctx, exportID, expires, signature)
+}
+
+// ProcessExportJobs 执行待处理的导出任务（ExportWorkerServer 调用）
+func (uc *BillingUseCase) ProcessExportJobs(ctx context.Context) (int, error) {
+	return uc.exportUseCase.ProcessPending(ctx)
+}
+
+// CleanupExpiredExports 清理过期的导出文件（ExportWorkerServer 调用）
+func (uc *BillingUseCase) CleanupExpiredExports(ctx context.Context) (int, error) {
+	return uc.exportUseCase.CleanupExpired(ctx)
+}
@@ -0,0 +1,412 @@
+package biz
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"billing-service/internal/constants"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// exportI18nDir 导出文案目录（与错误消息共用 i18n 目录），文件为 {lang}/export.json
+	exportI18nDir = "i18n"
+	// exportLangDefault 默认语言
+	exportLangDefault = "zh-CN"
+	// exportLangEnglish 英文
+	exportLangEnglish = "en-US"
+	// exportTimeFormat 账单时间格式
+	exportTimeFormat = "2006-01-02 15:04:05"
+	// exportCategoryRecharge 充值行类型（消费记录行使用 free / balance / package / true_up）
+	exportCategoryRecharge = "recharge"
+)
+
+// exportLabels 导出文案
+type exportLabels map[string]string
+
+// get 获取文案，缺失时返回 key
+func (l exportLabels) get(key string) string {
+	if v, ok := l[key]; ok {
+		return v
+	}
+	return key
+}
+
+var exportLabelCache sync.Map // lang -> exportLabels
+
+// loadExportLabels 加载导出文案，语言文件不存在时使用默认语言
+func loadExportLabels(lang string) exportLabels {
+	if v, ok := exportLabelCache.Load(lang); ok {
+		return v.(exportLabels)
+	}
+	labels := exportLabels{}
+	data, err := os.ReadFile(filepath.Join(exportI18nDir, lang, "export.json"))
+	if err != nil || json.Unmarshal(data, &labels) != nil {
+		if lang != exportLangDefault {
+			return loadExportLabels(exportLangDefault)
+		}
+		return labels
+	}
+	exportLabelCache.Store(lang, labels)
+	return labels
+}
+
+// statementHeader 账单抬头
+type statementHeader struct {
+	UserID      string
+	StartTime   time.Time
+	EndTime     time.Time
+	GeneratedAt time.Time
+}
+
+// statementLine 账单明细行：消费记录或充值订单
+type statementLine struct {
+	Time        time.Time
+	Category    string // free / balance / package / recharge
+	ServiceName string
+	Count       int
+	Amount      float64 // 充值为正，余额扣费为负
+	Reference   string  // 消费记录ID / 充值订单号
+}
+
+// recordLine 消费记录转为明细行
+func recordLine(r *BillingRecord) *statementLine {
+	line := &statementLine{
+		Time:        r.CreatedAt,
+		Category:    r.Type,
+		ServiceName: r.ServiceName,
+		Count:       r.Count,
+		Reference:   r.ID,
+	}
+	if r.Amount != 0 {
+		line.Amount = -r.Amount
+	}
+	return line
+}
+
+// rechargeLine 充值订单转为明细行（按到账时间）
+func rechargeLine(o *RechargeOrder) *statementLine {
+	return &statementLine{
+		Time:      o.UpdatedAt,
+		Category:  exportCategoryRecharge,
+		Amount:    o.Amount,
+		Reference: o.OrderID,
+	}
+}
+
+// statementSummary 账单汇总
+type statementSummary struct {
+	TotalRecharge float64
+	TotalSpend    float64 // 余额扣费合计（正数）
+	FreeCount     int
+	PaidCount     int
+}
+
+func (s *statementSummary) add(line *statementLine) {
+	switch line.Category {
+	case exportCategoryRecharge:
+		s.TotalRecharge += line.Amount
+	case constants.BillingTypeFree:
+		s.FreeCount += line.Count
+	case constants.BillingTypeBalance:
+		s.TotalSpend -= line.Amount
+		s.PaidCount += line.Count
+	case constants.BillingTypePackage:
+		// 用量包已在购买时付费，不计入余额消费
+		s.PaidCount += line.Count
+	case constants.BillingTypeTrueUp:
+		// 合同补差从余额扣除，计入余额消费（不含用量）
+		s.TotalSpend -= line.Amount
+	}
+}
+
+// statementWriter 账单写出器，按 WriteHeader -> WriteLine... -> Close 顺序调用
+type statementWriter interface {
+	WriteHeader(h *statementHeader) error
+	WriteLine(line *statementLine) error
+	Close(s *statementSummary) error
+}
+
+// newStatementWriter 按格式创建账单写出器
+func newStatementWriter(format string, w io.Writer, labels exportLabels, pdfFont string) (statementWriter, error) {
+	switch format {
+	case constants.ExportFormatCSV:
+		return newCSVStatementWriter(w, labels), nil
+	case constants.ExportFormatXLSX:
+		return newXLSXStatementWriter(w, labels)
+	case constants.ExportFormatPDF:
+		return newPDFStatementWriter(w, labels, pdfFont), nil
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
+
+// columns 明细表头
+func (l exportLabels) columns() []string {
+	return []string{
+		l.get("col_time"), l.get("col_category"), l.get("col_service"),
+		l.get("col_count"), l.get("col_amount"), l.get("col_reference"),
+	}
+}
+
+// category 明细行类型文案
+func (l exportLabels) category(category string) string {
+	return l.get("category_" + category)
+}
+
+// period 账单周期文案（结束时间不含）
+func period(h *statementHeader) string {
+	return h.StartTime.Format(exportTimeFormat) + " ~ " + h.EndTime.Format(exportTimeFormat)
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 4, 64)
+}
+
+// ========== CSV ==========
+
+// csvStatementWriter CSV 只包含明细表（便于程序处理），带 UTF-8 BOM 以便 Excel 正确识别中文
+type csvStatementWriter struct {
+	w      io.Writer
+	csv    *csv.Writer
+	labels exportLabels
+}
+
+func newCSVStatementWriter(w io.Writer, labels exportLabels) *csvStatementWriter {
+	return &csvStatementWriter{w: w, csv: csv.NewWriter(w), labels: labels}
+}
+
+func (c *csvStatementWriter) WriteHeader(h *statementHeader) error {
+	if _, err := io.WriteString(c.w, "\ufeff"); err != nil {
+		return err
+	}
+	return c.csv.Write(c.labels.columns())
+}
+
+func (c *csvStatementWriter) WriteLine(line *statementLine) error {
+	return c.csv.Write([]string{
+		line.Time.Format(exportTimeFormat),
+		c.labels.category(line.Category),
+		line.ServiceName,
+		strconv.Itoa(line.Count),
+		formatAmount(line.Amount),
+		line.Reference,
+	})
+}
+
+func (c *csvStatementWriter) Close(s *statementSummary) error {
+	c.csv.Flush()
+	return c.csv.Error()
+}
+
+// ========== XLSX ==========
+
+// xlsxStatementWriter 明细表使用流式写入，汇总信息写在单独的工作表
+type xlsxStatementWriter struct {
+	w           io.Writer
+	file        *excelize.File
+	stream      *excelize.StreamWriter
+	labels      exportLabels
+	header      *statementHeader
+	amountStyle int
+	row         int
+}
+
+func newXLSXStatementWriter(w io.Writer, labels exportLabels) (*xlsxStatementWriter, error) {
+	file := excelize.NewFile()
+	sheet := labels.get("sheet_lines")
+	if err := file.SetSheetName("Sheet1", sheet); err != nil {
+		return nil, err
+	}
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, err
+	}
+	amountFormat := "0.0000"
+	amountStyle, err := file.NewStyle(&excelize.Style{CustomNumFmt: &amountFormat})
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxStatementWriter{w: w, file: file, stream: stream, labels: labels, amountStyle: amountStyle}, nil
+}
+
+func (x *xlsxStatementWriter) WriteHeader(h *statementHeader) error {
+	x.header = h
+	if err := x.stream.SetColWidth(1, 1, 20); err != nil {
+		return err
+	}
+	if err := x.stream.SetColWidth(6, 6, 40); err != nil {
+		return err
+	}
+	columns := x.labels.columns()
+	cells := make([]interface{}, len(columns))
+	for i, col := range columns {
+		cells[i] = excelize.Cell{Value: col}
+	}
+	x.row = 1
+	return x.stream.SetRow("A1", cells)
+}
+
+func (x *xlsxStatementWriter) WriteLine(line *statementLine) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.stream.SetRow(cell, []interface{}{
+		line.Time.Format(exportTimeFormat),
+		x.labels.category(line.Category),
+		line.ServiceName,
+		line.Count,
+		excelize.Cell{StyleID: x.amountStyle, Value: line.Amount},
+		line.Reference,
+	})
+}
+
+func (x *xlsxStatementWriter) Close(s *statementSummary) error {
+	defer x.file.Close()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+
+	sheet := x.labels.get("sheet_summary")
+	if _, err := x.file.NewSheet(sheet); err != nil {
+		return err
+	}
+	rows := [][]interface{}{
+		{x.labels.get("title")},
+		{x.labels.get("user"), x.header.UserID},
+		{x.labels.get("period"), period(x.header)},
+		{x.labels.get("generated_at"), x.header.GeneratedAt.Format(exportTimeFormat)},
+		{},
+		{x.labels.get("total_recharge"), s.TotalRecharge},
+		{x.labels.get("total_spend"), s.TotalSpend},
+		{x.labels.get("free_count"), s.FreeCount},
+		{x.labels.get("paid_count"), s.PaidCount},
+	}
+	for i, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := x.file.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+	if err := x.file.SetCellStyle(sheet, "B6", "B7", x.amountStyle); err != nil {
+		return err
+	}
+	if err := x.file.SetColWidth(sheet, "A", "B", 36); err != nil {
+		return err
+	}
+	return x.file.Write(x.w)
+}
+
+// ========== PDF ==========
+
+// pdfColumnWidths 明细表列宽（mm），A4 纵向去掉左右边距共 190mm
+var pdfColumnWidths = []float64{34, 28, 22, 18, 28, 60}
+
+const (
+	pdfFontFamily = "statement"
+	pdfFontSize   = 8
+	pdfRowHeight  = 5
+	pdfPageBottom = 282 // A4 高 297mm，留出下边距
+)
+
+// pdfStatementWriter PDF 在内存中生成，明细表跨页时重复表头
+type pdfStatementWriter struct {
+	w      io.Writer
+	pdf    *gofpdf.Fpdf
+	labels exportLabels
+	font   string
+}
+
+func newPDFStatementWriter(w io.Writer, labels exportLabels, fontPath string) *pdfStatementWriter {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(10, 10, 10)
+	pdf.SetAutoPageBreak(false, 0)
+	font := "Helvetica"
+	if fontPath != "" {
+		pdf.AddUTF8Font(pdfFontFamily, "", fontPath)
+		font = pdfFontFamily
+	}
+	return &pdfStatementWriter{w: w, pdf: pdf, labels: labels, font: font}
+}
+
+func (p *pdfStatementWriter) WriteHeader(h *statementHeader) error {
+	p.pdf.AddPage()
+	p.pdf.SetFont(p.font, "", 14)
+	p.pdf.CellFormat(0, 10, p.labels.get("title"), "", 1, "C", false, 0, "")
+	p.pdf.SetFont(p.font, "", 9)
+	for _, kv := range [][2]string{
+		{p.labels.get("user"), h.UserID},
+		{p.labels.get("period"), period(h)},
+		{p.labels.get("generated_at"), h.GeneratedAt.Format(exportTimeFormat)},
+	} {
+		p.pdf.CellFormat(0, 6, kv[0]+": "+kv[1], "", 1, "L", false, 0, "")
+	}
+	p.pdf.Ln(2)
+	p.tableHeader()
+	return p.pdf.Error()
+}
+
+func (p *pdfStatementWriter) tableHeader() {
+	p.pdf.SetFont(p.font, "", pdfFontSize)
+	p.pdf.SetFillColor(230, 230, 230)
+	for i, col := range p.labels.columns() {
+		p.pdf.CellFormat(pdfColumnWidths[i], pdfRowHeight+1, col, "1", 0, "C", true, 0, "")
+	}
+	p.pdf.Ln(-1)
+}
+
+func (p *pdfStatementWriter) WriteLine(line *statementLine) error {
+	if p.pdf.GetY()+pdfRowHeight > pdfPageBottom {
+		p.pdf.AddPage()
+		p.tableHeader()
+	}
+	cells := []string{
+		line.Time.Format(exportTimeFormat),
+		p.labels.category(line.Category),
+		line.ServiceName,
+		strconv.Itoa(line.Count),
+		formatAmount(line.Amount),
+		line.Reference,
+	}
+	for i, cell := range cells {
+		align := "L"
+		if i == 3 || i == 4 {
+			align = "R"
+		}
+		p.pdf.CellFormat(pdfColumnWidths[i], pdfRowHeight, cell, "1", 0, align, false, 0, "")
+	}
+	p.pdf.Ln(-1)
+	return p.pdf.Error()
+}
+
+func (p *pdfStatementWriter) Close(s *statementSummary) error {
+	if p.pdf.GetY()+6*5 > pdfPageBottom {
+		p.pdf.AddPage()
+	}
+	p.pdf.Ln(4)
+	p.pdf.SetFont(p.font, "", 10)
+	p.pdf.CellFormat(0, 7, p.labels.get("summary"), "", 1, "L", false, 0, "")
+	p.pdf.SetFont(p.font, "", 9)
+	for _, kv := range [][2]string{
+		{p.labels.get("total_recharge"), formatAmount(s.TotalRecharge)},
+		{p.labels.get("total_spend"), formatAmount(s.TotalSpend)},
+		{p.labels.get("free_count"), strconv.Itoa(s.FreeCount)},
+		{p.labels.get("paid_count"), strconv.Itoa(s.PaidCount)},
+	} {
+		p.pdf.CellFormat(0, 6, kv[0]+": "+kv[1], "", 1, "L", false, 0, "")
+	}
+	return p.pdf.Output(p.w)
+}
@@ -0,0 +1,203 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/xuri/excelize/v2"
+)
+
+// fakeExportRepo 固定的消费记录与充值订单，消费记录每批返回两条
+type fakeExportRepo struct {
+	ExportRepo
+	records   []*BillingRecord
+	recharges []*RechargeOrder
+}
+
+func (r *fakeExportRepo) ScanStatementRecords(_ context.Context, _ string, _, _ time.Time, _ int, fn func([]*BillingRecord) error) error {
+	for i := 0; i < len(r.records); i += 2 {
+		if err := fn(r.records[i:min(i+2, len(r.records))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeExportRepo) ListStatementRecharges(context.Context, string, time.Time, time.Time) ([]*RechargeOrder, error) {
+	return r.recharges, nil
+}
+
+// newTestExportUseCase 从仓库根目录读取导出文案
+func newTestExportUseCase(t *testing.T) *ExportUseCase {
+	t.Helper()
+	t.Chdir("../..")
+	at := func(hour, minute int) time.Time { return time.Date(2025, 11, 5, hour, minute, 0, 0, time.UTC) }
+	repo := &fakeExportRepo{
+		records: []*BillingRecord{
+			{ID: "rec-free", ServiceName: "passport", Type: constants.BillingTypeFree, Count: 3, CreatedAt: at(10, 0)},
+			{ID: "rec-balance", ServiceName: "passport", Type: constants.BillingTypeBalance, Count: 2, Amount: 0.5, CreatedAt: at(10, 5)},
+			{ID: "rec-package", ServiceName: "asset", Type: constants.BillingTypePackage, Count: 4, CreatedAt: at(10, 10)},
+			{ID: "rec-true-up", Type: constants.BillingTypeTrueUp, Amount: 10, CreatedAt: at(10, 20)},
+		},
+		recharges: []*RechargeOrder{
+			{OrderID: "order-1", Amount: 100, UpdatedAt: at(9, 0)},
+			{OrderID: "order-2", Amount: 30, UpdatedAt: at(10, 5)}, // 与消费记录同时，排在消费记录之后
+			{OrderID: "order-3", Amount: 20, UpdatedAt: at(11, 0)},
+		},
+	}
+	return &ExportUseCase{repo: repo, conf: &BillingConfig{}, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func newTestExportJob(format, lang string) *ExportJob {
+	return &ExportJob{
+		ExportID:  "exp-1",
+		UserID:    "u1",
+		Format:    format,
+		Lang:      lang,
+		StartTime: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// TestExportRenderCSV 消费记录与充值订单按时间正序合并，余额扣费金额为负，表头与类型按导出语言
+func TestExportRenderCSV(t *testing.T) {
+	uc := newTestExportUseCase(t)
+	var buf bytes.Buffer
+	rows, err := uc.render(context.Background(), newTestExportJob(constants.ExportFormatCSV, exportLangEnglish), &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows != 7 {
+		t.Errorf("rows = %d, want 7", rows)
+	}
+
+	body, ok := strings.CutPrefix(buf.String(), "\ufeff")
+	if !ok {
+		t.Fatal("csv without UTF-8 BOM")
+	}
+	lines, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"Time", "Type", "Service", "Usage", "Amount (CNY)", "Reference"},
+		{"2025-11-05 09:00:00", "Recharge", "", "0", "100.0000", "order-1"},
+		{"2025-11-05 10:00:00", "Free quota", "passport", "3", "0.0000", "rec-free"},
+		{"2025-11-05 10:05:00", "Balance deduction", "passport", "2", "-0.5000", "rec-balance"},
+		{"2025-11-05 10:05:00", "Recharge", "", "0", "30.0000", "order-2"},
+		{"2025-11-05 10:10:00", "Usage package", "asset", "4", "0.0000", "rec-package"},
+		{"2025-11-05 10:20:00", "Contract true-up", "", "0", "-10.0000", "rec-true-up"},
+		{"2025-11-05 11:00:00", "Recharge", "", "0", "20.0000", "order-3"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("csv lines = %d, want %d:\n%v", len(lines), len(want), lines)
+	}
+	for i := range want {
+		if !slices.Equal(lines[i], want[i]) {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+// TestExportRenderXLSX 明细表与 CSV 一致，汇总表中余额消费包含合同补差，用量包计入付费用量
+func TestExportRenderXLSX(t *testing.T) {
+	uc := newTestExportUseCase(t)
+	var buf bytes.Buffer
+	if _, err := uc.render(context.Background(), newTestExportJob(constants.ExportFormatXLSX, exportLangEnglish), &buf); err != nil {
+		t.Fatal(err)
+	}
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if sheets := f.GetSheetList(); !slices.Equal(sheets, []string{"Details", "Summary"}) {
+		t.Fatalf("sheets = %v", sheets)
+	}
+	details, err := f.GetRows("Details")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(details) != 8 || details[3][5] != "rec-balance" || details[3][4] != "-0.5000" {
+		t.Errorf("details = %v", details)
+	}
+
+	summary, err := f.GetRows("Summary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, row := range summary {
+		if len(row) == 2 {
+			got[row[0]] = row[1]
+		}
+	}
+	want := map[string]string{
+		"User ID":                           "u1",
+		"Period":                            "2025-11-01 00:00:00 ~ 2025-12-01 00:00:00",
+		"Total recharged (CNY)":             "150.0000",
+		"Total deducted from balance (CNY)": "10.5000",
+		"Free usage":                        "3",
+		"Paid usage":                        "6",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("summary %s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+// TestExportRenderPDF 明细跨页时分页；未配置字体时中文导出使用英文模板生成
+func TestExportRenderPDF(t *testing.T) {
+	uc := newTestExportUseCase(t)
+	repo := uc.repo.(*fakeExportRepo)
+	base := time.Date(2025, 11, 6, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 120; i++ {
+		repo.records = append(repo.records, &BillingRecord{ID: fmt.Sprintf("rec-%d", i), ServiceName: "passport", Type: constants.BillingTypeBalance, Count: 1, Amount: 0.1, CreatedAt: base.Add(time.Duration(i) * time.Minute)})
+	}
+
+	for _, lang := range []string{exportLangEnglish, exportLangDefault} {
+		var buf bytes.Buffer
+		rows, err := uc.render(context.Background(), newTestExportJob(constants.ExportFormatPDF, lang), &buf)
+		if err != nil {
+			t.Fatalf("%s: %v", lang, err)
+		}
+		if rows != 127 || !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+			t.Errorf("%s: rows = %d, prefix = %q", lang, rows, buf.Bytes()[:min(8, buf.Len())])
+		}
+		if pages := bytes.Count(buf.Bytes(), []byte("/Type /Page\n")); pages < 3 {
+			t.Errorf("%s: pages = %d, want at least 3", lang, pages)
+		}
+	}
+}
+
+// TestLoadExportLabels 按语言加载文案，未知语言使用默认语言，缺失的文案返回 key
+func TestLoadExportLabels(t *testing.T) {
+	t.Chdir("../..")
+	if got := loadExportLabels(exportLangEnglish).get("col_time"); got != "Time" {
+		t.Errorf("en-US col_time = %q", got)
+	}
+	zh := loadExportLabels(exportLangDefault).get("col_time")
+	if zh == "col_time" || zh == "Time" {
+		t.Errorf("zh-CN col_time = %q", zh)
+	}
+	if got := loadExportLabels("fr-FR").get("col_time"); got != zh {
+		t.Errorf("unknown language col_time = %q, want default %q", got, zh)
+	}
+	if got := loadExportLabels(exportLangEnglish).get("missing"); got != "missing" {
+		t.Errorf("missing label = %q", got)
+	}
+	if _, err := newStatementWriter("docx", &bytes.Buffer{}, exportLabels{}, ""); err == nil {
+		t.Error("unsupported format: want error")
+	}
+}
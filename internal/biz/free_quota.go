@@ -2,22 +2,47 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-// FreeQuota 免费额度领域对象
+// FreeQuota 免费额度领域对象，每个用户每个服务每个周期一条
+// 上期结转的额度（RolloverQuota）计入 TotalQuota，作为单独的额度在本周期结束时过期，扣费时优先使用
 type FreeQuota struct {
-	UID         string
-	ServiceName string
-	TotalQuota  int
-	UsedQuota   int
-	ResetMonth  string
+	UID           string
+	ServiceName   string
+	TotalQuota    int // 本周期可用的总额度（含结转部分）
+	UsedQuota     int
+	RolloverQuota int       // 上期结转的额度
+	Period        string    // 周期标识（见 BillingPeriod.Key）
+	Cycle         string    // 周期类型
+	PeriodStart   time.Time // 周期开始时间（含）
+	PeriodEnd     time.Time // 周期结束时间（不含），结转额度同时过期
+}
+
+// RolloverUsed 已使用的结转额度（已用额度优先计入结转部分）
+func (q *FreeQuota) RolloverUsed() int {
+	return min(q.UsedQuota, q.RolloverQuota)
+}
+
+// RolloverEligible 可结转到下一个周期的额度：本周期发放部分的未用额度按 percent 百分比向下取整
+// 结转额度只保留一个周期，本身不再结转
+func (q *FreeQuota) RolloverEligible(percent float64) int {
+	if percent <= 0 {
+		return 0
+	}
+	allowance := q.TotalQuota - q.RolloverQuota
+	unused := allowance - (q.UsedQuota - q.RolloverUsed())
+	if unused <= 0 {
+		return 0
+	}
+	return int(float64(unused) * percent / 100)
 }
 
 // FreeQuotaRepo 免费额度数据层接口（定义在 biz 层）
 type FreeQuotaRepo interface {
-	GetFreeQuota(ctx context.Context, userID, serviceName, month string) (*FreeQuota, error)
+	GetFreeQuota(ctx context.Context, userID, serviceName, period string) (*FreeQuota, error)
 	CreateFreeQuota(ctx context.Context, quota *FreeQuota) error
 	UpdateFreeQuota(ctx context.Context, quota *FreeQuota) error
 }
@@ -38,9 +63,9 @@ func NewFreeQuotaUseCase(repo FreeQuotaRepo, conf *BillingConfig, logger log.Log
 	}
 }
 
-// GetQuota 获取免费额度
-func (uc *FreeQuotaUseCase) GetQuota(ctx context.Context, userID, serviceName, month string) (*FreeQuota, error) {
-	return uc.repo.GetFreeQuota(ctx, userID, serviceName, month)
+// GetQuota 获取指定周期的免费额度
+func (uc *FreeQuotaUseCase) GetQuota(ctx context.Context, userID, serviceName, period string) (*FreeQuota, error) {
+	return uc.repo.GetFreeQuota(ctx, userID, serviceName, period)
 }
 
 // CreateQuota 创建免费额度
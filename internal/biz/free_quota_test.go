@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeFreeQuotaRepo 按 服务/周期 保存的额度记录
+type fakeFreeQuotaRepo struct {
+	quotas map[string]*FreeQuota
+}
+
+func (r *fakeFreeQuotaRepo) GetFreeQuota(_ context.Context, _, serviceName, period string) (*FreeQuota, error) {
+	return r.quotas[serviceName+"/"+period], nil
+}
+
+func (r *fakeFreeQuotaRepo) CreateFreeQuota(_ context.Context, quota *FreeQuota) error {
+	r.quotas[quota.ServiceName+"/"+quota.Period] = quota
+	return nil
+}
+
+func (r *fakeFreeQuotaRepo) UpdateFreeQuota(_ context.Context, quota *FreeQuota) error {
+	r.quotas[quota.ServiceName+"/"+quota.Period] = quota
+	return nil
+}
+
+// newTestRolloverUseCase 个人账户，按月周期，pro 套餐结转 50%，free（默认）套餐不结转
+func newTestRolloverUseCase(plan string, quotas map[string]*FreeQuota) *BillingUseCase {
+	config := &BillingConfig{
+		FreeQuotas: map[string]int32{"passport": 100},
+		QuotaPeriod: QuotaPeriodConfig{
+			DefaultCycle: constants.QuotaCycleMonthly,
+			Rollover:     map[string]float64{"pro": 50},
+		},
+		Location: time.UTC,
+	}
+	rateLimitUseCase := newTestRateLimitUseCase(&fakeRateLimitRepo{user: &UserRateLimit{Plan: plan}})
+	return &BillingUseCase{
+		freeQuotaUseCase: NewFreeQuotaUseCase(&fakeFreeQuotaRepo{quotas: quotas}, config, log.DefaultLogger),
+		periodUseCase:    NewPeriodUseCase(&fakeAccountSettingRepo{}, rateLimitUseCase, config, log.DefaultLogger),
+		orgUseCase:       newTestOrgUseCase(newFakeOrgRepo(), 10),
+		conf:             config,
+		log:              log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// TestFreeQuotaRollover 已用额度优先计入结转部分；只有本周期发放部分的未用额度按百分比向下取整结转
+func TestFreeQuotaRollover(t *testing.T) {
+	cases := []struct {
+		name         string
+		quota        FreeQuota
+		percent      float64
+		wantUsed     int
+		wantEligible int
+	}{
+		{"no rollover", FreeQuota{TotalQuota: 100, UsedQuota: 30}, 50, 0, 35},
+		{"rounds down", FreeQuota{TotalQuota: 100, UsedQuota: 33}, 50, 0, 33},
+		{"full rollover", FreeQuota{TotalQuota: 100}, 100, 0, 100},
+		{"disabled", FreeQuota{TotalQuota: 100, UsedQuota: 30}, 0, 0, 0},
+		{"used within rollover", FreeQuota{TotalQuota: 135, RolloverQuota: 35, UsedQuota: 20}, 50, 20, 50},
+		{"used beyond rollover", FreeQuota{TotalQuota: 135, RolloverQuota: 35, UsedQuota: 50}, 50, 35, 42},
+		{"rollover not carried again", FreeQuota{TotalQuota: 135, RolloverQuota: 35}, 100, 0, 100},
+		{"exhausted", FreeQuota{TotalQuota: 135, RolloverQuota: 35, UsedQuota: 135}, 50, 35, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.quota.RolloverUsed(); got != tc.wantUsed {
+				t.Errorf("rollover used = %d, want %d", got, tc.wantUsed)
+			}
+			if got := tc.quota.RolloverEligible(tc.percent); got != tc.wantEligible {
+				t.Errorf("rollover eligible = %d, want %d", got, tc.wantEligible)
+			}
+		})
+	}
+}
+
+// TestGetOrCreateQuotaRollover 新周期的额度记录包含上一个周期的结转额度，结转额度计入总额度；
+// 套餐未配置结转、上一个周期没有记录或服务未配置免费额度时不结转
+func TestGetOrCreateQuotaRollover(t *testing.T) {
+	ctx := context.Background()
+	november := PeriodCalculator{}.Month(time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC))
+	previous := func(used, rollover int) map[string]*FreeQuota {
+		return map[string]*FreeQuota{"passport/2025-10": {UID: "u1", ServiceName: "passport", TotalQuota: 100 + rollover, UsedQuota: used, RolloverQuota: rollover, Period: "2025-10"}}
+	}
+
+	cases := []struct {
+		name         string
+		plan         string
+		quotas       map[string]*FreeQuota
+		wantTotal    int
+		wantRollover int
+	}{
+		{"rollover plan", "pro", previous(30, 0), 135, 35},
+		{"previous rollover used first", "pro", previous(50, 35), 142, 42},
+		{"previous period exhausted", "pro", previous(135, 35), 100, 0},
+		{"no previous period", "pro", map[string]*FreeQuota{}, 100, 0},
+		{"plan without rollover", "", previous(30, 0), 100, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestRolloverUseCase(tc.plan, tc.quotas)
+			quota, err := uc.getOrCreateQuota(ctx, "u1", "passport", november)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if quota.TotalQuota != tc.wantTotal || quota.RolloverQuota != tc.wantRollover || quota.UsedQuota != 0 || quota.Period != "2025-11" {
+				t.Errorf("quota = %+v, want total %d with rollover %d", *quota, tc.wantTotal, tc.wantRollover)
+			}
+			if tc.quotas["passport/2025-11"] != quota {
+				t.Error("quota record not created")
+			}
+
+			// 已存在的记录直接返回，不重新计算结转
+			tc.quotas["passport/2025-11"].UsedQuota = 7
+			again, err := uc.getOrCreateQuota(ctx, "u1", "passport", november)
+			if err != nil || again.UsedQuota != 7 || again.TotalQuota != tc.wantTotal {
+				t.Errorf("existing quota = %+v, err = %v", again, err)
+			}
+		})
+	}
+
+	quotas := map[string]*FreeQuota{}
+	quota, err := newTestRolloverUseCase("pro", quotas).getOrCreateQuota(ctx, "u1", "ocr", november)
+	if err != nil || quota != nil || len(quotas) != 0 {
+		t.Errorf("service without free quota: quota = %+v, err = %v, records = %d", quota, err, len(quotas))
+	}
+}
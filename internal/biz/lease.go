@@ -0,0 +1,284 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// reclaimBatchSize 每轮最多回收的过期租约数
+const reclaimBatchSize = 100
+
+// callerContextKey 内部调用方名称的 context key
+type callerContextKey struct{}
+
+// NewCallerContext 记录已认证的内部调用方（服务令牌的 iss），由内部接口鉴权中间件设置
+func NewCallerContext(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext 获取内部调用方名称，未认证时为空
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerContextKey{}).(string)
+	return caller
+}
+
+// Lease 额度租约领域对象
+// 网关申请租约后在有效期内本地放行，授予的次数在申请时即按免费额度 → 用量包 → 余额的顺序预留（计入在途扣费），
+// 上报的用量转为正常扣费事件落库，未用部分在释放或过期回收时归还
+type Lease struct {
+	LeaseID        string
+	UserID         string  // 扣费账户，组织成员申请时为组织ID
+	MemberID       string  // 组织账户中申请租约的成员，个人账户为空
+	SpendLimit     float64 // 申请时成员的每月消费上限，只用于预留，不保存在租约中
+	Caller         string  // 申请租约的内部调用方，上报与释放时须为同一调用方
+	ServiceName    string
+	Period         string        // 额度周期标识
+	BudgetMonth    BillingPeriod // 预算月份，租约只保存 Key（释放时扣回预算消费缓存）
+	UnitPrice      float64
+	FreeGranted    int // 占用免费额度的次数
+	PackageGranted int // 占用用量包的次数
+	PaidGranted    int // 占用余额的次数
+	FreeUsed       int
+	PackageUsed    int
+	PaidUsed       int
+	ExpiresAt      time.Time
+}
+
+// Payer 租约的扣费账户
+func (l *Lease) Payer() Payer {
+	return Payer{AccountID: l.UserID, MemberID: l.MemberID, SpendLimit: l.SpendLimit}
+}
+
+// Granted 授予的总次数
+func (l *Lease) Granted() int {
+	return l.FreeGranted + l.PackageGranted + l.PaidGranted
+}
+
+// Remaining 剩余可用次数
+func (l *Lease) Remaining() int {
+	return l.Granted() - l.FreeUsed - l.PackageUsed - l.PaidUsed
+}
+
+// LeaseOwner 上报/释放租约的调用方与服务，须与申请时一致，否则按租约不存在处理
+type LeaseOwner struct {
+	Caller      string
+	ServiceName string
+}
+
+// LeaseRepo 额度租约数据层接口（定义在 biz 层）
+type LeaseRepo interface {
+	// AcquireLease 预留额度，授予次数写回 lease；可授予次数为 0 时返回余额不足
+	AcquireLease(ctx context.Context, lease *Lease, count int) error
+	// ReportLeaseUsage 记录增量用量并生成扣费，renewUntil 非零时续期
+	ReportLeaseUsage(ctx context.Context, leaseID string, owner LeaseOwner, used int, renewUntil time.Time) (*Lease, error)
+	// ReleaseLease 删除租约并归还未用部分，返回释放前的租约；owner 为 nil 时不校验（过期回收）
+	ReleaseLease(ctx context.Context, leaseID string, owner *LeaseOwner) (*Lease, error)
+	// ListExpiredLeases 获取过期时间早于 before 的租约ID
+	ListExpiredLeases(ctx context.Context, before time.Time, limit int) ([]string, error)
+}
+
+// LeaseConfig 额度租约配置
+type LeaseConfig struct {
+	MaxCount        int           // 单个租约最多申请的调用次数
+	DefaultTTL      time.Duration // 默认租约时长
+	MaxTTL          time.Duration // 租约时长上限
+	ReclaimGrace    time.Duration // 过期后等待最终用量上报的宽限期
+	ReclaimInterval time.Duration // 过期租约扫描间隔
+}
+
+// LeaseUseCase 额度租约业务逻辑
+type LeaseUseCase struct {
+	repo LeaseRepo
+	conf *BillingConfig
+	log  *log.Helper
+}
+
+// NewLeaseUseCase 创建额度租约 UseCase
+func NewLeaseUseCase(repo LeaseRepo, conf *BillingConfig, logger log.Logger) *LeaseUseCase {
+	return &LeaseUseCase{
+		repo: repo,
+		conf: conf,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ttl 计算租约时长：未指定时使用默认值，不超过上限
+func (uc *LeaseUseCase) ttl(ttlSeconds int) time.Duration {
+	if ttlSeconds <= 0 {
+		return uc.conf.Lease.DefaultTTL
+	}
+	return min(time.Duration(ttlSeconds)*time.Second, uc.conf.Lease.MaxTTL)
+}
+
+// Acquire 申请租约
+func (uc *LeaseUseCase) Acquire(ctx context.Context, payer Payer, serviceName string, periods DeductPeriods, unitPrice float64, count, ttlSeconds int) (*Lease, error) {
+	lease := &Lease{
+		LeaseID:     uuid.New().String(),
+		UserID:      payer.AccountID,
+		MemberID:    payer.MemberID,
+		SpendLimit:  payer.SpendLimit,
+		Caller:      CallerFromContext(ctx),
+		ServiceName: serviceName,
+		Period:      periods.Quota.Key,
+		BudgetMonth: periods.BudgetMonth,
+		UnitPrice:   unitPrice,
+		ExpiresAt:   time.Now().Add(uc.ttl(ttlSeconds)),
+	}
+	if err := uc.repo.AcquireLease(ctx, lease, min(count, uc.conf.Lease.MaxCount)); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// Report 上报增量用量，renew 为 true 时续期
+func (uc *LeaseUseCase) Report(ctx context.Context, leaseID, serviceName string, used int, renew bool, ttlSeconds int) (*Lease, error) {
+	var renewUntil time.Time
+	if renew {
+		renewUntil = time.Now().Add(uc.ttl(ttlSeconds))
+	}
+	return uc.repo.ReportLeaseUsage(ctx, leaseID, leaseOwner(ctx, serviceName), used, renewUntil)
+}
+
+// Release 上报最终用量并释放租约，返回的租约中 Remaining 即归还的次数
+func (uc *LeaseUseCase) Release(ctx context.Context, leaseID, serviceName string, used int) (*Lease, error) {
+	owner := leaseOwner(ctx, serviceName)
+	if used > 0 {
+		if _, err := uc.repo.ReportLeaseUsage(ctx, leaseID, owner, used, time.Time{}); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.ReleaseLease(ctx, leaseID, &owner)
+}
+
+// leaseOwner 当前调用方
+func leaseOwner(ctx context.Context, serviceName string) LeaseOwner {
+	return LeaseOwner{Caller: CallerFromContext(ctx), ServiceName: serviceName}
+}
+
+// ReclaimExpired 回收过期（超过宽限期）的租约，返回本轮回收的租约
+func (uc *LeaseUseCase) ReclaimExpired(ctx context.Context) ([]*Lease, error) {
+	leaseIDs, err := uc.repo.ListExpiredLeases(ctx, time.Now().Add(-uc.conf.Lease.ReclaimGrace), reclaimBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var reclaimed []*Lease
+	for _, leaseID := range leaseIDs {
+		lease, err := uc.repo.ReleaseLease(ctx, leaseID, nil)
+		if err != nil {
+			// 已被网关释放或其他实例回收
+			if kratosErrors.FromError(err).Code == billingErrors.ErrCodeLeaseNotFound {
+				continue
+			}
+			return reclaimed, err
+		}
+		uc.log.Infof("Lease reclaimed: lease_id=%s, user_id=%s, service=%s, unused=%d",
+			lease.LeaseID, lease.UserID, lease.ServiceName, lease.Remaining())
+		reclaimed = append(reclaimed, lease)
+	}
+	return reclaimed, nil
+}
+
+// AcquireLease 申请额度租约（确保当月免费额度记录存在后预留），组织成员从组织账户预留
+// 租约不检查频率限制：租约内的调用由网关本地放行，不经过 CheckQuota，需要频率限制的服务由网关在本地限流
+func (uc *BillingUseCase) AcquireLease(ctx context.Context, userID, serviceName string, count, ttlSeconds int) (*Lease, error) {
+	if userID == "" || serviceName == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if count <= 0 {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidLeaseCount)
+	}
+	now := time.Now()
+	payer, err := uc.orgUseCase.Payer(ctx, userID)
+	if err != nil {
+		uc.recordLeaseOperation(constants.LeaseOperationAcquire, err)
+		return nil, err
+	}
+
+	// 租约内的用量按申请时的适用单价计费
+	rate, err := uc.ratingUseCase.Rate(ctx, payer.AccountID, serviceName, now)
+	if err != nil {
+		uc.recordLeaseOperation(constants.LeaseOperationAcquire, err)
+		return nil, err
+	}
+
+	periods, err := uc.periodUseCase.DeductPeriods(ctx, payer.AccountID, serviceName, now)
+	if err != nil {
+		uc.recordLeaseOperation(constants.LeaseOperationAcquire, err)
+		return nil, err
+	}
+	if _, err := uc.getOrCreateQuota(ctx, payer.AccountID, serviceName, periods.Quota); err != nil {
+		uc.recordLeaseOperation(constants.LeaseOperationAcquire, err)
+		return nil, err
+	}
+
+	lease, err := uc.leaseUseCase.Acquire(ctx, payer, serviceName, periods, rate.UnitPrice, count, ttlSeconds)
+	uc.recordLeaseOperation(constants.LeaseOperationAcquire, err)
+	if err != nil {
+		return nil, err
+	}
+	if uc.metrics != nil {
+		uc.metrics.LeaseGrantedCount.WithLabelValues(serviceName).Add(float64(lease.Granted()))
+	}
+	return lease, nil
+}
+
+// ReportLeaseUsage 上报租约用量，serviceName 须与申请时一致
+func (uc *BillingUseCase) ReportLeaseUsage(ctx context.Context, leaseID, serviceName string, used int, renew bool, ttlSeconds int) (*Lease, error) {
+	if leaseID == "" || serviceName == "" || used < 0 {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	lease, err := uc.leaseUseCase.Report(ctx, leaseID, serviceName, used, renew, ttlSeconds)
+	uc.recordLeaseOperation(constants.LeaseOperationReport, err)
+	return lease, err
+}
+
+// ReleaseLease 释放租约，返回归还的次数；serviceName 须与申请时一致
+func (uc *BillingUseCase) ReleaseLease(ctx context.Context, leaseID, serviceName string, used int) (int, error) {
+	if leaseID == "" || serviceName == "" || used < 0 {
+		return 0, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	lease, err := uc.leaseUseCase.Release(ctx, leaseID, serviceName, used)
+	uc.recordLeaseOperation(constants.LeaseOperationRelease, err)
+	if err != nil {
+		return 0, err
+	}
+	if uc.metrics != nil {
+		uc.metrics.LeaseReclaimedCount.WithLabelValues(lease.ServiceName).Add(float64(lease.Remaining()))
+	}
+	return lease.Remaining(), nil
+}
+
+// ReclaimExpiredLeases 回收过期租约的未用部分
+// 由 LeaseReclaimServer 定时调用
+func (uc *BillingUseCase) ReclaimExpiredLeases(ctx context.Context) (int, error) {
+	leases, err := uc.leaseUseCase.ReclaimExpired(ctx)
+	if len(leases) > 0 || err != nil {
+		uc.recordLeaseOperation(constants.LeaseOperationReclaim, err)
+	}
+	if uc.metrics != nil {
+		for _, lease := range leases {
+			uc.metrics.LeaseReclaimedCount.WithLabelValues(lease.ServiceName).Add(float64(lease.Remaining()))
+		}
+	}
+	return len(leases), err
+}
+
+func (uc *BillingUseCase) recordLeaseOperation(operation string, err error) {
+	if uc.metrics == nil {
+		return
+	}
+	result := constants.OrderStatusSuccess
+	if err != nil {
+		result = constants.OrderStatusFailed
+	}
+	uc.metrics.LeaseOperationTotal.WithLabelValues(operation, result).Inc()
+}
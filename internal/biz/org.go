@@ -0,0 +1,458 @@
+package biz
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OrganizationConfig 组织配置
+type OrganizationConfig struct {
+	MaxMembers         int           // 单个组织的成员数上限（含所有者）
+	MembershipCacheTTL time.Duration // 用户组织成员身份缓存时间
+}
+
+// Organization 组织：成员共享组织钱包
+// 组织ID即扣费账户ID，余额、免费额度、用量包、预算、价格规则等都按组织ID存储
+type Organization struct {
+	ID        string
+	Name      string
+	OwnerID   string
+	CreatedAt time.Time
+}
+
+// OrgMember 组织成员
+// 添加的成员为邀请状态，用户接受后才由组织支付、取得组织权限并计入共享免费额度
+type OrgMember struct {
+	OrgID      string    `json:"orgId"`
+	UserID     string    `json:"-"`
+	Role       string    `json:"role"`                 // owner / admin / member
+	Status     string    `json:"status"`               // invited / active
+	SpendLimit float64   `json:"spendLimit,omitempty"` // 每月余额消费上限，0 表示不限制
+	Spent      float64   `json:"-"`                    // 本月余额消费（查询时填写）
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// IsActive 是否已加入组织（已接受邀请）
+func (m *OrgMember) IsActive() bool {
+	return m.Status == constants.OrgMemberStatusActive
+}
+
+// IsManager 是否可管理组织（所有者或管理员）
+func (m *OrgMember) IsManager() bool {
+	return m.Role == constants.OrgRoleOwner || m.Role == constants.OrgRoleAdmin
+}
+
+// Payer 扣费账户：组织成员的用量由组织钱包支付，消费记录保留实际使用的成员
+type Payer struct {
+	AccountID  string  // 扣费账户（组织ID，非组织成员时为用户ID）
+	MemberID   string  // 实际使用的成员，非组织成员时为空
+	SpendLimit float64 // 成员每月余额消费上限，0 表示不限制
+}
+
+// AccountScope 账户查询范围（GetAccount、统计、消费记录）
+type AccountScope struct {
+	AccountID string     // 查询的账户（组织ID或用户ID）
+	MemberID  string     // 非空时只统计该成员在组织账户中的用量
+	Member    *OrgMember // 按成员查询时为该成员（含本月消费）
+}
+
+// OrgRepo 组织数据层接口（定义在 biz 层）
+type OrgRepo interface {
+	// CreateOrganization 创建组织（生成ID）并将所有者加入为成员，所有者已属于其他组织时返回 ErrCodeOrgMemberExists，
+	// 所有者尚未接受的邀请视为拒绝
+	CreateOrganization(ctx context.Context, org *Organization) error
+	// GetOrganization 获取组织，不存在时返回 nil
+	GetOrganization(ctx context.Context, orgID string) (*Organization, error)
+	// GetMembership 获取用户的组织成员身份（带缓存，含未接受的邀请），不属于任何组织时返回 nil
+	GetMembership(ctx context.Context, userID string) (*OrgMember, error)
+	// ListMembers 获取组织成员（含未接受的邀请），按加入时间正序
+	ListMembers(ctx context.Context, orgID string) ([]*OrgMember, error)
+	// CountMembers 组织已加入的成员数（不含未接受的邀请），orgID 不是组织时为 0
+	CountMembers(ctx context.Context, orgID string) (int, error)
+	// AddMember 添加成员（按 member.Status 保存）；成员数（含未接受的邀请）达到 maxMembers 时返回 ErrCodeOrgMemberCountExceeded，
+	// 用户已属于某个组织或已被邀请时返回 ErrCodeOrgMemberExists
+	AddMember(ctx context.Context, member *OrgMember, maxMembers int) error
+	// AcceptInvitation 接受邀请并失效缓存，用户没有该组织的待接受邀请时返回 false
+	AcceptInvitation(ctx context.Context, orgID, userID string) (bool, error)
+	// UpdateMember 更新成员角色与消费上限并失效缓存，成员不存在时返回 false
+	UpdateMember(ctx context.Context, member *OrgMember) (bool, error)
+	// RemoveMember 移除成员并失效缓存，成员不存在时返回 false
+	RemoveMember(ctx context.Context, orgID, userID string) (bool, error)
+	// GetMemberSpent 成员在 [start, end) 内记入组织账户的余额消费（已落库部分），memberIDs 为空表示全部成员
+	GetMemberSpent(ctx context.Context, orgID string, start, end time.Time, memberIDs ...string) (map[string]float64, error)
+	// ExceedsSpendLimit 成员在预算月份内的余额消费（已落库 + 在途）加上本次金额 cost 是否超出上限 limit，优先读缓存
+	ExceedsSpendLimit(ctx context.Context, orgID, memberID string, month BillingPeriod, limit, cost float64) (bool, error)
+}
+
+// OrgUseCase 组织业务逻辑
+type OrgUseCase struct {
+	repo          OrgRepo
+	periodUseCase *PeriodUseCase
+	conf          *BillingConfig
+	log           *log.Helper
+}
+
+// NewOrgUseCase 创建组织 UseCase
+func NewOrgUseCase(repo OrgRepo, periodUseCase *PeriodUseCase, conf *BillingConfig, logger log.Logger) *OrgUseCase {
+	return &OrgUseCase{
+		repo:          repo,
+		periodUseCase: periodUseCase,
+		conf:          conf,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// Payer 确定用户用量的扣费账户：已加入的组织成员由组织支付，否则（含尚未接受邀请）由用户本人支付
+func (uc *OrgUseCase) Payer(ctx context.Context, userID string) (Payer, error) {
+	member, err := uc.repo.GetMembership(ctx, userID)
+	if err != nil {
+		return Payer{}, err
+	}
+	if member == nil || !member.IsActive() {
+		return Payer{AccountID: userID}, nil
+	}
+	return Payer{AccountID: member.OrgID, MemberID: userID, SpendLimit: member.SpendLimit}, nil
+}
+
+// ExceedsSpendLimit 成员本月（组织账户时区的自然月）余额消费加上本次余额费用 cost 后是否超出上限（CheckQuota / BatchCheckQuota 使用）
+// 消费包含尚未落库的在途扣费；扣费时由 repo 在扣减余额的同时原子检查
+func (uc *OrgUseCase) ExceedsSpendLimit(ctx context.Context, payer Payer, now time.Time, cost float64) (bool, error) {
+	if payer.MemberID == "" || payer.SpendLimit <= 0 || cost <= 0 {
+		return false, nil
+	}
+	month, err := uc.periodUseCase.Month(ctx, payer.AccountID, now)
+	if err != nil {
+		return false, err
+	}
+	return uc.repo.ExceedsSpendLimit(ctx, payer.AccountID, payer.MemberID, month, payer.SpendLimit, cost)
+}
+
+// PooledQuotaMultiplier 组织账户的免费额度按已加入的成员数汇总（周期创建时的成员数，不含未接受的邀请），个人账户为 1
+func (uc *OrgUseCase) PooledQuotaMultiplier(ctx context.Context, accountID string) (int, error) {
+	count, err := uc.repo.CountMembers(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+	return max(count, 1), nil
+}
+
+// CreateOrganization 创建组织，创建者为所有者
+func (uc *OrgUseCase) CreateOrganization(ctx context.Context, userID, name string) (*Organization, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if name == "" || utf8.RuneCountInString(name) > constants.MaxOrgNameLength {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidOrganization)
+	}
+	org := &Organization{Name: name, OwnerID: userID}
+	if err := uc.repo.CreateOrganization(ctx, org); err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Organization created: org_id=%s, owner=%s", org.ID, userID)
+	return org, nil
+}
+
+// GetOrganization 获取组织及成员（含本月消费），管理员可查看全部成员，普通成员只返回自己
+func (uc *OrgUseCase) GetOrganization(ctx context.Context, userID, orgID string) (*Organization, []*OrgMember, error) {
+	caller, err := uc.member(ctx, userID, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	org, err := uc.repo.GetOrganization(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if org == nil {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgNotFound)
+	}
+
+	members := []*OrgMember{caller}
+	var memberIDs []string
+	if caller.IsManager() {
+		if members, err = uc.repo.ListMembers(ctx, orgID); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		memberIDs = []string{userID}
+	}
+	month, err := uc.periodUseCase.Month(ctx, orgID, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	spent, err := uc.repo.GetMemberSpent(ctx, orgID, month.Start, month.End, memberIDs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range members {
+		m.Spent = spent[m.UserID]
+	}
+	return org, members, nil
+}
+
+// AddMember 邀请成员：所有者与管理员可邀请普通成员，只有所有者可邀请管理员
+// 被邀请的用户接受（AcceptInvitation）后才加入组织，拒绝即自行移除（RemoveMember）
+func (uc *OrgUseCase) AddMember(ctx context.Context, userID string, member *OrgMember) (*OrgMember, error) {
+	if member.UserID == "" || member.OrgID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if member.Role == "" {
+		member.Role = constants.OrgRoleMember
+	}
+	if err := validateMember(ctx, member); err != nil {
+		return nil, err
+	}
+	caller, err := uc.member(ctx, userID, member.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.canAssign(member.Role) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+	}
+	member.Status = constants.OrgMemberStatusInvited
+	if err := uc.repo.AddMember(ctx, member, uc.conf.Organization.MaxMembers); err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Organization member invited: org_id=%s, member=%s, role=%s, spend_limit=%.2f, by=%s",
+		member.OrgID, member.UserID, member.Role, member.SpendLimit, userID)
+	return member, nil
+}
+
+// AcceptInvitation 用户接受组织的邀请，加入后由组织支付
+func (uc *OrgUseCase) AcceptInvitation(ctx context.Context, userID, orgID string) (*OrgMember, error) {
+	if userID == "" || orgID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	member, err := uc.target(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsActive() {
+		return member, nil
+	}
+	accepted, err := uc.repo.AcceptInvitation(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberNotFound)
+	}
+	member.Status = constants.OrgMemberStatusActive
+	uc.log.Infof("Organization invitation accepted: org_id=%s, member=%s, role=%s", orgID, userID, member.Role)
+	return member, nil
+}
+
+// UpdateMember 更新成员角色与每月消费上限（role 为空时保持不变）
+// 所有者不能变更；管理员只能修改普通成员，只有所有者可修改管理员或将成员设为管理员
+func (uc *OrgUseCase) UpdateMember(ctx context.Context, userID string, member *OrgMember) (*OrgMember, error) {
+	if member.UserID == "" || member.OrgID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	caller, err := uc.member(ctx, userID, member.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.target(ctx, member.OrgID, member.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == "" {
+		member.Role = target.Role
+	}
+	if err := validateMember(ctx, member); err != nil {
+		return nil, err
+	}
+	if !caller.canAssign(target.Role) || !caller.canAssign(member.Role) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+	}
+	updated, err := uc.repo.UpdateMember(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberNotFound)
+	}
+	member.CreatedAt = target.CreatedAt
+	uc.log.Infof("Organization member updated: org_id=%s, member=%s, role=%s, spend_limit=%.2f, by=%s",
+		member.OrgID, member.UserID, member.Role, member.SpendLimit, userID)
+	return member, nil
+}
+
+// RemoveMember 移除成员：成员可自行退出（被邀请的用户自行移除即拒绝邀请），所有者与管理员可移除普通成员或撤回邀请，
+// 只有所有者可移除管理员，所有者不能被移除；成员之前产生的消费记录仍保留在组织账户中
+func (uc *OrgUseCase) RemoveMember(ctx context.Context, userID, orgID, memberID string) error {
+	if orgID == "" || memberID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	var caller *OrgMember
+	if memberID != userID {
+		var err error
+		if caller, err = uc.member(ctx, userID, orgID); err != nil {
+			return err
+		}
+	}
+	target, err := uc.target(ctx, orgID, memberID)
+	if err != nil {
+		return err
+	}
+	if target.Role == constants.OrgRoleOwner || (caller != nil && !caller.canAssign(target.Role)) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+	}
+	removed, err := uc.repo.RemoveMember(ctx, orgID, memberID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberNotFound)
+	}
+	uc.log.Infof("Organization member removed: org_id=%s, member=%s, by=%s", orgID, memberID, userID)
+	return nil
+}
+
+// Scope 解析查询范围：orgID 为空时为用户本人账户；否则调用者须为组织成员，
+// 所有者与管理员可查询组织整体（memberID 为空）或任一成员，普通成员只能查询自己
+func (uc *OrgUseCase) Scope(ctx context.Context, userID, orgID, memberID string) (*AccountScope, error) {
+	if orgID == "" {
+		if memberID != "" {
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidOrganization)
+		}
+		return &AccountScope{AccountID: userID}, nil
+	}
+	caller, err := uc.member(ctx, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.IsManager() {
+		if memberID != "" && memberID != userID {
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+		}
+		memberID = userID
+	}
+	if memberID == "" {
+		return &AccountScope{AccountID: orgID}, nil
+	}
+
+	member := caller
+	if memberID != userID {
+		if member, err = uc.target(ctx, orgID, memberID); err != nil {
+			return nil, err
+		}
+	}
+	month, err := uc.periodUseCase.Month(ctx, orgID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	spent, err := uc.repo.GetMemberSpent(ctx, orgID, month.Start, month.End, memberID)
+	if err != nil {
+		return nil, err
+	}
+	member.Spent = spent[memberID]
+	return &AccountScope{AccountID: orgID, MemberID: memberID, Member: member}, nil
+}
+
+// CheckManager 检查调用者是否为组织所有者或管理员
+func (uc *OrgUseCase) CheckManager(ctx context.Context, userID, orgID string) error {
+	caller, err := uc.member(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !caller.IsManager() {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+	}
+	return nil
+}
+
+// member 获取调用者在组织中的成员身份，不是该组织已加入的成员时返回无权限
+func (uc *OrgUseCase) member(ctx context.Context, userID, orgID string) (*OrgMember, error) {
+	if userID == "" || orgID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	m, err := uc.repo.GetMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil || m.OrgID != orgID || !m.IsActive() {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgPermissionDenied)
+	}
+	m.UserID = userID
+	return m, nil
+}
+
+// target 获取被操作的组织成员（含未接受的邀请）
+func (uc *OrgUseCase) target(ctx context.Context, orgID, memberID string) (*OrgMember, error) {
+	m, err := uc.repo.GetMembership(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil || m.OrgID != orgID {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberNotFound)
+	}
+	m.UserID = memberID
+	return m, nil
+}
+
+// canAssign 是否可添加、修改或移除该角色的成员：所有者可管理管理员与普通成员，管理员只能管理普通成员
+func (m *OrgMember) canAssign(role string) bool {
+	switch m.Role {
+	case constants.OrgRoleOwner:
+		return role == constants.OrgRoleAdmin || role == constants.OrgRoleMember
+	case constants.OrgRoleAdmin:
+		return role == constants.OrgRoleMember
+	default:
+		return false
+	}
+}
+
+// validateMember 校验成员角色（不能指定为所有者）与消费上限
+func validateMember(ctx context.Context, member *OrgMember) error {
+	if (member.Role != constants.OrgRoleAdmin && member.Role != constants.OrgRoleMember) || member.SpendLimit < 0 {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidOrganization)
+	}
+	return nil
+}
+
+// ========== BillingUseCase 组合方法 ==========
+
+// CreateOrganization 创建组织
+func (uc *BillingUseCase) CreateOrganization(ctx context.Context, userID, name string) (*Organization, error) {
+	return uc.orgUseCase.CreateOrganization(ctx, userID, name)
+}
+
+// GetOrganization 获取组织及成员
+func (uc *BillingUseCase) GetOrganization(ctx context.Context, userID, orgID string) (*Organization, []*OrgMember, error) {
+	return uc.orgUseCase.GetOrganization(ctx, userID, orgID)
+}
+
+// AddOrgMember 邀请组织成员
+func (uc *BillingUseCase) AddOrgMember(ctx context.Context, userID string, member *OrgMember) (*OrgMember, error) {
+	return uc.orgUseCase.AddMember(ctx, userID, member)
+}
+
+// AcceptOrgInvitation 接受组织邀请
+func (uc *BillingUseCase) AcceptOrgInvitation(ctx context.Context, userID, orgID string) (*OrgMember, error) {
+	return uc.orgUseCase.AcceptInvitation(ctx, userID, orgID)
+}
+
+// UpdateOrgMember 更新组织成员
+func (uc *BillingUseCase) UpdateOrgMember(ctx context.Context, userID string, member *OrgMember) (*OrgMember, error) {
+	return uc.orgUseCase.UpdateMember(ctx, userID, member)
+}
+
+// RemoveOrgMember 移除组织成员
+func (uc *BillingUseCase) RemoveOrgMember(ctx context.Context, userID, orgID, memberID string) error {
+	return uc.orgUseCase.RemoveMember(ctx, userID, orgID, memberID)
+}
+
+// ResolveAccountScope 解析 GetAccount、统计与消费记录的查询范围
+func (uc *BillingUseCase) ResolveAccountScope(ctx context.Context, userID, orgID, memberID string) (*AccountScope, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.orgUseCase.Scope(ctx, userID, orgID, memberID)
+}
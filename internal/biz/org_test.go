@@ -0,0 +1,324 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	testOrgID     = "org_1"
+	testOwnerID   = "u_owner"
+	testAdminID   = "u_admin"
+	testMemberID  = "u_member"
+	testInvitedID = "u_invited"
+	testOutsider  = "u_outsider"
+)
+
+// fakeOrgRepo 内存中的组织成员，读取时返回副本（与缓存反序列化一致）
+type fakeOrgRepo struct {
+	OrgRepo
+	members map[string]*OrgMember
+}
+
+func newFakeOrgRepo() *fakeOrgRepo {
+	r := &fakeOrgRepo{members: map[string]*OrgMember{}}
+	for _, m := range []*OrgMember{
+		{UserID: testOwnerID, Role: constants.OrgRoleOwner, Status: constants.OrgMemberStatusActive},
+		{UserID: testAdminID, Role: constants.OrgRoleAdmin, Status: constants.OrgMemberStatusActive},
+		{UserID: testMemberID, Role: constants.OrgRoleMember, Status: constants.OrgMemberStatusActive, SpendLimit: 50},
+		{UserID: testInvitedID, Role: constants.OrgRoleMember, Status: constants.OrgMemberStatusInvited},
+	} {
+		m.OrgID = testOrgID
+		r.members[m.UserID] = m
+	}
+	return r
+}
+
+func (r *fakeOrgRepo) GetOrganization(ctx context.Context, orgID string) (*Organization, error) {
+	if orgID != testOrgID {
+		return nil, nil
+	}
+	return &Organization{ID: testOrgID, Name: "team", OwnerID: testOwnerID}, nil
+}
+
+func (r *fakeOrgRepo) GetMembership(ctx context.Context, userID string) (*OrgMember, error) {
+	m, ok := r.members[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *m
+	return &copied, nil
+}
+
+func (r *fakeOrgRepo) ListMembers(ctx context.Context, orgID string) ([]*OrgMember, error) {
+	var members []*OrgMember
+	for _, m := range r.members {
+		if m.OrgID == orgID {
+			copied := *m
+			members = append(members, &copied)
+		}
+	}
+	return members, nil
+}
+
+func (r *fakeOrgRepo) CountMembers(ctx context.Context, orgID string) (int, error) {
+	count := 0
+	for _, m := range r.members {
+		if m.OrgID == orgID && m.IsActive() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeOrgRepo) AddMember(ctx context.Context, member *OrgMember, maxMembers int) error {
+	if _, ok := r.members[member.UserID]; ok {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberExists)
+	}
+	count := 0
+	for _, m := range r.members {
+		if m.OrgID == member.OrgID {
+			count++
+		}
+	}
+	if maxMembers > 0 && count >= maxMembers {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberCountExceeded)
+	}
+	copied := *member
+	r.members[member.UserID] = &copied
+	return nil
+}
+
+func (r *fakeOrgRepo) AcceptInvitation(ctx context.Context, orgID, userID string) (bool, error) {
+	m, ok := r.members[userID]
+	if !ok || m.OrgID != orgID || m.Status != constants.OrgMemberStatusInvited {
+		return false, nil
+	}
+	m.Status = constants.OrgMemberStatusActive
+	return true, nil
+}
+
+func (r *fakeOrgRepo) UpdateMember(ctx context.Context, member *OrgMember) (bool, error) {
+	m, ok := r.members[member.UserID]
+	if !ok || m.OrgID != member.OrgID || m.Role == constants.OrgRoleOwner {
+		return false, nil
+	}
+	m.Role, m.SpendLimit = member.Role, member.SpendLimit
+	return true, nil
+}
+
+func (r *fakeOrgRepo) RemoveMember(ctx context.Context, orgID, userID string) (bool, error) {
+	m, ok := r.members[userID]
+	if !ok || m.OrgID != orgID || m.Role == constants.OrgRoleOwner {
+		return false, nil
+	}
+	delete(r.members, userID)
+	return true, nil
+}
+
+func (r *fakeOrgRepo) GetMemberSpent(ctx context.Context, orgID string, start, end time.Time, memberIDs ...string) (map[string]float64, error) {
+	return map[string]float64{}, nil
+}
+
+func newTestOrgUseCase(repo OrgRepo, maxMembers int) *OrgUseCase {
+	conf := &BillingConfig{Location: time.UTC, Organization: OrganizationConfig{MaxMembers: maxMembers}}
+	periodUseCase := NewPeriodUseCase(&fakeAccountSettingRepo{}, nil, conf, log.DefaultLogger)
+	return NewOrgUseCase(repo, periodUseCase, conf, log.DefaultLogger)
+}
+
+func assertErrCode(t *testing.T, err error, code int) {
+	t.Helper()
+	if code == 0 {
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("err = nil, want code %d", code)
+	}
+	if got := kratosErrors.FromError(err).Code; int(got) != code {
+		t.Fatalf("err = %v (code %d), want code %d", err, got, code)
+	}
+}
+
+// TestOrgPermissionMatrix 所有者可管理管理员与普通成员，管理员只能管理普通成员，普通成员只能查看自己与退出，
+// 被邀请的用户在接受前没有任何组织权限（只能接受或拒绝邀请）
+func TestOrgPermissionMatrix(t *testing.T) {
+	ctx := context.Background()
+	denied := billingErrors.ErrCodeOrgPermissionDenied
+	invite := func(role string) func(uc *OrgUseCase, actor string) error {
+		return func(uc *OrgUseCase, actor string) error {
+			_, err := uc.AddMember(ctx, actor, &OrgMember{OrgID: testOrgID, UserID: "u_new", Role: role})
+			return err
+		}
+	}
+	update := func(memberID, role string) func(uc *OrgUseCase, actor string) error {
+		return func(uc *OrgUseCase, actor string) error {
+			_, err := uc.UpdateMember(ctx, actor, &OrgMember{OrgID: testOrgID, UserID: memberID, Role: role, SpendLimit: 10})
+			return err
+		}
+	}
+	remove := func(memberID string) func(uc *OrgUseCase, actor string) error {
+		return func(uc *OrgUseCase, actor string) error {
+			return uc.RemoveMember(ctx, actor, testOrgID, memberID)
+		}
+	}
+	view := func(uc *OrgUseCase, actor string) error {
+		_, _, err := uc.GetOrganization(ctx, actor, testOrgID)
+		return err
+	}
+	scope := func(memberID string) func(uc *OrgUseCase, actor string) error {
+		return func(uc *OrgUseCase, actor string) error {
+			_, err := uc.Scope(ctx, actor, testOrgID, memberID)
+			return err
+		}
+	}
+	accept := func(orgID string) func(uc *OrgUseCase, actor string) error {
+		return func(uc *OrgUseCase, actor string) error {
+			_, err := uc.AcceptInvitation(ctx, actor, orgID)
+			return err
+		}
+	}
+
+	cases := []struct {
+		name     string
+		actor    string
+		action   func(uc *OrgUseCase, actor string) error
+		wantCode int
+	}{
+		{name: "owner invites member", actor: testOwnerID, action: invite(constants.OrgRoleMember)},
+		{name: "owner invites admin", actor: testOwnerID, action: invite(constants.OrgRoleAdmin)},
+		{name: "admin invites member", actor: testAdminID, action: invite(constants.OrgRoleMember)},
+		{name: "admin invites admin", actor: testAdminID, action: invite(constants.OrgRoleAdmin), wantCode: denied},
+		{name: "member invites member", actor: testMemberID, action: invite(constants.OrgRoleMember), wantCode: denied},
+		{name: "invited user invites member", actor: testInvitedID, action: invite(constants.OrgRoleMember), wantCode: denied},
+		{name: "outsider invites member", actor: testOutsider, action: invite(constants.OrgRoleMember), wantCode: denied},
+		{name: "nobody invites owner", actor: testOwnerID, action: invite(constants.OrgRoleOwner), wantCode: billingErrors.ErrCodeInvalidOrganization},
+
+		{name: "owner updates admin", actor: testOwnerID, action: update(testAdminID, "")},
+		{name: "owner promotes member", actor: testOwnerID, action: update(testMemberID, constants.OrgRoleAdmin)},
+		{name: "admin updates member", actor: testAdminID, action: update(testMemberID, "")},
+		{name: "admin updates invitation", actor: testAdminID, action: update(testInvitedID, "")},
+		{name: "admin promotes member", actor: testAdminID, action: update(testMemberID, constants.OrgRoleAdmin), wantCode: denied},
+		{name: "admin updates admin", actor: testAdminID, action: update(testAdminID, ""), wantCode: denied},
+		{name: "admin updates owner", actor: testAdminID, action: update(testOwnerID, constants.OrgRoleMember), wantCode: denied},
+		{name: "member updates self", actor: testMemberID, action: update(testMemberID, ""), wantCode: denied},
+		{name: "invited user updates self", actor: testInvitedID, action: update(testInvitedID, ""), wantCode: denied},
+
+		{name: "owner removes admin", actor: testOwnerID, action: remove(testAdminID)},
+		{name: "owner cannot leave", actor: testOwnerID, action: remove(testOwnerID), wantCode: denied},
+		{name: "admin removes member", actor: testAdminID, action: remove(testMemberID)},
+		{name: "admin revokes invitation", actor: testAdminID, action: remove(testInvitedID)},
+		{name: "admin leaves", actor: testAdminID, action: remove(testAdminID)},
+		{name: "admin removes owner", actor: testAdminID, action: remove(testOwnerID), wantCode: denied},
+		{name: "member removes member", actor: testMemberID, action: remove(testInvitedID), wantCode: denied},
+		{name: "member leaves", actor: testMemberID, action: remove(testMemberID)},
+		{name: "invited user declines", actor: testInvitedID, action: remove(testInvitedID)},
+		{name: "invited user removes member", actor: testInvitedID, action: remove(testMemberID), wantCode: denied},
+		{name: "outsider removes member", actor: testOutsider, action: remove(testMemberID), wantCode: denied},
+		{name: "outsider leaves", actor: testOutsider, action: remove(testOutsider), wantCode: billingErrors.ErrCodeOrgMemberNotFound},
+
+		{name: "owner views org", actor: testOwnerID, action: view},
+		{name: "member views org", actor: testMemberID, action: view},
+		{name: "invited user views org", actor: testInvitedID, action: view, wantCode: denied},
+		{name: "outsider views org", actor: testOutsider, action: view, wantCode: denied},
+		{name: "admin scopes org", actor: testAdminID, action: scope("")},
+		{name: "admin scopes member", actor: testAdminID, action: scope(testMemberID)},
+		{name: "member scopes self", actor: testMemberID, action: scope(testMemberID)},
+		{name: "member scopes other member", actor: testMemberID, action: scope(testAdminID), wantCode: denied},
+		{name: "invited user scopes self", actor: testInvitedID, action: scope(testInvitedID), wantCode: denied},
+
+		{name: "invited user accepts", actor: testInvitedID, action: accept(testOrgID)},
+		{name: "member accepts again", actor: testMemberID, action: accept(testOrgID)},
+		{name: "invited user accepts other org", actor: testInvitedID, action: accept("org_2"), wantCode: billingErrors.ErrCodeOrgMemberNotFound},
+		{name: "outsider accepts", actor: testOutsider, action: accept(testOrgID), wantCode: billingErrors.ErrCodeOrgMemberNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestOrgUseCase(newFakeOrgRepo(), 0)
+			assertErrCode(t, tc.action(uc, tc.actor), tc.wantCode)
+		})
+	}
+}
+
+// TestOrgInvitation 添加的成员为邀请状态，接受后才加入；邀请计入成员数上限，已被邀请的用户不能再被邀请
+func TestOrgInvitation(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeOrgRepo()
+	uc := newTestOrgUseCase(repo, 5)
+
+	member, err := uc.AddMember(ctx, testOwnerID, &OrgMember{OrgID: testOrgID, UserID: "u_new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Status != constants.OrgMemberStatusInvited || member.Role != constants.OrgRoleMember {
+		t.Fatalf("member = %+v, want invited member", member)
+	}
+	_, err = uc.AddMember(ctx, testAdminID, &OrgMember{OrgID: testOrgID, UserID: "u_new"})
+	assertErrCode(t, err, billingErrors.ErrCodeOrgMemberExists)
+	// 3 个已加入成员 + 2 个邀请达到上限
+	_, err = uc.AddMember(ctx, testOwnerID, &OrgMember{OrgID: testOrgID, UserID: "u_more"})
+	assertErrCode(t, err, billingErrors.ErrCodeOrgMemberCountExceeded)
+
+	accepted, err := uc.AcceptInvitation(ctx, "u_new", testOrgID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accepted.IsActive() || repo.members["u_new"].Status != constants.OrgMemberStatusActive {
+		t.Fatalf("accepted = %+v, want active", accepted)
+	}
+	if _, _, err := uc.GetOrganization(ctx, "u_new", testOrgID); err != nil {
+		t.Fatalf("GetOrganization after accept: %v", err)
+	}
+}
+
+// TestOrgPayer 已加入的成员由组织支付并带消费上限，未接受邀请的用户与非成员由本人支付；
+// 共享免费额度只按已加入的成员数汇总
+func TestOrgPayer(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeOrgRepo()
+	uc := newTestOrgUseCase(repo, 0)
+
+	cases := []struct {
+		userID string
+		want   Payer
+	}{
+		{userID: testOwnerID, want: Payer{AccountID: testOrgID, MemberID: testOwnerID}},
+		{userID: testMemberID, want: Payer{AccountID: testOrgID, MemberID: testMemberID, SpendLimit: 50}},
+		{userID: testInvitedID, want: Payer{AccountID: testInvitedID}},
+		{userID: testOutsider, want: Payer{AccountID: testOutsider}},
+	}
+	for _, tc := range cases {
+		payer, err := uc.Payer(ctx, tc.userID)
+		if err != nil || payer != tc.want {
+			t.Fatalf("payer(%s) = %+v, err = %v, want %+v", tc.userID, payer, err, tc.want)
+		}
+	}
+
+	if n, err := uc.PooledQuotaMultiplier(ctx, testOrgID); err != nil || n != 3 {
+		t.Fatalf("org multiplier = %d, err = %v, want 3", n, err)
+	}
+	if n, err := uc.PooledQuotaMultiplier(ctx, testOutsider); err != nil || n != 1 {
+		t.Fatalf("personal multiplier = %d, err = %v, want 1", n, err)
+	}
+
+	// 接受邀请后由组织支付，计入共享免费额度
+	if _, err := uc.AcceptInvitation(ctx, testInvitedID, testOrgID); err != nil {
+		t.Fatal(err)
+	}
+	if payer, err := uc.Payer(ctx, testInvitedID); err != nil || payer != (Payer{AccountID: testOrgID, MemberID: testInvitedID}) {
+		t.Fatalf("payer after accept = %+v, err = %v", payer, err)
+	}
+	if n, err := uc.PooledQuotaMultiplier(ctx, testOrgID); err != nil || n != 4 {
+		t.Fatalf("org multiplier after accept = %d, err = %v, want 4", n, err)
+	}
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// UsagePackage 用量包目录项（来自配置）
+type UsagePackage struct {
+	ID          string
+	Name        string
+	ServiceName string
+	Units       int64   // 包含用量（按服务计量单位）
+	Price       float64 // 售价（单位：元）
+	ValidMonths int     // 有效期（月），从支付成功时起算
+}
+
+// UserPackage 账户持有的用量包
+// 下单时按目录快照创建，支付成功后发放（设置到期时间），未发放的用量包不可用也不对外展示
+type UserPackage struct {
+	ID          string
+	UID         string
+	ServiceName string
+	PackageID   string
+	Name        string
+	OrderID     string
+	TotalUnits  int64
+	UsedUnits   int64 // 已使用（查询时包含尚未落库的在途扣减）
+	ValidMonths int
+	ExpiresAt   time.Time // 到期时间（不含）
+	CreatedAt   time.Time // 下单时间
+}
+
+// Remaining 指定时间的剩余用量，已过期时为 0
+func (p *UserPackage) Remaining(now time.Time) int64 {
+	if !now.Before(p.ExpiresAt) {
+		return 0
+	}
+	return max(p.TotalUnits-p.UsedUnits, 0)
+}
+
+// PackageRepo 用量包数据层接口（定义在 biz 层）
+// 扣减发生在扣费事务中（见 BillingRepo.DeductQuota），这里只负责查询
+type PackageRepo interface {
+	// ListUserPackages 获取已发放的用量包，按到期时间正序，serviceName 为空表示全部服务
+	// includeExpired 为 false 时只返回未过期且有剩余的；在途扣减按先到期先用计入 UsedUnits
+	ListUserPackages(ctx context.Context, userID, serviceName string, includeExpired bool) ([]*UserPackage, error)
+	// GetPackageRemaining 服务有效用量包的剩余用量合计（已扣除在途扣减）
+	GetPackageRemaining(ctx context.Context, userID, serviceName string) (int64, error)
+}
+
+// PackageUseCase 用量包业务逻辑
+type PackageUseCase struct {
+	repo PackageRepo
+	conf *BillingConfig
+	log  *log.Helper
+}
+
+// NewPackageUseCase 创建用量包 UseCase
+func NewPackageUseCase(repo PackageRepo, conf *BillingConfig, logger log.Logger) *PackageUseCase {
+	return &PackageUseCase{
+		repo: repo,
+		conf: conf,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Catalog 可购买的用量包目录（配置顺序）
+func (uc *PackageUseCase) Catalog() []*UsagePackage {
+	return uc.conf.Packages
+}
+
+// GetPackage 按ID获取目录中的用量包
+func (uc *PackageUseCase) GetPackage(ctx context.Context, packageID string) (*UsagePackage, error) {
+	for _, pkg := range uc.conf.Packages {
+		if pkg.ID == packageID {
+			return pkg, nil
+		}
+	}
+	return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePackageNotFound)
+}
+
+// ListUserPackages 获取账户持有的用量包
+func (uc *PackageUseCase) ListUserPackages(ctx context.Context, userID, serviceName string, includeExpired bool) ([]*UserPackage, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.repo.ListUserPackages(ctx, userID, serviceName, includeExpired)
+}
+
+// Remaining 服务有效用量包的剩余用量合计
+// 已下架的用量包在到期前仍可使用，因此不能按目录判断
+func (uc *PackageUseCase) Remaining(ctx context.Context, userID, serviceName string) (int64, error) {
+	return uc.repo.GetPackageRemaining(ctx, userID, serviceName)
+}
+
+// ========== BillingUseCase 组合方法 ==========
+
+// ListPackageCatalog 获取可购买的用量包目录
+func (uc *BillingUseCase) ListPackageCatalog() []*UsagePackage {
+	return uc.packageUseCase.Catalog()
+}
+
+// PurchasePackage 购买用量包：按目录价格创建订单并返回支付链接，支付成功后由充值回调发放
+func (uc *BillingUseCase) PurchasePackage(ctx context.Context, userID, packageID string, method int32, currency, returnURL, notifyURL string) (string, string, error) {
+	if userID == "" || packageID == "" {
+		return "", "", pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	pkg, err := uc.packageUseCase.GetPackage(ctx, packageID)
+	if err != nil {
+		return "", "", err
+	}
+	return uc.rechargeOrderUseCase.CreatePackageOrder(ctx, userID, pkg, method, currency, returnURL, notifyURL)
+}
+
+// ListUserPackages 获取账户持有的用量包
+func (uc *BillingUseCase) ListUserPackages(ctx context.Context, userID, serviceName string, includeExpired bool) ([]*UserPackage, error) {
+	return uc.packageUseCase.ListUserPackages(ctx, userID, serviceName, includeExpired)
+}
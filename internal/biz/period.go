@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// QuotaPeriodConfig 免费额度周期配置
+type QuotaPeriodConfig struct {
+	DefaultCycle    string                       // 默认周期
+	Services        map[string]string            // 服务名 -> 周期
+	Plans           map[string]map[string]string // 套餐名 -> 服务名 -> 周期，服务 "*" 适用于未单独配置的服务
+	Rollover        map[string]float64           // 套餐名 -> 未用额度结转百分比（0-100），未配置的套餐不结转
+	AccountCacheTTL time.Duration                // 账户设置缓存时间
+}
+
+// BillingPeriod 计费周期：免费额度周期（每个用户每个服务每个周期一条额度记录）、预算月份与统计范围
+type BillingPeriod struct {
+	Cycle string
+	Key   string    // 周期标识，自然月为 YYYY-MM（与升级前的 reset_month 一致），其余为类型前缀 + 开始日期
+	Start time.Time // 开始时间（含）
+	End   time.Time // 结束时间（不含）
+}
+
+// DeductPeriods 扣费涉及的周期
+type DeductPeriods struct {
+	Quota       BillingPeriod // 免费额度周期
+	BudgetMonth BillingPeriod // 消费预算月份
+}
+
+// AccountSetting 账户计费设置
+type AccountSetting struct {
+	UserID      string    `json:"-"`
+	CycleAnchor time.Time `json:"cycleAnchor"` // 周年周期锚点，每月的同一日开始新周期
+	Timezone    string    `json:"timezone"`    // 计费时区（IANA 名称），为空表示使用默认时区
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// AccountSettingRepo 账户设置数据层接口（定义在 biz 层）
+type AccountSettingRepo interface {
+	// GetAccountSetting 获取账户设置（带缓存），不存在时创建：
+	// 周期锚点取账户最早的免费额度或余额记录的创建时间（近似注册时间），都没有时取当前时间
+	GetAccountSetting(ctx context.Context, userID string) (*AccountSetting, error)
+	// SetTimezone 设置账户计费时区（账户设置不存在时同样创建）并删除缓存
+	SetTimezone(ctx context.Context, userID, timezone string) error
+}
+
+// PeriodCalculator 周期计算，额度周期、预算月份与统计范围的边界都由它计算
+// 时间按 now 所在时区计算（调用方先转换到账户时区），日、周、月均从 00:00 开始
+type PeriodCalculator struct{}
+
+// Period 计算 now 所在的周期，anchor 仅用于周年周期
+// 未知的周期类型按自然月计算
+func (PeriodCalculator) Period(cycle string, anchor, now time.Time) BillingPeriod {
+	y, m, d := now.Date()
+	loc := now.Location()
+	today := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	switch cycle {
+	case constants.QuotaCycleDaily:
+		return BillingPeriod{Cycle: cycle, Key: "D" + today.Format(constants.TimeFormatDate), Start: today, End: today.AddDate(0, 0, 1)}
+	case constants.QuotaCycleWeekly:
+		offset := (int(now.Weekday()) + 6) % 7 // 周一开始
+		start := today.AddDate(0, 0, -offset)
+		return BillingPeriod{Cycle: cycle, Key: "W" + start.Format(constants.TimeFormatDate), Start: start, End: start.AddDate(0, 0, 7)}
+	case constants.QuotaCycleAnniversary:
+		day := anchor.In(loc).Day()
+		start := anniversaryDate(y, m, day, loc)
+		if start.After(now) {
+			start = anniversaryDate(y, m-1, day, loc)
+		}
+		end := anniversaryDate(start.Year(), start.Month()+1, day, loc)
+		return BillingPeriod{Cycle: cycle, Key: "A" + start.Format(constants.TimeFormatDate), Start: start, End: end}
+	default:
+		start := time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		return BillingPeriod{Cycle: constants.QuotaCycleMonthly, Key: start.Format(constants.TimeFormatMonth), Start: start, End: start.AddDate(0, 1, 0)}
+	}
+}
+
+// Month now 所在的自然月
+func (c PeriodCalculator) Month(now time.Time) BillingPeriod {
+	return c.Period(constants.QuotaCycleMonthly, time.Time{}, now)
+}
+
+// Day now 所在的自然日
+func (c PeriodCalculator) Day(now time.Time) BillingPeriod {
+	return c.Period(constants.QuotaCycleDaily, time.Time{}, now)
+}
+
+// anniversaryDate 指定月份的周年日，当月没有该日时取月末（如锚点为 31 日，2 月取 28/29 日）
+func anniversaryDate(year int, month time.Month, day int, loc *time.Location) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, last)-1)
+}
+
+// PeriodUseCase 计费周期业务逻辑：按账户时区计算额度周期、预算月份与统计范围
+type PeriodUseCase struct {
+	repo             AccountSettingRepo
+	rateLimitUseCase *RateLimitUseCase
+	conf             *BillingConfig
+	calc             PeriodCalculator
+	locations        sync.Map // 时区名称 -> *time.Location
+	log              *log.Helper
+}
+
+// NewPeriodUseCase 创建计费周期 UseCase
+func NewPeriodUseCase(repo AccountSettingRepo, rateLimitUseCase *RateLimitUseCase, conf *BillingConfig, logger log.Logger) *PeriodUseCase {
+	return &PeriodUseCase{
+		repo:             repo,
+		rateLimitUseCase: rateLimitUseCase,
+		conf:             conf,
+		log:              log.NewHelper(logger),
+	}
+}
+
+// Cycle 用户服务的额度周期类型：套餐（服务、"*"）→ 服务 → 默认
+// 只有配置了套餐周期时才读取用户套餐
+func (uc *PeriodUseCase) Cycle(ctx context.Context, userID, serviceName string) (string, error) {
+	if len(uc.conf.QuotaPeriod.Plans) > 0 {
+		plan, err := uc.rateLimitUseCase.UserPlan(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		if cycles, ok := uc.conf.QuotaPeriod.Plans[plan]; ok {
+			if cycle, ok := cycles[serviceName]; ok {
+				return cycle, nil
+			}
+			if cycle, ok := cycles[constants.QuotaCycleAllServices]; ok {
+				return cycle, nil
+			}
+		}
+	}
+	if cycle, ok := uc.conf.QuotaPeriod.Services[serviceName]; ok {
+		return cycle, nil
+	}
+	return uc.conf.QuotaPeriod.DefaultCycle, nil
+}
+
+// RolloverPercent 用户套餐的未用额度结转百分比，0 表示不结转
+// 只有配置了结转的套餐时才读取用户套餐
+func (uc *PeriodUseCase) RolloverPercent(ctx context.Context, userID string) (float64, error) {
+	if len(uc.conf.QuotaPeriod.Rollover) == 0 {
+		return 0, nil
+	}
+	plan, err := uc.rateLimitUseCase.UserPlan(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return uc.conf.QuotaPeriod.Rollover[plan], nil
+}
+
+// Period 用户服务在 now 所在的额度周期（账户时区）
+func (uc *PeriodUseCase) Period(ctx context.Context, userID, serviceName string, now time.Time) (BillingPeriod, error) {
+	cycle, err := uc.Cycle(ctx, userID, serviceName)
+	if err != nil {
+		return BillingPeriod{}, err
+	}
+	setting, loc, err := uc.setting(ctx, userID)
+	if err != nil {
+		return BillingPeriod{}, err
+	}
+	return uc.calc.Period(cycle, setting.CycleAnchor, now.In(loc)), nil
+}
+
+// DeductPeriods 用户服务在 now 所在的额度周期与预算月份（扣费使用，只读取一次账户设置）
+func (uc *PeriodUseCase) DeductPeriods(ctx context.Context, userID, serviceName string, now time.Time) (DeductPeriods, error) {
+	cycle, err := uc.Cycle(ctx, userID, serviceName)
+	if err != nil {
+		return DeductPeriods{}, err
+	}
+	setting, loc, err := uc.setting(ctx, userID)
+	if err != nil {
+		return DeductPeriods{}, err
+	}
+	now = now.In(loc)
+	return DeductPeriods{
+		Quota:       uc.calc.Period(cycle, setting.CycleAnchor, now),
+		BudgetMonth: uc.calc.Month(now),
+	}, nil
+}
+
+// Month 用户在 now 所在的自然月（账户时区），用于消费预算与本月统计
+func (uc *PeriodUseCase) Month(ctx context.Context, userID string, now time.Time) (BillingPeriod, error) {
+	loc, err := uc.Location(ctx, userID)
+	if err != nil {
+		return BillingPeriod{}, err
+	}
+	return uc.calc.Month(now.In(loc)), nil
+}
+
+// Today 用户在 now 所在的自然日（账户时区），用于今日统计
+func (uc *PeriodUseCase) Today(ctx context.Context, userID string, now time.Time) (BillingPeriod, error) {
+	loc, err := uc.Location(ctx, userID)
+	if err != nil {
+		return BillingPeriod{}, err
+	}
+	return uc.calc.Day(now.In(loc)), nil
+}
+
+// Location 账户计费时区，未设置时为默认时区
+func (uc *PeriodUseCase) Location(ctx context.Context, userID string) (*time.Location, error) {
+	_, loc, err := uc.setting(ctx, userID)
+	return loc, err
+}
+
+// Timezone 账户设置的计费时区名称，未设置时为空
+func (uc *PeriodUseCase) Timezone(ctx context.Context, userID string) (string, error) {
+	setting, err := uc.repo.GetAccountSetting(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return setting.Timezone, nil
+}
+
+// SetTimezone 设置账户计费时区，为空表示恢复默认时区
+// 修改时区只影响之后计算的周期，已创建的额度记录保持原来的边界
+func (uc *PeriodUseCase) SetTimezone(ctx context.Context, userID, timezone string) error {
+	if userID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if timezone != "" {
+		if _, err := uc.location(timezone); err != nil {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidTimezone)
+		}
+	}
+	return uc.repo.SetTimezone(ctx, userID, timezone)
+}
+
+// setting 读取账户设置及其计费时区，保存的时区无法加载时使用默认时区
+func (uc *PeriodUseCase) setting(ctx context.Context, userID string) (*AccountSetting, *time.Location, error) {
+	setting, err := uc.repo.GetAccountSetting(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if setting.Timezone == "" {
+		return setting, uc.conf.Location, nil
+	}
+	loc, err := uc.location(setting.Timezone)
+	if err != nil {
+		uc.log.Warnf("Invalid account timezone, use default: user_id=%s, timezone=%s, error=%v", userID, setting.Timezone, err)
+		return setting, uc.conf.Location, nil
+	}
+	return setting, loc, nil
+}
+
+// location 加载时区（进程内缓存，避免每次读取时区数据库）
+func (uc *PeriodUseCase) location(name string) (*time.Location, error) {
+	if loc, ok := uc.locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	uc.locations.Store(name, loc)
+	return loc, nil
+}
+
+// GetAccountTimezone 获取账户设置的计费时区，未设置时为空
+func (uc *BillingUseCase) GetAccountTimezone(ctx context.Context, userID string) (string, error) {
+	return uc.periodUseCase.Timezone(ctx, userID)
+}
+
+// SetAccountTimezone 设置账户计费时区
+func (uc *BillingUseCase) SetAccountTimezone(ctx context.Context, userID, timezone string) error {
+	return uc.periodUseCase.SetTimezone(ctx, userID, timezone)
+}
@@ -0,0 +1,295 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有系统时区数据库
+
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// TestPeriodCalculator 各周期类型的边界：DST 切换日、月末、周年日不存在时取月末
+func TestPeriodCalculator(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	shanghai := mustLoadLocation(t, "Asia/Shanghai")
+	anchor31 := time.Date(2024, 1, 31, 15, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name      string
+		cycle     string
+		anchor    time.Time
+		now       time.Time
+		wantKey   string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name: "monthly", cycle: constants.QuotaCycleMonthly,
+			now:     time.Date(2025, 11, 20, 8, 0, 0, 0, time.UTC),
+			wantKey: "2025-11", wantStart: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "unknown cycle falls back to monthly", cycle: "fortnightly",
+			now:     time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC),
+			wantKey: "2025-12", wantStart: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly across DST start", cycle: constants.QuotaCycleMonthly,
+			now:     time.Date(2025, 3, 15, 12, 0, 0, 0, ny),
+			wantKey: "2025-03", wantStart: time.Date(2025, 3, 1, 0, 0, 0, 0, ny), wantEnd: time.Date(2025, 4, 1, 0, 0, 0, 0, ny),
+		},
+		{
+			name: "daily on DST start is 23h", cycle: constants.QuotaCycleDaily,
+			now:     time.Date(2025, 3, 9, 12, 0, 0, 0, ny),
+			wantKey: "D2025-03-09", wantStart: time.Date(2025, 3, 9, 0, 0, 0, 0, ny), wantEnd: time.Date(2025, 3, 10, 0, 0, 0, 0, ny),
+		},
+		{
+			name: "daily on DST end is 25h", cycle: constants.QuotaCycleDaily,
+			now:     time.Date(2025, 11, 2, 1, 30, 0, 0, ny),
+			wantKey: "D2025-11-02", wantStart: time.Date(2025, 11, 2, 0, 0, 0, 0, ny), wantEnd: time.Date(2025, 11, 3, 0, 0, 0, 0, ny),
+		},
+		{
+			name: "weekly starts on monday", cycle: constants.QuotaCycleWeekly,
+			now:     time.Date(2025, 3, 9, 23, 0, 0, 0, ny), // 周日
+			wantKey: "W2025-03-03", wantStart: time.Date(2025, 3, 3, 0, 0, 0, 0, ny), wantEnd: time.Date(2025, 3, 10, 0, 0, 0, 0, ny),
+		},
+		{
+			name: "anniversary in short month uses month end", cycle: constants.QuotaCycleAnniversary, anchor: anchor31,
+			now:     time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+			wantKey: "A2025-02-28", wantStart: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "anniversary before month-end anchor", cycle: constants.QuotaCycleAnniversary, anchor: anchor31,
+			now:     time.Date(2025, 3, 30, 23, 0, 0, 0, time.UTC),
+			wantKey: "A2025-02-28", wantStart: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "anniversary leap year", cycle: constants.QuotaCycleAnniversary, anchor: anchor31,
+			now:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantKey: "A2024-02-29", wantStart: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "anniversary across year end", cycle: constants.QuotaCycleAnniversary, anchor: time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC),
+			now:     time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+			wantKey: "A2025-12-15", wantStart: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), wantEnd: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "anniversary anchor day in account timezone", cycle: constants.QuotaCycleAnniversary, anchor: time.Date(2024, 1, 31, 16, 0, 0, 0, time.UTC), // 上海时间 2 月 1 日
+			now:     time.Date(2025, 3, 1, 12, 0, 0, 0, shanghai),
+			wantKey: "A2025-03-01", wantStart: time.Date(2025, 3, 1, 0, 0, 0, 0, shanghai), wantEnd: time.Date(2025, 4, 1, 0, 0, 0, 0, shanghai),
+		},
+	}
+	var calc PeriodCalculator
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := calc.Period(tc.cycle, tc.anchor, tc.now)
+			if p.Key != tc.wantKey || !p.Start.Equal(tc.wantStart) || !p.End.Equal(tc.wantEnd) {
+				t.Fatalf("period = %s [%s, %s), want %s [%s, %s)", p.Key, p.Start, p.End, tc.wantKey, tc.wantStart, tc.wantEnd)
+			}
+			if p.Start.After(tc.now) || !p.End.After(tc.now) {
+				t.Fatalf("now %s is outside period [%s, %s)", tc.now, p.Start, p.End)
+			}
+		})
+	}
+
+	if d := calc.Day(time.Date(2025, 3, 9, 12, 0, 0, 0, ny)); d.End.Sub(d.Start) != 23*time.Hour {
+		t.Fatalf("DST start day length = %s, want 23h", d.End.Sub(d.Start))
+	}
+	if d := calc.Day(time.Date(2025, 11, 2, 12, 0, 0, 0, ny)); d.End.Sub(d.Start) != 25*time.Hour {
+		t.Fatalf("DST end day length = %s, want 25h", d.End.Sub(d.Start))
+	}
+}
+
+// fakeAccountSettingRepo 固定的账户设置
+type fakeAccountSettingRepo struct {
+	setting AccountSetting
+}
+
+func (f *fakeAccountSettingRepo) GetAccountSetting(ctx context.Context, userID string) (*AccountSetting, error) {
+	s := f.setting
+	s.UserID = userID
+	return &s, nil
+}
+
+func (f *fakeAccountSettingRepo) SetTimezone(ctx context.Context, userID, timezone string) error {
+	f.setting.Timezone = timezone
+	return nil
+}
+
+// TestPeriodUseCaseTimezone 按账户时区计算周期，未设置或无法加载时使用默认时区（UTC）
+func TestPeriodUseCaseTimezone(t *testing.T) {
+	ctx := context.Background()
+	// UTC 1 月 31 日 20:00，上海已是 2 月 1 日
+	now := time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		timezone string
+		wantLoc  string
+		wantKey  string
+	}{
+		{timezone: "", wantLoc: "UTC", wantKey: "2025-01"},
+		{timezone: "Asia/Shanghai", wantLoc: "Asia/Shanghai", wantKey: "2025-02"},
+		{timezone: "Mars/Olympus_Mons", wantLoc: "UTC", wantKey: "2025-01"},
+	}
+	for _, tc := range cases {
+		repo := &fakeAccountSettingRepo{setting: AccountSetting{Timezone: tc.timezone}}
+		uc := NewPeriodUseCase(repo, nil, &BillingConfig{Location: time.UTC}, log.DefaultLogger)
+
+		loc, err := uc.Location(ctx, "u_10001")
+		if err != nil || loc.String() != tc.wantLoc {
+			t.Fatalf("timezone %q: location = %v, err = %v, want %s", tc.timezone, loc, err, tc.wantLoc)
+		}
+		month, err := uc.Month(ctx, "u_10001", now)
+		if err != nil || month.Key != tc.wantKey {
+			t.Fatalf("timezone %q: month = %s, err = %v, want %s", tc.timezone, month.Key, err, tc.wantKey)
+		}
+		periods, err := uc.DeductPeriods(ctx, "u_10001", "passport", now)
+		if err != nil || periods.Quota.Key != tc.wantKey || periods.BudgetMonth.Key != tc.wantKey {
+			t.Fatalf("timezone %q: deduct periods = %+v, err = %v, want %s", tc.timezone, periods, err, tc.wantKey)
+		}
+	}
+
+	// 无效时区不能保存
+	uc := NewPeriodUseCase(&fakeAccountSettingRepo{}, nil, &BillingConfig{Location: time.UTC}, log.DefaultLogger)
+	if err := uc.SetTimezone(ctx, "u_10001", "Mars/Olympus_Mons"); err == nil {
+		t.Fatal("SetTimezone with invalid timezone should fail")
+	}
+	if err := uc.SetTimezone(ctx, "u_10001", "Asia/Shanghai"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestNewBillingConfigQuotaPeriod 周期名称统一为小写，结转百分比不超过 100，未配置结转的套餐不结转；默认计费时区按配置加载
+func TestNewBillingConfigQuotaPeriod(t *testing.T) {
+	config := NewBillingConfig(&conf.Bootstrap{Billing: &conf.Billing{
+		Timezone: "Asia/Shanghai",
+		QuotaPeriod: &conf.QuotaPeriod{
+			DefaultCycle: "Daily",
+			Services:     map[string]string{"asset": "WEEKLY"},
+			Plans: map[string]*conf.QuotaPeriodPlan{
+				"pro":        {Services: map[string]string{"*": "Anniversary"}, RolloverPercent: 150},
+				"team":       {Services: map[string]string{"passport": "monthly"}, RolloverPercent: 25},
+				"enterprise": {Services: map[string]string{"passport": "daily"}},
+			},
+		},
+	}})
+	qp := config.QuotaPeriod
+	if qp.DefaultCycle != constants.QuotaCycleDaily || qp.Services["asset"] != constants.QuotaCycleWeekly ||
+		qp.Plans["pro"][constants.QuotaCycleAllServices] != constants.QuotaCycleAnniversary {
+		t.Errorf("quota period = %+v", qp)
+	}
+	if !maps.Equal(qp.Rollover, map[string]float64{"pro": 100, "team": 25}) {
+		t.Errorf("rollover = %v, want pro 100, team 25", qp.Rollover)
+	}
+	if qp.AccountCacheTTL != 10*time.Minute {
+		t.Errorf("account cache ttl = %s, want default 10m", qp.AccountCacheTTL)
+	}
+	if config.Location.String() != "Asia/Shanghai" {
+		t.Errorf("location = %s, want Asia/Shanghai", config.Location)
+	}
+
+	defaults := NewBillingConfig(&conf.Bootstrap{Billing: &conf.Billing{}})
+	if defaults.QuotaPeriod.DefaultCycle != constants.QuotaCycleMonthly || defaults.Location != time.Local {
+		t.Errorf("defaults: cycle = %s, location = %s, want monthly, Local", defaults.QuotaPeriod.DefaultCycle, defaults.Location)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("invalid billing.timezone: want panic")
+		}
+	}()
+	NewBillingConfig(&conf.Bootstrap{Billing: &conf.Billing{Timezone: "Mars/Olympus_Mons"}})
+}
+
+// TestPeriodUseCaseCycle 周期按 套餐（服务、"*"）→ 服务 → 默认 解析；未配置套餐周期或结转时不读取用户套餐
+func TestPeriodUseCaseCycle(t *testing.T) {
+	ctx := context.Background()
+	quotaPeriod := QuotaPeriodConfig{
+		DefaultCycle: constants.QuotaCycleMonthly,
+		Services:     map[string]string{"asset": constants.QuotaCycleWeekly, "ocr": constants.QuotaCycleDaily},
+		Plans: map[string]map[string]string{
+			"pro":  {constants.QuotaCycleAllServices: constants.QuotaCycleAnniversary, "ocr": constants.QuotaCycleWeekly},
+			"free": {"passport": constants.QuotaCycleDaily},
+		},
+		Rollover: map[string]float64{"pro": 50},
+	}
+
+	cases := []struct {
+		plan        string
+		service     string
+		want        string
+		wantPercent float64
+	}{
+		{"pro", "ocr", constants.QuotaCycleWeekly, 50},
+		{"pro", "asset", constants.QuotaCycleAnniversary, 50},
+		{"", "passport", constants.QuotaCycleDaily, 0}, // 默认套餐 free
+		{"", "asset", constants.QuotaCycleWeekly, 0},
+		{"", "llm", constants.QuotaCycleMonthly, 0},
+		{"unknown", "ocr", constants.QuotaCycleDaily, 0},
+	}
+	for _, tc := range cases {
+		rateLimitUseCase := newTestRateLimitUseCase(&fakeRateLimitRepo{user: &UserRateLimit{Plan: tc.plan}})
+		uc := NewPeriodUseCase(&fakeAccountSettingRepo{}, rateLimitUseCase, &BillingConfig{QuotaPeriod: quotaPeriod, Location: time.UTC}, log.DefaultLogger)
+		cycle, err := uc.Cycle(ctx, "u_10001", tc.service)
+		if err != nil || cycle != tc.want {
+			t.Errorf("plan %q service %s: cycle = %s, err = %v, want %s", tc.plan, tc.service, cycle, err, tc.want)
+		}
+		percent, err := uc.RolloverPercent(ctx, "u_10001")
+		if err != nil || percent != tc.wantPercent {
+			t.Errorf("plan %q: rollover = %v, err = %v, want %v", tc.plan, percent, err, tc.wantPercent)
+		}
+	}
+
+	// 读取用户套餐失败时返回错误
+	failing := newTestRateLimitUseCase(&fakeRateLimitRepo{userErr: errors.New("redis down")})
+	uc := NewPeriodUseCase(&fakeAccountSettingRepo{}, failing, &BillingConfig{QuotaPeriod: quotaPeriod, Location: time.UTC}, log.DefaultLogger)
+	if _, err := uc.Cycle(ctx, "u_10001", "passport"); err == nil {
+		t.Error("plan lookup failure: want error")
+	}
+
+	uc = NewPeriodUseCase(&fakeAccountSettingRepo{}, failing, &BillingConfig{QuotaPeriod: QuotaPeriodConfig{
+		DefaultCycle: constants.QuotaCycleMonthly,
+		Services:     map[string]string{"asset": constants.QuotaCycleWeekly},
+	}, Location: time.UTC}, log.DefaultLogger)
+	if cycle, err := uc.Cycle(ctx, "u_10001", "asset"); err != nil || cycle != constants.QuotaCycleWeekly {
+		t.Errorf("without plan cycles: cycle = %s, err = %v, want weekly", cycle, err)
+	}
+	if percent, err := uc.RolloverPercent(ctx, "u_10001"); err != nil || percent != 0 {
+		t.Errorf("without rollover: percent = %v, err = %v, want 0", percent, err)
+	}
+}
+
+// TestPeriodUseCaseAnniversary 周年周期使用账户的周期锚点，边界按账户时区计算
+func TestPeriodUseCaseAnniversary(t *testing.T) {
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	repo := &fakeAccountSettingRepo{setting: AccountSetting{
+		Timezone:    "Asia/Tokyo",
+		CycleAnchor: time.Date(2025, 1, 9, 20, 0, 0, 0, time.UTC), // 东京 1 月 10 日
+	}}
+	config := &BillingConfig{QuotaPeriod: QuotaPeriodConfig{DefaultCycle: constants.QuotaCycleAnniversary}, Location: time.UTC}
+	uc := NewPeriodUseCase(repo, nil, config, log.DefaultLogger)
+
+	// UTC 11 月 9 日 16:00，东京已是 11 月 10 日
+	p, err := uc.Period(context.Background(), "u_10001", "passport", time.Date(2025, 11, 9, 16, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Key != "A2025-11-10" || !p.Start.Equal(time.Date(2025, 11, 10, 0, 0, 0, 0, tokyo)) || !p.End.Equal(time.Date(2025, 12, 10, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("period = %s [%s, %s)", p.Key, p.Start, p.End)
+	}
+}
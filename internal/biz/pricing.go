@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// Usage 一次扣费的用量
+type Usage struct {
+	Quantity   int     // 用量（按服务计量单位）
+	Unit       string  // 调用方声明的单位，为空时按服务计量单位
+	CallerCost float64 // 调用方预计算的费用，仅服务允许时生效，0 表示未传
+}
+
+// resolveCost 校验用量单位并计算费用
+// 默认按账户在 at 时的适用单价（见 RatingUseCase.Rate）× 用量 计费；服务允许调用方定价时使用调用方费用，
+// 需在 [MinCost, MaxCost] 范围内，未配置 MaxCost 时不超过 适用单价 × 用量。服务不允许时忽略调用方费用（兼容旧版调用方总是传 cost）
+func (uc *BillingUseCase) resolveCost(ctx context.Context, userID, serviceName string, usage Usage, at time.Time) (float64, error) {
+	if err := uc.checkUnit(ctx, serviceName, usage.Unit); err != nil {
+		return 0, err
+	}
+
+	rate, err := uc.ratingUseCase.Rate(ctx, userID, serviceName, at)
+	if err != nil {
+		return 0, err
+	}
+	ratedCost := rate.UnitPrice * float64(usage.Quantity)
+	pricing := uc.conf.Pricing[serviceName]
+	if !pricing.AllowCallerCost || usage.CallerCost <= 0 {
+		return ratedCost, nil
+	}
+
+	maxCost := pricing.MaxCost
+	if maxCost <= 0 {
+		maxCost = ratedCost
+	}
+	if usage.CallerCost < pricing.MinCost || usage.CallerCost > maxCost {
+		return 0, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeCallerCostOutOfBounds)
+	}
+	return usage.CallerCost, nil
+}
+
+// checkUnit 校验调用方声明的用量单位与服务计量单位一致，未声明时不校验
+func (uc *BillingUseCase) checkUnit(ctx context.Context, serviceName, unit string) error {
+	if unit != "" && !strings.EqualFold(unit, uc.conf.Unit(serviceName)) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUsageUnitMismatch)
+	}
+	return nil
+}
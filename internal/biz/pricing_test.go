@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestPricingUseCase(rules []*PriceRule) *BillingUseCase {
+	config := &BillingConfig{
+		Prices: map[string]float64{"passport": 1, "asset": 2, "ocr": 0.25},
+		Pricing: map[string]ServicePricing{
+			"passport": {Unit: constants.UsageUnitToken, AllowCallerCost: true, MinCost: 0.5},
+			"asset":    {Unit: constants.UsageUnitMB, AllowCallerCost: true, MinCost: 1, MaxCost: 100},
+		},
+	}
+	return &BillingUseCase{
+		ratingUseCase: NewRatingUseCase(&fakePricingRepo{rules: rules}, config, log.DefaultLogger),
+		conf:          config,
+		log:           log.NewHelper(log.DefaultLogger),
+	}
+}
+
+// TestResolveCost 默认按适用单价 × 用量，未允许调用方定价的服务忽略调用方费用；
+// 允许时校验调用方费用的上下限，未配置上限时不超过适用单价 × 用量
+func TestResolveCost(t *testing.T) {
+	at := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	discount := &PriceRule{ID: "d", ServiceName: "passport", DiscountPercent: 50, StartsAt: at.AddDate(0, -1, 0)}
+
+	cases := []struct {
+		name     string
+		rules    []*PriceRule
+		service  string
+		usage    Usage
+		wantCost float64
+		wantCode int
+	}{
+		{name: "rated", service: "passport", usage: Usage{Quantity: 3}, wantCost: 3},
+		{name: "rated with account discount", rules: []*PriceRule{discount}, service: "passport", usage: Usage{Quantity: 3}, wantCost: 1.5},
+		{name: "service without pricing config", service: "ocr", usage: Usage{Quantity: 2, CallerCost: 9}, wantCost: 0.5},
+		{name: "caller cost within rated bound", service: "passport", usage: Usage{Quantity: 3, CallerCost: 2}, wantCost: 2},
+		{name: "caller cost at rated bound", service: "passport", usage: Usage{Quantity: 3, CallerCost: 3}, wantCost: 3},
+		{name: "caller cost above rated bound", service: "passport", usage: Usage{Quantity: 3, CallerCost: 3.01}, wantCode: billingErrors.ErrCodeCallerCostOutOfBounds},
+		{name: "caller cost above discounted bound", rules: []*PriceRule{discount}, service: "passport", usage: Usage{Quantity: 3, CallerCost: 2}, wantCode: billingErrors.ErrCodeCallerCostOutOfBounds},
+		{name: "caller cost below min", service: "passport", usage: Usage{Quantity: 3, CallerCost: 0.4}, wantCode: billingErrors.ErrCodeCallerCostOutOfBounds},
+		{name: "caller cost within max cost", service: "asset", usage: Usage{Quantity: 1, CallerCost: 50}, wantCost: 50},
+		{name: "caller cost above max cost", service: "asset", usage: Usage{Quantity: 100, CallerCost: 101}, wantCode: billingErrors.ErrCodeCallerCostOutOfBounds},
+		{name: "unit matches case-insensitively", service: "passport", usage: Usage{Quantity: 2, Unit: "TOKEN"}, wantCost: 2},
+		{name: "unit mismatch", service: "passport", usage: Usage{Quantity: 2, Unit: constants.UsageUnitCall}, wantCode: billingErrors.ErrCodeUsageUnitMismatch},
+		{name: "default unit is call", service: "ocr", usage: Usage{Quantity: 2, Unit: constants.UsageUnitCall}, wantCost: 0.5},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestPricingUseCase(tc.rules)
+			cost, err := uc.resolveCost(context.Background(), "u1", tc.service, tc.usage, at)
+			assertErrCode(t, err, tc.wantCode)
+			if err == nil && cost != tc.wantCost {
+				t.Errorf("cost = %v, want %v", cost, tc.wantCost)
+			}
+		})
+	}
+}
+
+// TestNewBillingConfigPricing 计量单位统一为小写，未配置的服务按次计费
+func TestNewBillingConfigPricing(t *testing.T) {
+	config := NewBillingConfig(&conf.Bootstrap{Billing: &conf.Billing{
+		Pricing: map[string]*conf.ServicePricing{
+			"llm": {Unit: "Token", AllowCallerCost: true, MinCost: 0.01, MaxCost: 5},
+		},
+	}})
+	if got := config.Pricing["llm"]; got != (ServicePricing{Unit: constants.UsageUnitToken, AllowCallerCost: true, MinCost: 0.01, MaxCost: 5}) {
+		t.Errorf("llm pricing = %+v", got)
+	}
+	if unit := config.Unit("llm"); unit != constants.UsageUnitToken {
+		t.Errorf("llm unit = %s, want token", unit)
+	}
+	if unit := config.Unit("passport"); unit != constants.UsageUnitCall {
+		t.Errorf("unconfigured unit = %s, want call", unit)
+	}
+}
@@ -0,0 +1,204 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// RateLimitRule 服务限流规则（按请求次数），0 表示不限
+type RateLimitRule struct {
+	PerSecond int64 `json:"perSecond"` // 每秒请求数上限（令牌桶，容量与每秒补充数相同）
+	PerDay    int64 `json:"perDay"`    // 每日请求数上限（本地时区自然日）
+}
+
+// Unlimited 是否不限流
+func (r RateLimitRule) Unlimited() bool {
+	return r.PerSecond <= 0 && r.PerDay <= 0
+}
+
+// RateLimitConfig 请求频率限制配置
+type RateLimitConfig struct {
+	DefaultPlan  string                              // 未指定套餐的用户使用的套餐
+	Plans        map[string]map[string]RateLimitRule // 套餐名 -> 服务名 -> 规则，服务 "*" 适用于未单独配置的服务
+	UserCacheTTL time.Duration                       // 用户限流设置缓存时间
+}
+
+// UserRateLimit 用户限流设置：套餐及用户级规则
+type UserRateLimit struct {
+	UserID    string                   `json:"-"`
+	Plan      string                   `json:"plan"`      // 为空表示默认套餐
+	Overrides map[string]RateLimitRule `json:"overrides"` // 服务名 -> 规则，优先于套餐规则
+	UpdatedAt time.Time                `json:"updatedAt"`
+}
+
+// RateLimitCheck 一个服务的限流检查
+type RateLimitCheck struct {
+	ServiceName string
+	Rule        RateLimitRule
+}
+
+// RateLimitDecision 限流检查结果
+type RateLimitDecision struct {
+	Allowed     bool
+	RetryAfter  time.Duration // 被限流时建议的重试等待时间
+	ServiceName string        // 被限流的服务
+	Limit       string        // 被限流的规则：second / day
+}
+
+// RateLimitRepo 请求频率限制数据层接口（定义在 biz 层）
+type RateLimitRepo interface {
+	// GetUserRateLimit 获取用户限流设置（带缓存），未设置时返回 nil
+	GetUserRateLimit(ctx context.Context, userID string) (*UserRateLimit, error)
+	// SaveUserRateLimit 保存用户限流设置并失效缓存
+	SaveUserRateLimit(ctx context.Context, limit *UserRateLimit) error
+	// Acquire 原子检查多个服务的限流规则，全部通过时才计入，now 用于令牌桶补充与每日窗口
+	Acquire(ctx context.Context, userID string, checks []*RateLimitCheck, now time.Time) (*RateLimitDecision, error)
+}
+
+// RateLimitUseCase 请求频率限制业务逻辑
+type RateLimitUseCase struct {
+	repo    RateLimitRepo
+	conf    *BillingConfig
+	log     *log.Helper
+	metrics *metrics.BillingMetrics
+}
+
+// NewRateLimitUseCase 创建请求频率限制 UseCase
+func NewRateLimitUseCase(repo RateLimitRepo, conf *BillingConfig, logger log.Logger) *RateLimitUseCase {
+	return &RateLimitUseCase{
+		repo:    repo,
+		conf:    conf,
+		log:     log.NewHelper(logger),
+		metrics: metrics.GetMetrics(),
+	}
+}
+
+// Check 检查并计入一次请求（批量检查时每个服务各计一次），每日限额按 now 所在时区的自然日计算
+// 限流存储不可用时放行：限流用于防滥用，不应因 Redis 故障拒绝正常请求
+func (uc *RateLimitUseCase) Check(ctx context.Context, userID string, services []string, now time.Time) *RateLimitDecision {
+	user, err := uc.repo.GetUserRateLimit(ctx, userID)
+	if err != nil {
+		uc.log.Warnf("Get user rate limit failed, skip rate limit: user_id=%s, error=%v", userID, err)
+		return &RateLimitDecision{Allowed: true}
+	}
+
+	var checks []*RateLimitCheck
+	for _, serviceName := range services {
+		rule := uc.resolveRule(user, serviceName)
+		if !rule.Unlimited() {
+			checks = append(checks, &RateLimitCheck{ServiceName: serviceName, Rule: rule})
+		}
+	}
+	if len(checks) == 0 {
+		return &RateLimitDecision{Allowed: true}
+	}
+
+	decision, err := uc.repo.Acquire(ctx, userID, checks, now)
+	if err != nil {
+		uc.log.Warnf("Rate limit acquire failed, skip rate limit: user_id=%s, error=%v", userID, err)
+		return &RateLimitDecision{Allowed: true}
+	}
+	if !decision.Allowed && uc.metrics != nil {
+		uc.metrics.RateLimitedTotal.WithLabelValues(decision.ServiceName, decision.Limit).Inc()
+	}
+	return decision
+}
+
+// plan 用户生效的套餐
+func (uc *RateLimitUseCase) plan(user *UserRateLimit) string {
+	if user != nil && user.Plan != "" {
+		return user.Plan
+	}
+	return uc.conf.RateLimit.DefaultPlan
+}
+
+// UserPlan 用户生效的套餐（未指定时为默认套餐）
+func (uc *RateLimitUseCase) UserPlan(ctx context.Context, userID string) (string, error) {
+	user, err := uc.repo.GetUserRateLimit(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return uc.plan(user), nil
+}
+
+// resolveRule 服务生效的规则：用户规则（服务、"*"）优先，其次为套餐规则（服务、"*"）
+func (uc *RateLimitUseCase) resolveRule(user *UserRateLimit, serviceName string) RateLimitRule {
+	if user != nil {
+		if rule, ok := user.Overrides[serviceName]; ok {
+			return rule
+		}
+		if rule, ok := user.Overrides[constants.RateLimitAllServices]; ok {
+			return rule
+		}
+	}
+	rules := uc.conf.RateLimit.Plans[uc.plan(user)]
+	if rule, ok := rules[serviceName]; ok {
+		return rule
+	}
+	return rules[constants.RateLimitAllServices]
+}
+
+// SetUserRateLimit 设置用户限流（整体覆盖之前的设置）
+func (uc *RateLimitUseCase) SetUserRateLimit(ctx context.Context, limit *UserRateLimit) error {
+	if limit.UserID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if limit.Plan != "" {
+		if _, ok := uc.conf.RateLimit.Plans[limit.Plan]; !ok {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownRateLimitPlan)
+		}
+	}
+	for serviceName, rule := range limit.Overrides {
+		if _, ok := uc.conf.Prices[serviceName]; !ok && serviceName != constants.RateLimitAllServices {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidRateLimit)
+		}
+		if rule.PerSecond < 0 || rule.PerDay < 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidRateLimit)
+		}
+	}
+	return uc.repo.SaveUserRateLimit(ctx, limit)
+}
+
+// GetUserRateLimit 获取用户限流设置及各服务生效的规则（按服务名排序，未设置时返回默认套餐）
+func (uc *RateLimitUseCase) GetUserRateLimit(ctx context.Context, userID string) (*UserRateLimit, []*RateLimitCheck, error) {
+	if userID == "" {
+		return nil, nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	user, err := uc.repo.GetUserRateLimit(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		user = &UserRateLimit{UserID: userID}
+	}
+	user.Plan = uc.plan(user)
+
+	services := make([]string, 0, len(uc.conf.Prices))
+	for serviceName := range uc.conf.Prices {
+		services = append(services, serviceName)
+	}
+	slices.Sort(services)
+	effective := make([]*RateLimitCheck, 0, len(services))
+	for _, serviceName := range services {
+		effective = append(effective, &RateLimitCheck{ServiceName: serviceName, Rule: uc.resolveRule(user, serviceName)})
+	}
+	return user, effective, nil
+}
+
+// SetUserRateLimit 设置用户限流
+func (uc *BillingUseCase) SetUserRateLimit(ctx context.Context, limit *UserRateLimit) error {
+	return uc.rateLimitUseCase.SetUserRateLimit(ctx, limit)
+}
+
+// GetUserRateLimit 获取用户限流设置及各服务生效的规则
+func (uc *BillingUseCase) GetUserRateLimit(ctx context.Context, userID string) (*UserRateLimit, []*RateLimitCheck, error) {
+	return uc.rateLimitUseCase.GetUserRateLimit(ctx, userID)
+}
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeRateLimitRepo 记录 Acquire 收到的检查项，返回预设的结果
+type fakeRateLimitRepo struct {
+	user       *UserRateLimit
+	userErr    error
+	decision   *RateLimitDecision
+	acquireErr error
+	checks     []*RateLimitCheck
+	acquired   int
+}
+
+func (r *fakeRateLimitRepo) GetUserRateLimit(context.Context, string) (*UserRateLimit, error) {
+	return r.user, r.userErr
+}
+
+func (r *fakeRateLimitRepo) SaveUserRateLimit(context.Context, *UserRateLimit) error {
+	return nil
+}
+
+func (r *fakeRateLimitRepo) Acquire(_ context.Context, _ string, checks []*RateLimitCheck, _ time.Time) (*RateLimitDecision, error) {
+	r.acquired++
+	r.checks = checks
+	if r.acquireErr != nil {
+		return nil, r.acquireErr
+	}
+	if r.decision != nil {
+		return r.decision, nil
+	}
+	return &RateLimitDecision{Allowed: true}, nil
+}
+
+func newTestRateLimitUseCase(repo RateLimitRepo) *RateLimitUseCase {
+	return NewRateLimitUseCase(repo, &BillingConfig{
+		Prices: map[string]float64{"passport": 1, "asset": 2, "ocr": 3},
+		RateLimit: RateLimitConfig{
+			DefaultPlan: "free",
+			Plans: map[string]map[string]RateLimitRule{
+				"free": {
+					constants.RateLimitAllServices: {PerSecond: 5, PerDay: 1000},
+					"asset":                        {PerSecond: 1},
+				},
+				"pro": {
+					constants.RateLimitAllServices: {PerSecond: 50},
+				},
+			},
+		},
+	}, log.DefaultLogger)
+}
+
+// TestRateLimitRuleResolution 规则优先级：用户指定服务 > 用户 "*" > 套餐指定服务 > 套餐 "*"，不限的服务不参与检查
+func TestRateLimitRuleResolution(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		user *UserRateLimit
+		want map[string]RateLimitRule
+	}{
+		{
+			name: "default plan",
+			want: map[string]RateLimitRule{"passport": {PerSecond: 5, PerDay: 1000}, "asset": {PerSecond: 1}},
+		},
+		{
+			name: "plan without service rule",
+			user: &UserRateLimit{Plan: "pro"},
+			want: map[string]RateLimitRule{"passport": {PerSecond: 50}, "asset": {PerSecond: 50}},
+		},
+		{
+			name: "user all services over plan service",
+			user: &UserRateLimit{Overrides: map[string]RateLimitRule{constants.RateLimitAllServices: {PerDay: 10}}},
+			want: map[string]RateLimitRule{"passport": {PerDay: 10}, "asset": {PerDay: 10}},
+		},
+		{
+			name: "user service over user all services",
+			user: &UserRateLimit{Plan: "pro", Overrides: map[string]RateLimitRule{
+				constants.RateLimitAllServices: {PerDay: 10},
+				"asset":                        {PerSecond: 2},
+			}},
+			want: map[string]RateLimitRule{"passport": {PerDay: 10}, "asset": {PerSecond: 2}},
+		},
+		{
+			name: "unlimited override skipped",
+			user: &UserRateLimit{Overrides: map[string]RateLimitRule{"asset": {}}},
+			want: map[string]RateLimitRule{"passport": {PerSecond: 5, PerDay: 1000}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeRateLimitRepo{user: tc.user}
+			uc := newTestRateLimitUseCase(repo)
+			if d := uc.Check(ctx, "u1", []string{"passport", "asset"}, now); !d.Allowed {
+				t.Fatalf("decision = %+v, want allowed", d)
+			}
+			got := map[string]RateLimitRule{}
+			for _, check := range repo.checks {
+				got[check.ServiceName] = check.Rule
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("checks = %v, want %v", got, tc.want)
+			}
+			for serviceName, rule := range tc.want {
+				if got[serviceName] != rule {
+					t.Errorf("%s rule = %+v, want %+v", serviceName, got[serviceName], rule)
+				}
+			}
+		})
+	}
+}
+
+// TestRateLimitCheck 全部不限时不访问存储；存储故障时放行；被限流时透传结果
+func TestRateLimitCheck(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	unlimited := &UserRateLimit{Overrides: map[string]RateLimitRule{constants.RateLimitAllServices: {}}}
+
+	repo := &fakeRateLimitRepo{user: unlimited}
+	if d := newTestRateLimitUseCase(repo).Check(ctx, "u1", []string{"passport"}, now); !d.Allowed || repo.acquired != 0 {
+		t.Errorf("unlimited: decision = %+v, acquired = %d, want allowed without acquire", d, repo.acquired)
+	}
+
+	repo = &fakeRateLimitRepo{userErr: errors.New("redis down")}
+	if d := newTestRateLimitUseCase(repo).Check(ctx, "u1", []string{"passport"}, now); !d.Allowed || repo.acquired != 0 {
+		t.Errorf("user setting failure: decision = %+v, want allowed", d)
+	}
+
+	repo = &fakeRateLimitRepo{acquireErr: errors.New("redis down")}
+	if d := newTestRateLimitUseCase(repo).Check(ctx, "u1", []string{"passport"}, now); !d.Allowed {
+		t.Errorf("acquire failure: decision = %+v, want allowed", d)
+	}
+
+	limited := &RateLimitDecision{RetryAfter: time.Second, ServiceName: "asset", Limit: constants.RateLimitPerSecond}
+	repo = &fakeRateLimitRepo{decision: limited}
+	if d := newTestRateLimitUseCase(repo).Check(ctx, "u1", []string{"passport", "asset"}, now); d != limited {
+		t.Errorf("limited: decision = %+v, want %+v", d, limited)
+	}
+}
@@ -0,0 +1,372 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// contractSettleBatchSize 每轮结算的合同数
+const contractSettleBatchSize = 100
+
+// AccountPricingConfig 账户价格规则与合同配置
+type AccountPricingConfig struct {
+	RuleCacheTTL time.Duration // 账户价格规则缓存时间
+	SettleGrace  time.Duration // 合同到期后等待在途扣费落库的时间
+}
+
+// PriceRule 账户价格规则：单价覆盖（UnitPrice > 0）或按目录价打折（DiscountPercent），在 [StartsAt, EndsAt) 内生效
+// 合同价是带 ContractID 的规则，有效期与合同期限一致
+type PriceRule struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"-"`
+	ServiceName     string    `json:"serviceName"` // "*" 表示全部服务
+	UnitPrice       float64   `json:"unitPrice,omitempty"`
+	DiscountPercent float64   `json:"discountPercent,omitempty"` // 折扣比例（百分比），30 表示按目录价的 70% 计费
+	ContractID      string    `json:"contractId,omitempty"`      // 所属合同，为空表示单独设置的规则
+	StartsAt        time.Time `json:"startsAt"`
+	EndsAt          time.Time `json:"endsAt"` // 零值表示长期有效
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// Active 规则在指定时间是否生效
+func (r *PriceRule) Active(at time.Time) bool {
+	return !at.Before(r.StartsAt) && (r.EndsAt.IsZero() || at.Before(r.EndsAt))
+}
+
+// Applies 规则是否适用于服务
+func (r *PriceRule) Applies(serviceName string) bool {
+	return r.ServiceName == serviceName || r.ServiceName == constants.PriceRuleAllServices
+}
+
+// Price 按目录价计算规则单价
+func (r *PriceRule) Price(listPrice float64) float64 {
+	if r.UnitPrice > 0 {
+		return r.UnitPrice
+	}
+	return listPrice * (100 - r.DiscountPercent) / 100
+}
+
+// Source 规则的价格来源
+func (r *PriceRule) Source() string {
+	switch {
+	case r.ContractID != "":
+		return constants.PriceSourceContract
+	case r.UnitPrice > 0:
+		return constants.PriceSourceOverride
+	default:
+		return constants.PriceSourceDiscount
+	}
+}
+
+// moreSpecific 两条都适用的规则中 r 是否更具体：
+// 指定服务 > 全部服务，同一范围内单价覆盖 > 折扣，仍相同时开始时间晚的优先（后签的合同/调价覆盖先前的）
+func (r *PriceRule) moreSpecific(other *PriceRule) bool {
+	if mine, theirs := r.ServiceName != constants.PriceRuleAllServices, other.ServiceName != constants.PriceRuleAllServices; mine != theirs {
+		return mine
+	}
+	if mine, theirs := r.UnitPrice > 0, other.UnitPrice > 0; mine != theirs {
+		return mine
+	}
+	if !r.StartsAt.Equal(other.StartsAt) {
+		return r.StartsAt.After(other.StartsAt)
+	}
+	return r.CreatedAt.After(other.CreatedAt)
+}
+
+// Rate 服务在某一时间的适用单价
+type Rate struct {
+	ServiceName string
+	ListPrice   float64 // 目录价（billing.prices）
+	UnitPrice   float64 // 适用单价
+	Source      string  // list / override / discount / contract
+	RuleID      string  // 生效的价格规则，目录价时为空
+	ContractID  string  // 合同价所属合同
+}
+
+// Contract 承诺消费合同
+// 客户预付承诺金额（创建时计入余额），合同期内的余额消费按合同价计费并累计为消耗；
+// 到期后未用完的承诺金额作为补差（true-up）从余额扣除
+type Contract struct {
+	ID            string
+	UserID        string
+	CommitAmount  float64 // 承诺金额（预付）
+	DrawnAmount   float64 // 合同期内的余额消费
+	StartsAt      time.Time
+	EndsAt        time.Time // 到期时间（不含）
+	Status        string    // active / settled
+	TrueUpAmount  float64   // 应补差额：承诺金额 - 消耗（不小于 0），结算时计算
+	TrueUpCharged float64   // 实际扣款，余额不足时小于 TrueUpAmount
+	SettledAt     time.Time
+	CreatedAt     time.Time
+	Rates         []*PriceRule // 合同价
+}
+
+// Remaining 未消耗的承诺金额，即到期结算时的应补差额
+func (c *Contract) Remaining() float64 {
+	return max(c.CommitAmount-c.DrawnAmount, 0)
+}
+
+// TrueUpCharge 到期结算时按账户余额实际扣除的补差：余额不足时扣至 0
+func (c *Contract) TrueUpCharge(balance float64) float64 {
+	return min(c.Remaining(), max(balance, 0))
+}
+
+// PricingRepo 账户价格规则与合同数据层接口（定义在 biz 层）
+// 合同消耗在扣费事务中累计（见 BillingRepo.DeductQuota），这里不单独更新
+type PricingRepo interface {
+	// GetUserPriceRules 获取账户未到期的价格规则（含合同价与未开始的规则，带缓存）
+	GetUserPriceRules(ctx context.Context, userID string) ([]*PriceRule, error)
+	// ListPriceRules 获取账户价格规则（不走缓存），按开始时间倒序，includeExpired 为 false 时不含已到期的
+	ListPriceRules(ctx context.Context, userID string, includeExpired bool) ([]*PriceRule, error)
+	// CreatePriceRule 创建价格规则（生成ID）并失效缓存
+	CreatePriceRule(ctx context.Context, rule *PriceRule) error
+	// DeletePriceRule 删除单独设置的价格规则并失效缓存，不存在（或属于合同）时返回 false
+	DeletePriceRule(ctx context.Context, userID, ruleID string) (bool, error)
+	// CreateContract 创建合同及合同价（生成ID），预付的承诺金额在同一事务中计入余额并记录为合同预付订单；
+	// 与账户其他未结算合同期限重叠时返回 ErrCodeContractOverlap
+	CreateContract(ctx context.Context, contract *Contract) error
+	// ListContracts 获取账户合同（含合同价），按开始时间倒序，includeSettled 为 false 时不含已结算的
+	ListContracts(ctx context.Context, userID string, includeSettled bool) ([]*Contract, error)
+	// ListContractsToSettle 获取到期时间早于 before 的未结算合同
+	ListContractsToSettle(ctx context.Context, before time.Time, limit int) ([]*Contract, error)
+	// SettleContract 合同 true-up 结算：未用完的承诺金额从余额扣除（余额不足时扣至 0）并记录补差流水，
+	// 合同置为已结算；已被其他实例结算时返回 nil
+	SettleContract(ctx context.Context, contractID string, now time.Time) (*Contract, error)
+}
+
+// RatingUseCase 计价：按账户价格规则与合同确定服务单价，管理价格规则与合同
+type RatingUseCase struct {
+	repo PricingRepo
+	conf *BillingConfig
+	log  *log.Helper
+}
+
+// NewRatingUseCase 创建计价 UseCase
+func NewRatingUseCase(repo PricingRepo, conf *BillingConfig, logger log.Logger) *RatingUseCase {
+	return &RatingUseCase{
+		repo: repo,
+		conf: conf,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Rate 服务在 at 时的适用单价：生效规则中最具体的一条（见 PriceRule.moreSpecific），没有时为目录价
+func (uc *RatingUseCase) Rate(ctx context.Context, userID, serviceName string, at time.Time) (*Rate, error) {
+	listPrice, ok := uc.conf.Prices[serviceName]
+	if !ok {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnknownService)
+	}
+	rules, err := uc.repo.GetUserPriceRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return resolveRate(rules, serviceName, listPrice, at), nil
+}
+
+// Rates 全部服务在 at 时的适用单价（按服务名排序）
+func (uc *RatingUseCase) Rates(ctx context.Context, userID string, at time.Time) ([]*Rate, error) {
+	rules, err := uc.repo.GetUserPriceRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	services := make([]string, 0, len(uc.conf.Prices))
+	for serviceName := range uc.conf.Prices {
+		services = append(services, serviceName)
+	}
+	slices.Sort(services)
+	rates := make([]*Rate, 0, len(services))
+	for _, serviceName := range services {
+		rates = append(rates, resolveRate(rules, serviceName, uc.conf.Prices[serviceName], at))
+	}
+	return rates, nil
+}
+
+// resolveRate 从账户规则中选出最具体的生效规则计算单价
+func resolveRate(rules []*PriceRule, serviceName string, listPrice float64, at time.Time) *Rate {
+	var best *PriceRule
+	for _, rule := range rules {
+		if !rule.Applies(serviceName) || !rule.Active(at) {
+			continue
+		}
+		if best == nil || rule.moreSpecific(best) {
+			best = rule
+		}
+	}
+	rate := &Rate{ServiceName: serviceName, ListPrice: listPrice, UnitPrice: listPrice, Source: constants.PriceSourceList}
+	if best != nil {
+		rate.UnitPrice = best.Price(listPrice)
+		rate.Source = best.Source()
+		rate.RuleID = best.ID
+		rate.ContractID = best.ContractID
+	}
+	return rate
+}
+
+// validateRule 校验规则的服务（已配置单价的服务或 "*"）、单价覆盖与折扣二选一、有效期
+func (uc *RatingUseCase) validateRule(ctx context.Context, rule *PriceRule) error {
+	if _, ok := uc.conf.Prices[rule.ServiceName]; !ok && rule.ServiceName != constants.PriceRuleAllServices {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidPriceRule)
+	}
+	if rule.UnitPrice < 0 || rule.DiscountPercent < 0 || rule.DiscountPercent > constants.MaxDiscountPercent {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidPriceRule)
+	}
+	if (rule.UnitPrice > 0) == (rule.DiscountPercent > 0) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidPriceRule)
+	}
+	if !rule.EndsAt.IsZero() && !rule.EndsAt.After(rule.StartsAt) {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidPriceRule)
+	}
+	return nil
+}
+
+// CreatePriceRule 创建账户价格规则，未指定开始时间时立即生效
+func (uc *RatingUseCase) CreatePriceRule(ctx context.Context, rule *PriceRule) (*PriceRule, error) {
+	if rule.UserID == "" || rule.ServiceName == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if rule.StartsAt.IsZero() {
+		rule.StartsAt = time.Now()
+	}
+	rule.ContractID = ""
+	if err := uc.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreatePriceRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Price rule created: rule_id=%s, user_id=%s, service=%s, unit_price=%.6f, discount=%.2f",
+		rule.ID, rule.UserID, rule.ServiceName, rule.UnitPrice, rule.DiscountPercent)
+	return rule, nil
+}
+
+// ListPriceRules 获取账户价格规则
+func (uc *RatingUseCase) ListPriceRules(ctx context.Context, userID string, includeExpired bool) ([]*PriceRule, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.repo.ListPriceRules(ctx, userID, includeExpired)
+}
+
+// DeletePriceRule 删除账户价格规则（合同价随合同存在，不能单独删除）
+func (uc *RatingUseCase) DeletePriceRule(ctx context.Context, userID, ruleID string) error {
+	if userID == "" || ruleID == "" {
+		return pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	deleted, err := uc.repo.DeletePriceRule(ctx, userID, ruleID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePriceRuleNotFound)
+	}
+	return nil
+}
+
+// CreateContract 创建承诺消费合同：校验承诺金额、期限与合同价，合同价的有效期与合同期限一致
+func (uc *RatingUseCase) CreateContract(ctx context.Context, contract *Contract) (*Contract, error) {
+	if contract.UserID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	if contract.StartsAt.IsZero() {
+		contract.StartsAt = time.Now()
+	}
+	if contract.CommitAmount <= 0 || !contract.EndsAt.After(contract.StartsAt) || !contract.EndsAt.After(time.Now()) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidContract)
+	}
+	for _, rate := range contract.Rates {
+		rate.UserID = contract.UserID
+		rate.StartsAt = contract.StartsAt
+		rate.EndsAt = contract.EndsAt
+		if err := uc.validateRule(ctx, rate); err != nil {
+			return nil, err
+		}
+	}
+	contract.Status = constants.ContractStatusActive
+	contract.DrawnAmount = 0
+	if err := uc.repo.CreateContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Contract created: contract_id=%s, user_id=%s, commit=%.2f, term=[%s, %s), rates=%d",
+		contract.ID, contract.UserID, contract.CommitAmount, contract.StartsAt.Format(time.RFC3339), contract.EndsAt.Format(time.RFC3339), len(contract.Rates))
+	return contract, nil
+}
+
+// ListContracts 获取账户合同
+func (uc *RatingUseCase) ListContracts(ctx context.Context, userID string, includeSettled bool) ([]*Contract, error) {
+	if userID == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
+	}
+	return uc.repo.ListContracts(ctx, userID, includeSettled)
+}
+
+// SettleContracts 结算到期超过宽限期的合同，返回本轮结算的合同数
+// 宽限期用于等待到期前的在途扣费落库，使其计入消耗；单个合同失败时记录日志，下一轮重试
+func (uc *RatingUseCase) SettleContracts(ctx context.Context, now time.Time) (int, error) {
+	contracts, err := uc.repo.ListContractsToSettle(ctx, now.Add(-uc.conf.AccountPricing.SettleGrace), contractSettleBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	settled := 0
+	for _, c := range contracts {
+		result, err := uc.repo.SettleContract(ctx, c.ID, now)
+		if err != nil {
+			uc.log.Errorf("Settle contract failed: contract_id=%s, user_id=%s, error=%v", c.ID, c.UserID, err)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		settled++
+		if result.TrueUpCharged < result.TrueUpAmount {
+			uc.log.Warnf("Contract true-up not fully charged: contract_id=%s, user_id=%s, true_up=%.2f, charged=%.2f",
+				result.ID, result.UserID, result.TrueUpAmount, result.TrueUpCharged)
+		}
+		uc.log.Infof("Contract settled: contract_id=%s, user_id=%s, commit=%.2f, drawn=%.2f, true_up=%.2f",
+			result.ID, result.UserID, result.CommitAmount, result.DrawnAmount, result.TrueUpCharged)
+	}
+	return settled, nil
+}
+
+// ========== BillingUseCase 组合方法 ==========
+
+// GetAccountRates 获取账户各服务当前适用单价
+func (uc *BillingUseCase) GetAccountRates(ctx context.Context, userID string) ([]*Rate, error) {
+	return uc.ratingUseCase.Rates(ctx, userID, time.Now())
+}
+
+// CreatePriceRule 创建账户价格规则
+func (uc *BillingUseCase) CreatePriceRule(ctx context.Context, rule *PriceRule) (*PriceRule, error) {
+	return uc.ratingUseCase.CreatePriceRule(ctx, rule)
+}
+
+// ListPriceRules 获取账户价格规则
+func (uc *BillingUseCase) ListPriceRules(ctx context.Context, userID string, includeExpired bool) ([]*PriceRule, error) {
+	return uc.ratingUseCase.ListPriceRules(ctx, userID, includeExpired)
+}
+
+// DeletePriceRule 删除账户价格规则
+func (uc *BillingUseCase) DeletePriceRule(ctx context.Context, userID, ruleID string) error {
+	return uc.ratingUseCase.DeletePriceRule(ctx, userID, ruleID)
+}
+
+// CreateContract 创建承诺消费合同
+func (uc *BillingUseCase) CreateContract(ctx context.Context, contract *Contract) (*Contract, error) {
+	return uc.ratingUseCase.CreateContract(ctx, contract)
+}
+
+// ListContracts 获取账户合同
+func (uc *BillingUseCase) ListContracts(ctx context.Context, userID string, includeSettled bool) ([]*Contract, error) {
+	return uc.ratingUseCase.ListContracts(ctx, userID, includeSettled)
+}
+
+// SettleContracts 结算到期的合同（由 Cron 调用）
+func (uc *BillingUseCase) SettleContracts(ctx context.Context) (int, error) {
+	return uc.ratingUseCase.SettleContracts(ctx, time.Now())
+}
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakePricingRepo 固定的价格规则与待结算合同
+type fakePricingRepo struct {
+	PricingRepo
+	rules        []*PriceRule
+	toSettle     []*Contract
+	settleBefore time.Time
+	settle       func(contractID string) (*Contract, error)
+}
+
+func (f *fakePricingRepo) GetUserPriceRules(ctx context.Context, userID string) ([]*PriceRule, error) {
+	return f.rules, nil
+}
+
+func (f *fakePricingRepo) ListContractsToSettle(ctx context.Context, before time.Time, limit int) ([]*Contract, error) {
+	f.settleBefore = before
+	return f.toSettle, nil
+}
+
+func (f *fakePricingRepo) SettleContract(ctx context.Context, contractID string, now time.Time) (*Contract, error) {
+	return f.settle(contractID)
+}
+
+func newTestRatingUseCase(repo PricingRepo) *RatingUseCase {
+	return NewRatingUseCase(repo, &BillingConfig{
+		Prices:         map[string]float64{"passport": 1, "asset": 2},
+		AccountPricing: AccountPricingConfig{SettleGrace: time.Hour},
+	}, log.DefaultLogger)
+}
+
+// TestRatingRatePrecedence 生效规则中指定服务优先于全部服务，同一范围内单价覆盖优先于折扣，
+// 仍相同时开始时间晚的优先（合同价与单独设置的规则同等比较），有效期外的规则不生效
+func TestRatingRatePrecedence(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	at := day(6, 15)
+
+	allDiscount := &PriceRule{ID: "all-discount", ServiceName: constants.PriceRuleAllServices, DiscountPercent: 20, StartsAt: day(1, 1)}
+	allOverride := &PriceRule{ID: "all-override", ServiceName: constants.PriceRuleAllServices, UnitPrice: 0.6, StartsAt: day(1, 1)}
+	serviceDiscount := &PriceRule{ID: "service-discount", ServiceName: "passport", DiscountPercent: 10, StartsAt: day(1, 1)}
+	serviceOverride := &PriceRule{ID: "service-override", ServiceName: "passport", UnitPrice: 0.5, StartsAt: day(3, 1)}
+	// 合同期 [2025-01-01, 2026-01-01)，早于 serviceOverride 开始
+	contractRate := &PriceRule{ID: "contract-rate", ServiceName: "passport", UnitPrice: 0.4, ContractID: "c1", StartsAt: day(1, 1), EndsAt: day(12, 31).AddDate(0, 0, 1)}
+	// 合同期 [2025-05-01, 2025-07-01)，晚于 serviceOverride 开始
+	laterContractRate := &PriceRule{ID: "later-contract-rate", ServiceName: "passport", UnitPrice: 0.3, ContractID: "c2", StartsAt: day(5, 1), EndsAt: day(7, 1)}
+	contractDiscount := &PriceRule{ID: "contract-discount", ServiceName: constants.PriceRuleAllServices, DiscountPercent: 50, ContractID: "c3", StartsAt: day(6, 1), EndsAt: day(7, 1)}
+	expired := &PriceRule{ID: "expired", ServiceName: "passport", UnitPrice: 0.1, StartsAt: day(1, 1), EndsAt: day(6, 1)}
+	notStarted := &PriceRule{ID: "not-started", ServiceName: "passport", UnitPrice: 0.1, StartsAt: day(7, 1)}
+	olderCreated := &PriceRule{ID: "older-created", ServiceName: "passport", UnitPrice: 0.7, StartsAt: day(3, 1), CreatedAt: day(2, 1)}
+	newerCreated := &PriceRule{ID: "newer-created", ServiceName: "passport", UnitPrice: 0.8, StartsAt: day(3, 1), CreatedAt: day(2, 2)}
+
+	cases := []struct {
+		name       string
+		rules      []*PriceRule
+		at         time.Time
+		wantPrice  float64
+		wantSource string
+		wantRule   string
+		wantContr  string
+	}{
+		{name: "list price", wantPrice: 1, wantSource: constants.PriceSourceList},
+		{name: "all services discount", rules: []*PriceRule{allDiscount}, wantPrice: 0.8, wantSource: constants.PriceSourceDiscount, wantRule: "all-discount"},
+		{name: "override beats discount in same scope", rules: []*PriceRule{allDiscount, allOverride}, wantPrice: 0.6, wantSource: constants.PriceSourceOverride, wantRule: "all-override"},
+		{name: "service discount beats all services override", rules: []*PriceRule{allOverride, serviceDiscount}, wantPrice: 0.9, wantSource: constants.PriceSourceDiscount, wantRule: "service-discount"},
+		{name: "service override beats service discount", rules: []*PriceRule{serviceDiscount, serviceOverride}, wantPrice: 0.5, wantSource: constants.PriceSourceOverride, wantRule: "service-override"},
+		{name: "account rule started after contract wins", rules: []*PriceRule{contractRate, serviceOverride}, wantPrice: 0.5, wantSource: constants.PriceSourceOverride, wantRule: "service-override"},
+		{name: "contract started after account rule wins", rules: []*PriceRule{serviceOverride, laterContractRate}, wantPrice: 0.3, wantSource: constants.PriceSourceContract, wantRule: "later-contract-rate", wantContr: "c2"},
+		{name: "overlapping contracts use the later one", rules: []*PriceRule{contractRate, laterContractRate}, wantPrice: 0.3, wantSource: constants.PriceSourceContract, wantRule: "later-contract-rate", wantContr: "c2"},
+		{name: "earlier contract applies after later one ends", rules: []*PriceRule{contractRate, laterContractRate}, at: day(7, 1), wantPrice: 0.4, wantSource: constants.PriceSourceContract, wantRule: "contract-rate", wantContr: "c1"},
+		{name: "earlier contract applies before later one starts", rules: []*PriceRule{contractRate, laterContractRate}, at: day(4, 30), wantPrice: 0.4, wantSource: constants.PriceSourceContract, wantRule: "contract-rate", wantContr: "c1"},
+		{name: "contract discount on all services loses to service override", rules: []*PriceRule{serviceOverride, contractDiscount}, wantPrice: 0.5, wantSource: constants.PriceSourceOverride, wantRule: "service-override"},
+		{name: "contract discount beats all services discount", rules: []*PriceRule{allDiscount, contractDiscount}, wantPrice: 0.5, wantSource: constants.PriceSourceContract, wantRule: "contract-discount", wantContr: "c3"},
+		{name: "account rule applies at contract end", rules: []*PriceRule{allDiscount, laterContractRate}, at: day(7, 1), wantPrice: 0.8, wantSource: constants.PriceSourceDiscount, wantRule: "all-discount"},
+		{name: "list price at contract end", rules: []*PriceRule{laterContractRate}, at: day(7, 1), wantPrice: 1, wantSource: constants.PriceSourceList},
+		{name: "expired and future rules ignored", rules: []*PriceRule{expired, notStarted, allDiscount}, wantPrice: 0.8, wantSource: constants.PriceSourceDiscount, wantRule: "all-discount"},
+		{name: "rule active from its start", rules: []*PriceRule{notStarted}, at: day(7, 1), wantPrice: 0.1, wantSource: constants.PriceSourceOverride, wantRule: "not-started"},
+		{name: "same start uses the later created", rules: []*PriceRule{newerCreated, olderCreated}, wantPrice: 0.8, wantSource: constants.PriceSourceOverride, wantRule: "newer-created"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := newTestRatingUseCase(&fakePricingRepo{rules: tc.rules})
+			when := tc.at
+			if when.IsZero() {
+				when = at
+			}
+			rate, err := uc.Rate(context.Background(), "u_10001", "passport", when)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rate.UnitPrice != tc.wantPrice || rate.Source != tc.wantSource || rate.RuleID != tc.wantRule || rate.ContractID != tc.wantContr {
+				t.Fatalf("rate = %+v, want price=%v source=%s rule=%q contract=%q", rate, tc.wantPrice, tc.wantSource, tc.wantRule, tc.wantContr)
+			}
+			if rate.ListPrice != 1 {
+				t.Fatalf("list price = %v, want 1", rate.ListPrice)
+			}
+		})
+	}
+
+	// 规则只适用于对应服务，其他服务按 "*" 规则或目录价
+	uc := newTestRatingUseCase(&fakePricingRepo{rules: []*PriceRule{serviceOverride, allDiscount}})
+	if rate, err := uc.Rate(context.Background(), "u_10001", "asset", at); err != nil || rate.UnitPrice != 1.6 || rate.RuleID != "all-discount" {
+		t.Fatalf("asset rate = %+v, err = %v, want 1.6 from all-discount", rate, err)
+	}
+	if _, err := uc.Rate(context.Background(), "u_10001", "unknown", at); kratosErrors.FromError(err).Code != billingErrors.ErrCodeUnknownService {
+		t.Fatalf("err = %v, want unknown service", err)
+	}
+}
+
+// TestContractTrueUp 到期补差为未消耗的承诺金额，余额不足时扣至 0
+func TestContractTrueUp(t *testing.T) {
+	cases := []struct {
+		name        string
+		commit      float64
+		drawn       float64
+		balance     float64
+		wantTrueUp  float64
+		wantCharged float64
+	}{
+		{name: "partly drawn", commit: 100, drawn: 30, balance: 200, wantTrueUp: 70, wantCharged: 70},
+		{name: "fully drawn", commit: 100, drawn: 100, balance: 200, wantTrueUp: 0, wantCharged: 0},
+		{name: "overdrawn", commit: 100, drawn: 150, balance: 200, wantTrueUp: 0, wantCharged: 0},
+		{name: "balance short", commit: 100, drawn: 30, balance: 50, wantTrueUp: 70, wantCharged: 50},
+		{name: "negative balance", commit: 100, drawn: 30, balance: -5, wantTrueUp: 70, wantCharged: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Contract{CommitAmount: tc.commit, DrawnAmount: tc.drawn}
+			if got := c.Remaining(); got != tc.wantTrueUp {
+				t.Fatalf("true-up = %v, want %v", got, tc.wantTrueUp)
+			}
+			if got := c.TrueUpCharge(tc.balance); got != tc.wantCharged {
+				t.Fatalf("charged = %v, want %v", got, tc.wantCharged)
+			}
+		})
+	}
+}
+
+// TestSettleContracts 只结算到期超过宽限期的合同，单个合同失败或已被其他实例结算时不计数
+func TestSettleContracts(t *testing.T) {
+	now := time.Date(2025, 7, 1, 1, 0, 0, 0, time.UTC)
+	repo := &fakePricingRepo{
+		toSettle: []*Contract{{ID: "c1"}, {ID: "c2"}, {ID: "c3"}},
+		settle: func(contractID string) (*Contract, error) {
+			switch contractID {
+			case "c1":
+				return &Contract{ID: "c1", CommitAmount: 100, DrawnAmount: 30, TrueUpAmount: 70, TrueUpCharged: 50}, nil
+			case "c2":
+				return nil, errors.New("db unavailable")
+			default:
+				return nil, nil
+			}
+		},
+	}
+	settled, err := newTestRatingUseCase(repo).SettleContracts(context.Background(), now)
+	if err != nil || settled != 1 {
+		t.Fatalf("settled = %d, err = %v, want 1", settled, err)
+	}
+	if !repo.settleBefore.Equal(now.Add(-time.Hour)) {
+		t.Fatalf("settle before = %s, want %s", repo.settleBefore, now.Add(-time.Hour))
+	}
+}
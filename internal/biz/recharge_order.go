@@ -22,6 +22,7 @@ type RechargeOrder struct {
 	Amount    float64   // 充值金额
 	PaymentID string    // 支付流水号（payment-service返回的payment_id）
 	Status    string    // 订单状态
+	OrderType string    // 订单类型：recharge（余额充值）/ package（购买用量包）
 	CreatedAt time.Time // 创建时间
 	UpdatedAt time.Time // 更新时间
 }
@@ -33,6 +34,10 @@ type RechargeOrderRepo interface {
 	GetRechargeOrderByPaymentID(ctx context.Context, paymentID string) (*RechargeOrder, error)
 	UpdateRechargeOrderStatus(ctx context.Context, orderID, paymentID, status string) error
 	RechargeWithIdempotency(ctx context.Context, orderID, paymentID string, amount float64) error
+	// CreatePackageOrder 创建用量包订单，同时按目录快照创建待发放的用量包
+	CreatePackageOrder(ctx context.Context, orderID, userID string, pkg *UsagePackage) error
+	// GrantPackageWithIdempotency 支付成功后发放用量包（从支付成功时起算有效期），重复回调直接返回
+	GrantPackageWithIdempotency(ctx context.Context, orderID, paymentID string) error
 }
 
 // RechargeOrderUseCase 充值订单业务逻辑
@@ -88,8 +93,49 @@ func (uc *RechargeOrderUseCase) CreateRecharge(ctx context.Context, userID strin
 	}
 
 	// 调用 Payment Service 创建支付订单
+	payURL, err := uc.createPayment(ctx, orderID, userID, amount, method, currency, fmt.Sprintf("账户充值 - %.2f元", amount), returnURL, notifyURL, startTime)
+	if err != nil {
+		return "", "", err
+	}
+	return orderID, payURL, nil
+}
+
+// CreatePackageOrder 创建用量包订单，按目录价格发起支付，返回订单号和支付链接
+func (uc *RechargeOrderUseCase) CreatePackageOrder(ctx context.Context, userID string, pkg *UsagePackage, method int32, currency, returnURL, notifyURL string) (string, string, error) {
+	startTime := time.Now()
+
+	// 验证币种必填
+	if currency == "" {
+		return "", "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeCurrencyRequired)
+	}
+
+	orderID := fmt.Sprintf("%s%s_%d", constants.OrderIDPrefixPackage, userID, time.Now().Unix())
+
+	orderCreateStart := time.Now()
+	if err := uc.repo.CreatePackageOrder(ctx, orderID, userID, pkg); err != nil {
+		uc.log.Errorf("CreatePackageOrder failed: %v", err)
+		if uc.metrics != nil {
+			uc.metrics.RechargeOrderTotal.WithLabelValues(constants.OrderStatusFailed).Inc()
+			uc.metrics.RechargeFailedTotal.Inc()
+		}
+		return "", "", pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeRechargeOrderCreateFailed)
+	}
+	if uc.metrics != nil {
+		uc.metrics.RechargeOrderCreateDuration.Observe(time.Since(orderCreateStart).Seconds())
+		uc.metrics.RechargeOrderTotal.WithLabelValues(constants.OrderStatusPending).Inc()
+	}
+
+	payURL, err := uc.createPayment(ctx, orderID, userID, pkg.Price, method, currency, fmt.Sprintf("购买用量包 - %s", pkg.Name), returnURL, notifyURL, startTime)
+	if err != nil {
+		return "", "", err
+	}
+	return orderID, payURL, nil
+}
+
+// createPayment 调用 payment-service 创建支付订单，返回支付链接
+func (uc *RechargeOrderUseCase) createPayment(ctx context.Context, orderID, userID string, amount float64, method int32, currency, subject, returnURL, notifyURL string, startTime time.Time) (string, error) {
 	if uc.paymentServiceClient == nil {
-		return "", "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePaymentServiceUnavailable)
+		return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePaymentServiceUnavailable)
 	}
 
 	// 默认支付方式：支付宝
@@ -111,7 +157,7 @@ func (uc *RechargeOrderUseCase) CreateRecharge(ctx context.Context, userID strin
 		Amount:    amount,
 		Currency:  currency,
 		Method:    method,
-		Subject:   fmt.Sprintf("账户充值 - %.2f元", amount),
+		Subject:   subject,
 		ReturnURL: returnURL,
 		NotifyURL: notifyURL,
 		ClientIP:  clientIP,
@@ -123,7 +169,7 @@ func (uc *RechargeOrderUseCase) CreateRecharge(ctx context.Context, userID strin
 			uc.metrics.RechargeFailedTotal.Inc()
 			uc.metrics.RechargeDuration.WithLabelValues("create").Observe(time.Since(startTime).Seconds())
 		}
-		return "", "", pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodePaymentCreateFailed)
+		return "", pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodePaymentCreateFailed)
 	}
 
 	// 记录充值成功指标
@@ -134,10 +180,11 @@ func (uc *RechargeOrderUseCase) CreateRecharge(ctx context.Context, userID strin
 	}
 
 	uc.log.Infof("Recharge order created: order_id=%s, payment_id=%s, pay_url=%s", orderID, paymentResp.PaymentID, paymentResp.PayURL)
-	return orderID, paymentResp.PayURL, nil
+	return paymentResp.PayURL, nil
 }
 
 // RechargeCallback 充值回调（支持幂等性）
+// 用量包订单与充值订单共用支付回调，用量包订单支付成功后发放用量包（不增加余额）
 func (uc *RechargeOrderUseCase) RechargeCallback(ctx context.Context, orderID string, amount float64) error {
 	// 使用 payment-service 的 payment_id 作为幂等性标识
 	// 这里假设 orderID 就是 payment-service 的 payment_id
@@ -174,6 +221,15 @@ func (uc *RechargeOrderUseCase) RechargeCallback(ctx context.Context, orderID st
 		}
 	}
 
+	// 用量包订单：发放用量包（带幂等性保证）
+	if existingOrder.OrderType == constants.OrderTypePackage {
+		if err := uc.repo.GrantPackageWithIdempotency(ctx, orderID, paymentID); err != nil {
+			uc.log.Errorf("GrantPackageWithIdempotency failed: order_id=%s, error=%v", orderID, err)
+			return err
+		}
+		return nil
+	}
+
 	// 执行充值（带幂等性保证）
 	return uc.repo.RechargeWithIdempotency(ctx, orderID, paymentID, amount)
 }
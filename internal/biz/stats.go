@@ -2,13 +2,30 @@ package biz
 
 import (
 	"context"
+	"sort"
+	"time"
 
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// UsageSlotSize 用量时间序列查询原始记录时的聚合粒度
+// 所有现行时区的 UTC 偏移都是 15 分钟的整数倍，按 15 分钟聚合后可在任意时区对齐到小时/天/月
+const UsageSlotSize = 15 * time.Minute
+
+// usageSlotSizes 用量时间序列可用的聚合粒度，从大到小依次为日汇总、小时汇总、原始记录
+var usageSlotSizes = []time.Duration{24 * time.Hour, time.Hour, UsageSlotSize}
+
+// usageBackfillChunk 重建用量汇总时单个事务覆盖的时间跨度
+const usageBackfillChunk = 7 * 24 * time.Hour
+
 // Stats 统计对象
 type Stats struct {
 	UID         string
+	MemberID    string // 按组织成员统计时为成员ID
 	ServiceName string
 	TotalCount  int     // 总调用次数
 	TotalCost   float64 // 总费用（仅余额扣费部分）
@@ -29,30 +46,75 @@ type ServiceStats struct {
 // StatsSummary 汇总统计对象
 type StatsSummary struct {
 	UID        string
+	MemberID   string // 按组织成员统计时为成员ID
 	TotalCount int
 	TotalCost  float64
 	Services   []*ServiceStats
 }
 
+// LiveStatsConfig 实时用量推送配置
+type LiveStatsConfig struct {
+	StreamInterval    time.Duration // SSE 推送间隔
+	StreamMaxDuration time.Duration // 单个 SSE 连接最长持续时间
+}
+
+// UsageSlot 时间片的用量聚合
+type UsageSlot struct {
+	StartTime  time.Time // 时间片起点（UTC 对齐到时间片粒度）
+	TotalCount int
+	TotalCost  float64
+	FreeCount  int
+	PaidCount  int
+}
+
+// UsagePoint 时间序列中单个时间桶的用量
+type UsagePoint struct {
+	StartTime  time.Time // 时间桶起点（请求时区）
+	TotalCount int
+	TotalCost  float64
+	FreeCount  int
+	PaidCount  int
+}
+
+// UsageSeries 用量时间序列
+type UsageSeries struct {
+	UID         string
+	MemberID    string // 按组织成员统计时为成员ID
+	ServiceName string
+	Granularity string // hour / day / month
+	Timezone    string
+	Points      []*UsagePoint // 按时间正序，无数据的时间桶为 0
+}
+
 // StatsRepo 统计数据层接口（定义在 biz 层）
 type StatsRepo interface {
 	GetAllUserIDs(ctx context.Context) ([]string, error)
-	GetStatsToday(ctx context.Context, userID, serviceName string) (*Stats, error)
-	GetStatsMonth(ctx context.Context, userID, serviceName string) (*Stats, error)
-	GetStatsSummary(ctx context.Context, userID string) (*StatsSummary, error)
+	// GetStats 统计 [start, end) 内的用量（Period 由 biz 层填写），memberID 非空时只统计该组织成员的用量
+	GetStats(ctx context.Context, userID, memberID, serviceName string, start, end time.Time) (*Stats, error)
+	// GetStatsSummary 按服务汇总 [start, end) 内的用量，memberID 非空时只统计该组织成员的用量
+	GetStatsSummary(ctx context.Context, userID, memberID string, start, end time.Time) (*StatsSummary, error)
+	// GetUsageSlots 按 slotSize（1 天 / 1 小时 / UsageSlotSize）聚合 [start, end) 内的用量，只返回有数据的时间片
+	// memberID 非空时只统计该组织成员的用量（汇总表不区分成员，始终查询原始记录）
+	GetUsageSlots(ctx context.Context, userID, memberID, serviceName string, start, end time.Time, slotSize time.Duration) ([]*UsageSlot, error)
+	// RebuildUsageRollups 从原始消费记录重建用户 [start, end) 内的小时/日汇总（start、end 为 UTC 零点），返回小时汇总行数
+	RebuildUsageRollups(ctx context.Context, userID string, start, end time.Time) (int64, error)
+	// GetLiveUsage 读取 Redis 实时用量计数：从 start 开始连续 points 个时间片（slot 为 1 分钟或 1 小时），无数据的时间片为 0
+	GetLiveUsage(ctx context.Context, userID, serviceName string, slot time.Duration, start time.Time, points int) ([]*UsagePoint, error)
 }
 
 // StatsUseCase 统计业务逻辑
 type StatsUseCase struct {
-	repo StatsRepo
-	log  *log.Helper
+	repo          StatsRepo
+	periodUseCase *PeriodUseCase
+	log           *log.Helper
 }
 
 // NewStatsUseCase 创建统计 UseCase
-func NewStatsUseCase(repo StatsRepo, logger log.Logger) *StatsUseCase {
+func NewStatsUseCase(repo StatsRepo, periodUseCase *PeriodUseCase, logger log.Logger) *StatsUseCase {
 	return &StatsUseCase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:          repo,
+		periodUseCase: periodUseCase,
+		log:           log.NewHelper(logger),
 	}
 }
 
@@ -61,17 +123,212 @@ func (uc *StatsUseCase) GetAllUserIDs(ctx context.Context) ([]string, error) {
 	return uc.repo.GetAllUserIDs(ctx)
 }
 
-// GetStatsToday 获取今日统计
-func (uc *StatsUseCase) GetStatsToday(ctx context.Context, userID, serviceName string) (*Stats, error) {
-	return uc.repo.GetStatsToday(ctx, userID, serviceName)
+// GetStatsToday 获取今日统计（账户时区的自然日），memberID 非空时只统计该组织成员
+func (uc *StatsUseCase) GetStatsToday(ctx context.Context, userID, memberID, serviceName string) (*Stats, error) {
+	today, err := uc.periodUseCase.Today(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := uc.repo.GetStats(ctx, userID, memberID, serviceName, today.Start, today.End)
+	if err != nil {
+		return nil, err
+	}
+	stats.Period = constants.StatsPeriodToday
+	return stats, nil
 }
 
-// GetStatsMonth 获取本月统计
-func (uc *StatsUseCase) GetStatsMonth(ctx context.Context, userID, serviceName string) (*Stats, error) {
-	return uc.repo.GetStatsMonth(ctx, userID, serviceName)
+// GetStatsMonth 获取本月统计（账户时区的自然月），memberID 非空时只统计该组织成员
+func (uc *StatsUseCase) GetStatsMonth(ctx context.Context, userID, memberID, serviceName string) (*Stats, error) {
+	month, err := uc.periodUseCase.Month(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := uc.repo.GetStats(ctx, userID, memberID, serviceName, month.Start, month.End)
+	if err != nil {
+		return nil, err
+	}
+	stats.Period = constants.StatsPeriodMonth
+	return stats, nil
 }
 
-// GetStatsSummary 获取汇总统计
-func (uc *StatsUseCase) GetStatsSummary(ctx context.Context, userID string) (*StatsSummary, error) {
-	return uc.repo.GetStatsSummary(ctx, userID)
+// GetStatsSummary 获取本月汇总统计（账户时区的自然月），memberID 非空时只统计该组织成员
+func (uc *StatsUseCase) GetStatsSummary(ctx context.Context, userID, memberID string) (*StatsSummary, error) {
+	month, err := uc.periodUseCase.Month(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.GetStatsSummary(ctx, userID, memberID, month.Start, month.End)
+}
+
+// GetUsageSeries 获取用量时间序列
+// 时间桶按 timezone 对齐（默认账户时区，账户未设置时为 UTC），第一个桶从 start 所在桶的起点开始，最后一个桶包含 end 之前的时刻
+func (uc *StatsUseCase) GetUsageSeries(ctx context.Context, userID, memberID, serviceName string, start, end time.Time, granularity, timezone string) (*UsageSeries, error) {
+	if granularity == "" {
+		granularity = constants.UsageGranularityDay
+	}
+	if timezone == "" {
+		accountTimezone, err := uc.periodUseCase.Timezone(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		timezone = accountTimezone
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || start.IsZero() || end.IsZero() || !end.After(start) ||
+		(granularity != constants.UsageGranularityHour && granularity != constants.UsageGranularityDay && granularity != constants.UsageGranularityMonth) {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidUsageSeriesQuery)
+	}
+
+	// 生成时间桶边界，bounds[i] 为第 i 个桶的起点，最后一个元素为结束边界
+	bounds := []time.Time{truncateUsageBucket(start.In(loc), granularity)}
+	for bounds[len(bounds)-1].Before(end) {
+		if len(bounds) > constants.MaxUsageSeriesPoints {
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidUsageSeriesQuery)
+		}
+		bounds = append(bounds, nextUsageBucket(bounds[len(bounds)-1], granularity))
+	}
+
+	slots, err := uc.repo.GetUsageSlots(ctx, userID, memberID, serviceName, bounds[0], bounds[len(bounds)-1], usageSlotSizeFor(bounds))
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*UsagePoint, len(bounds)-1)
+	for i := range points {
+		points[i] = &UsagePoint{StartTime: bounds[i]}
+	}
+	for _, slot := range slots {
+		// 找到起点不晚于时间片起点的最后一个桶
+		i := sort.Search(len(points), func(i int) bool { return bounds[i+1].After(slot.StartTime) })
+		if i >= len(points) {
+			continue
+		}
+		p := points[i]
+		p.TotalCount += slot.TotalCount
+		p.TotalCost += slot.TotalCost
+		p.FreeCount += slot.FreeCount
+		p.PaidCount += slot.PaidCount
+	}
+
+	return &UsageSeries{
+		UID:         userID,
+		MemberID:    memberID,
+		ServiceName: serviceName,
+		Granularity: granularity,
+		Timezone:    loc.String(),
+		Points:      points,
+	}, nil
+}
+
+// GetLiveUsage 获取实时用量（Redis 分钟/小时计数，扣费成功即计入，不等待 MQ 落库）
+// 返回截至当前时间片的最近 points 个时间片（UTC），最后一个为进行中的时间片
+func (uc *StatsUseCase) GetLiveUsage(ctx context.Context, userID, serviceName, granularity string, points int) (*UsageSeries, error) {
+	slot := time.Minute
+	defaultPoints, maxPoints := constants.DefaultLiveUsageMinutePoints, constants.MaxLiveUsageMinutePoints
+	switch granularity {
+	case "", constants.UsageGranularityMinute:
+		granularity = constants.UsageGranularityMinute
+	case constants.UsageGranularityHour:
+		slot = time.Hour
+		defaultPoints, maxPoints = constants.DefaultLiveUsageHourPoints, constants.MaxLiveUsageHourPoints
+	default:
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidUsageSeriesQuery)
+	}
+	if points <= 0 {
+		points = defaultPoints
+	} else if points > maxPoints {
+		points = maxPoints
+	}
+
+	start := time.Now().UTC().Truncate(slot).Add(-time.Duration(points-1) * slot)
+	usagePoints, err := uc.repo.GetLiveUsage(ctx, userID, serviceName, slot, start, points)
+	if err != nil {
+		uc.log.Errorf("Get live usage failed: user_id=%s, error=%v", userID, err)
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+	return &UsageSeries{
+		UID:         userID,
+		ServiceName: serviceName,
+		Granularity: granularity,
+		Timezone:    "UTC",
+		Points:      usagePoints,
+	}, nil
+}
+
+// RebuildUsageRollups 从原始消费记录重建 [start, end) 内所有用户的用量汇总（按 UTC 日期对齐），返回处理的用户数
+func (uc *StatsUseCase) RebuildUsageRollups(ctx context.Context, start, end time.Time) (int, error) {
+	start = start.UTC().Truncate(24 * time.Hour)
+	if t := end.UTC().Truncate(24 * time.Hour); t.Before(end) {
+		end = t.Add(24 * time.Hour)
+	}
+	if !end.After(start) {
+		return 0, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidUsageSeriesQuery)
+	}
+
+	userIDs, err := uc.repo.GetAllUserIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i, userID := range userIDs {
+		var rows int64
+		for chunkStart := start; chunkStart.Before(end); chunkStart = chunkStart.Add(usageBackfillChunk) {
+			chunkEnd := chunkStart.Add(usageBackfillChunk)
+			if chunkEnd.After(end) {
+				chunkEnd = end
+			}
+			n, err := uc.repo.RebuildUsageRollups(ctx, userID, chunkStart, chunkEnd)
+			if err != nil {
+				uc.log.Errorf("Rebuild usage rollups failed: user_id=%s, start=%s, end=%s, error=%v", userID, chunkStart.Format(time.DateOnly), chunkEnd.Format(time.DateOnly), err)
+				return i, err
+			}
+			rows += n
+		}
+		uc.log.Infof("Rebuilt usage rollups: user_id=%s, hourly_rows=%d, progress=%d/%d", userID, rows, i+1, len(userIDs))
+	}
+	return len(userIDs), nil
+}
+
+// usageSlotSizeFor 选择所有时间桶边界都能对齐的最大聚合粒度
+func usageSlotSizeFor(bounds []time.Time) time.Duration {
+	for _, size := range usageSlotSizes {
+		aligned := true
+		for _, b := range bounds {
+			if !b.Truncate(size).Equal(b) {
+				aligned = false
+				break
+			}
+		}
+		if aligned {
+			return size
+		}
+	}
+	return UsageSlotSize
+}
+
+// truncateUsageBucket 取 t 所在时间桶的起点（t 所在时区）
+func truncateUsageBucket(t time.Time, granularity string) time.Time {
+	switch granularity {
+	case constants.UsageGranularityHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case constants.UsageGranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+// nextUsageBucket 下一个时间桶的起点
+// 小时按绝对时长递增（夏令时回拨时重复的本地小时是两个桶），天/月按本地日历递增
+func nextUsageBucket(t time.Time, granularity string) time.Time {
+	switch granularity {
+	case constants.UsageGranularityHour:
+		return t.Add(time.Hour)
+	case constants.UsageGranularityMonth:
+		return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+	}
 }
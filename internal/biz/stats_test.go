@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeStatsRepo 返回预设的时间片，记录 GetUsageSlots 的查询参数
+type fakeStatsRepo struct {
+	StatsRepo
+	slots    []*UsageSlot
+	start    time.Time
+	end      time.Time
+	slotSize time.Duration
+}
+
+func (r *fakeStatsRepo) GetUsageSlots(_ context.Context, _, _, _ string, start, end time.Time, slotSize time.Duration) ([]*UsageSlot, error) {
+	r.start, r.end, r.slotSize = start, end, slotSize
+	return r.slots, nil
+}
+
+func newTestStatsUseCase(repo StatsRepo, accountTimezone string) *StatsUseCase {
+	periodUseCase := NewPeriodUseCase(&fakeAccountSettingRepo{setting: AccountSetting{Timezone: accountTimezone}}, nil, &BillingConfig{Location: time.UTC}, log.DefaultLogger)
+	return NewStatsUseCase(repo, periodUseCase, log.DefaultLogger)
+}
+
+// TestGetUsageSeriesBuckets 时间桶按请求时区对齐，时间片归入所在的桶，无数据的桶为 0，结束边界之后的时间片丢弃
+func TestGetUsageSeriesBuckets(t *testing.T) {
+	shanghai := mustLoadLocation(t, "Asia/Shanghai")
+	utc := func(day, hour int) time.Time { return time.Date(2025, 11, day, hour, 0, 0, 0, time.UTC) }
+	repo := &fakeStatsRepo{slots: []*UsageSlot{
+		{StartTime: utc(4, 16), TotalCount: 1, FreeCount: 1},               // 上海 11-05 00:00
+		{StartTime: utc(5, 15), TotalCount: 2, PaidCount: 2, TotalCost: 3}, // 上海 11-05 23:00
+		{StartTime: utc(5, 16), TotalCount: 4, PaidCount: 4, TotalCost: 5}, // 上海 11-06 00:00
+		{StartTime: utc(7, 20), TotalCount: 8, FreeCount: 8},               // 上海 11-08 04:00
+		{StartTime: utc(8, 16), TotalCount: 100},                           // 上海 11-09 00:00，超出范围
+	}}
+	uc := newTestStatsUseCase(repo, "")
+
+	// 上海 11-05 18:00 至 11-08 01:00
+	series, err := uc.GetUsageSeries(context.Background(), "u1", "", "passport", utc(5, 10), utc(7, 17), constants.UsageGranularityDay, "Asia/Shanghai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if series.Timezone != "Asia/Shanghai" || series.Granularity != constants.UsageGranularityDay {
+		t.Errorf("series = %+v", series)
+	}
+	if !repo.start.Equal(utc(4, 16)) || !repo.end.Equal(utc(8, 16)) || repo.slotSize != time.Hour {
+		t.Errorf("query = [%s, %s) by %s, want [%s, %s) by 1h", repo.start, repo.end, repo.slotSize, utc(4, 16), utc(8, 16))
+	}
+
+	want := []UsagePoint{
+		{StartTime: time.Date(2025, 11, 5, 0, 0, 0, 0, shanghai), TotalCount: 3, FreeCount: 1, PaidCount: 2, TotalCost: 3},
+		{StartTime: time.Date(2025, 11, 6, 0, 0, 0, 0, shanghai), TotalCount: 4, PaidCount: 4, TotalCost: 5},
+		{StartTime: time.Date(2025, 11, 7, 0, 0, 0, 0, shanghai)},
+		{StartTime: time.Date(2025, 11, 8, 0, 0, 0, 0, shanghai), TotalCount: 8, FreeCount: 8},
+	}
+	if len(series.Points) != len(want) {
+		t.Fatalf("points = %d, want %d", len(series.Points), len(want))
+	}
+	for i, p := range series.Points {
+		if !p.StartTime.Equal(want[i].StartTime) || p.StartTime.Location().String() != shanghai.String() ||
+			p.TotalCount != want[i].TotalCount || p.FreeCount != want[i].FreeCount || p.PaidCount != want[i].PaidCount || p.TotalCost != want[i].TotalCost {
+			t.Errorf("point %d = %+v, want %+v", i, *p, want[i])
+		}
+	}
+}
+
+// TestGetUsageSeriesTimezone 未指定时区时使用账户时区，账户未设置时为 UTC
+func TestGetUsageSeriesTimezone(t *testing.T) {
+	start := time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC)
+	for accountTimezone, want := range map[string]string{"Asia/Tokyo": "Asia/Tokyo", "": "UTC"} {
+		uc := newTestStatsUseCase(&fakeStatsRepo{}, accountTimezone)
+		series, err := uc.GetUsageSeries(context.Background(), "u1", "", "", start, start.Add(time.Hour), "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if series.Timezone != want || series.Granularity != constants.UsageGranularityDay {
+			t.Errorf("account timezone %q: series timezone = %s, granularity = %s, want %s, day", accountTimezone, series.Timezone, series.Granularity, want)
+		}
+	}
+}
+
+// TestGetUsageSeriesDST 夏令时回拨时重复的本地小时是两个桶；按天时切换日只有 23 / 25 小时
+func TestGetUsageSeriesDST(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	uc := newTestStatsUseCase(&fakeStatsRepo{}, "")
+
+	// 2025-11-02 01:00-02:00 重复一次
+	start := time.Date(2025, 11, 2, 0, 0, 0, 0, ny)
+	series, err := uc.GetUsageSeries(context.Background(), "u1", "", "", start, time.Date(2025, 11, 2, 4, 0, 0, 0, ny), constants.UsageGranularityHour, "America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHours := []int{0, 1, 1, 2, 3}
+	if len(series.Points) != len(wantHours) {
+		t.Fatalf("points = %d, want %d", len(series.Points), len(wantHours))
+	}
+	for i, p := range series.Points {
+		if p.StartTime.Hour() != wantHours[i] || !p.StartTime.Equal(start.Add(time.Duration(i)*time.Hour)) {
+			t.Errorf("point %d start = %s, want local hour %d", i, p.StartTime, wantHours[i])
+		}
+	}
+
+	cases := []struct {
+		name        string
+		t           time.Time
+		granularity string
+		want        time.Time
+		wantLength  time.Duration
+	}{
+		{"spring forward day", time.Date(2025, 3, 9, 0, 0, 0, 0, ny), constants.UsageGranularityDay, time.Date(2025, 3, 10, 0, 0, 0, 0, ny), 23 * time.Hour},
+		{"fall back day", time.Date(2025, 11, 2, 0, 0, 0, 0, ny), constants.UsageGranularityDay, time.Date(2025, 11, 3, 0, 0, 0, 0, ny), 25 * time.Hour},
+		{"spring forward hour", time.Date(2025, 3, 9, 1, 0, 0, 0, ny), constants.UsageGranularityHour, time.Date(2025, 3, 9, 3, 0, 0, 0, ny), time.Hour},
+		{"month", time.Date(2025, 12, 1, 0, 0, 0, 0, ny), constants.UsageGranularityMonth, time.Date(2026, 1, 1, 0, 0, 0, 0, ny), 31 * 24 * time.Hour},
+	}
+	for _, tc := range cases {
+		got := nextUsageBucket(tc.t, tc.granularity)
+		if !got.Equal(tc.want) || got.Sub(tc.t) != tc.wantLength {
+			t.Errorf("%s: next = %s (%s), want %s (%s)", tc.name, got, got.Sub(tc.t), tc.want, tc.wantLength)
+		}
+	}
+}
+
+// TestUsageSlotSizeFor 选择所有边界都能对齐的最大粒度：UTC 按天，整点偏移按小时，半点偏移按 15 分钟
+func TestUsageSlotSizeFor(t *testing.T) {
+	bounds := func(loc *time.Location, granularity string) []time.Time {
+		b := []time.Time{time.Date(2025, 11, 5, 0, 0, 0, 0, loc)}
+		for i := 0; i < 3; i++ {
+			b = append(b, nextUsageBucket(b[len(b)-1], granularity))
+		}
+		return b
+	}
+	cases := []struct {
+		name   string
+		bounds []time.Time
+		want   time.Duration
+	}{
+		{"utc day", bounds(time.UTC, constants.UsageGranularityDay), 24 * time.Hour},
+		{"utc hour", bounds(time.UTC, constants.UsageGranularityHour), time.Hour},
+		{"shanghai day", bounds(mustLoadLocation(t, "Asia/Shanghai"), constants.UsageGranularityDay), time.Hour},
+		{"kolkata day", bounds(mustLoadLocation(t, "Asia/Kolkata"), constants.UsageGranularityDay), UsageSlotSize},
+		{"kathmandu hour", bounds(mustLoadLocation(t, "Asia/Kathmandu"), constants.UsageGranularityHour), UsageSlotSize},
+	}
+	for _, tc := range cases {
+		if got := usageSlotSizeFor(tc.bounds); got != tc.want {
+			t.Errorf("%s: slot size = %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestGetUsageSeriesInvalid 参数无效或时间桶超过 MaxUsageSeriesPoints 时拒绝查询
+func TestGetUsageSeriesInvalid(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestStatsUseCase(&fakeStatsRepo{}, "")
+	start := time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC)
+
+	if _, err := uc.GetUsageSeries(ctx, "u1", "", "", start, start.Add(constants.MaxUsageSeriesPoints*time.Hour), constants.UsageGranularityHour, "UTC"); err != nil {
+		t.Errorf("max points: err = %v, want nil", err)
+	}
+
+	cases := []struct {
+		name        string
+		end         time.Time
+		granularity string
+		timezone    string
+	}{
+		{"too many points", start.Add((constants.MaxUsageSeriesPoints + 1) * time.Hour), constants.UsageGranularityHour, "UTC"},
+		{"end before start", start.Add(-time.Hour), constants.UsageGranularityDay, "UTC"},
+		{"empty range", start, constants.UsageGranularityDay, "UTC"},
+		{"unknown granularity", start.Add(time.Hour), "week", "UTC"},
+		{"unknown timezone", start.Add(time.Hour), constants.UsageGranularityDay, "Mars/Olympus"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := uc.GetUsageSeries(ctx, "u1", "", "", start, tc.end, tc.granularity, tc.timezone)
+			assertErrCode(t, err, billingErrors.ErrCodeInvalidUsageSeriesQuery)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"billing-service/internal/constants"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+)
+
+// StreamDeductConfig 流式扣费配置
+type StreamDeductConfig struct {
+	MaxBatchSize int           // 单批最多合并的扣费请求数
+	MaxBatchWait time.Duration // 攒批最长等待时间
+	MaxInFlight  int           // 单条流最多在途的请求数
+}
+
+// DeductRequest 单次扣费请求（流式扣费攒批后批量处理）
+type DeductRequest struct {
+	UserID      string  // 扣费账户，由 BillingUseCase 替换为组织成员所属的组织
+	MemberID    string  // 组织账户中实际使用的成员，由 BillingUseCase 解析
+	SpendLimit  float64 // 成员每月余额消费上限（0 表示不限制），由 BillingUseCase 解析
+	ServiceName string
+	Count       int             // 用量（按服务计量单位）
+	Unit        string          // 调用方声明的单位，可选
+	CallerCost  float64         // 调用方预计算的费用，可选
+	Cost        float64         // 实际费用，由 BillingUseCase 计算
+	Period      string          // 额度周期标识，由 BillingUseCase 计算
+	BudgetMonth BillingPeriod   // 预算月份，由 BillingUseCase 计算
+	Metadata    *DeductMetadata // 扣费来源信息，可选
+}
+
+// Payer 请求的扣费账户
+func (r *DeductRequest) Payer() Payer {
+	return Payer{AccountID: r.UserID, MemberID: r.MemberID, SpendLimit: r.SpendLimit}
+}
+
+// DeductResult 单次扣费结果，Err 非空表示该请求失败
+type DeductResult struct {
+	RecordID string
+	Err      error
+}
+
+// DeductQuotaBatch 批量扣费
+// 批内各请求相互独立，分别成功或失败；返回结果与 reqs 一一对应
+// 组织成员的请求改为从组织账户扣费（req.UserID 替换为组织ID，req.MemberID 为成员）
+func (uc *BillingUseCase) DeductQuotaBatch(ctx context.Context, reqs []*DeductRequest) []*DeductResult {
+	startTime := time.Now()
+	periods := make(map[string]DeductPeriods) // uid:service -> 扣费周期，同一批内只计算一次
+	payers := make(map[string]Payer)          // uid -> 扣费账户，同一批内只解析一次
+
+	results := make([]*DeductResult, len(reqs))
+	valid := make([]*DeductRequest, 0, len(reqs))
+	validIdx := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if req.UserID == "" || req.ServiceName == "" || req.Count <= 0 {
+			results[i] = &DeductResult{Err: pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)}
+			continue
+		}
+		if err := validateDeductMetadata(ctx, req.Metadata); err != nil {
+			results[i] = &DeductResult{Err: err}
+			continue
+		}
+		usage := Usage{Quantity: req.Count, Unit: req.Unit, CallerCost: req.CallerCost}
+		payer, ok := payers[req.UserID]
+		if !ok {
+			var err error
+			payer, err = uc.orgUseCase.Payer(ctx, req.UserID)
+			if IsDependencyError(err) {
+				// 读取组织成员身份失败时按降级策略处理，结算时确定扣费账户
+				res := &DeductResult{}
+				res.RecordID, res.Err = uc.deductUnresolvedDegraded(ctx, req.UserID, req.ServiceName, usage, req.Metadata, err)
+				uc.recordDeduct(req.ServiceName, constants.DeductTypeDeferred, 0, startTime, res.Err)
+				results[i] = res
+				continue
+			}
+			if err != nil {
+				results[i] = &DeductResult{Err: err}
+				continue
+			}
+			payers[req.UserID] = payer
+		}
+		req.UserID, req.MemberID, req.SpendLimit = payer.AccountID, payer.MemberID, payer.SpendLimit
+
+		cost, err := uc.resolveCost(ctx, req.UserID, req.ServiceName, usage, startTime)
+		if IsDependencyError(err) {
+			// 读取账户价格失败时按降级策略处理，结算时重新计价
+			res := &DeductResult{}
+			res.RecordID, res.Err = uc.deductUnratedDegraded(ctx, payer, req.ServiceName, usage, req.Metadata, err)
+			uc.recordDeduct(req.ServiceName, constants.DeductTypeDeferred, 0, startTime, res.Err)
+			results[i] = res
+			continue
+		}
+		if err != nil {
+			results[i] = &DeductResult{Err: err}
+			continue
+		}
+		key := req.UserID + ":" + req.ServiceName
+		if _, ok := periods[key]; !ok {
+			p, err := uc.periodUseCase.DeductPeriods(ctx, req.UserID, req.ServiceName, startTime)
+			if IsDependencyError(err) {
+				// 无法计算额度周期时直接按降级策略处理，结算时重新计算周期
+				res := &DeductResult{}
+				res.RecordID, res.Err = uc.deductQuotaDegraded(ctx, payer, req.ServiceName, "", req.Count, cost, req.Metadata, err)
+				uc.recordDeduct(req.ServiceName, constants.DeductTypeDeferred, cost, startTime, res.Err)
+				results[i] = res
+				continue
+			}
+			if err != nil {
+				results[i] = &DeductResult{Err: err}
+				continue
+			}
+			periods[key] = p
+		}
+		req.Cost = cost
+		req.Period = periods[key].Quota.Key
+		req.BudgetMonth = periods[key].BudgetMonth
+		valid = append(valid, req)
+		validIdx = append(validIdx, i)
+	}
+	if len(valid) == 0 {
+		return results
+	}
+
+	for i, res := range uc.repo.DeductQuotaBatch(ctx, valid) {
+		req := valid[i]
+		deductType := constants.DeductTypeMixed
+		if IsDependencyError(res.Err) {
+			deductType = constants.DeductTypeDeferred
+			res.RecordID, res.Err = uc.deductQuotaDegraded(ctx, req.Payer(), req.ServiceName, req.Period, req.Count, req.Cost, req.Metadata, res.Err)
+		}
+		uc.recordDeduct(req.ServiceName, deductType, req.Cost, startTime, res.Err)
+		uc.recordBudgetDenied(res.Err, req.ServiceName)
+		results[validIdx[i]] = res
+	}
+	return results
+}
@@ -94,6 +94,7 @@ type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Auth          *Server_Auth           `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,30 +143,1452 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetAuth() *Server_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
 type Data struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis    *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Rocketmq *Data_RocketMQ         `protobuf:"bytes,3,opt,name=rocketmq,proto3" json:"rocketmq,omitempty"`
+	// 导出文件存储
+	ExportStorage *Data_ExportStorage `protobuf:"bytes,4,opt,name=export_storage,json=exportStorage,proto3" json:"export_storage,omitempty"`
+	// 延迟扣费日志文件，默认 ./data/deferred_charges.journal
+	// 降级放行的扣费先写入日志再返回，进程重启后重放；多实例部署时每个实例需使用独立路径
+	DeferredJournalPath string `protobuf:"bytes,5,opt,name=deferred_journal_path,json=deferredJournalPath,proto3" json:"deferred_journal_path,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Data) GetRocketmq() *Data_RocketMQ {
+	if x != nil {
+		return x.Rocketmq
+	}
+	return nil
+}
+
+func (x *Data) GetExportStorage() *Data_ExportStorage {
+	if x != nil {
+		return x.ExportStorage
+	}
+	return nil
+}
+
+func (x *Data) GetDeferredJournalPath() string {
+	if x != nil {
+		return x.DeferredJournalPath
+	}
+	return ""
+}
+
+type Billing struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Prices     map[string]float64     `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	FreeQuotas map[string]int32       `protobuf:"bytes,2,rep,name=free_quotas,json=freeQuotas,proto3" json:"free_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// 余额低阈值（单位：元），当余额低于此值时触发告警
+	BalanceLowThreshold float64 `protobuf:"fixed64,3,opt,name=balance_low_threshold,json=balanceLowThreshold,proto3" json:"balance_low_threshold,omitempty"`
+	// 配额低阈值（百分比），当剩余配额低于此百分比时触发告警
+	QuotaLowPercentThreshold float64 `protobuf:"fixed64,4,opt,name=quota_low_percent_threshold,json=quotaLowPercentThreshold,proto3" json:"quota_low_percent_threshold,omitempty"`
+	// 依赖（Redis/MySQL）故障时各服务的降级策略，未配置的服务默认 fail_closed
+	Degradation map[string]*Degradation `protobuf:"bytes,5,rep,name=degradation,proto3" json:"degradation,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 延迟扣费结算间隔，默认 10s
+	DeferredSettleInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=deferred_settle_interval,json=deferredSettleInterval,proto3" json:"deferred_settle_interval,omitempty"`
+	// 网关额度租约配置
+	Lease *Lease `protobuf:"bytes,7,opt,name=lease,proto3" json:"lease,omitempty"`
+	// 流式扣费配置
+	StreamDeduct *StreamDeduct `protobuf:"bytes,8,opt,name=stream_deduct,json=streamDeduct,proto3" json:"stream_deduct,omitempty"`
+	// 各服务计量单位与调用方费用策略，未配置的服务按次计费
+	// prices 为每单位单价，free_quotas 按同一单位计量
+	Pricing map[string]*ServicePricing `protobuf:"bytes,9,rep,name=pricing,proto3" json:"pricing,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 账单导出配置
+	Export *Export `protobuf:"bytes,10,opt,name=export,proto3" json:"export,omitempty"`
+	// 实时用量推送配置
+	LiveStats *LiveStats `protobuf:"bytes,11,opt,name=live_stats,json=liveStats,proto3" json:"live_stats,omitempty"`
+	// 用户消费预算配置
+	Budget *Budget `protobuf:"bytes,12,opt,name=budget,proto3" json:"budget,omitempty"`
+	// 按服务的请求频率限制（每秒 / 每日），在 CheckQuota 中检查
+	RateLimit *RateLimit `protobuf:"bytes,13,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// 免费额度周期（按天 / 周 / 自然月 / 账户周年），默认自然月
+	QuotaPeriod *QuotaPeriod `protobuf:"bytes,14,opt,name=quota_period,json=quotaPeriod,proto3" json:"quota_period,omitempty"`
+	// 默认计费时区（IANA 名称，如 Asia/Shanghai），账户未设置时区时使用，为空表示服务器本地时区
+	Timezone string `protobuf:"bytes,15,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 可购买的预付费用量包目录
+	Packages []*UsagePackage `protobuf:"bytes,16,rep,name=packages,proto3" json:"packages,omitempty"`
+	// 账户价格规则（单价覆盖 / 折扣）与承诺消费合同
+	AccountPricing *AccountPricing `protobuf:"bytes,17,opt,name=account_pricing,json=accountPricing,proto3" json:"account_pricing,omitempty"`
+	// 组织（团队共享钱包）
+	Organization *Organization `protobuf:"bytes,18,opt,name=organization,proto3" json:"organization,omitempty"`
+	// 单实例最多待结算的延迟扣费数，超出后降级扣费按 fail_closed 拒绝，默认 100000
+	MaxDeferredCharges int32 `protobuf:"varint,19,opt,name=max_deferred_charges,json=maxDeferredCharges,proto3" json:"max_deferred_charges,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Billing) Reset() {
+	*x = Billing{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Billing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Billing) ProtoMessage() {}
+
+func (x *Billing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Billing.ProtoReflect.Descriptor instead.
+func (*Billing) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Billing) GetPrices() map[string]float64 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *Billing) GetFreeQuotas() map[string]int32 {
+	if x != nil {
+		return x.FreeQuotas
+	}
+	return nil
+}
+
+func (x *Billing) GetBalanceLowThreshold() float64 {
+	if x != nil {
+		return x.BalanceLowThreshold
+	}
+	return 0
+}
+
+func (x *Billing) GetQuotaLowPercentThreshold() float64 {
+	if x != nil {
+		return x.QuotaLowPercentThreshold
+	}
+	return 0
+}
+
+func (x *Billing) GetDegradation() map[string]*Degradation {
+	if x != nil {
+		return x.Degradation
+	}
+	return nil
+}
+
+func (x *Billing) GetDeferredSettleInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DeferredSettleInterval
+	}
+	return nil
+}
+
+func (x *Billing) GetLease() *Lease {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+func (x *Billing) GetStreamDeduct() *StreamDeduct {
+	if x != nil {
+		return x.StreamDeduct
+	}
+	return nil
+}
+
+func (x *Billing) GetPricing() map[string]*ServicePricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *Billing) GetExport() *Export {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *Billing) GetLiveStats() *LiveStats {
+	if x != nil {
+		return x.LiveStats
+	}
+	return nil
+}
+
+func (x *Billing) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *Billing) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Billing) GetQuotaPeriod() *QuotaPeriod {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return nil
+}
+
+func (x *Billing) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Billing) GetPackages() []*UsagePackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *Billing) GetAccountPricing() *AccountPricing {
+	if x != nil {
+		return x.AccountPricing
+	}
+	return nil
+}
+
+func (x *Billing) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *Billing) GetMaxDeferredCharges() int32 {
+	if x != nil {
+		return x.MaxDeferredCharges
+	}
+	return 0
+}
+
+type AccountPricing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 账户价格规则的缓存时间，默认 1m（修改规则时主动失效）
+	RuleCacheTtl *durationpb.Duration `protobuf:"bytes,1,opt,name=rule_cache_ttl,json=ruleCacheTtl,proto3" json:"rule_cache_ttl,omitempty"`
+	// 合同到期后等待在途扣费落库的时间，之后才进行 true-up 结算，默认 1h
+	SettleGrace   *durationpb.Duration `protobuf:"bytes,2,opt,name=settle_grace,json=settleGrace,proto3" json:"settle_grace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountPricing) Reset() {
+	*x = AccountPricing{}
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountPricing) ProtoMessage() {}
+
+func (x *AccountPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountPricing.ProtoReflect.Descriptor instead.
+func (*AccountPricing) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountPricing) GetRuleCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RuleCacheTtl
+	}
+	return nil
+}
+
+func (x *AccountPricing) GetSettleGrace() *durationpb.Duration {
+	if x != nil {
+		return x.SettleGrace
+	}
+	return nil
+}
+
+type Organization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单个组织的成员数上限（含所有者），默认 100
+	MaxMembers int32 `protobuf:"varint,1,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	// 用户组织成员身份的缓存时间，默认 1m（成员变更时主动失效）
+	MembershipCacheTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=membership_cache_ttl,json=membershipCacheTtl,proto3" json:"membership_cache_ttl,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Organization) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *Organization) GetMembershipCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MembershipCacheTtl
+	}
+	return nil
+}
+
+type UsagePackage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用量包ID（目录内唯一，购买时引用）
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 展示名称，如 "护照识别 100 万次年包"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 适用服务
+	ServiceName string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// 包含用量（按服务计量单位）
+	Units int64 `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	// 售价（单位：元）
+	Price float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	// 有效期（月），从支付成功时起算
+	ValidMonths   int32 `protobuf:"varint,6,opt,name=valid_months,json=validMonths,proto3" json:"valid_months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsagePackage) Reset() {
+	*x = UsagePackage{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsagePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePackage) ProtoMessage() {}
+
+func (x *UsagePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePackage.ProtoReflect.Descriptor instead.
+func (*UsagePackage) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UsagePackage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UsagePackage) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *UsagePackage) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UsagePackage) GetValidMonths() int32 {
+	if x != nil {
+		return x.ValidMonths
+	}
+	return 0
+}
+
+type QuotaPeriod struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 默认周期：daily / weekly / monthly / anniversary，默认 monthly
+	DefaultCycle string `protobuf:"bytes,1,opt,name=default_cycle,json=defaultCycle,proto3" json:"default_cycle,omitempty"`
+	// 服务名 -> 周期
+	Services map[string]string `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 套餐名（见 rate_limit 中用户的套餐）-> 套餐周期，优先于 services 与 default_cycle
+	Plans map[string]*QuotaPeriodPlan `protobuf:"bytes,3,rep,name=plans,proto3" json:"plans,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 账户设置（周期锚点）的缓存时间，默认 10m
+	AccountCacheTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=account_cache_ttl,json=accountCacheTtl,proto3" json:"account_cache_ttl,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaPeriod) Reset() {
+	*x = QuotaPeriod{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaPeriod) ProtoMessage() {}
+
+func (x *QuotaPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaPeriod.ProtoReflect.Descriptor instead.
+func (*QuotaPeriod) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuotaPeriod) GetDefaultCycle() string {
+	if x != nil {
+		return x.DefaultCycle
+	}
+	return ""
+}
+
+func (x *QuotaPeriod) GetServices() map[string]string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *QuotaPeriod) GetPlans() map[string]*QuotaPeriodPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *QuotaPeriod) GetAccountCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.AccountCacheTtl
+	}
+	return nil
+}
+
+type QuotaPeriodPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 服务名 -> 周期，"*" 适用于未单独配置的服务
+	Services map[string]string `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 未用完的免费额度（不含上期结转部分）按该百分比（0-100）结转到下一个周期，下一个周期结束时过期；0 表示不结转
+	RolloverPercent float64 `protobuf:"fixed64,2,opt,name=rollover_percent,json=rolloverPercent,proto3" json:"rollover_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaPeriodPlan) Reset() {
+	*x = QuotaPeriodPlan{}
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaPeriodPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaPeriodPlan) ProtoMessage() {}
+
+func (x *QuotaPeriodPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaPeriodPlan.ProtoReflect.Descriptor instead.
+func (*QuotaPeriodPlan) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuotaPeriodPlan) GetServices() map[string]string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *QuotaPeriodPlan) GetRolloverPercent() float64 {
+	if x != nil {
+		return x.RolloverPercent
+	}
+	return 0
+}
+
+type RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未指定套餐的用户使用的套餐，为空表示不限流（用户级规则仍生效）
+	DefaultPlan string `protobuf:"bytes,1,opt,name=default_plan,json=defaultPlan,proto3" json:"default_plan,omitempty"`
+	// 限流套餐：套餐名 -> 套餐规则
+	Plans map[string]*RateLimitPlan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 用户限流设置的缓存时间，默认 1m
+	UserCacheTtl  *durationpb.Duration `protobuf:"bytes,3,opt,name=user_cache_ttl,json=userCacheTtl,proto3" json:"user_cache_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RateLimit) GetDefaultPlan() string {
+	if x != nil {
+		return x.DefaultPlan
+	}
+	return ""
+}
+
+func (x *RateLimit) GetPlans() map[string]*RateLimitPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *RateLimit) GetUserCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.UserCacheTtl
+	}
+	return nil
+}
+
+type RateLimitPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 服务名 -> 限流规则，"*" 适用于未单独配置的服务
+	Services      map[string]*RateLimitRule `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitPlan) Reset() {
+	*x = RateLimitPlan{}
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitPlan) ProtoMessage() {}
+
+func (x *RateLimitPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitPlan.ProtoReflect.Descriptor instead.
+func (*RateLimitPlan) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RateLimitPlan) GetServices() map[string]*RateLimitRule {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type RateLimitRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 每秒请求数上限，0 表示不限
+	PerSecond int64 `protobuf:"varint,1,opt,name=per_second,json=perSecond,proto3" json:"per_second,omitempty"`
+	// 每日请求数上限，0 表示不限
+	PerDay        int64 `protobuf:"varint,2,opt,name=per_day,json=perDay,proto3" json:"per_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitRule) Reset() {
+	*x = RateLimitRule{}
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitRule) ProtoMessage() {}
+
+func (x *RateLimitRule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitRule.ProtoReflect.Descriptor instead.
+func (*RateLimitRule) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RateLimitRule) GetPerSecond() int64 {
+	if x != nil {
+		return x.PerSecond
+	}
+	return 0
+}
+
+func (x *RateLimitRule) GetPerDay() int64 {
+	if x != nil {
+		return x.PerDay
+	}
+	return 0
+}
+
+type Budget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 预算提醒扫描间隔，默认 1m
+	AlertInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=alert_interval,json=alertInterval,proto3" json:"alert_interval,omitempty"`
+	// 预算提醒通知地址（POST JSON，由通知服务发送邮件等），为空时只记录日志
+	NotifyUrl string `protobuf:"bytes,2,opt,name=notify_url,json=notifyUrl,proto3" json:"notify_url,omitempty"`
+	// 通知请求超时，默认 3s
+	NotifyTimeout *durationpb.Duration `protobuf:"bytes,3,opt,name=notify_timeout,json=notifyTimeout,proto3" json:"notify_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Budget) GetAlertInterval() *durationpb.Duration {
+	if x != nil {
+		return x.AlertInterval
+	}
+	return nil
+}
+
+func (x *Budget) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+func (x *Budget) GetNotifyTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.NotifyTimeout
+	}
+	return nil
+}
+
+type LiveStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SSE 推送间隔，默认 2s
+	StreamInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	// 单个 SSE 连接最长持续时间，到期后服务端关闭，客户端（EventSource）自动重连，默认 10m
+	StreamMaxDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=stream_max_duration,json=streamMaxDuration,proto3" json:"stream_max_duration,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LiveStats) Reset() {
+	*x = LiveStats{}
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveStats) ProtoMessage() {}
+
+func (x *LiveStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveStats.ProtoReflect.Descriptor instead.
+func (*LiveStats) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LiveStats) GetStreamInterval() *durationpb.Duration {
+	if x != nil {
+		return x.StreamInterval
+	}
+	return nil
+}
+
+func (x *LiveStats) GetStreamMaxDuration() *durationpb.Duration {
+	if x != nil {
+		return x.StreamMaxDuration
+	}
+	return nil
+}
+
+type Export struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单次导出的最大时间范围，默认 8784h（366 天）
+	MaxRange *durationpb.Duration `protobuf:"bytes,1,opt,name=max_range,json=maxRange,proto3" json:"max_range,omitempty"`
+	// 单次导出的最大行数（消费记录 + 充值订单），超出时拒绝，默认 1000000
+	MaxRows int32 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// 行数不超过此值时在请求中同步生成，否则创建异步任务，默认 1000
+	SyncMaxRows int32 `protobuf:"varint,3,opt,name=sync_max_rows,json=syncMaxRows,proto3" json:"sync_max_rows,omitempty"`
+	// 下载链接有效期，默认 15m
+	LinkTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=link_ttl,json=linkTtl,proto3" json:"link_ttl,omitempty"`
+	// 导出文件保留时长，过期后删除文件，默认 24h
+	Retention *durationpb.Duration `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	// 异步任务扫描间隔，默认 5s
+	PollInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// 任务执行超时，超时未完成的任务由其他实例重新执行，默认 10m
+	JobTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	// 下载链接的对外访问地址（本地存储时使用），例如 http://localhost:8107
+	PublicBaseUrl string `protobuf:"bytes,8,opt,name=public_base_url,json=publicBaseUrl,proto3" json:"public_base_url,omitempty"`
+	// 下载链接签名密钥，多实例部署时必须一致
+	SignSecret string `protobuf:"bytes,9,opt,name=sign_secret,json=signSecret,proto3" json:"sign_secret,omitempty"`
+	// PDF 使用的 TrueType 字体路径（中文账单需要），未配置时中文 PDF 使用英文模板
+	PdfFont       string `protobuf:"bytes,10,opt,name=pdf_font,json=pdfFont,proto3" json:"pdf_font,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Export) Reset() {
+	*x = Export{}
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Export) ProtoMessage() {}
+
+func (x *Export) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Export.ProtoReflect.Descriptor instead.
+func (*Export) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Export) GetMaxRange() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRange
+	}
+	return nil
+}
+
+func (x *Export) GetMaxRows() int32 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *Export) GetSyncMaxRows() int32 {
+	if x != nil {
+		return x.SyncMaxRows
+	}
+	return 0
+}
+
+func (x *Export) GetLinkTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LinkTtl
+	}
+	return nil
+}
+
+func (x *Export) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Export) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Export) GetJobTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.JobTimeout
+	}
+	return nil
+}
+
+func (x *Export) GetPublicBaseUrl() string {
+	if x != nil {
+		return x.PublicBaseUrl
+	}
+	return ""
+}
+
+func (x *Export) GetSignSecret() string {
+	if x != nil {
+		return x.SignSecret
+	}
+	return ""
+}
+
+func (x *Export) GetPdfFont() string {
+	if x != nil {
+		return x.PdfFont
+	}
+	return ""
+}
+
+type ServicePricing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 计量单位：call / token / mb / second，默认 call
+	Unit string `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// 是否允许调用方传入预计算费用（cost），不允许时忽略 cost，按 单价 × 用量 计费
+	AllowCallerCost bool `protobuf:"varint,2,opt,name=allow_caller_cost,json=allowCallerCost,proto3" json:"allow_caller_cost,omitempty"`
+	// 调用方费用下限（单次请求）
+	MinCost float64 `protobuf:"fixed64,3,opt,name=min_cost,json=minCost,proto3" json:"min_cost,omitempty"`
+	// 调用方费用上限（单次请求），不配置时不超过 单价 × 用量
+	MaxCost       float64 `protobuf:"fixed64,4,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePricing) Reset() {
+	*x = ServicePricing{}
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePricing) ProtoMessage() {}
+
+func (x *ServicePricing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePricing.ProtoReflect.Descriptor instead.
+func (*ServicePricing) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ServicePricing) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ServicePricing) GetAllowCallerCost() bool {
+	if x != nil {
+		return x.AllowCallerCost
+	}
+	return false
+}
+
+func (x *ServicePricing) GetMinCost() float64 {
+	if x != nil {
+		return x.MinCost
+	}
+	return 0
+}
+
+func (x *ServicePricing) GetMaxCost() float64 {
+	if x != nil {
+		return x.MaxCost
+	}
+	return 0
+}
+
+type Lease struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单个租约最多申请的调用次数，默认 10000
+	MaxCount int32 `protobuf:"varint,1,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// 未指定 TTL 时的默认租约时长，默认 30s
+	DefaultTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"`
+	// 租约时长上限，默认 5m
+	MaxTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=max_ttl,json=maxTtl,proto3" json:"max_ttl,omitempty"`
+	// 租约过期后等待网关上报最终用量的宽限期，之后回收未用部分，默认 10s
+	ReclaimGrace *durationpb.Duration `protobuf:"bytes,4,opt,name=reclaim_grace,json=reclaimGrace,proto3" json:"reclaim_grace,omitempty"`
+	// 过期租约扫描间隔，默认 5s
+	ReclaimInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=reclaim_interval,json=reclaimInterval,proto3" json:"reclaim_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Lease) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *Lease) GetDefaultTtl() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultTtl
+	}
+	return nil
+}
+
+func (x *Lease) GetMaxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTtl
+	}
+	return nil
+}
+
+func (x *Lease) GetReclaimGrace() *durationpb.Duration {
+	if x != nil {
+		return x.ReclaimGrace
+	}
+	return nil
+}
+
+func (x *Lease) GetReclaimInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReclaimInterval
+	}
+	return nil
+}
+
+type StreamDeduct struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 单批最多合并的扣费请求数，默认 100
+	MaxBatchSize int32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// 攒批最长等待时间，默认 2ms
+	MaxBatchWait *durationpb.Duration `protobuf:"bytes,2,opt,name=max_batch_wait,json=maxBatchWait,proto3" json:"max_batch_wait,omitempty"`
+	// 单条流最多在途（已接收未返回）的请求数，超出后暂停接收，由 gRPC 流控反压调用方，默认 1000
+	MaxInFlight   int32 `protobuf:"varint,3,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeduct) Reset() {
+	*x = StreamDeduct{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeduct) ProtoMessage() {}
+
+func (x *StreamDeduct) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeduct.ProtoReflect.Descriptor instead.
+func (*StreamDeduct) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamDeduct) GetMaxBatchSize() int32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *StreamDeduct) GetMaxBatchWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBatchWait
+	}
+	return nil
+}
+
+func (x *StreamDeduct) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+type Degradation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 降级策略：
+	//   fail_closed: 拒绝请求
+	//   fail_open:   放行请求，单用户故障期间累计消费不超过 user_spend_ceiling
+	//   snapshot:    按本地内存中最近一次成功读取的余额/额度快照判断
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// 单用户故障期间允许的最大延迟扣费金额（元），fail_open / snapshot 生效
+	UserSpendCeiling float64 `protobuf:"fixed64,2,opt,name=user_spend_ceiling,json=userSpendCeiling,proto3" json:"user_spend_ceiling,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Degradation) Reset() {
+	*x = Degradation{}
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Degradation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Degradation) ProtoMessage() {}
+
+func (x *Degradation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Degradation.ProtoReflect.Descriptor instead.
+func (*Degradation) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Degradation) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Degradation) GetUserSpendCeiling() float64 {
+	if x != nil {
+		return x.UserSpendCeiling
+	}
+	return 0
+}
+
+type PaymentService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrpcAddr      string                 `protobuf:"bytes,1,opt,name=grpc_addr,json=grpcAddr,proto3" json:"grpc_addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	ReturnUrl     string                 `protobuf:"bytes,3,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"` // 支付成功后的返回URL
+	NotifyUrl     string                 `protobuf:"bytes,4,opt,name=notify_url,json=notifyUrl,proto3" json:"notify_url,omitempty"` // 支付回调通知URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentService) Reset() {
+	*x = PaymentService{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentService) ProtoMessage() {}
+
+func (x *PaymentService) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentService.ProtoReflect.Descriptor instead.
+func (*PaymentService) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PaymentService) GetGrpcAddr() string {
+	if x != nil {
+		return x.GrpcAddr
+	}
+	return ""
+}
+
+func (x *PaymentService) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *PaymentService) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+func (x *PaymentService) GetNotifyUrl() string {
+	if x != nil {
+		return x.NotifyUrl
+	}
+	return ""
+}
+
+type Server_HTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Rocketmq      *Data_RocketMQ         `protobuf:"bytes,3,opt,name=rocketmq,proto3" json:"rocketmq,omitempty"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_internal_conf_conf_proto_msgTypes[2]
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *Server_GRPC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*Server_GRPC) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[2]
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,59 +1599,60 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Server_GRPC) GetNetwork() string {
 	if x != nil {
-		return x.Database
+		return x.Network
 	}
-	return nil
+	return ""
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Server_GRPC) GetAddr() string {
 	if x != nil {
-		return x.Redis
+		return x.Addr
 	}
-	return nil
+	return ""
 }
 
-func (x *Data) GetRocketmq() *Data_RocketMQ {
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Rocketmq
+		return x.Timeout
 	}
 	return nil
 }
 
-type Billing struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	Prices     map[string]float64     `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	FreeQuotas map[string]int32       `protobuf:"bytes,2,rep,name=free_quotas,json=freeQuotas,proto3" json:"free_quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	// 余额低阈值（单位：元），当余额低于此值时触发告警
-	BalanceLowThreshold float64 `protobuf:"fixed64,3,opt,name=balance_low_threshold,json=balanceLowThreshold,proto3" json:"balance_low_threshold,omitempty"`
-	// 配额低阈值（百分比），当剩余配额低于此百分比时触发告警
-	QuotaLowPercentThreshold float64 `protobuf:"fixed64,4,opt,name=quota_low_percent_threshold,json=quotaLowPercentThreshold,proto3" json:"quota_low_percent_threshold,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// Auth 接口鉴权
+type Server_Auth struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	AdminTokens        []string                      `protobuf:"bytes,1,rep,name=admin_tokens,json=adminTokens,proto3" json:"admin_tokens,omitempty"` // 管理接口（/admin/...）的 Bearer Token，为空时只接受带管理员权限范围的用户令牌
+	Jwt                *Server_Auth_JWT              `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Session            *Server_Auth_Session          `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	AdminScope         string                        `protobuf:"bytes,4,opt,name=admin_scope,json=adminScope,proto3" json:"admin_scope,omitempty"`                             // 管理员权限范围，可查询其他用户及访问管理接口，默认 billing:admin
+	InternalCallers    []*Server_Auth_InternalCaller `protobuf:"bytes,5,rep,name=internal_callers,json=internalCallers,proto3" json:"internal_callers,omitempty"`              // 内部接口调用方策略表，为空时拒绝所有内部请求
+	ServiceTokenMaxTtl *durationpb.Duration          `protobuf:"bytes,6,opt,name=service_token_max_ttl,json=serviceTokenMaxTtl,proto3" json:"service_token_max_ttl,omitempty"` // 服务令牌最长剩余有效期（exp - 当前时间），不配置不限制
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Billing) Reset() {
-	*x = Billing{}
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+func (x *Server_Auth) Reset() {
+	*x = Server_Auth{}
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Billing) String() string {
+func (x *Server_Auth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Billing) ProtoMessage() {}
+func (*Server_Auth) ProtoMessage() {}
 
-func (x *Billing) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+func (x *Server_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,64 +1663,81 @@ func (x *Billing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Billing.ProtoReflect.Descriptor instead.
-func (*Billing) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Server_Auth.ProtoReflect.Descriptor instead.
+func (*Server_Auth) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2}
 }
 
-func (x *Billing) GetPrices() map[string]float64 {
+func (x *Server_Auth) GetAdminTokens() []string {
 	if x != nil {
-		return x.Prices
+		return x.AdminTokens
 	}
 	return nil
 }
 
-func (x *Billing) GetFreeQuotas() map[string]int32 {
+func (x *Server_Auth) GetJwt() *Server_Auth_JWT {
 	if x != nil {
-		return x.FreeQuotas
+		return x.Jwt
 	}
 	return nil
 }
 
-func (x *Billing) GetBalanceLowThreshold() float64 {
+func (x *Server_Auth) GetSession() *Server_Auth_Session {
 	if x != nil {
-		return x.BalanceLowThreshold
+		return x.Session
 	}
-	return 0
+	return nil
 }
 
-func (x *Billing) GetQuotaLowPercentThreshold() float64 {
+func (x *Server_Auth) GetAdminScope() string {
 	if x != nil {
-		return x.QuotaLowPercentThreshold
+		return x.AdminScope
 	}
-	return 0
+	return ""
 }
 
-type PaymentService struct {
+func (x *Server_Auth) GetInternalCallers() []*Server_Auth_InternalCaller {
+	if x != nil {
+		return x.InternalCallers
+	}
+	return nil
+}
+
+func (x *Server_Auth) GetServiceTokenMaxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ServiceTokenMaxTtl
+	}
+	return nil
+}
+
+// JWT passport 签发的访问令牌，本地验签
+type Server_Auth_JWT struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GrpcAddr      string                 `protobuf:"bytes,1,opt,name=grpc_addr,json=grpcAddr,proto3" json:"grpc_addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	ReturnUrl     string                 `protobuf:"bytes,3,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"` // 支付成功后的返回URL
-	NotifyUrl     string                 `protobuf:"bytes,4,opt,name=notify_url,json=notifyUrl,proto3" json:"notify_url,omitempty"` // 支付回调通知URL
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                           // HS256/HS384/HS512 共享密钥
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`    // RS*/PS*/ES* 公钥（PEM）
+	Issuer        string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`                           // 可选，校验 iss
+	Audience      string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`                       // 可选，校验 aud
+	UidClaim      string                 `protobuf:"bytes,5,opt,name=uid_claim,json=uidClaim,proto3" json:"uid_claim,omitempty"`       // 用户ID所在的 claim，默认 sub
+	ScopeClaim    string                 `protobuf:"bytes,6,opt,name=scope_claim,json=scopeClaim,proto3" json:"scope_claim,omitempty"` // 权限范围所在的 claim（空格分隔字符串或数组），默认 scope
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentService) Reset() {
-	*x = PaymentService{}
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+func (x *Server_Auth_JWT) Reset() {
+	*x = Server_Auth_JWT{}
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentService) String() string {
+func (x *Server_Auth_JWT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentService) ProtoMessage() {}
+func (*Server_Auth_JWT) ProtoMessage() {}
 
-func (x *PaymentService) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+func (x *Server_Auth_JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,63 +1748,80 @@ func (x *PaymentService) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentService.ProtoReflect.Descriptor instead.
-func (*PaymentService) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Server_Auth_JWT.ProtoReflect.Descriptor instead.
+func (*Server_Auth_JWT) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2, 0}
 }
 
-func (x *PaymentService) GetGrpcAddr() string {
+func (x *Server_Auth_JWT) GetSecret() string {
 	if x != nil {
-		return x.GrpcAddr
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *PaymentService) GetTimeout() *durationpb.Duration {
+func (x *Server_Auth_JWT) GetPublicKey() string {
 	if x != nil {
-		return x.Timeout
+		return x.PublicKey
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentService) GetReturnUrl() string {
+func (x *Server_Auth_JWT) GetIssuer() string {
 	if x != nil {
-		return x.ReturnUrl
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *PaymentService) GetNotifyUrl() string {
+func (x *Server_Auth_JWT) GetAudience() string {
 	if x != nil {
-		return x.NotifyUrl
+		return x.Audience
 	}
 	return ""
 }
 
-type Server_HTTP struct {
+func (x *Server_Auth_JWT) GetUidClaim() string {
+	if x != nil {
+		return x.UidClaim
+	}
+	return ""
+}
+
+func (x *Server_Auth_JWT) GetScopeClaim() string {
+	if x != nil {
+		return x.ScopeClaim
+	}
+	return ""
+}
+
+// Session passport 会话令牌（非 JWT），通过令牌内省接口（RFC 7662）校验
+type Server_Auth_Session struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	IntrospectUrl string                 `protobuf:"bytes,1,opt,name=introspect_url,json=introspectUrl,proto3" json:"introspect_url,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // 可选，内省接口 Basic 认证
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                   // 内省请求超时，默认 500ms
+	CacheTtl      *durationpb.Duration   `protobuf:"bytes,5,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"` // 有效会话的本地缓存时间，默认 1m（不超过令牌过期时间）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+func (x *Server_Auth_Session) Reset() {
+	*x = Server_Auth_Session{}
+	mi := &file_internal_conf_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Server_Auth_Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Server_Auth_Session) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+func (x *Server_Auth_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,56 +1832,72 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Server_Auth_Session.ProtoReflect.Descriptor instead.
+func (*Server_Auth_Session) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2, 1}
 }
 
-func (x *Server_HTTP) GetNetwork() string {
+func (x *Server_Auth_Session) GetIntrospectUrl() string {
 	if x != nil {
-		return x.Network
+		return x.IntrospectUrl
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetAddr() string {
+func (x *Server_Auth_Session) GetClientId() string {
 	if x != nil {
-		return x.Addr
+		return x.ClientId
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+func (x *Server_Auth_Session) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Server_Auth_Session) GetTimeout() *durationpb.Duration {
 	if x != nil {
 		return x.Timeout
 	}
 	return nil
 }
 
-type Server_GRPC struct {
+func (x *Server_Auth_Session) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+// InternalCaller 内部调用方（BillingInternalService）及其权限策略
+type Server_Auth_InternalCaller struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // 调用方名称，即服务令牌的 iss
+	Secrets       []string               `protobuf:"bytes,2,rep,name=secrets,proto3" json:"secrets,omitempty"`       // 服务令牌 HS256 签名密钥，配置多个用于轮换
+	Operations    []string               `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"` // 允许调用的 RPC 方法名（如 DeductQuota），* 表示全部
+	Services      []string               `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`     // 允许扣费/检查的 serviceName，* 表示全部
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+func (x *Server_Auth_InternalCaller) Reset() {
+	*x = Server_Auth_InternalCaller{}
+	mi := &file_internal_conf_conf_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_GRPC) String() string {
+func (x *Server_Auth_InternalCaller) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_GRPC) ProtoMessage() {}
+func (*Server_Auth_InternalCaller) ProtoMessage() {}
 
-func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+func (x *Server_Auth_InternalCaller) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,28 +1908,35 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
-func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use Server_Auth_InternalCaller.ProtoReflect.Descriptor instead.
+func (*Server_Auth_InternalCaller) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2, 2}
 }
 
-func (x *Server_GRPC) GetNetwork() string {
+func (x *Server_Auth_InternalCaller) GetName() string {
 	if x != nil {
-		return x.Network
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Server_GRPC) GetAddr() string {
+func (x *Server_Auth_InternalCaller) GetSecrets() []string {
 	if x != nil {
-		return x.Addr
+		return x.Secrets
 	}
-	return ""
+	return nil
 }
 
-func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+func (x *Server_Auth_InternalCaller) GetOperations() []string {
 	if x != nil {
-		return x.Timeout
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *Server_Auth_InternalCaller) GetServices() []string {
+	if x != nil {
+		return x.Services
 	}
 	return nil
 }
@@ -470,7 +1951,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +1963,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +2005,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +2017,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,20 +2062,30 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 }
 
 type Data_RocketMQ struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NameServers   []string               `protobuf:"bytes,1,rep,name=name_servers,json=nameServers,proto3" json:"name_servers,omitempty"`
-	GroupName     string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Topic         string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
-	RetryTimes    int32                  `protobuf:"varint,4,opt,name=retry_times,json=retryTimes,proto3" json:"retry_times,omitempty"`
-	SendTimeout   *durationpb.Duration   `protobuf:"bytes,5,opt,name=send_timeout,json=sendTimeout,proto3" json:"send_timeout,omitempty"`
-	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NameServers []string               `protobuf:"bytes,1,rep,name=name_servers,json=nameServers,proto3" json:"name_servers,omitempty"`
+	GroupName   string                 `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Topic       string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	RetryTimes  int32                  `protobuf:"varint,4,opt,name=retry_times,json=retryTimes,proto3" json:"retry_times,omitempty"`
+	SendTimeout *durationpb.Duration   `protobuf:"bytes,5,opt,name=send_timeout,json=sendTimeout,proto3" json:"send_timeout,omitempty"`
+	Enabled     bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 扣费事件编码：json（旧格式，默认）或 protobuf（带版本信封）
+	// 迁移期间消费端同时支持两种编码，全部消费端升级后再切换生产端
+	EventEncoding string `protobuf:"bytes,7,opt,name=event_encoding,json=eventEncoding,proto3" json:"event_encoding,omitempty"`
+	// 消费端单条消息最多重试次数，超过后转入死信 topic（默认 16）
+	MaxReconsumeTimes int32 `protobuf:"varint,8,opt,name=max_reconsume_times,json=maxReconsumeTimes,proto3" json:"max_reconsume_times,omitempty"`
+	// 死信 topic：无法解析或重试耗尽的扣费消息（默认为 topic 加 _dlq 后缀）
+	DeadLetterTopic string `protobuf:"bytes,9,opt,name=dead_letter_topic,json=deadLetterTopic,proto3" json:"dead_letter_topic,omitempty"`
+	// 原子批量扣费的多个事件合并为一条消息（消费端在同一事务中落库），默认关闭：逐条投递（同一 sharding key）
+	// 旧版消费端无法解析批量消息，全部消费端升级后再开启
+	BatchEvents   bool `protobuf:"varint,10,opt,name=batch_events,json=batchEvents,proto3" json:"batch_events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_RocketMQ) Reset() {
 	*x = Data_RocketMQ{}
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +2097,7 @@ func (x *Data_RocketMQ) String() string {
 func (*Data_RocketMQ) ProtoMessage() {}
 
 func (x *Data_RocketMQ) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,6 +2155,88 @@ func (x *Data_RocketMQ) GetEnabled() bool {
 	return false
 }
 
+func (x *Data_RocketMQ) GetEventEncoding() string {
+	if x != nil {
+		return x.EventEncoding
+	}
+	return ""
+}
+
+func (x *Data_RocketMQ) GetMaxReconsumeTimes() int32 {
+	if x != nil {
+		return x.MaxReconsumeTimes
+	}
+	return 0
+}
+
+func (x *Data_RocketMQ) GetDeadLetterTopic() string {
+	if x != nil {
+		return x.DeadLetterTopic
+	}
+	return ""
+}
+
+func (x *Data_RocketMQ) GetBatchEvents() bool {
+	if x != nil {
+		return x.BatchEvents
+	}
+	return false
+}
+
+type Data_ExportStorage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 存储类型：local（本地文件，默认），后续可扩展对象存储
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 本地存储目录，默认 ./data/exports
+	LocalDir      string `protobuf:"bytes,2,opt,name=local_dir,json=localDir,proto3" json:"local_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_ExportStorage) Reset() {
+	*x = Data_ExportStorage{}
+	mi := &file_internal_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_ExportStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_ExportStorage) ProtoMessage() {}
+
+func (x *Data_ExportStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_ExportStorage.ProtoReflect.Descriptor instead.
+func (*Data_ExportStorage) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_ExportStorage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_ExportStorage) GetLocalDir() string {
+	if x != nil {
+		return x.LocalDir
+	}
+	return ""
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
@@ -674,10 +2247,11 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12-\n" +
 	"\abilling\x18\x03 \x01(\v2\x13.kratos.api.BillingR\abilling\x12C\n" +
-	"\x0fpayment_service\x18\x04 \x01(\v2\x1a.kratos.api.PaymentServiceR\x0epaymentService\"\xb8\x02\n" +
+	"\x0fpayment_service\x18\x04 \x01(\v2\x1a.kratos.api.PaymentServiceR\x0epaymentService\"\xcc\t\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12+\n" +
+	"\x04auth\x18\x03 \x01(\v2\x17.kratos.api.Server.AuthR\x04auth\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -685,11 +2259,43 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xf2\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xe4\x06\n" +
+	"\x04Auth\x12!\n" +
+	"\fadmin_tokens\x18\x01 \x03(\tR\vadminTokens\x12-\n" +
+	"\x03jwt\x18\x02 \x01(\v2\x1b.kratos.api.Server.Auth.JWTR\x03jwt\x129\n" +
+	"\asession\x18\x03 \x01(\v2\x1f.kratos.api.Server.Auth.SessionR\asession\x12\x1f\n" +
+	"\vadmin_scope\x18\x04 \x01(\tR\n" +
+	"adminScope\x12Q\n" +
+	"\x10internal_callers\x18\x05 \x03(\v2&.kratos.api.Server.Auth.InternalCallerR\x0finternalCallers\x12L\n" +
+	"\x15service_token_max_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x12serviceTokenMaxTtl\x1a\xae\x01\n" +
+	"\x03JWT\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\x04 \x01(\tR\baudience\x12\x1b\n" +
+	"\tuid_claim\x18\x05 \x01(\tR\buidClaim\x12\x1f\n" +
+	"\vscope_claim\x18\x06 \x01(\tR\n" +
+	"scopeClaim\x1a\xdf\x01\n" +
+	"\aSession\x12%\n" +
+	"\x0eintrospect_url\x18\x01 \x01(\tR\rintrospectUrl\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x126\n" +
+	"\tcache_ttl\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x1az\n" +
+	"\x0eInternalCaller\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asecrets\x18\x02 \x03(\tR\asecrets\x12\x1e\n" +
+	"\n" +
+	"operations\x18\x03 \x03(\tR\n" +
+	"operations\x12\x1a\n" +
+	"\bservices\x18\x04 \x03(\tR\bservices\"\xd9\a\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x125\n" +
-	"\brocketmq\x18\x03 \x01(\v2\x19.kratos.api.Data.RocketMQR\brocketmq\x1a:\n" +
+	"\brocketmq\x18\x03 \x01(\v2\x19.kratos.api.Data.RocketMQR\brocketmq\x12E\n" +
+	"\x0eexport_storage\x18\x04 \x01(\v2\x1e.kratos.api.Data.ExportStorageR\rexportStorage\x122\n" +
+	"\x15deferred_journal_path\x18\x05 \x01(\tR\x13deferredJournalPath\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -697,7 +2303,7 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\xdb\x01\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x81\x03\n" +
 	"\bRocketMQ\x12!\n" +
 	"\fname_servers\x18\x01 \x03(\tR\vnameServers\x12\x1d\n" +
 	"\n" +
@@ -706,19 +2312,142 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\vretry_times\x18\x04 \x01(\x05R\n" +
 	"retryTimes\x12<\n" +
 	"\fsend_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vsendTimeout\x12\x18\n" +
-	"\aenabled\x18\x06 \x01(\bR\aenabled\"\xf5\x02\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12%\n" +
+	"\x0eevent_encoding\x18\a \x01(\tR\reventEncoding\x12.\n" +
+	"\x13max_reconsume_times\x18\b \x01(\x05R\x11maxReconsumeTimes\x12*\n" +
+	"\x11dead_letter_topic\x18\t \x01(\tR\x0fdeadLetterTopic\x12!\n" +
+	"\fbatch_events\x18\n" +
+	" \x01(\bR\vbatchEvents\x1aD\n" +
+	"\rExportStorage\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x1b\n" +
+	"\tlocal_dir\x18\x02 \x01(\tR\blocalDir\"\xee\n" +
+	"\n" +
 	"\aBilling\x127\n" +
 	"\x06prices\x18\x01 \x03(\v2\x1f.kratos.api.Billing.PricesEntryR\x06prices\x12D\n" +
 	"\vfree_quotas\x18\x02 \x03(\v2#.kratos.api.Billing.FreeQuotasEntryR\n" +
 	"freeQuotas\x122\n" +
 	"\x15balance_low_threshold\x18\x03 \x01(\x01R\x13balanceLowThreshold\x12=\n" +
-	"\x1bquota_low_percent_threshold\x18\x04 \x01(\x01R\x18quotaLowPercentThreshold\x1a9\n" +
+	"\x1bquota_low_percent_threshold\x18\x04 \x01(\x01R\x18quotaLowPercentThreshold\x12F\n" +
+	"\vdegradation\x18\x05 \x03(\v2$.kratos.api.Billing.DegradationEntryR\vdegradation\x12S\n" +
+	"\x18deferred_settle_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x16deferredSettleInterval\x12'\n" +
+	"\x05lease\x18\a \x01(\v2\x11.kratos.api.LeaseR\x05lease\x12=\n" +
+	"\rstream_deduct\x18\b \x01(\v2\x18.kratos.api.StreamDeductR\fstreamDeduct\x12:\n" +
+	"\apricing\x18\t \x03(\v2 .kratos.api.Billing.PricingEntryR\apricing\x12*\n" +
+	"\x06export\x18\n" +
+	" \x01(\v2\x12.kratos.api.ExportR\x06export\x124\n" +
+	"\n" +
+	"live_stats\x18\v \x01(\v2\x15.kratos.api.LiveStatsR\tliveStats\x12*\n" +
+	"\x06budget\x18\f \x01(\v2\x12.kratos.api.BudgetR\x06budget\x124\n" +
+	"\n" +
+	"rate_limit\x18\r \x01(\v2\x15.kratos.api.RateLimitR\trateLimit\x12:\n" +
+	"\fquota_period\x18\x0e \x01(\v2\x17.kratos.api.QuotaPeriodR\vquotaPeriod\x12\x1a\n" +
+	"\btimezone\x18\x0f \x01(\tR\btimezone\x124\n" +
+	"\bpackages\x18\x10 \x03(\v2\x18.kratos.api.UsagePackageR\bpackages\x12C\n" +
+	"\x0faccount_pricing\x18\x11 \x01(\v2\x1a.kratos.api.AccountPricingR\x0eaccountPricing\x12<\n" +
+	"\forganization\x18\x12 \x01(\v2\x18.kratos.api.OrganizationR\forganization\x120\n" +
+	"\x14max_deferred_charges\x18\x13 \x01(\x05R\x12maxDeferredCharges\x1a9\n" +
 	"\vPricesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a=\n" +
 	"\x0fFreeQuotasEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa0\x01\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aW\n" +
+	"\x10DegradationEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.kratos.api.DegradationR\x05value:\x028\x01\x1aV\n" +
+	"\fPricingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.kratos.api.ServicePricingR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x0eAccountPricing\x12?\n" +
+	"\x0erule_cache_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fruleCacheTtl\x12<\n" +
+	"\fsettle_grace\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vsettleGrace\"|\n" +
+	"\fOrganization\x12\x1f\n" +
+	"\vmax_members\x18\x01 \x01(\x05R\n" +
+	"maxMembers\x12K\n" +
+	"\x14membership_cache_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x12membershipCacheTtl\"\xa4\x01\n" +
+	"\fUsagePackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12!\n" +
+	"\fvalid_months\x18\x06 \x01(\x05R\vvalidMonths\"\x8a\x03\n" +
+	"\vQuotaPeriod\x12#\n" +
+	"\rdefault_cycle\x18\x01 \x01(\tR\fdefaultCycle\x12A\n" +
+	"\bservices\x18\x02 \x03(\v2%.kratos.api.QuotaPeriod.ServicesEntryR\bservices\x128\n" +
+	"\x05plans\x18\x03 \x03(\v2\".kratos.api.QuotaPeriod.PlansEntryR\x05plans\x12E\n" +
+	"\x11account_cache_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0faccountCacheTtl\x1a;\n" +
+	"\rServicesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\n" +
+	"PlansEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.kratos.api.QuotaPeriodPlanR\x05value:\x028\x01\"\xc0\x01\n" +
+	"\x0fQuotaPeriodPlan\x12E\n" +
+	"\bservices\x18\x01 \x03(\v2).kratos.api.QuotaPeriodPlan.ServicesEntryR\bservices\x12)\n" +
+	"\x10rollover_percent\x18\x02 \x01(\x01R\x0frolloverPercent\x1a;\n" +
+	"\rServicesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfc\x01\n" +
+	"\tRateLimit\x12!\n" +
+	"\fdefault_plan\x18\x01 \x01(\tR\vdefaultPlan\x126\n" +
+	"\x05plans\x18\x02 \x03(\v2 .kratos.api.RateLimit.PlansEntryR\x05plans\x12?\n" +
+	"\x0euser_cache_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fuserCacheTtl\x1aS\n" +
+	"\n" +
+	"PlansEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.RateLimitPlanR\x05value:\x028\x01\"\xac\x01\n" +
+	"\rRateLimitPlan\x12C\n" +
+	"\bservices\x18\x01 \x03(\v2'.kratos.api.RateLimitPlan.ServicesEntryR\bservices\x1aV\n" +
+	"\rServicesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.RateLimitRuleR\x05value:\x028\x01\"G\n" +
+	"\rRateLimitRule\x12\x1d\n" +
+	"\n" +
+	"per_second\x18\x01 \x01(\x03R\tperSecond\x12\x17\n" +
+	"\aper_day\x18\x02 \x01(\x03R\x06perDay\"\xab\x01\n" +
+	"\x06Budget\x12@\n" +
+	"\x0ealert_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\ralertInterval\x12\x1d\n" +
+	"\n" +
+	"notify_url\x18\x02 \x01(\tR\tnotifyUrl\x12@\n" +
+	"\x0enotify_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rnotifyTimeout\"\x9a\x01\n" +
+	"\tLiveStats\x12B\n" +
+	"\x0fstream_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0estreamInterval\x12I\n" +
+	"\x13stream_max_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x11streamMaxDuration\"\xce\x03\n" +
+	"\x06Export\x126\n" +
+	"\tmax_range\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bmaxRange\x12\x19\n" +
+	"\bmax_rows\x18\x02 \x01(\x05R\amaxRows\x12\"\n" +
+	"\rsync_max_rows\x18\x03 \x01(\x05R\vsyncMaxRows\x124\n" +
+	"\blink_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alinkTtl\x127\n" +
+	"\tretention\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12>\n" +
+	"\rpoll_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12:\n" +
+	"\vjob_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"jobTimeout\x12&\n" +
+	"\x0fpublic_base_url\x18\b \x01(\tR\rpublicBaseUrl\x12\x1f\n" +
+	"\vsign_secret\x18\t \x01(\tR\n" +
+	"signSecret\x12\x19\n" +
+	"\bpdf_font\x18\n" +
+	" \x01(\tR\apdfFont\"\x86\x01\n" +
+	"\x0eServicePricing\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\tR\x04unit\x12*\n" +
+	"\x11allow_caller_cost\x18\x02 \x01(\bR\x0fallowCallerCost\x12\x19\n" +
+	"\bmin_cost\x18\x03 \x01(\x01R\aminCost\x12\x19\n" +
+	"\bmax_cost\x18\x04 \x01(\x01R\amaxCost\"\x9a\x02\n" +
+	"\x05Lease\x12\x1b\n" +
+	"\tmax_count\x18\x01 \x01(\x05R\bmaxCount\x12:\n" +
+	"\vdefault_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"defaultTtl\x122\n" +
+	"\amax_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06maxTtl\x12>\n" +
+	"\rreclaim_grace\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\freclaimGrace\x12D\n" +
+	"\x10reclaim_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0freclaimInterval\"\x99\x01\n" +
+	"\fStreamDeduct\x12$\n" +
+	"\x0emax_batch_size\x18\x01 \x01(\x05R\fmaxBatchSize\x12?\n" +
+	"\x0emax_batch_wait\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fmaxBatchWait\x12\"\n" +
+	"\rmax_in_flight\x18\x03 \x01(\x05R\vmaxInFlight\"S\n" +
+	"\vDegradation\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12,\n" +
+	"\x12user_spend_ceiling\x18\x02 \x01(\x01R\x10userSpendCeiling\"\xa0\x01\n" +
 	"\x0ePaymentService\x12\x1b\n" +
 	"\tgrpc_addr\x18\x01 \x01(\tR\bgrpcAddr\x123\n" +
 	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1d\n" +
@@ -739,45 +2468,122 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_internal_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Billing)(nil),             // 3: kratos.api.Billing
-	(*PaymentService)(nil),      // 4: kratos.api.PaymentService
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*Data_RocketMQ)(nil),       // 9: kratos.api.Data.RocketMQ
-	nil,                         // 10: kratos.api.Billing.PricesEntry
-	nil,                         // 11: kratos.api.Billing.FreeQuotasEntry
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Bootstrap)(nil),                  // 0: kratos.api.Bootstrap
+	(*Server)(nil),                     // 1: kratos.api.Server
+	(*Data)(nil),                       // 2: kratos.api.Data
+	(*Billing)(nil),                    // 3: kratos.api.Billing
+	(*AccountPricing)(nil),             // 4: kratos.api.AccountPricing
+	(*Organization)(nil),               // 5: kratos.api.Organization
+	(*UsagePackage)(nil),               // 6: kratos.api.UsagePackage
+	(*QuotaPeriod)(nil),                // 7: kratos.api.QuotaPeriod
+	(*QuotaPeriodPlan)(nil),            // 8: kratos.api.QuotaPeriodPlan
+	(*RateLimit)(nil),                  // 9: kratos.api.RateLimit
+	(*RateLimitPlan)(nil),              // 10: kratos.api.RateLimitPlan
+	(*RateLimitRule)(nil),              // 11: kratos.api.RateLimitRule
+	(*Budget)(nil),                     // 12: kratos.api.Budget
+	(*LiveStats)(nil),                  // 13: kratos.api.LiveStats
+	(*Export)(nil),                     // 14: kratos.api.Export
+	(*ServicePricing)(nil),             // 15: kratos.api.ServicePricing
+	(*Lease)(nil),                      // 16: kratos.api.Lease
+	(*StreamDeduct)(nil),               // 17: kratos.api.StreamDeduct
+	(*Degradation)(nil),                // 18: kratos.api.Degradation
+	(*PaymentService)(nil),             // 19: kratos.api.PaymentService
+	(*Server_HTTP)(nil),                // 20: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),                // 21: kratos.api.Server.GRPC
+	(*Server_Auth)(nil),                // 22: kratos.api.Server.Auth
+	(*Server_Auth_JWT)(nil),            // 23: kratos.api.Server.Auth.JWT
+	(*Server_Auth_Session)(nil),        // 24: kratos.api.Server.Auth.Session
+	(*Server_Auth_InternalCaller)(nil), // 25: kratos.api.Server.Auth.InternalCaller
+	(*Data_Database)(nil),              // 26: kratos.api.Data.Database
+	(*Data_Redis)(nil),                 // 27: kratos.api.Data.Redis
+	(*Data_RocketMQ)(nil),              // 28: kratos.api.Data.RocketMQ
+	(*Data_ExportStorage)(nil),         // 29: kratos.api.Data.ExportStorage
+	nil,                                // 30: kratos.api.Billing.PricesEntry
+	nil,                                // 31: kratos.api.Billing.FreeQuotasEntry
+	nil,                                // 32: kratos.api.Billing.DegradationEntry
+	nil,                                // 33: kratos.api.Billing.PricingEntry
+	nil,                                // 34: kratos.api.QuotaPeriod.ServicesEntry
+	nil,                                // 35: kratos.api.QuotaPeriod.PlansEntry
+	nil,                                // 36: kratos.api.QuotaPeriodPlan.ServicesEntry
+	nil,                                // 37: kratos.api.RateLimit.PlansEntry
+	nil,                                // 38: kratos.api.RateLimitPlan.ServicesEntry
+	(*durationpb.Duration)(nil),        // 39: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.billing:type_name -> kratos.api.Billing
-	4,  // 3: kratos.api.Bootstrap.payment_service:type_name -> kratos.api.PaymentService
-	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.Data.rocketmq:type_name -> kratos.api.Data.RocketMQ
-	10, // 9: kratos.api.Billing.prices:type_name -> kratos.api.Billing.PricesEntry
-	11, // 10: kratos.api.Billing.free_quotas:type_name -> kratos.api.Billing.FreeQuotasEntry
-	12, // 11: kratos.api.PaymentService.timeout:type_name -> google.protobuf.Duration
-	12, // 12: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 13: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 14: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 15: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	12, // 16: kratos.api.Data.RocketMQ.send_timeout:type_name -> google.protobuf.Duration
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	19, // 3: kratos.api.Bootstrap.payment_service:type_name -> kratos.api.PaymentService
+	20, // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	21, // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	22, // 6: kratos.api.Server.auth:type_name -> kratos.api.Server.Auth
+	26, // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	27, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	28, // 9: kratos.api.Data.rocketmq:type_name -> kratos.api.Data.RocketMQ
+	29, // 10: kratos.api.Data.export_storage:type_name -> kratos.api.Data.ExportStorage
+	30, // 11: kratos.api.Billing.prices:type_name -> kratos.api.Billing.PricesEntry
+	31, // 12: kratos.api.Billing.free_quotas:type_name -> kratos.api.Billing.FreeQuotasEntry
+	32, // 13: kratos.api.Billing.degradation:type_name -> kratos.api.Billing.DegradationEntry
+	39, // 14: kratos.api.Billing.deferred_settle_interval:type_name -> google.protobuf.Duration
+	16, // 15: kratos.api.Billing.lease:type_name -> kratos.api.Lease
+	17, // 16: kratos.api.Billing.stream_deduct:type_name -> kratos.api.StreamDeduct
+	33, // 17: kratos.api.Billing.pricing:type_name -> kratos.api.Billing.PricingEntry
+	14, // 18: kratos.api.Billing.export:type_name -> kratos.api.Export
+	13, // 19: kratos.api.Billing.live_stats:type_name -> kratos.api.LiveStats
+	12, // 20: kratos.api.Billing.budget:type_name -> kratos.api.Budget
+	9,  // 21: kratos.api.Billing.rate_limit:type_name -> kratos.api.RateLimit
+	7,  // 22: kratos.api.Billing.quota_period:type_name -> kratos.api.QuotaPeriod
+	6,  // 23: kratos.api.Billing.packages:type_name -> kratos.api.UsagePackage
+	4,  // 24: kratos.api.Billing.account_pricing:type_name -> kratos.api.AccountPricing
+	5,  // 25: kratos.api.Billing.organization:type_name -> kratos.api.Organization
+	39, // 26: kratos.api.AccountPricing.rule_cache_ttl:type_name -> google.protobuf.Duration
+	39, // 27: kratos.api.AccountPricing.settle_grace:type_name -> google.protobuf.Duration
+	39, // 28: kratos.api.Organization.membership_cache_ttl:type_name -> google.protobuf.Duration
+	34, // 29: kratos.api.QuotaPeriod.services:type_name -> kratos.api.QuotaPeriod.ServicesEntry
+	35, // 30: kratos.api.QuotaPeriod.plans:type_name -> kratos.api.QuotaPeriod.PlansEntry
+	39, // 31: kratos.api.QuotaPeriod.account_cache_ttl:type_name -> google.protobuf.Duration
+	36, // 32: kratos.api.QuotaPeriodPlan.services:type_name -> kratos.api.QuotaPeriodPlan.ServicesEntry
+	37, // 33: kratos.api.RateLimit.plans:type_name -> kratos.api.RateLimit.PlansEntry
+	39, // 34: kratos.api.RateLimit.user_cache_ttl:type_name -> google.protobuf.Duration
+	38, // 35: kratos.api.RateLimitPlan.services:type_name -> kratos.api.RateLimitPlan.ServicesEntry
+	39, // 36: kratos.api.Budget.alert_interval:type_name -> google.protobuf.Duration
+	39, // 37: kratos.api.Budget.notify_timeout:type_name -> google.protobuf.Duration
+	39, // 38: kratos.api.LiveStats.stream_interval:type_name -> google.protobuf.Duration
+	39, // 39: kratos.api.LiveStats.stream_max_duration:type_name -> google.protobuf.Duration
+	39, // 40: kratos.api.Export.max_range:type_name -> google.protobuf.Duration
+	39, // 41: kratos.api.Export.link_ttl:type_name -> google.protobuf.Duration
+	39, // 42: kratos.api.Export.retention:type_name -> google.protobuf.Duration
+	39, // 43: kratos.api.Export.poll_interval:type_name -> google.protobuf.Duration
+	39, // 44: kratos.api.Export.job_timeout:type_name -> google.protobuf.Duration
+	39, // 45: kratos.api.Lease.default_ttl:type_name -> google.protobuf.Duration
+	39, // 46: kratos.api.Lease.max_ttl:type_name -> google.protobuf.Duration
+	39, // 47: kratos.api.Lease.reclaim_grace:type_name -> google.protobuf.Duration
+	39, // 48: kratos.api.Lease.reclaim_interval:type_name -> google.protobuf.Duration
+	39, // 49: kratos.api.StreamDeduct.max_batch_wait:type_name -> google.protobuf.Duration
+	39, // 50: kratos.api.PaymentService.timeout:type_name -> google.protobuf.Duration
+	39, // 51: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	39, // 52: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	23, // 53: kratos.api.Server.Auth.jwt:type_name -> kratos.api.Server.Auth.JWT
+	24, // 54: kratos.api.Server.Auth.session:type_name -> kratos.api.Server.Auth.Session
+	25, // 55: kratos.api.Server.Auth.internal_callers:type_name -> kratos.api.Server.Auth.InternalCaller
+	39, // 56: kratos.api.Server.Auth.service_token_max_ttl:type_name -> google.protobuf.Duration
+	39, // 57: kratos.api.Server.Auth.Session.timeout:type_name -> google.protobuf.Duration
+	39, // 58: kratos.api.Server.Auth.Session.cache_ttl:type_name -> google.protobuf.Duration
+	39, // 59: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	39, // 60: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	39, // 61: kratos.api.Data.RocketMQ.send_timeout:type_name -> google.protobuf.Duration
+	18, // 62: kratos.api.Billing.DegradationEntry.value:type_name -> kratos.api.Degradation
+	15, // 63: kratos.api.Billing.PricingEntry.value:type_name -> kratos.api.ServicePricing
+	8,  // 64: kratos.api.QuotaPeriod.PlansEntry.value:type_name -> kratos.api.QuotaPeriodPlan
+	10, // 65: kratos.api.RateLimit.PlansEntry.value:type_name -> kratos.api.RateLimitPlan
+	11, // 66: kratos.api.RateLimitPlan.ServicesEntry.value:type_name -> kratos.api.RateLimitRule
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -791,7 +2597,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
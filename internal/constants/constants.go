@@ -4,6 +4,8 @@ package constants
 const (
 	// TimeFormatMonth 月份格式 (YYYY-MM)
 	TimeFormatMonth = "2006-01"
+	// TimeFormatDate 日期格式 (YYYY-MM-DD)
+	TimeFormatDate = "2006-01-02"
 )
 
 // Redis Key 前缀常量
@@ -16,6 +18,74 @@ const (
 	RedisKeyDeductLock = "deduct:lock:"
 	// RedisKeyRechargeOrder 充值订单 key 前缀
 	RedisKeyRechargeOrder = "recharge:order:"
+	// RedisKeyPendingBalance 在途（已在 Redis 扣减、尚未落库）余额扣费 key 前缀
+	RedisKeyPendingBalance = "pending:balance:"
+	// RedisKeyPendingQuota 在途（已在 Redis 扣减、尚未落库）免费额度 key 前缀
+	RedisKeyPendingQuota = "pending:quota:"
+	// RedisKeyLease 额度租约 key 前缀
+	RedisKeyLease = "lease:"
+	// RedisKeyLeaseExpiry 额度租约过期索引（zset，score 为过期时间毫秒）
+	RedisKeyLeaseExpiry = "lease_expiry"
+	// RedisKeyLiveUsage 实时用量计数 key 前缀（hash，按分钟/小时）
+	RedisKeyLiveUsage = "usage:live:"
+	// RedisKeyBudget 硬性预算上限缓存 key 前缀（hash，按用户）
+	RedisKeyBudget = "budget:"
+	// RedisKeyBudgetSpent 预算周期内余额消费缓存 key 前缀（按用户+预算范围+月份）
+	RedisKeyBudgetSpent = "budget:spent:"
+	// RedisKeyRateLimitBucket 每秒限流令牌桶 key 前缀（hash，按用户+服务）
+	RedisKeyRateLimitBucket = "ratelimit:bucket:"
+	// RedisKeyRateLimitDay 每日限流计数 key 前缀（按用户+服务+日期）
+	RedisKeyRateLimitDay = "ratelimit:day:"
+	// RedisKeyRateLimitUser 用户限流设置缓存 key 前缀
+	RedisKeyRateLimitUser = "ratelimit:user:"
+	// RedisKeyAccountSetting 账户设置（额度周期锚点）缓存 key 前缀
+	RedisKeyAccountSetting = "account:setting:"
+	// RedisKeyPackage 用量包剩余量缓存 key 前缀（按用户+服务，有效用量包合计）
+	RedisKeyPackage = "package:"
+	// RedisKeyPendingPackage 在途（已在 Redis 扣减、尚未落库）用量包扣减 key 前缀
+	RedisKeyPendingPackage = "pending:package:"
+	// RedisKeyPriceRules 账户价格规则缓存 key 前缀（JSON，按用户）
+	RedisKeyPriceRules = "pricing:rules:"
+	// RedisKeyOrgMember 用户组织成员身份缓存 key 前缀（JSON，按用户，非成员时缓存 null）
+	RedisKeyOrgMember = "org:member:"
+	// RedisKeyMemberSpent 组织成员本月余额消费缓存 key 前缀（按组织+成员+月份）
+	RedisKeyMemberSpent = "org:spent:"
+)
+
+// 消息队列常量
+const (
+	// MQTopicDeduct 扣费事件默认 topic（未配置 rocketmq.topic 时使用）
+	MQTopicDeduct = "billing_deduct_queue"
+	// MQDeadLetterSuffix 未配置 rocketmq.dead_letter_topic 时，死信 topic 为扣费 topic 加此后缀
+	MQDeadLetterSuffix = "_dlq"
+	// MQMaxReconsumeTimes 未配置 rocketmq.max_reconsume_times 时消费端单条消息最多重试次数
+	MQMaxReconsumeTimes = 16
+	// MQPropertyDeadLetterReason 消息属性：转入死信的原因
+	MQPropertyDeadLetterReason = "DLQ_REASON"
+	// MQPropertyContentType 消息属性：消息体编码
+	MQPropertyContentType = "CONTENT_TYPE"
+)
+
+// 事件编码常量
+const (
+	// EventEncodingJSON 旧版 JSON 编码（无版本信息）
+	EventEncodingJSON = "json"
+	// EventEncodingProtobuf protobuf 信封编码
+	EventEncodingProtobuf = "protobuf"
+	// ContentTypeJSON JSON 消息体
+	ContentTypeJSON = "application/json"
+	// ContentTypeProtobuf protobuf 消息体
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// 事件类型与版本常量
+const (
+	// EventTypeDeduct 扣费事件
+	EventTypeDeduct = "billing.deduct"
+	// EventTypeDeductBatch 原子批量扣费事件
+	EventTypeDeductBatch = "billing.deduct.batch"
+	// EventSchemaVersion 当前事件 schema 版本
+	EventSchemaVersion = 1
 )
 
 // 计费类型常量
@@ -24,6 +94,10 @@ const (
 	BillingTypeFree = "free"
 	// BillingTypeBalance 余额扣费
 	BillingTypeBalance = "balance"
+	// BillingTypePackage 用量包扣减
+	BillingTypePackage = "package"
+	// BillingTypeTrueUp 合同到期补差（未用完的承诺金额）
+	BillingTypeTrueUp = "true_up"
 )
 
 // 计费类型消息常量
@@ -32,8 +106,30 @@ const (
 	BillingMessageFree = "free"
 	// BillingMessageBalance 使用余额
 	BillingMessageBalance = "balance"
+	// BillingMessagePackage 使用用量包（免费额度不足部分由用量包覆盖）
+	BillingMessagePackage = "package"
 	// BillingMessageInsufficientBalance 余额不足
 	BillingMessageInsufficientBalance = "insufficient balance"
+	// BillingMessageDegraded 依赖故障，按降级策略处理
+	BillingMessageDegraded = "degraded"
+	// BillingMessageBudgetExceeded 超出消费预算（硬性上限）
+	BillingMessageBudgetExceeded = "budget exceeded"
+	// BillingMessageRateLimited 超出请求频率限制
+	BillingMessageRateLimited = "rate limited"
+	// BillingMessageMemberLimitExceeded 超出组织成员每月消费上限
+	BillingMessageMemberLimitExceeded = "member spend limit exceeded"
+)
+
+// 计量单位常量
+const (
+	// UsageUnitCall 按调用次数
+	UsageUnitCall = "call"
+	// UsageUnitToken 按 token 数
+	UsageUnitToken = "token"
+	// UsageUnitMB 按数据量（MB）
+	UsageUnitMB = "mb"
+	// UsageUnitSecond 按时长（秒）
+	UsageUnitSecond = "second"
 )
 
 // 订单状态常量
@@ -68,12 +164,116 @@ const (
 	QuotaCheckResultDenied = "denied"
 	// QuotaCheckResultError 错误
 	QuotaCheckResultError = "error"
+	// QuotaCheckResultDegraded 依赖故障，按降级策略放行
+	QuotaCheckResultDegraded = "degraded"
+)
+
+// 降级策略常量（依赖故障时）
+const (
+	// DegradationPolicyFailClosed 拒绝请求（默认）
+	DegradationPolicyFailClosed = "fail_closed"
+	// DegradationPolicyFailOpen 放行请求，单用户累计不超过消费上限
+	DegradationPolicyFailOpen = "fail_open"
+	// DegradationPolicySnapshot 按本地内存快照判断
+	DegradationPolicySnapshot = "snapshot"
+)
+
+// 延迟扣费拒绝原因常量（策略放行但未能记录，用于指标）
+const (
+	// DeferredRejectQueueFull 待结算的延迟扣费已达上限
+	DeferredRejectQueueFull = "queue_full"
+	// DeferredRejectJournalError 写入延迟扣费日志失败
+	DeferredRejectJournalError = "journal_error"
+)
+
+// 依赖名称常量（用于熔断器与指标）
+const (
+	// DependencyRedis Redis
+	DependencyRedis = "redis"
+	// DependencyMySQL MySQL
+	DependencyMySQL = "mysql"
+	// DependencyPaymentService payment-service
+	DependencyPaymentService = "payment_service"
 )
 
 // 扣费类型常量（用于指标）
 const (
 	// DeductTypeMixed 混合扣费
 	DeductTypeMixed = "mixed"
+	// DeductTypeDeferred 延迟扣费（降级期间记录，依赖恢复后结算）
+	DeductTypeDeferred = "deferred"
+	// DeductTypeBatch 多服务原子扣费（DB 事务）
+	DeductTypeBatch = "batch"
+)
+
+// 批量检查/扣费常量
+const (
+	// MaxQuotaItems 单次批量检查/扣费最多的服务项数
+	MaxQuotaItems = 20
+)
+
+// 扣费来源信息长度限制（与 billing_record_metadata 表字段一致）
+const (
+	// MaxMetadataIDLength 请求ID / API Key ID / 应用ID 最大长度
+	MaxMetadataIDLength = 64
+	// MaxMetadataOperationLength 接口/操作最大长度
+	MaxMetadataOperationLength = 128
+	// MaxMetadataLabels 自定义标签最多个数
+	MaxMetadataLabels = 16
+	// MaxMetadataLabelKeyLength 标签名最大长度
+	MaxMetadataLabelKeyLength = 64
+	// MaxMetadataLabelValueLength 标签值最大长度
+	MaxMetadataLabelValueLength = 256
+)
+
+// 消费记录查询常量
+const (
+	// DefaultRecordPageSize 消费记录默认每页条数
+	DefaultRecordPageSize = 20
+	// MaxRecordPageSize 消费记录每页最大条数
+	MaxRecordPageSize = 100
+)
+
+// 账单导出格式常量
+const (
+	// ExportFormatCSV CSV
+	ExportFormatCSV = "csv"
+	// ExportFormatXLSX Excel
+	ExportFormatXLSX = "xlsx"
+	// ExportFormatPDF PDF
+	ExportFormatPDF = "pdf"
+)
+
+// 账单导出任务状态常量
+const (
+	// ExportStatusPending 等待执行
+	ExportStatusPending = "pending"
+	// ExportStatusRunning 执行中
+	ExportStatusRunning = "running"
+	// ExportStatusSuccess 已完成，可下载
+	ExportStatusSuccess = "success"
+	// ExportStatusFailed 执行失败
+	ExportStatusFailed = "failed"
+	// ExportStatusExpired 文件已过期删除
+	ExportStatusExpired = "expired"
+)
+
+// 导出文件存储类型常量
+const (
+	// ExportStorageLocal 本地文件存储
+	ExportStorageLocal = "local"
+)
+
+// 额度租约操作常量（用于指标）
+const (
+	// LeaseOperationAcquire 申请租约
+	LeaseOperationAcquire = "acquire"
+	// LeaseOperationReport 上报用量
+	LeaseOperationReport = "report"
+	// LeaseOperationRelease 释放租约
+	LeaseOperationRelease = "release"
+	// LeaseOperationReclaim 回收过期租约
+	LeaseOperationReclaim = "reclaim"
 )
 
 // 统计周期常量
@@ -84,10 +284,154 @@ const (
 	StatsPeriodMonth = "month"
 )
 
+// 用量时间序列粒度常量
+const (
+	// UsageGranularityHour 按小时
+	UsageGranularityHour = "hour"
+	// UsageGranularityDay 按天
+	UsageGranularityDay = "day"
+	// UsageGranularityMonth 按月
+	UsageGranularityMonth = "month"
+	// MaxUsageSeriesPoints 单次查询最多返回的时间桶数
+	MaxUsageSeriesPoints = 1000
+)
+
+// 实时用量常量
+const (
+	// UsageGranularityMinute 按分钟（仅实时用量）
+	UsageGranularityMinute = "minute"
+	// DefaultLiveUsageMinutePoints 实时用量按分钟默认返回的时间片数
+	DefaultLiveUsageMinutePoints = 60
+	// MaxLiveUsageMinutePoints 实时用量按分钟最多返回的时间片数（分钟计数保留 3 小时）
+	MaxLiveUsageMinutePoints = 120
+	// DefaultLiveUsageHourPoints 实时用量按小时默认返回的时间片数
+	DefaultLiveUsageHourPoints = 24
+	// MaxLiveUsageHourPoints 实时用量按小时最多返回的时间片数（小时计数保留 49 小时）
+	MaxLiveUsageHourPoints = 48
+)
+
+// 管理报表常量
+const (
+	// MaxReportRangeDays 报表单次查询最大时间跨度（天）
+	MaxReportRangeDays = 366
+	// ReportOrderByRevenue 按收入排序
+	ReportOrderByRevenue = "revenue"
+	// ReportOrderByCount 按调用次数排序
+	ReportOrderByCount = "count"
+	// DefaultTopConsumers 消费排行默认返回条数
+	DefaultTopConsumers = 10
+	// MaxTopConsumers 消费排行最多返回条数
+	MaxTopConsumers = 100
+)
+
+// 预算常量
+const (
+	// BudgetAlertBatchSize 预算提醒每批扫描的预算数
+	BudgetAlertBatchSize = 100
+	// MaxBudgetAlertPercent 提醒阈值上限（百分比）
+	MaxBudgetAlertPercent = 100
+	// BudgetOperationCheck 预算拒绝指标：配额检查
+	BudgetOperationCheck = "check"
+	// BudgetOperationDeduct 预算拒绝指标：扣费
+	BudgetOperationDeduct = "deduct"
+)
+
+// 限流常量
+const (
+	// RateLimitAllServices 限流规则适用于未单独配置的服务
+	RateLimitAllServices = "*"
+	// RateLimitPerSecond 限流指标：每秒上限
+	RateLimitPerSecond = "second"
+	// RateLimitPerDay 限流指标：每日上限
+	RateLimitPerDay = "day"
+)
+
+// 额度周期常量
+const (
+	// QuotaCycleDaily 按天（自然日）
+	QuotaCycleDaily = "daily"
+	// QuotaCycleWeekly 按周（周一开始）
+	QuotaCycleWeekly = "weekly"
+	// QuotaCycleMonthly 按自然月
+	QuotaCycleMonthly = "monthly"
+	// QuotaCycleAnniversary 按账户周年月（从周期锚点所在日开始，每月同一日重置）
+	QuotaCycleAnniversary = "anniversary"
+	// QuotaCycleAllServices 套餐周期适用于未单独配置的服务
+	QuotaCycleAllServices = "*"
+)
+
+// 认证常量
+const (
+	// DefaultAdminScope 默认管理员权限范围（可查询其他用户及访问管理接口）
+	DefaultAdminScope = "billing:admin"
+	// DefaultJWTUIDClaim JWT 中用户ID默认所在的 claim
+	DefaultJWTUIDClaim = "sub"
+	// DefaultJWTScopeClaim JWT 中权限范围默认所在的 claim
+	DefaultJWTScopeClaim = "scope"
+	// ServiceTokenHeader 内部调用方服务令牌请求头（gRPC metadata 为小写）
+	ServiceTokenHeader = "X-Service-Token"
+	// ServiceTokenAudience 服务令牌的 aud
+	ServiceTokenAudience = "billing-service"
+)
+
 // 订单ID前缀常量
 const (
 	// OrderIDPrefixRecharge 充值订单ID前缀
 	OrderIDPrefixRecharge = "recharge_"
+	// OrderIDPrefixPackage 用量包订单ID前缀
+	OrderIDPrefixPackage = "package_"
+	// OrderIDPrefixContract 合同预付订单ID前缀
+	OrderIDPrefixContract = "contract_"
+)
+
+// 订单类型常量
+const (
+	// OrderTypeRecharge 余额充值
+	OrderTypeRecharge = "recharge"
+	// OrderTypePackage 购买用量包
+	OrderTypePackage = "package"
+	// OrderTypeContract 合同预付承诺金额（计入余额）
+	OrderTypeContract = "contract"
+)
+
+// 账户价格常量
+const (
+	// PriceRuleAllServices 价格规则适用于全部服务
+	PriceRuleAllServices = "*"
+	// PriceSourceList 目录价（billing.prices）
+	PriceSourceList = "list"
+	// PriceSourceOverride 账户单价覆盖
+	PriceSourceOverride = "override"
+	// PriceSourceDiscount 账户折扣
+	PriceSourceDiscount = "discount"
+	// PriceSourceContract 合同价
+	PriceSourceContract = "contract"
+	// MaxDiscountPercent 折扣比例上限（百分比，100 表示免费）
+	MaxDiscountPercent = 100
+)
+
+// 合同状态常量
+const (
+	// ContractStatusActive 生效中（含未开始），到期后等待结算
+	ContractStatusActive = "active"
+	// ContractStatusSettled 已完成 true-up 结算
+	ContractStatusSettled = "settled"
+)
+
+// 组织常量
+const (
+	// OrgRoleOwner 所有者（创建者，每个组织一个，不能移除）
+	OrgRoleOwner = "owner"
+	// OrgRoleAdmin 管理员（可管理普通成员、为组织充值、查看组织用量）
+	OrgRoleAdmin = "admin"
+	// OrgRoleMember 普通成员（只能查看自己的用量）
+	OrgRoleMember = "member"
+	// OrgMemberStatusInvited 已邀请，等待用户接受（不由组织支付，没有组织权限）
+	OrgMemberStatusInvited = "invited"
+	// OrgMemberStatusActive 已加入
+	OrgMemberStatusActive = "active"
+	// MaxOrgNameLength 组织名称最大长度（字符）
+	MaxOrgNameLength = 64
 )
 
 // 支付来源常量（用于 payment-service）
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// accountSettingRepo 账户设置数据访问
+type accountSettingRepo struct {
+	data     *Data
+	cacheTTL time.Duration
+	log      *log.Helper
+}
+
+// NewAccountSettingRepo 创建账户设置 repo（返回 biz.AccountSettingRepo 接口）
+func NewAccountSettingRepo(data *Data, conf *biz.BillingConfig, logger log.Logger) biz.AccountSettingRepo {
+	return &accountSettingRepo{
+		data:     data,
+		cacheTTL: conf.QuotaPeriod.AccountCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func accountSettingKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyAccountSetting, userID)
+}
+
+// GetAccountSetting 获取账户设置，先读缓存，缓存缺失时读 DB（不存在时创建）并缓存
+func (r *accountSettingRepo) GetAccountSetting(ctx context.Context, userID string) (*biz.AccountSetting, error) {
+	key := accountSettingKey(userID)
+	cached, err := r.data.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var setting biz.AccountSetting
+		if err := json.Unmarshal(cached, &setting); err == nil {
+			setting.UserID = userID
+			return &setting, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	setting, err := r.loadAccountSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if b, err := json.Marshal(setting); err == nil {
+		if err := r.data.rdb.Set(ctx, key, b, r.cacheTTL).Err(); err != nil {
+			r.log.Warnf("failed to cache account setting: user_id=%s, error=%v", userID, err)
+		}
+	}
+	return setting, nil
+}
+
+// loadAccountSetting 从 DB 读取账户设置，不存在时以账户最早的记录时间为锚点创建
+// 并发创建时以先写入的为准
+func (r *accountSettingRepo) loadAccountSetting(ctx context.Context, userID string) (*biz.AccountSetting, error) {
+	db := r.data.db.WithContext(ctx)
+	var m model.AccountSetting
+	err := db.Where("uid = ?", userID).First(&m).Error
+	if err == nil {
+		return toAccountSetting(&m), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	anchor, err := r.earliestActivity(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	m = model.AccountSetting{UID: userID, CycleAnchor: anchor}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&m).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("uid = ?", userID).First(&m).Error; err != nil {
+		return nil, err
+	}
+	return toAccountSetting(&m), nil
+}
+
+// SetTimezone 设置账户计费时区并删除缓存，账户设置不存在时按最早的记录时间创建
+func (r *accountSettingRepo) SetTimezone(ctx context.Context, userID, timezone string) error {
+	anchor, err := r.earliestActivity(ctx, userID)
+	if err != nil {
+		return err
+	}
+	m := &model.AccountSetting{UID: userID, CycleAnchor: anchor, Timezone: timezone}
+	err = r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "uid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"timezone", "updated_at"}),
+	}).Create(m).Error
+	if err != nil {
+		return err
+	}
+	if err := r.data.rdb.Del(ctx, accountSettingKey(userID)).Err(); err != nil {
+		// 删除失败时旧时区最多在缓存时间内继续生效
+		r.log.Warnf("failed to invalidate account setting cache: user_id=%s, error=%v", userID, err)
+	}
+	return nil
+}
+
+// earliestActivity 账户最早的余额或免费额度记录创建时间，都没有时返回当前时间
+func (r *accountSettingRepo) earliestActivity(ctx context.Context, userID string) (time.Time, error) {
+	db := r.data.db.WithContext(ctx)
+	anchor := time.Now()
+
+	var balance model.UserBalance
+	err := db.Select("created_at").Where("uid = ?", userID).First(&balance).Error
+	if err == nil {
+		if balance.CreatedAt.Before(anchor) {
+			anchor = balance.CreatedAt
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, err
+	}
+
+	var quota model.FreeQuota
+	err = db.Select("created_at").Where("uid = ?", userID).Order("created_at ASC").First(&quota).Error
+	if err == nil {
+		if quota.CreatedAt.Before(anchor) {
+			anchor = quota.CreatedAt
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, err
+	}
+	return anchor, nil
+}
+
+func toAccountSetting(m *model.AccountSetting) *biz.AccountSetting {
+	return &biz.AccountSetting{
+		UserID:      m.UID,
+		CycleAnchor: m.CycleAnchor,
+		Timezone:    m.Timezone,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// analyticsRepo 平台级统计数据访问（跨用户查询日汇总表、充值订单与余额）
+type analyticsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAnalyticsRepo 创建平台级统计 repo（返回 biz.AnalyticsRepo 接口）
+func NewAnalyticsRepo(data *Data, logger log.Logger) biz.AnalyticsRepo {
+	return &analyticsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListDailyRevenue 按 UTC 日统计收入（日汇总表 bucket_start 即 UTC 零点）
+func (r *analyticsRepo) ListDailyRevenue(ctx context.Context, start, end time.Time, serviceName string, groupByService bool) ([]*biz.RevenueItem, error) {
+	group := "bucket_start"
+	if groupByService {
+		group = "bucket_start, service_name"
+	}
+	query := r.data.db.WithContext(ctx).Table(model.UsageDaily{}.TableName()).
+		Where("bucket_start >= ? AND bucket_start < ?", start, end)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+
+	var rows []struct {
+		BucketStart time.Time
+		Usage       usageSum `gorm:"embedded"` // gorm 不解析未导出的匿名字段，需以导出字段嵌入
+	}
+	if err := query.Select(joinColumns(group, rollupUsageColumns)).
+		Group(group).
+		Order(group).
+		Scan(&rows).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+
+	items := make([]*biz.RevenueItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &biz.RevenueItem{
+			PeriodStart: row.BucketStart,
+			ServiceName: row.Usage.ServiceName,
+			Revenue:     row.Usage.TotalCost,
+			TotalCount:  int64(row.Usage.TotalCount),
+			FreeCount:   int64(row.Usage.FreeCount),
+			PaidCount:   int64(row.Usage.PaidCount),
+		})
+	}
+	return items, nil
+}
+
+// SumRecharges 统计成功充值（以订单更新时间即支付成功时间为准），含合同预付，不含用量包订单
+// 时间桶以 UTC 对齐的 start 为基准用 TIMESTAMPDIFF 计算，与数据库会话时区无关
+func (r *analyticsRepo) SumRecharges(ctx context.Context, start, end time.Time, granularity string) ([]*biz.RechargeItem, error) {
+	columns := "SUM(amount) as amount, COUNT(*) as order_count, COUNT(DISTINCT uid) as user_count"
+	query := r.data.db.WithContext(ctx).Model(&model.RechargeOrder{}).
+		Where("status = ? AND order_type IN ? AND updated_at >= ? AND updated_at < ?",
+			model.RechargeStatusSuccess, balanceOrderTypes, start, end)
+	if granularity != "" {
+		unit := "DAY"
+		if granularity == constants.UsageGranularityMonth {
+			unit = "MONTH"
+		}
+		query = query.Select(fmt.Sprintf("DATE_ADD(?, INTERVAL TIMESTAMPDIFF(%s, ?, updated_at) %s) as period_start, %s", unit, unit, columns), start, start).
+			Group("period_start").
+			Order("period_start")
+	} else {
+		query = query.Select(columns)
+	}
+
+	var rows []struct {
+		PeriodStart time.Time
+		Amount      float64
+		OrderCount  int64
+		UserCount   int64
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+
+	items := make([]*biz.RechargeItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &biz.RechargeItem{
+			PeriodStart: row.PeriodStart,
+			Amount:      row.Amount,
+			OrderCount:  row.OrderCount,
+			UserCount:   row.UserCount,
+		})
+	}
+	return items, nil
+}
+
+// GetUserActivity 统计活跃用户、付费用户与新增付费用户
+// 新增付费用户：最早一条有余额扣费的日汇总落在 [start, end) 内的用户
+func (r *analyticsRepo) GetUserActivity(ctx context.Context, start, end time.Time, serviceName string) (*biz.UserActivity, error) {
+	daily := model.UsageDaily{}.TableName()
+
+	query := r.data.db.WithContext(ctx).Table(daily).
+		Where("bucket_start >= ? AND bucket_start < ?", start, end)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+	var activity biz.UserActivity
+	if err := query.Select("COUNT(DISTINCT uid) as active_users, " +
+		"COUNT(DISTINCT CASE WHEN paid_count > 0 THEN uid END) as paying_users").
+		Scan(&activity).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+
+	firstPaid := r.data.db.WithContext(ctx).Table(daily).
+		Select("uid").
+		Where("paid_count > 0 AND bucket_start < ?", end)
+	if serviceName != "" {
+		firstPaid = firstPaid.Where("service_name = ?", serviceName)
+	}
+	firstPaid = firstPaid.Group("uid").Having("MIN(bucket_start) >= ?", start)
+	if err := r.data.db.WithContext(ctx).Table("(?) as first_paid", firstPaid).
+		Count(&activity.NewPayingUsers).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+	return &activity, nil
+}
+
+// ListTopConsumers 按收入或调用次数降序返回前 limit 个用户
+func (r *analyticsRepo) ListTopConsumers(ctx context.Context, start, end time.Time, serviceName, orderBy string, limit int) ([]*biz.TopConsumer, error) {
+	order := "revenue DESC, uid"
+	if orderBy == constants.ReportOrderByCount {
+		order = "total_count DESC, uid"
+	}
+	query := r.data.db.WithContext(ctx).Table(model.UsageDaily{}.TableName()).
+		Where("bucket_start >= ? AND bucket_start < ?", start, end)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+
+	var rows []struct {
+		UID        string `gorm:"column:uid"`
+		Revenue    float64
+		TotalCount int64
+		PaidCount  int64
+	}
+	if err := query.Select("uid, SUM(total_cost) as revenue, SUM(total_count) as total_count, SUM(paid_count) as paid_count").
+		Group("uid").
+		Order(order).
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+
+	consumers := make([]*biz.TopConsumer, 0, len(rows))
+	for _, row := range rows {
+		consumers = append(consumers, &biz.TopConsumer{
+			UID:        row.UID,
+			Revenue:    row.Revenue,
+			TotalCount: row.TotalCount,
+			PaidCount:  row.PaidCount,
+		})
+	}
+	return consumers, nil
+}
+
+// GetBalanceLiability 统计全部用户余额（已落库部分）
+func (r *analyticsRepo) GetBalanceLiability(ctx context.Context) (*biz.BalanceLiability, error) {
+	liability := &biz.BalanceLiability{AsOf: time.Now()}
+	if err := r.data.db.WithContext(ctx).Model(&model.UserBalance{}).
+		Select("SUM(balance) as total_balance, COUNT(*) as accounts, " +
+			"SUM(CASE WHEN balance > 0 THEN 1 ELSE 0 END) as funded_accounts").
+		Scan(liability).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+	return liability, nil
+}
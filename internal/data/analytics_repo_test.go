@@ -0,0 +1,180 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// newTestAnalyticsRepo 预置日汇总：u1 在区间内首次付费，u2 在区间前已付费，u3 只用免费额度；11-08 的数据在区间外
+func newTestAnalyticsRepo(t *testing.T) *analyticsRepo {
+	t.Helper()
+	d, _ := newTestData(t)
+	newTestDB(t, d, &model.UsageDaily{}, &model.UserBalance{}, &model.RechargeOrder{})
+	day := func(d int) time.Time { return time.Date(2025, 11, d, 0, 0, 0, 0, time.UTC) }
+
+	daily := []model.UsageDaily{
+		{UID: "u1", ServiceName: testService, BucketStart: day(3), TotalCount: 5, FreeCount: 5},
+		{UID: "u1", ServiceName: testService, BucketStart: day(5), TotalCount: 4, FreeCount: 1, PaidCount: 3, TotalCost: 3},
+		{UID: "u1", ServiceName: testAtomicService, BucketStart: day(5), TotalCount: 2, PaidCount: 2, TotalCost: 4},
+		{UID: "u2", ServiceName: testService, BucketStart: day(2), TotalCount: 1, PaidCount: 1, TotalCost: 1},
+		{UID: "u2", ServiceName: testService, BucketStart: day(6), TotalCount: 20, FreeCount: 18, PaidCount: 2, TotalCost: 2},
+		{UID: "u3", ServiceName: testAtomicService, BucketStart: day(6), TotalCount: 1, FreeCount: 1},
+		{UID: "u1", ServiceName: testService, BucketStart: day(8), TotalCount: 100, PaidCount: 100, TotalCost: 100},
+	}
+	if err := d.db.Create(&daily).Error; err != nil {
+		t.Fatal(err)
+	}
+	balances := []model.UserBalance{
+		{UserBalanceID: "b1", UID: "u1", Balance: 10},
+		{UserBalanceID: "b2", UID: "u2", Balance: 0},
+		{UserBalanceID: "b3", UID: "u3", Balance: -2},
+	}
+	if err := d.db.Create(&balances).Error; err != nil {
+		t.Fatal(err)
+	}
+	orders := []model.RechargeOrder{
+		{OrderID: "o1", UID: "u1", PaymentID: "p1", Amount: 100, Status: model.RechargeStatusSuccess, OrderType: constants.OrderTypeRecharge, UpdatedAt: day(3)},
+		{OrderID: "o2", UID: "u2", PaymentID: "p2", Amount: 50, Status: model.RechargeStatusSuccess, OrderType: constants.OrderTypeContract, UpdatedAt: day(4)},
+		{OrderID: "o3", UID: "u1", PaymentID: "p3", Amount: 20, Status: model.RechargeStatusSuccess, OrderType: constants.OrderTypeRecharge, UpdatedAt: day(5)},
+		{OrderID: "o4", UID: "u3", PaymentID: "p4", Amount: 30, Status: model.RechargeStatusSuccess, OrderType: constants.OrderTypePackage, UpdatedAt: day(5)},
+		{OrderID: "o5", UID: "u3", PaymentID: "p5", Amount: 40, Status: model.RechargeStatusPending, OrderType: constants.OrderTypeRecharge, UpdatedAt: day(5)},
+		{OrderID: "o6", UID: "u3", PaymentID: "p6", Amount: 60, Status: model.RechargeStatusSuccess, OrderType: constants.OrderTypeRecharge, UpdatedAt: day(8)},
+	}
+	if err := d.db.Create(&orders).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &analyticsRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// TestListDailyRevenue 按日合计或按日、服务拆分，只统计区间内的日汇总
+func TestListDailyRevenue(t *testing.T) {
+	r := newTestAnalyticsRepo(t)
+	start, end := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 7, 0, 0, 0, 0, time.UTC)
+	item := func(d int, service string, total, free, paid int64, revenue float64) biz.RevenueItem {
+		return biz.RevenueItem{PeriodStart: time.Date(2025, 11, d, 0, 0, 0, 0, time.UTC), ServiceName: service, Revenue: revenue, TotalCount: total, FreeCount: free, PaidCount: paid}
+	}
+
+	cases := []struct {
+		name           string
+		serviceName    string
+		groupByService bool
+		want           []biz.RevenueItem
+	}{
+		{"by day", "", false, []biz.RevenueItem{
+			item(3, "", 5, 5, 0, 0), item(5, "", 6, 1, 5, 7), item(6, "", 21, 19, 2, 2),
+		}},
+		{"by day and service", "", true, []biz.RevenueItem{
+			item(3, testService, 5, 5, 0, 0), item(5, testAtomicService, 2, 0, 2, 4), item(5, testService, 4, 1, 3, 3),
+			item(6, testAtomicService, 1, 1, 0, 0), item(6, testService, 20, 18, 2, 2),
+		}},
+		{"single service", testAtomicService, false, []biz.RevenueItem{
+			item(5, "", 2, 0, 2, 4), item(6, "", 1, 1, 0, 0),
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := r.ListDailyRevenue(context.Background(), start, end, tc.serviceName, tc.groupByService)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != len(tc.want) {
+				t.Fatalf("items = %d, want %d", len(items), len(tc.want))
+			}
+			for i, got := range items {
+				if !got.PeriodStart.Equal(tc.want[i].PeriodStart) || got.ServiceName != tc.want[i].ServiceName || got.Revenue != tc.want[i].Revenue ||
+					got.TotalCount != tc.want[i].TotalCount || got.FreeCount != tc.want[i].FreeCount || got.PaidCount != tc.want[i].PaidCount {
+					t.Errorf("item %d = %+v, want %+v", i, *got, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestGetUserActivity 区间前已有余额扣费的用户不算新增付费用户；按服务过滤时只看该服务的汇总
+func TestGetUserActivity(t *testing.T) {
+	r := newTestAnalyticsRepo(t)
+	start, end := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 7, 0, 0, 0, 0, time.UTC)
+
+	for service, want := range map[string]biz.UserActivity{
+		"":                {ActiveUsers: 3, PayingUsers: 2, NewPayingUsers: 1},
+		testService:       {ActiveUsers: 2, PayingUsers: 2, NewPayingUsers: 1},
+		testAtomicService: {ActiveUsers: 2, PayingUsers: 1, NewPayingUsers: 1},
+	} {
+		got, err := r.GetUserActivity(context.Background(), start, end, service)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != want {
+			t.Errorf("service %q: activity = %+v, want %+v", service, *got, want)
+		}
+	}
+}
+
+// TestListTopConsumers 按收入或调用次数降序，截取前 limit 个用户
+func TestListTopConsumers(t *testing.T) {
+	r := newTestAnalyticsRepo(t)
+	start, end := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 7, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		orderBy string
+		limit   int
+		want    []biz.TopConsumer
+	}{
+		{constants.ReportOrderByRevenue, 10, []biz.TopConsumer{
+			{UID: "u1", Revenue: 7, TotalCount: 11, PaidCount: 5},
+			{UID: "u2", Revenue: 2, TotalCount: 20, PaidCount: 2},
+			{UID: "u3", TotalCount: 1},
+		}},
+		{constants.ReportOrderByCount, 2, []biz.TopConsumer{
+			{UID: "u2", Revenue: 2, TotalCount: 20, PaidCount: 2},
+			{UID: "u1", Revenue: 7, TotalCount: 11, PaidCount: 5},
+		}},
+	}
+	for _, tc := range cases {
+		consumers, err := r.ListTopConsumers(context.Background(), start, end, "", tc.orderBy, tc.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(consumers) != len(tc.want) {
+			t.Fatalf("%s: consumers = %d, want %d", tc.orderBy, len(consumers), len(tc.want))
+		}
+		for i, got := range consumers {
+			if *got != tc.want[i] {
+				t.Errorf("%s: consumer %d = %+v, want %+v", tc.orderBy, i, *got, tc.want[i])
+			}
+		}
+	}
+}
+
+// TestSumRechargesTotal 合计只统计区间内成功的余额充值与合同预付，充值用户去重
+func TestSumRechargesTotal(t *testing.T) {
+	r := newTestAnalyticsRepo(t)
+	start, end := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 11, 7, 0, 0, 0, 0, time.UTC)
+
+	items, err := r.SumRecharges(context.Background(), start, end, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].Amount != 170 || items[0].OrderCount != 3 || items[0].UserCount != 2 {
+		t.Errorf("recharges = %+v, want 170 from 3 orders by 2 users", items)
+	}
+}
+
+// TestGetBalanceLiability 余额合计包含欠费账户，有余额的账户只统计余额大于 0 的
+func TestGetBalanceLiability(t *testing.T) {
+	r := newTestAnalyticsRepo(t)
+	liability, err := r.GetBalanceLiability(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if liability.TotalBalance != 8 || liability.Accounts != 3 || liability.FundedAccounts != 1 {
+		t.Errorf("liability = %+v, want 8 over 3 accounts, 1 funded", *liability)
+	}
+}
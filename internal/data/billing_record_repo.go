@@ -2,12 +2,15 @@ package data
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"billing-service/internal/biz"
 	"billing-service/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // billingRecordRepo 消费记录相关数据访问
@@ -24,32 +27,102 @@ func NewBillingRecordRepo(data *Data, logger log.Logger) biz.BillingRecordRepo {
 	}
 }
 
-// CreateBillingRecord 创建消费记录
+// CreateBillingRecord 创建消费记录（同时累加用量汇总表）
 func (r *billingRecordRepo) CreateBillingRecord(ctx context.Context, record *biz.BillingRecord) error {
 	m := model.BillingRecord{
 		BillingRecordID: uuid.New().String(),
 		UID:             record.UID,
+		MemberUID:       record.MemberID,
 		ServiceName:     record.ServiceName,
 		Type:            record.Type,
 		Amount:          record.Amount,
 		Count:           record.Count,
+		CreatedAt:       time.Now(),
 	}
-	return r.data.db.WithContext(ctx).Create(&m).Error
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&m).Error; err != nil {
+			return err
+		}
+		return addUsageRollup(tx, &m)
+	})
 }
 
 // ListBillingRecords 获取消费流水列表
-func (r *billingRecordRepo) ListBillingRecords(ctx context.Context, userID string, page, pageSize int) ([]*biz.BillingRecord, int64, error) {
-	var models []model.BillingRecord
+// 按 (created_at, billing_record_id) 倒序，after 非空时使用 keyset 分页，避免大 OFFSET 扫描
+func (r *billingRecordRepo) ListBillingRecords(ctx context.Context, userID string, filter *biz.RecordFilter, after *biz.RecordCursor, offset, limit int, withTotal bool) ([]*biz.BillingRecord, int64, error) {
+	db := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).Where("billing_record.uid = ?", userID)
+	if filter.MemberID != "" {
+		db = db.Where("billing_record.member_uid = ?", filter.MemberID)
+	}
+	if filter.ServiceName != "" {
+		db = db.Where("billing_record.service_name = ?", filter.ServiceName)
+	}
+	if filter.Type != "" {
+		db = db.Where("billing_record.type = ?", filter.Type)
+	}
+	if !filter.StartTime.IsZero() {
+		db = db.Where("billing_record.created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		db = db.Where("billing_record.created_at < ?", filter.EndTime)
+	}
+	if filter.MinAmount > 0 {
+		db = db.Where("billing_record.amount >= ?", filter.MinAmount)
+	}
+	if filter.AppID != "" {
+		db = db.Joins("JOIN billing_record_metadata m ON m.billing_record_id = billing_record.billing_record_id").
+			Where("m.uid = ? AND m.app_id = ?", userID, filter.AppID)
+	}
+
 	var total int64
+	if withTotal {
+		if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
 
-	offset := (page - 1) * pageSize
-	db := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).Where("uid = ?", userID)
+	if after != nil {
+		db = db.Where("(billing_record.created_at < ? OR (billing_record.created_at = ? AND billing_record.billing_record_id < ?))",
+			after.CreatedAt, after.CreatedAt, after.ID)
+	} else if offset > 0 {
+		db = db.Offset(offset)
+	}
 
-	if err := db.Count(&total).Error; err != nil {
+	var models []model.BillingRecord
+	if err := db.Order("billing_record.created_at DESC, billing_record.billing_record_id DESC").Limit(limit).Find(&models).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := db.Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&models).Error; err != nil {
+	records, _, err := r.toBizRecords(ctx, models)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// ListBillingRecordsByRequestID 获取某个调用方请求产生的消费记录
+func (r *billingRecordRepo) ListBillingRecordsByRequestID(ctx context.Context, userID, requestID string) ([]*biz.BillingRecord, error) {
+	var models []model.BillingRecord
+	err := r.data.db.WithContext(ctx).
+		Joins("JOIN billing_record_metadata m ON m.billing_record_id = billing_record.billing_record_id").
+		Where("m.uid = ? AND m.request_id = ?", userID, requestID).
+		Order("billing_record.created_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	records, _, err := r.toBizRecords(ctx, models)
+	return records, err
+}
+
+// toBizRecords 转换为领域对象，并批量加载来源信息
+func (r *billingRecordRepo) toBizRecords(ctx context.Context, models []model.BillingRecord) ([]*biz.BillingRecord, int64, error) {
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.BillingRecordID)
+	}
+	metadata, err := r.loadMetadata(ctx, ids)
+	if err != nil {
 		return nil, 0, err
 	}
 
@@ -63,7 +136,65 @@ func (r *billingRecordRepo) ListBillingRecords(ctx context.Context, userID strin
 			Amount:      m.Amount,
 			Count:       m.Count,
 			CreatedAt:   m.CreatedAt,
+			Metadata:    metadata[m.BillingRecordID],
+			MemberID:    m.MemberUID,
 		})
 	}
-	return records, total, nil
+	return records, int64(len(records)), nil
+}
+
+// loadMetadata 批量加载消费记录的来源信息
+func (r *billingRecordRepo) loadMetadata(ctx context.Context, recordIDs []string) (map[string]*biz.DeductMetadata, error) {
+	result := make(map[string]*biz.DeductMetadata, len(recordIDs))
+	if len(recordIDs) == 0 {
+		return result, nil
+	}
+	var models []model.BillingRecordMetadata
+	if err := r.data.db.WithContext(ctx).Where("billing_record_id IN ?", recordIDs).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		meta := &biz.DeductMetadata{
+			RequestID: m.RequestID,
+			APIKeyID:  m.APIKeyID,
+			AppID:     m.AppID,
+			Operation: m.Operation,
+		}
+		if m.Labels != "" {
+			if err := json.Unmarshal([]byte(m.Labels), &meta.Labels); err != nil {
+				r.log.Warnf("Invalid record metadata labels: record_id=%s, error=%v", m.BillingRecordID, err)
+			}
+		}
+		result[m.BillingRecordID] = meta
+	}
+	return result, nil
+}
+
+// createRecordMetadata 在扣费事务中写入消费记录的来源信息，未携带来源信息时不写入
+func createRecordMetadata(tx *gorm.DB, meta *biz.DeductMetadata, userID string, createdAt time.Time, recordIDs ...string) error {
+	if meta.IsEmpty() || len(recordIDs) == 0 {
+		return nil
+	}
+	var labels string
+	if len(meta.Labels) > 0 {
+		b, err := json.Marshal(meta.Labels)
+		if err != nil {
+			return err
+		}
+		labels = string(b)
+	}
+	rows := make([]model.BillingRecordMetadata, 0, len(recordIDs))
+	for _, recordID := range recordIDs {
+		rows = append(rows, model.BillingRecordMetadata{
+			BillingRecordID: recordID,
+			UID:             userID,
+			RequestID:       meta.RequestID,
+			APIKeyID:        meta.APIKeyID,
+			AppID:           meta.AppID,
+			Operation:       meta.Operation,
+			Labels:          labels,
+			CreatedAt:       createdAt,
+		})
+	}
+	return tx.Create(&rows).Error
 }
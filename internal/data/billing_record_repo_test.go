@@ -0,0 +1,218 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// newTestBillingRecordRepo 预置消费记录：r01-r05 创建时间相同，r02、r04 有来源信息（app1）
+func newTestBillingRecordRepo(t *testing.T) *billingRecordRepo {
+	t.Helper()
+	d, _ := newTestData(t)
+	newTestDB(t, d, &model.BillingRecord{}, &model.BillingRecordMetadata{})
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	records := []model.BillingRecord{
+		{BillingRecordID: "r01", UID: testUserID, ServiceName: testService, Type: "balance", Amount: 1, Count: 1, CreatedAt: base},
+		{BillingRecordID: "r02", UID: testUserID, MemberUID: "m1", ServiceName: testService, Type: "balance", Amount: 2, Count: 1, CreatedAt: base},
+		{BillingRecordID: "r03", UID: testUserID, ServiceName: testService, Type: "free", Count: 1, CreatedAt: base},
+		{BillingRecordID: "r04", UID: testUserID, MemberUID: "m1", ServiceName: testService, Type: "balance", Amount: 0.5, Count: 1, CreatedAt: base},
+		{BillingRecordID: "r05", UID: testUserID, ServiceName: testAtomicService, Type: "balance", Amount: 3, Count: 1, CreatedAt: base},
+		{BillingRecordID: "r06", UID: testUserID, MemberUID: "m1", ServiceName: testService, Type: "balance", Amount: 4, Count: 1, CreatedAt: base.Add(-time.Second)},
+		{BillingRecordID: "r07", UID: testUserID, ServiceName: testService, Type: "balance", Amount: 5, Count: 1, CreatedAt: base.Add(time.Second)},
+		{BillingRecordID: "r08", UID: "u_other", ServiceName: testService, Type: "balance", Amount: 6, Count: 1, CreatedAt: base},
+	}
+	if err := d.db.Create(&records).Error; err != nil {
+		t.Fatal(err)
+	}
+	metadata := []model.BillingRecordMetadata{
+		{BillingRecordID: "r02", UID: testUserID, RequestID: "req-2", AppID: "app1", CreatedAt: base},
+		{BillingRecordID: "r04", UID: testUserID, RequestID: "req-4", AppID: "app1", CreatedAt: base},
+		{BillingRecordID: "r06", UID: testUserID, RequestID: "req-6", AppID: "app2", CreatedAt: base},
+	}
+	if err := d.db.Create(&metadata).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &billingRecordRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+}
+
+// listAllRecords 以 pageSize 按游标翻页读取全部记录
+func listAllRecords(t *testing.T, r *billingRecordRepo, filter *biz.RecordFilter, pageSize int) []string {
+	t.Helper()
+	var ids []string
+	var after *biz.RecordCursor
+	for page := 0; ; page++ {
+		if page > 10 {
+			t.Fatalf("pagination does not terminate: %v", ids)
+		}
+		records, _, err := r.ListBillingRecords(context.Background(), testUserID, filter, after, 0, pageSize, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, record := range records {
+			ids = append(ids, record.ID)
+		}
+		if len(records) < pageSize {
+			return ids
+		}
+		last := records[len(records)-1]
+		// 与 biz 层一致：经过编码、解码的游标
+		cursor, ok := biz.DecodeRecordCursor(biz.EncodeRecordCursor(&biz.RecordCursor{CreatedAt: last.CreatedAt, ID: last.ID}))
+		if !ok {
+			t.Fatal("cursor round trip failed")
+		}
+		after = cursor
+	}
+}
+
+// TestListBillingRecordsKeyset 创建时间相同的记录按 ID 倒序，游标翻页不重复、不遗漏，与过滤条件组合时结果一致
+func TestListBillingRecordsKeyset(t *testing.T) {
+	r := newTestBillingRecordRepo(t)
+	base := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		filter biz.RecordFilter
+		want   []string
+	}{
+		{"all", biz.RecordFilter{}, []string{"r07", "r05", "r04", "r03", "r02", "r01", "r06"}},
+		{"service and type", biz.RecordFilter{ServiceName: testService, Type: "balance"}, []string{"r07", "r04", "r02", "r01", "r06"}},
+		{"time range", biz.RecordFilter{StartTime: base, EndTime: base.Add(time.Second)}, []string{"r05", "r04", "r03", "r02", "r01"}},
+		{"min amount and member", biz.RecordFilter{MinAmount: 1, MemberID: "m1"}, []string{"r02", "r06"}},
+		{"app", biz.RecordFilter{AppID: "app1"}, []string{"r04", "r02"}},
+		{"combined", biz.RecordFilter{ServiceName: testService, Type: "balance", StartTime: base, EndTime: base.Add(time.Second), MinAmount: 0.5, AppID: "app1", MemberID: "m1"}, []string{"r04", "r02"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, pageSize := range []int{1, 2, 3, 100} {
+				if got := listAllRecords(t, r, &tc.filter, pageSize); !slices.Equal(got, tc.want) {
+					t.Errorf("page size %d: records = %v, want %v", pageSize, got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+// TestListBillingRecordsTotal 总数只按过滤条件统计，不受游标与页码影响；页码分页按 offset 跳过
+func TestListBillingRecordsTotal(t *testing.T) {
+	ctx := context.Background()
+	r := newTestBillingRecordRepo(t)
+	filter := &biz.RecordFilter{ServiceName: testService}
+
+	records, total, err := r.ListBillingRecords(ctx, testUserID, filter, nil, 2, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 6 || fmt.Sprint(recordIDs(records)) != "[r03 r02]" {
+		t.Errorf("offset page: records = %v, total = %d, want [r03 r02], 6", recordIDs(records), total)
+	}
+
+	after := &biz.RecordCursor{CreatedAt: records[1].CreatedAt, ID: records[1].ID}
+	records, total, err = r.ListBillingRecords(ctx, testUserID, filter, after, 0, 10, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 6 || fmt.Sprint(recordIDs(records)) != "[r01 r06]" {
+		t.Errorf("cursor page: records = %v, total = %d, want [r01 r06], 6", recordIDs(records), total)
+	}
+	if records[0].Metadata != nil || records[1].Metadata == nil || records[1].Metadata.RequestID != "req-6" || records[1].MemberID != "m1" {
+		t.Errorf("metadata = %+v, %+v", records[0].Metadata, records[1].Metadata)
+	}
+}
+
+func recordIDs(records []*biz.BillingRecord) []string {
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+	return ids
+}
+
+// TestRecordMetadataPropagation 来源信息经直接落库、MQ 事件编解码后落库两条路径写入附表，
+// 混合扣费的每条记录都关联同一来源信息，未携带来源信息时不写附表
+func TestRecordMetadataPropagation(t *testing.T) {
+	ctx := context.Background()
+	r, d := newTestBillingRepo(t)
+	records := &billingRecordRepo{data: d, log: r.log}
+	if err := d.db.Create(&model.UserBalance{UserBalanceID: "b1", UID: testUserID, Balance: 10}).Error; err != nil {
+		t.Fatal(err)
+	}
+	labels := map[string]string{"env": "prod", "model": "v2"}
+
+	// 直接落库：免费额度 + 余额两条记录
+	dbMeta := &biz.DeductMetadata{RequestID: "req-db", APIKeyID: "key-1", AppID: "app1", Operation: "/v1/verify", Labels: labels}
+	if err := d.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := createDeductRecords(tx, testUserID, "", testService, 1, 0, 2, 0.5, dbMeta); err != nil {
+			return err
+		}
+		_, err := createDeductRecords(tx, testUserID, "", testService, 1, 0, 0, 0, &biz.DeductMetadata{})
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// MQ 落库：事件按 protobuf 编解码
+	event := &biz.DeductEvent{RecordID: "rec-mq", UserID: testUserID, ServiceName: testService, Count: 1, Cost: 1, PaidCount: 1, BalanceDeducted: 1,
+		DeductTime: time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC), Period: testMonth,
+		Metadata: &biz.DeductMetadata{RequestID: "req-mq", Operation: "/v1/ocr", Labels: labels}}
+	body, contentType, err := encodeDeductEvent(event, constants.EventEncodingProtobuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeDeductEvents(body, contentType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.BatchDeductQuota(ctx, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	var metadataRows int64
+	d.db.Model(&model.BillingRecordMetadata{}).Count(&metadataRows)
+	if metadataRows != 3 {
+		t.Errorf("metadata rows = %d, want 3", metadataRows)
+	}
+
+	cases := []struct {
+		requestID string
+		want      biz.DeductMetadata
+		wantTypes []string
+	}{
+		{"req-db", *dbMeta, []string{"balance", "free"}},
+		{"req-mq", *event.Metadata, []string{"balance"}},
+	}
+	for _, tc := range cases {
+		got, err := records.ListBillingRecordsByRequestID(ctx, testUserID, tc.requestID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var types []string
+		for _, record := range got {
+			types = append(types, record.Type)
+			m := record.Metadata
+			if m == nil || m.RequestID != tc.want.RequestID || m.APIKeyID != tc.want.APIKeyID || m.AppID != tc.want.AppID ||
+				m.Operation != tc.want.Operation || !maps.Equal(m.Labels, tc.want.Labels) {
+				t.Errorf("%s %s metadata = %+v, want %+v", tc.requestID, record.Type, m, tc.want)
+			}
+		}
+		slices.Sort(types)
+		if !slices.Equal(types, tc.wantTypes) {
+			t.Errorf("%s record types = %v, want %v", tc.requestID, types, tc.wantTypes)
+		}
+	}
+
+	if got, _ := records.ListBillingRecordsByRequestID(ctx, "u_other", "req-db"); len(got) != 0 {
+		t.Errorf("other user's request = %d records, want 0", len(got))
+	}
+}
@@ -2,9 +2,10 @@ package data
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"billing-service/internal/biz"
@@ -13,7 +14,6 @@ import (
 	billingErrors "billing-service/internal/errors"
 	"billing-service/internal/metrics"
 
-	"github.com/apache/rocketmq-client-go/v2/primitive"
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redsync/redsync/v4"
@@ -22,53 +22,6 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-const deductScript = `
-local quotaKey = KEYS[1]
-local balanceKey = KEYS[2]
-local count = tonumber(ARGV[1])
-local totalCost = tonumber(ARGV[2])
-
--- Get remaining quota
-local quota = redis.call('GET', quotaKey)
-if not quota then
-    return {-1, 0, 0, 0} -- Quota Cache Missing
-end
-quota = tonumber(quota)
-
--- Case 1: Quota enough
-if quota >= count then
-    redis.call('DECRBY', quotaKey, count)
-    return {1, count, 0, 0} -- Success (Free)
-end
-
--- Case 2: Mixed (Quota + Balance)
-local balance = redis.call('GET', balanceKey)
-if not balance then
-    return {-2, 0, 0, 0} -- Balance Cache Missing
-end
-balance = tonumber(balance)
-
-local freeUsed = quota
-local paidCount = count - quota
-local unitPrice = 0
-if count > 0 then
-    unitPrice = totalCost / count
-end
-local needed = paidCount * unitPrice
-
-if balance >= needed then
-    redis.call('SET', quotaKey, 0)
-    redis.call('INCRBYFLOAT', balanceKey, -needed)
-    -- Formatting needed as string to avoid precision loss? Or just number.
-    -- Redis Lua usually returns logic numbers. Let's return as string for safety if float?
-    -- Actually Redis response for float is Bulk String if configured?
-    -- Let's return number and hope Go Redis handles it.
-    return {1, freeUsed, paidCount, tostring(needed)} -- Success (Mixed)
-end
-
-return {0, 0, 0, 0} -- Insufficient
-`
-
 // billingRepo 组合 repo，实现 biz.BillingRepo 接口
 type billingRepo struct {
 	data              *Data
@@ -121,8 +74,8 @@ func (r *billingRepo) Recharge(ctx context.Context, userID string, amount float6
 // ========== 免费额度相关 ==========
 
 // GetFreeQuota 获取免费额度
-func (r *billingRepo) GetFreeQuota(ctx context.Context, userID, serviceName, month string) (*biz.FreeQuota, error) {
-	return r.freeQuotaRepo.GetFreeQuota(ctx, userID, serviceName, month)
+func (r *billingRepo) GetFreeQuota(ctx context.Context, userID, serviceName, period string) (*biz.FreeQuota, error) {
+	return r.freeQuotaRepo.GetFreeQuota(ctx, userID, serviceName, period)
 }
 
 // CreateFreeQuota 创建免费额度
@@ -143,115 +96,260 @@ func (r *billingRepo) CreateBillingRecord(ctx context.Context, record *biz.Billi
 }
 
 // ListBillingRecords 获取消费流水列表
-func (r *billingRepo) ListBillingRecords(ctx context.Context, userID string, page, pageSize int) ([]*biz.BillingRecord, int64, error) {
-	return r.billingRecordRepo.ListBillingRecords(ctx, userID, page, pageSize)
+func (r *billingRepo) ListBillingRecords(ctx context.Context, userID string, filter *biz.RecordFilter, after *biz.RecordCursor, offset, limit int, withTotal bool) ([]*biz.BillingRecord, int64, error) {
+	return r.billingRecordRepo.ListBillingRecords(ctx, userID, filter, after, offset, limit, withTotal)
 }
 
 // ========== 事务操作 ==========
 
 // DeductQuota 核心扣费逻辑（事务）
-// 支持混合扣费：优先扣除免费额度，不足时扣除余额
+// 支持混合扣费：优先扣除免费额度，其次用量包（先到期先用），不足时扣除余额
 // 使用分布式锁防止高并发超扣
 // DeductQuota 核心扣费逻辑
 // 优化版：优先使用 Redis Lua + RocketMQ 异步处理
 // 降级版：如果 MQ 未启用，回退 to DB 事务
-func (r *billingRepo) DeductQuota(ctx context.Context, userID, serviceName string, count int, cost float64, month string) (string, error) {
+// 扣减余额时检查硬性预算与成员消费上限（payer.SpendLimit）
+func (r *billingRepo) DeductQuota(ctx context.Context, payer biz.Payer, serviceName string, count int, cost float64, period string, month biz.BillingPeriod, meta *biz.DeductMetadata) (string, error) {
 	// 如果 MQ 未启用，走降级方案（DB事务）
 	if r.data.mq == nil {
-		return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month)
+		return r.deductQuotaDB(ctx, payer, serviceName, count, cost, period, month, meta)
 	}
+	userID, memberID := payer.AccountID, payer.MemberID
 
-	// 1. 准备 Keys
-	quotaKey := fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyQuota, userID, serviceName, month)
-	balanceKey := fmt.Sprintf("%s%s", constants.RedisKeyBalance, userID)
-
-	// 2. 执行 Lua 脚本
+	// 1. 执行 Lua 脚本（扣减缓存并记录在途扣费）
 	// 重试机制：如果 Cache Missing，加载后重试
 	for i := 0; i < 2; i++ {
-		res, err := r.data.rdb.Eval(ctx, deductScript, []string{quotaKey, balanceKey}, count, cost).Result()
+		res, err := r.data.evalDeduct(ctx, payer, serviceName, period, month.Key, count, cost)
 		if err != nil {
 			r.log.Errorf("Lua script failed: %v", err)
-			return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month) // 出错降级
+			return r.deductQuotaDB(ctx, payer, serviceName, count, cost, period, month, meta) // 出错降级
 		}
 
-		// Parse result: []interface{}
-		// {code, freeUsed, paidCount, balanceDeducted}
-		vals, ok := res.([]interface{})
-		if !ok || len(vals) != 4 {
-			r.log.Errorf("Lua script returned invalid result: %v", res)
-			return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month)
-		}
-
-		code := int(vals[0].(int64))
-
-		if code == 1 {
-			freeUsed := int(vals[1].(int64))
-			paidCount := int(vals[2].(int64))
-			// balanceDeducted returned as string from Lua to preserve float? Or standard float?
-			// We used tostring(needed) in Lua.
-			balanceDeductedStr := vals[3].(string)
-			// Parse float
-			balanceDeducted := 0.0
-			if balanceDeductedStr != "0" {
-				fmt.Sscanf(balanceDeductedStr, "%f", &balanceDeducted)
-			}
-
+		if res.Code == 1 {
 			// 成功扣费
 			recordID := uuid.New().String()
 
-			// 3. 发送消息到 RocketMQ
+			// 2. 发送消息到 RocketMQ
 			event := &biz.DeductEvent{
 				RecordID:        recordID,
 				UserID:          userID,
+				MemberID:        memberID,
 				ServiceName:     serviceName,
 				Count:           count,
 				Cost:            cost,
-				FreeCount:       freeUsed,
-				PaidCount:       paidCount,
-				BalanceDeducted: balanceDeducted,
+				FreeCount:       res.FreeUsed,
+				PackageCount:    res.PackageUsed,
+				PaidCount:       res.PaidCount,
+				BalanceDeducted: res.BalanceDeducted,
 				DeductTime:      time.Now(),
-				Month:           month,
+				Period:          period,
+				Metadata:        meta,
 			}
-			msgBytes, _ := json.Marshal(event)
-			msg := primitive.NewMessage("billing_deduct_queue", msgBytes)
-
-			_, err := r.data.mq.SendSync(ctx, msg)
-			if err != nil {
-				r.log.Errorf("Send RocketMQ failed: %v", err)
-				// 降级回 DB 事务
-				return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month)
+			if err := r.data.publishDeductEvent(ctx, event); err != nil {
+				r.log.Errorf("Publish deduct event failed: %v", err)
+				if err := r.applyUnpublished(ctx, []*biz.DeductEvent{event}, []*deductResult{res}); err != nil {
+					return "", err
+				}
 			}
-
+			r.recordLiveUsage(liveUsage{
+				userID:      userID,
+				serviceName: serviceName,
+				freeCount:   res.FreeUsed,
+				paidCount:   res.PaidCount + res.PackageUsed,
+				cost:        res.BalanceDeducted,
+			})
 			return recordID, nil
-		} else if code == 0 {
+		} else if res.Code == 0 {
 			// 余额不足
 			return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
-		} else if code == -1 || code == -2 {
+		} else if res.Code == 2 {
+			// 超出硬性预算
+			return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)
+		} else if res.Code == 3 {
+			// 超出成员消费上限
+			return "", pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)
+		} else if res.Code < 0 {
 			// Cache Missing，加载数据
 			if i == 0 {
-				r.loadCache(ctx, userID, serviceName, month)
+				r.loadCache(ctx, payer, serviceName, period, month)
 				continue
 			}
 			// 还是缺失，降级
-			return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month)
+			return r.deductQuotaDB(ctx, payer, serviceName, count, cost, period, month, meta)
+		}
+	}
+
+	return r.deductQuotaDB(ctx, payer, serviceName, count, cost, period, month, meta)
+}
+
+// applyUnpublished 扣费事件投递失败时直接落库，deducts 为与 events 一一对应的 Lua 扣费结果
+// 保留 Lua 扣费（缓存已扣减、在途计数已累加），按消费端相同的方式落库并扣回在途计数；
+// 不能撤销 Lua 扣费后走 DB 事务：DB 事务提交与删除缓存之间，并发的 Lua 扣费仍按未扣减的缓存放行，造成超扣。
+// 投递超时但消息实际已送达时，消费端按记录ID跳过已落库的事件。
+// 落库失败时撤销 Lua 扣费并返回错误（依赖故障，调用方可重试）
+func (r *billingRepo) applyUnpublished(ctx context.Context, events []*biz.DeductEvent, deducts []*deductResult) error {
+	err := r.BatchDeductQuota(ctx, events)
+	if err == nil {
+		return nil
+	}
+	r.log.Errorf("Apply unpublished deduct events failed: %v", err)
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if err := r.data.revertDeduct(context.Background(), event.UserID, event.ServiceName, event.Period, deducts[i]); err != nil {
+			// 撤销失败时缓存与在途计数偏大，只会导致少放行，不会超扣
+			r.log.Errorf("Revert lua deduct failed: user_id=%s, service=%s, error=%v", event.UserID, event.ServiceName, err)
 		}
 	}
+	return err
+}
+
+// DeductQuotaBatch 批量扣费（流式扣费调用）
+// 整批 Lua 扣费通过一次 pipeline 完成，扣费事件按用户分组并发投递（同一用户内保持顺序）；
+// Lua 执行出错、缓存缺失的请求回退到单条扣费流程，投递失败的事件直接落库（见 applyUnpublished），落库也失败时回退到单条扣费流程
+func (r *billingRepo) DeductQuotaBatch(ctx context.Context, reqs []*biz.DeductRequest) []*biz.DeductResult {
+	results := make([]*biz.DeductResult, len(reqs))
+
+	// 如果 MQ 未启用，逐条走 DB 事务
+	if r.data.mq == nil {
+		for i, req := range reqs {
+			recordID, err := r.deductQuotaDB(ctx, req.Payer(), req.ServiceName, req.Count, req.Cost, req.Period, req.BudgetMonth, req.Metadata)
+			results[i] = &biz.DeductResult{RecordID: recordID, Err: err}
+		}
+		return results
+	}
+
+	// 1. pipeline 执行 Lua 脚本
+	deducts, errs := r.data.evalDeductBatch(ctx, reqs)
+
+	var fallback []int
+	pending := make(map[string][]int) // uid -> 待投递的请求下标（保持请求顺序）
+	events := make([]*biz.DeductEvent, len(reqs))
+	for i, req := range reqs {
+		if errs[i] != nil {
+			r.log.Errorf("Lua script failed: %v", errs[i])
+			fallback = append(fallback, i)
+			continue
+		}
+		switch deducts[i].Code {
+		case 1:
+			events[i] = &biz.DeductEvent{
+				RecordID:        uuid.New().String(),
+				UserID:          req.UserID,
+				MemberID:        req.MemberID,
+				ServiceName:     req.ServiceName,
+				Count:           req.Count,
+				Cost:            req.Cost,
+				FreeCount:       deducts[i].FreeUsed,
+				PackageCount:    deducts[i].PackageUsed,
+				PaidCount:       deducts[i].PaidCount,
+				BalanceDeducted: deducts[i].BalanceDeducted,
+				DeductTime:      time.Now(),
+				Period:          req.Period,
+				Metadata:        req.Metadata,
+			}
+			pending[req.UserID] = append(pending[req.UserID], i)
+		case 0:
+			// 余额不足
+			results[i] = &biz.DeductResult{Err: pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)}
+		case 2:
+			// 超出硬性预算
+			results[i] = &biz.DeductResult{Err: pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)}
+		case 3:
+			// 超出成员消费上限
+			results[i] = &biz.DeductResult{Err: pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)}
+		default:
+			// Cache Missing，由单条扣费流程加载缓存后重试
+			fallback = append(fallback, i)
+		}
+	}
+
+	// 2. 按用户并发投递扣费事件
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var usages []liveUsage
+	for _, idxs := range pending {
+		wg.Add(1)
+		go func(idxs []int) {
+			defer wg.Done()
+			userEvents := make([]*biz.DeductEvent, len(idxs))
+			for j, i := range idxs {
+				userEvents[j] = events[i]
+			}
+			sent, err := r.data.publishDeductEvents(ctx, userEvents)
+			if err != nil {
+				// 未投递的事件直接落库，落库失败时已撤销 Lua 扣费，回退到单条扣费流程
+				r.log.Errorf("Publish deduct event failed: %v", err)
+				unsent := make([]*deductResult, 0, len(idxs)-sent)
+				for _, i := range idxs[sent:] {
+					unsent = append(unsent, deducts[i])
+				}
+				if err := r.applyUnpublished(ctx, userEvents[sent:], unsent); err == nil {
+					sent = len(idxs)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, i := range idxs[:sent] {
+				results[i] = &biz.DeductResult{RecordID: events[i].RecordID}
+				usages = append(usages, liveUsage{
+					userID:      events[i].UserID,
+					serviceName: events[i].ServiceName,
+					freeCount:   events[i].FreeCount,
+					paidCount:   events[i].PaidCount + events[i].PackageCount,
+					cost:        events[i].BalanceDeducted,
+				})
+			}
+			fallback = append(fallback, idxs[sent:]...)
+		}(idxs)
+	}
+	wg.Wait()
+	r.recordLiveUsage(usages...)
+
+	// 3. 回退请求逐条处理
+	for _, i := range fallback {
+		req := reqs[i]
+		recordID, err := r.DeductQuota(ctx, req.Payer(), req.ServiceName, req.Count, req.Cost, req.Period, req.BudgetMonth, req.Metadata)
+		results[i] = &biz.DeductResult{RecordID: recordID, Err: err}
+	}
+	return results
+}
+
+// DecodeDeductEvents 解析扣费事件，同时兼容旧版 JSON 与 protobuf 信封编码
+func (r *billingRepo) DecodeDeductEvents(body []byte, contentType string) ([]*biz.DeductEvent, error) {
+	return decodeDeductEvents(body, contentType)
+}
 
-	return r.deductQuotaDB(ctx, userID, serviceName, count, cost, month)
+// DeadLetterDeductMessage 将无法落库的扣费消息转入死信 topic
+func (r *billingRepo) DeadLetterDeductMessage(ctx context.Context, body []byte, contentType, reason string) error {
+	return r.data.publishDeadLetter(ctx, body, contentType, reason)
 }
 
 // BatchDeductQuota 批量处理扣费记录（Consumer调用）
+// 消息可能重复投递（消费失败重试、重新平衡），已落库的事件直接跳过，不会重复扣减
 func (r *billingRepo) BatchDeductQuota(ctx context.Context, events []*biz.DeductEvent) error {
 	if len(events) == 0 {
 		return nil
 	}
 
-	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	var applied []*biz.DeductEvent
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		for _, event := range events {
+			ids := newDeductRecordIDs(event)
+			exists, err := deductRecordsExist(tx, ids.list())
+			if err != nil {
+				return err
+			}
+			if exists {
+				r.log.Warnf("Skip duplicate deduct event: record_id=%s, user_id=%s, service=%s", event.RecordID, event.UserID, event.ServiceName)
+				continue
+			}
+			var recordIDs []string
+
 			// 1. 更新 FreeQuota
 			if event.FreeCount > 0 {
 				if err := tx.Model(&model.FreeQuota{}).
-					Where("uid = ? AND service_name = ? AND reset_month = ?", event.UserID, event.ServiceName, event.Month).
+					Where("uid = ? AND service_name = ? AND period = ?", event.UserID, event.ServiceName, event.Period).
 					Update("used_quota", gorm.Expr("used_quota + ?", event.FreeCount)).Error; err != nil {
 					// 如果更新失败（例如记录不存在），可能需要处理。但理论上应该存在。
 					r.log.Errorf("Failed to update free quota in batch: %v", err)
@@ -260,28 +358,61 @@ func (r *billingRepo) BatchDeductQuota(ctx context.Context, events []*biz.Deduct
 
 				// 插入免费记录
 				freeRecord := model.BillingRecord{
-					BillingRecordID: event.RecordID, // 如果全是免费，用这个ID
+					BillingRecordID: ids.Free,
 					UID:             event.UserID,
+					MemberUID:       event.MemberID,
 					ServiceName:     event.ServiceName,
 					Type:            model.BillingTypeFree,
 					Amount:          0,
 					Count:           event.FreeCount,
 					CreatedAt:       event.DeductTime,
 				}
-				if event.PaidCount > 0 {
-					// 如果混合，免费记录要换个ID或加后缀？或者主ID给谁？
-					// 之前逻辑：freeRecord ID = recordID, balanceRecord ID = recordID (if pure) or new uuid.
-					// 让我们保持简单：都生成新UUID，或者复用。
-					// 之前逻辑：free used recordID, balance used recordID/newID.
-					// 这里我们生成 uuid 吧。
-					freeRecord.BillingRecordID = uuid.New().String()
-				}
 				if err := tx.Create(&freeRecord).Error; err != nil {
 					return err
 				}
+				if err := addUsageRollup(tx, &freeRecord); err != nil {
+					return err
+				}
+				recordIDs = append(recordIDs, freeRecord.BillingRecordID)
 			}
 
-			// 2. 更新 Balance
+			// 2. 扣减用量包：按扣费时有效的用量包先到期先用（扣费后才到期的用量包仍可扣减）
+			if event.PackageCount > 0 {
+				packages, err := lockActivePackages(tx, event.UserID, event.ServiceName, event.DeductTime)
+				if err != nil {
+					return err
+				}
+				consumed, err := consumePackages(tx, packages, 0, int64(event.PackageCount))
+				if err != nil {
+					r.log.Errorf("Failed to update packages in batch: %v", err)
+					return err
+				}
+				if consumed < int64(event.PackageCount) {
+					// Lua 扣减时缓存保证了剩余充足，不足说明缓存与 DB 不一致；流水仍按扣费结果记录，只告警
+					r.log.Errorf("Package units short in batch: record_id=%s, user_id=%s, service=%s, expected=%d, consumed=%d",
+						event.RecordID, event.UserID, event.ServiceName, event.PackageCount, consumed)
+				}
+
+				packageRecord := model.BillingRecord{
+					BillingRecordID: ids.Package,
+					UID:             event.UserID,
+					MemberUID:       event.MemberID,
+					ServiceName:     event.ServiceName,
+					Type:            model.BillingTypePackage,
+					Amount:          0,
+					Count:           event.PackageCount,
+					CreatedAt:       event.DeductTime,
+				}
+				if err := tx.Create(&packageRecord).Error; err != nil {
+					return err
+				}
+				if err := addUsageRollup(tx, &packageRecord); err != nil {
+					return err
+				}
+				recordIDs = append(recordIDs, packageRecord.BillingRecordID)
+			}
+
+			// 3. 更新 Balance
 			if event.BalanceDeducted > 0 {
 				if err := tx.Model(&model.UserBalance{}).
 					Where("uid = ?", event.UserID).
@@ -289,108 +420,161 @@ func (r *billingRepo) BatchDeductQuota(ctx context.Context, events []*biz.Deduct
 					r.log.Errorf("Failed to update balance in batch: %v", err)
 					return err
 				}
+				if err := drawDownContract(tx, event.UserID, event.BalanceDeducted, event.DeductTime); err != nil {
+					r.log.Errorf("Failed to draw down contract in batch: %v", err)
+					return err
+				}
 
 				// 插入余额记录
 				balanceRecord := model.BillingRecord{
-					BillingRecordID: event.RecordID, // 主ID给余额记录（如果混合）
+					BillingRecordID: ids.Balance,
 					UID:             event.UserID,
+					MemberUID:       event.MemberID,
 					ServiceName:     event.ServiceName,
 					Type:            model.BillingTypeBalance,
 					Amount:          event.BalanceDeducted,
 					Count:           event.PaidCount,
 					CreatedAt:       event.DeductTime,
 				}
-				// 如果纯扣余额，用 recordID. 如果混合，free用了uuid, balance 用 recordID. OK.
-				if event.FreeCount > 0 {
-					// 混合情况，recordID 给谁？
-					// repo 里面返回的是 recordID.
-					// 对于用户来说，拥有 recordID 的那条记录比较重要。
-					// 之前的逻辑：
-					// Free: recordID
-					// Balance: recordID
-					// Mixed: Free=recordID, Balance=UUID ? Or Free=UUID, Balance=recordID?
-					// Checked `deductQuotaDB`:
-					// recordID = new UUID
-					// Free: ID = recordID
-					// Balance: ID = recordID (if no free), else NewUUID.
-					// So Free always gets recordID? No.
-					// If free > 0: freeRecord.ID = recordID.
-					// If balance > 0: balanceRecord.ID = recordID (if free==0) else newUUID.
-					// So if Mixed, Free gets recordID, Balance gets NewUUID.
-
-					// Let's mimic this.
-					balanceRecord.BillingRecordID = uuid.New().String()
-				}
-
 				if err := tx.Create(&balanceRecord).Error; err != nil {
 					return err
 				}
+				if err := addUsageRollup(tx, &balanceRecord); err != nil {
+					return err
+				}
+				recordIDs = append(recordIDs, balanceRecord.BillingRecordID)
+			}
+
+			// 4. 记录来源信息
+			if err := createRecordMetadata(tx, event.Metadata, event.UserID, event.DeductTime, recordIDs...); err != nil {
+				return err
 			}
+			applied = append(applied, event)
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// 事件已落库，扣回在途计数（重复投递被跳过的事件此前已扣回）
+	// 失败时在途值偏大，缓存回填结果偏小（保守），直到在途 key 过期
+	if err := r.data.settlePending(ctx, applied); err != nil {
+		r.log.Errorf("Settle pending deductions failed: %v", err)
+	}
+	return nil
 }
 
-// loadCache 加载缓存 (同步)
-func (r *billingRepo) loadCache(ctx context.Context, userID, serviceName, month string) {
-	// 加载 Quota
-	q, err := r.freeQuotaRepo.GetFreeQuota(ctx, userID, serviceName, month)
-	if err == nil && q != nil {
-		remaining := q.TotalQuota - q.UsedQuota
-		quotaKey := fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyQuota, userID, serviceName, month)
-		// 同步写入 Redis
-		r.data.rdb.Set(ctx, quotaKey, remaining, 5*time.Minute)
-	}
-
-	// 加载 Balance
-	b, err := r.userBalanceRepo.GetUserBalance(ctx, userID)
-	if err == nil {
-		balance := 0.0
-		if b != nil {
-			balance = b.Balance
+// deductRecordNamespace 派生混合扣费记录ID的命名空间
+var deductRecordNamespace = uuid.MustParse("5b0c6f3e-8a41-4d2b-9f6e-2c7d1a9e4b10")
+
+// deductRecordIDs 扣费事件落库的免费额度/用量包/余额记录ID，未扣减的部分为空
+type deductRecordIDs struct {
+	Free    string
+	Package string
+	Balance string
+}
+
+// newDeductRecordIDs 计算扣费事件的记录ID：只有一部分时使用事件的 RecordID，
+// 混合扣费时由 RecordID 与记录类型派生，同一事件重复投递得到相同的记录ID
+func newDeductRecordIDs(event *biz.DeductEvent) deductRecordIDs {
+	parts := 0
+	if event.FreeCount > 0 {
+		parts++
+	}
+	if event.PackageCount > 0 {
+		parts++
+	}
+	if event.BalanceDeducted > 0 {
+		parts++
+	}
+	derive := func(recordType string) string {
+		if parts == 1 {
+			return event.RecordID
 		}
-		balanceKey := fmt.Sprintf("%s%s", constants.RedisKeyBalance, userID)
-		r.data.rdb.Set(ctx, balanceKey, balance, 5*time.Minute)
+		return uuid.NewSHA1(deductRecordNamespace, []byte(event.RecordID+":"+recordType)).String()
 	}
+
+	var ids deductRecordIDs
+	if event.FreeCount > 0 {
+		ids.Free = derive(model.BillingTypeFree)
+	}
+	if event.PackageCount > 0 {
+		ids.Package = derive(model.BillingTypePackage)
+	}
+	if event.BalanceDeducted > 0 {
+		ids.Balance = derive(model.BillingTypeBalance)
+	}
+	return ids
 }
 
-// deductQuotaDB DB 事务扣费（原 DeductQuota）
-func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName string, count int, cost float64, month string) (string, error) {
-	// 获取分布式锁（按用户+服务+月份）
-	lockKey := fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyDeductLock, userID, serviceName, month)
-	if r.sync != nil {
-		lockStartTime := time.Now()
-		mutex := r.sync.NewMutex(lockKey, redsync.WithExpiry(5*time.Second))
-		if err := mutex.Lock(); err != nil {
-			r.log.Errorf("Failed to acquire lock for deduct quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
-			if r.metrics != nil {
-				r.metrics.LockAcquireTotal.WithLabelValues(constants.OrderStatusFailed).Inc()
-				r.metrics.LockAcquireDuration.Observe(time.Since(lockStartTime).Seconds())
-			}
-			return "", pkgErrors.NewBizErrorWithLang(context.Background(), billingErrors.ErrCodeDeductLockFailed)
+// list 非空的记录ID
+func (ids deductRecordIDs) list() []string {
+	var list []string
+	for _, id := range []string{ids.Free, ids.Package, ids.Balance} {
+		if id != "" {
+			list = append(list, id)
 		}
-		if r.metrics != nil {
-			r.metrics.LockAcquireTotal.WithLabelValues(constants.OrderStatusSuccess).Inc()
-			r.metrics.LockAcquireDuration.Observe(time.Since(lockStartTime).Seconds())
-		}
-		defer func() {
-			if ok, err := mutex.Unlock(); !ok || err != nil {
-				r.log.Warnf("Failed to unlock for deduct quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
-			}
-		}()
 	}
+	return list
+}
+
+// deductRecordsExist 记录是否已落库（事件重复投递）
+// 并发落库同一事件时由主键冲突兜底，事务回滚后重试会跳过
+func deductRecordsExist(tx *gorm.DB, recordIDs []string) (bool, error) {
+	if len(recordIDs) == 0 {
+		return false, nil
+	}
+	var count int64
+	if err := tx.Model(&model.BillingRecord{}).Where("billing_record_id IN ?", recordIDs).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// loadCache 加载缓存 (同步)
+// 缓存值 = DB 值 - 在途扣费，避免把已在 Redis 扣减但尚未落库的部分重新计入
+func (r *billingRepo) loadCache(ctx context.Context, payer biz.Payer, serviceName, period string, month biz.BillingPeriod) {
+	if err := r.data.loadDeductCache(ctx, payer, serviceName, period, month); err != nil {
+		r.log.Warnf("Load deduct cache failed: user_id=%s, service=%s, error=%v", payer.AccountID, serviceName, err)
+	}
+}
+
+// deductQuotaDB DB 事务扣费（原 DeductQuota），month 为预算月份
+func (r *billingRepo) deductQuotaDB(ctx context.Context, payer biz.Payer, serviceName string, count int, cost float64, period string, month biz.BillingPeriod, meta *biz.DeductMetadata) (string, error) {
+	userID, memberID := payer.AccountID, payer.MemberID
+	// 获取分布式锁（按用户+服务+额度周期）
+	unlock, err := r.lockDeduct(userID, serviceName, period)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
 
 	var recordID string
 	var needUpdateQuotaCache bool
+	var needUpdatePackageCache bool
 	var needUpdateBalanceCache bool
-	var quotaRemaining int
-	var newBalance float64
+	var usage liveUsage
 
-	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	// 在途扣费（已在 Redis 扣减、尚未落库）同样占用额度和余额
+	pendingQuota, err := r.data.getPendingQuota(ctx, userID, serviceName, period)
+	if err != nil {
+		r.log.Warnf("Failed to get pending quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+	}
+	pendingBalance, err := r.data.getPendingBalance(ctx, userID)
+	if err != nil {
+		r.log.Warnf("Failed to get pending balance: user_id=%s, error=%v", userID, err)
+	}
+	pendingPackage, err := r.data.getPendingPackage(ctx, userID, serviceName)
+	if err != nil {
+		r.log.Warnf("Failed to get pending package: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+	}
+
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. 检查并扣减免费额度
 		var quota model.FreeQuota
 		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("uid = ? AND service_name = ? AND reset_month = ?", userID, serviceName, month).
+			Where("uid = ? AND service_name = ? AND period = ?", userID, serviceName, period).
 			First(&quota).Error
 
 		quotaNotFound := errors.Is(err, gorm.ErrRecordNotFound)
@@ -399,12 +583,13 @@ func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName str
 		}
 
 		var freeQuotaUsed int
+		var packageUsed int
 		var balanceDeducted float64
 		var balanceCount int
 
 		// 如果有免费额度记录且还有剩余额度
-		if !quotaNotFound && quota.TotalQuota > quota.UsedQuota {
-			remaining := quota.TotalQuota - quota.UsedQuota
+		if !quotaNotFound && quota.TotalQuota-quota.UsedQuota-int(pendingQuota.Amount()) > 0 {
+			remaining := quota.TotalQuota - quota.UsedQuota - int(pendingQuota.Amount())
 			if remaining >= count {
 				// 免费额度充足，全部使用免费额度
 				freeQuotaUsed = count
@@ -413,7 +598,6 @@ func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName str
 				}
 				// 记录需要更新的缓存信息
 				needUpdateQuotaCache = true
-				quotaRemaining = remaining - count
 			} else {
 				// 免费额度不足，先扣完免费额度，剩余部分扣余额
 				freeQuotaUsed = remaining
@@ -425,7 +609,6 @@ func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName str
 				}
 				// 记录需要更新的缓存信息
 				needUpdateQuotaCache = true
-				quotaRemaining = 0
 			}
 		} else {
 			// 没有免费额度或已用完，全部扣余额
@@ -433,7 +616,25 @@ func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName str
 			balanceDeducted = cost
 		}
 
-		// 2. 如果需要扣余额
+		// 2. 免费额度不足部分扣减用量包（先到期先用，在途扣减同样先到期先用，因此先跳过在途部分）
+		if balanceCount > 0 {
+			packages, err := lockActivePackages(tx, userID, serviceName, time.Now())
+			if err != nil {
+				return err
+			}
+			skip := int64(pendingPackage.Amount())
+			if available := packagesRemaining(packages) - skip; available > 0 {
+				packageUsed = int(min(available, int64(balanceCount)))
+				if _, err := consumePackages(tx, packages, skip, int64(packageUsed)); err != nil {
+					return err
+				}
+				balanceCount -= packageUsed
+				balanceDeducted = cost * float64(balanceCount) / float64(count) // 按比例计算余额扣费金额
+				needUpdatePackageCache = true
+			}
+		}
+
+		// 3. 如果需要扣余额
 		if balanceCount > 0 {
 			var balance model.UserBalance
 			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
@@ -454,89 +655,495 @@ func (r *billingRepo) deductQuotaDB(ctx context.Context, userID, serviceName str
 				return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeDatabaseError)
 			}
 
-			if balance.Balance < balanceDeducted {
+			if balance.Balance-pendingBalance.Amount() < balanceDeducted {
 				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
 			}
 
+			// 检查硬性预算（锁定预算行）
+			charges := map[string]float64{serviceName: balanceDeducted}
+			exceeded, err := exceedsBudgetDB(tx, userID, month, charges, pendingBalance.Amount(), true)
+			if err != nil {
+				return err
+			}
+			if exceeded {
+				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)
+			}
+
+			// 检查成员消费上限（同一组织的 DB 扣费按余额行串行）
+			exceeded, err = exceedsSpendLimitDB(tx, userID, memberID, month, payer.SpendLimit, balanceDeducted, pendingBalance.Amount())
+			if err != nil {
+				return err
+			}
+			if exceeded {
+				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)
+			}
+
 			if err := tx.Model(&balance).Update("balance", gorm.Expr("balance - ?", balanceDeducted)).Error; err != nil {
 				return err
 			}
 
 			// 记录需要更新的缓存信息
 			needUpdateBalanceCache = true
-			newBalance = balance.Balance - balanceDeducted
 		}
 
-		// 3. 记录流水
-		// 如果混合扣费，生成两条记录；否则生成一条记录
-		recordID = uuid.New().String()
+		// 4. 记录流水
+		recordID, err = createDeductRecords(tx, userID, memberID, serviceName, freeQuotaUsed, packageUsed, balanceCount, balanceDeducted, meta)
+		if err != nil {
+			return err
+		}
+		usage = liveUsage{
+			userID:      userID,
+			serviceName: serviceName,
+			freeCount:   freeQuotaUsed,
+			paidCount:   packageUsed + balanceCount,
+			cost:        balanceDeducted,
+		}
 
-		// 如果有使用免费额度，创建免费额度记录
-		if freeQuotaUsed > 0 {
-			freeRecord := model.BillingRecord{
-				BillingRecordID: recordID,
-				UID:             userID,
-				ServiceName:     serviceName,
-				Type:            model.BillingTypeFree,
-				Amount:          0,
-				Count:           freeQuotaUsed,
+		return nil
+	})
+
+	// 事务提交成功后，失效 Redis 缓存（使用独立的 context，设置较短的超时时间）
+	// 缓存中可能包含尚未落库的 Lua 扣费，不能直接用 DB 值覆盖，下次访问时按 DB 值 - 在途值回填
+	if err == nil {
+		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cacheCancel()
+
+		var keys []string
+		if needUpdateQuotaCache {
+			keys = append(keys, quotaCacheKey(userID, serviceName, period))
+		}
+		if needUpdatePackageCache {
+			keys = append(keys, packageCacheKey(userID, serviceName))
+		}
+		if needUpdateBalanceCache {
+			keys = append(keys, balanceCacheKey(userID))
+			keys = append(keys, budgetSpentKeys(userID, serviceName, month.Key)...)
+			if memberID != "" {
+				keys = append(keys, memberSpentKey(userID, memberID, month.Key))
+			}
+		}
+		if err := r.data.invalidateDeductCache(cacheCtx, keys...); err != nil {
+			// 缓存失效失败不影响主流程，只记录日志
+			r.log.Warnf("failed to invalidate deduct cache: %v", err)
+		}
+		r.recordLiveUsage(usage)
+	}
+
+	return recordID, err
+}
+
+// DeductQuotaAtomic 原子批量扣费：同一用户的多个服务项要么全部成功要么全部失败，返回的记录ID与 reqs 一一对应
+// MQ 模式下与单条扣费共用 Redis 缓存：所有服务项在一个 Lua 脚本中扣减，扣费事件合并为一条消息投递；
+// 不能直接走 DB 事务，否则提交与删除缓存之间的 Lua 扣费仍按旧缓存放行，造成超扣
+// 如果 MQ 未启用、Lua 出错或缓存回填后仍缺失，回退到 DB 事务；投递失败时事件直接落库（见 applyUnpublished）
+func (r *billingRepo) DeductQuotaAtomic(ctx context.Context, userID string, reqs []*biz.DeductRequest) ([]string, error) {
+	if r.data.mq == nil {
+		return r.deductAtomicDB(ctx, userID, reqs)
+	}
+
+	for i := 0; i < 2; i++ {
+		code, _, deducts, err := r.data.evalDeductAtomic(ctx, reqs)
+		if err != nil {
+			r.log.Errorf("Lua script failed: %v", err)
+			return r.deductAtomicDB(ctx, userID, reqs) // 出错降级
+		}
+
+		switch code {
+		case 1:
+			return r.publishDeductAtomic(ctx, userID, reqs, deducts)
+		case 0:
+			// 余额不足
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
+		case 2:
+			// 超出硬性预算
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)
+		case 3:
+			// 超出成员消费上限
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)
+		}
+
+		// Cache Missing：加载所有服务项的缓存后重试，还是缺失则降级
+		if i == 0 {
+			loaded := make(map[string]bool, len(reqs))
+			for _, req := range reqs {
+				if !loaded[req.ServiceName] {
+					loaded[req.ServiceName] = true
+					r.loadCache(ctx, req.Payer(), req.ServiceName, req.Period, req.BudgetMonth)
+				}
+			}
+		}
+	}
+	return r.deductAtomicDB(ctx, userID, reqs)
+}
+
+// publishDeductAtomic 投递原子批量扣费的事件，未投递的事件在一个事务中直接落库
+// 开启 rocketmq.batch_events 时合并为一条消息，否则逐条投递（同一 sharding key，消费端按序落库），兼容旧版消费端。
+// 逐条投递中途失败且直接落库也失败时，已投递的事件仍会落库，只撤销未投递部分的 Lua 扣费
+func (r *billingRepo) publishDeductAtomic(ctx context.Context, userID string, reqs []*biz.DeductRequest, deducts []*deductResult) ([]string, error) {
+	now := time.Now()
+	events := make([]*biz.DeductEvent, len(reqs))
+	for i, req := range reqs {
+		events[i] = &biz.DeductEvent{
+			RecordID:        uuid.New().String(),
+			UserID:          userID,
+			MemberID:        req.MemberID,
+			ServiceName:     req.ServiceName,
+			Count:           req.Count,
+			Cost:            req.Cost,
+			FreeCount:       deducts[i].FreeUsed,
+			PackageCount:    deducts[i].PackageUsed,
+			PaidCount:       deducts[i].PaidCount,
+			BalanceDeducted: deducts[i].BalanceDeducted,
+			DeductTime:      now,
+			Period:          req.Period,
+			Metadata:        req.Metadata,
+		}
+	}
+
+	sent := 0
+	var err error
+	if r.data.mqBatchEvents {
+		if err = r.data.publishDeductEventBatch(ctx, events); err == nil {
+			sent = len(events)
+		}
+	} else {
+		sent, err = r.data.publishDeductEvents(ctx, events)
+	}
+	if err != nil {
+		r.log.Errorf("Publish deduct event failed: %v", err)
+		if err := r.applyUnpublished(ctx, events[sent:], deducts[sent:]); err != nil {
+			return nil, err
+		}
+	}
+
+	recordIDs := make([]string, len(events))
+	usages := make([]liveUsage, len(events))
+	for i, event := range events {
+		recordIDs[i] = event.RecordID
+		usages[i] = liveUsage{
+			userID:      userID,
+			serviceName: event.ServiceName,
+			freeCount:   event.FreeCount,
+			paidCount:   event.PaidCount + event.PackageCount,
+			cost:        event.BalanceDeducted,
+		}
+	}
+	r.recordLiveUsage(usages...)
+	return recordIDs, nil
+}
+
+// deductAtomicDB 原子批量扣费的 DB 事务版本：同一用户的多个服务项在一个 DB 事务中扣费
+// 按服务名排序依次获取扣费锁、免费额度行锁和用量包行锁，与单条 DB 扣费使用相同的锁，避免死锁；
+// 在途扣费（已在 Redis 扣减、尚未落库）同样占用额度、用量包和余额
+func (r *billingRepo) deductAtomicDB(ctx context.Context, userID string, reqs []*biz.DeductRequest) ([]string, error) {
+	month := reqs[0].BudgetMonth // 同一用户的服务项预算月份相同
+	services := make([]string, 0, len(reqs))
+	periods := make(map[string]string, len(reqs)) // 服务名 -> 额度周期标识
+	for _, req := range reqs {
+		if !slices.Contains(services, req.ServiceName) {
+			services = append(services, req.ServiceName)
+			periods[req.ServiceName] = req.Period
+		}
+	}
+	slices.Sort(services)
+
+	for _, serviceName := range services {
+		unlock, err := r.lockDeduct(userID, serviceName, periods[serviceName])
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	pendingQuotas := make(map[string]pendingState, len(services))
+	for _, serviceName := range services {
+		pending, err := r.data.getPendingQuota(ctx, userID, serviceName, periods[serviceName])
+		if err != nil {
+			r.log.Warnf("Failed to get pending quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+		}
+		pendingQuotas[serviceName] = pending
+	}
+	pendingPackages := make(map[string]pendingState, len(services))
+	for _, serviceName := range services {
+		pending, err := r.data.getPendingPackage(ctx, userID, serviceName)
+		if err != nil {
+			r.log.Warnf("Failed to get pending package: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+		}
+		pendingPackages[serviceName] = pending
+	}
+	pendingBalance, err := r.data.getPendingBalance(ctx, userID)
+	if err != nil {
+		r.log.Warnf("Failed to get pending balance: user_id=%s, error=%v", userID, err)
+	}
+
+	recordIDs := make([]string, len(reqs))
+	freeUsed := make(map[string]int, len(services))
+	packageUsed := make(map[string]int, len(services))
+	var totalBalanceDeducted float64
+	var usages []liveUsage
+
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 按服务名顺序锁定免费额度并计算剩余
+		remaining := make(map[string]int, len(services))
+		for _, serviceName := range services {
+			var quota model.FreeQuota
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("uid = ? AND service_name = ? AND period = ?", userID, serviceName, periods[serviceName]).
+				First(&quota).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
 			}
-			if err := tx.Create(&freeRecord).Error; err != nil {
+			if err != nil {
 				return err
 			}
+			remaining[serviceName] = max(quota.TotalQuota-quota.UsedQuota-int(pendingQuotas[serviceName].Amount()), 0)
 		}
 
-		// 如果有扣余额，创建余额扣费记录
-		if balanceCount > 0 {
-			balanceRecordID := recordID
-			if freeQuotaUsed > 0 {
-				// 混合扣费时，余额记录使用新的ID
-				balanceRecordID = uuid.New().String()
-			}
-			balanceRecord := model.BillingRecord{
-				BillingRecordID: balanceRecordID,
-				UID:             userID,
-				ServiceName:     serviceName,
-				Type:            model.BillingTypeBalance,
-				Amount:          balanceDeducted,
-				Count:           balanceCount,
+		// 按服务名顺序锁定有效用量包，可用量扣除在途扣减
+		now := time.Now()
+		packages := make(map[string][]model.UserPackage, len(services))
+		packageAvailable := make(map[string]int64, len(services))
+		for _, serviceName := range services {
+			rows, err := lockActivePackages(tx, userID, serviceName, now)
+			if err != nil {
+				return err
 			}
-			if err := tx.Create(&balanceRecord).Error; err != nil {
+			packages[serviceName] = rows
+			packageAvailable[serviceName] = max(packagesRemaining(rows)-int64(pendingPackages[serviceName].Amount()), 0)
+		}
+
+		// 2. 按请求顺序分配免费额度和用量包，不足部分扣余额
+		type allocation struct {
+			freeCount       int
+			packageCount    int
+			balanceCount    int
+			balanceDeducted float64
+		}
+		allocations := make([]allocation, len(reqs))
+		for i, req := range reqs {
+			free := min(remaining[req.ServiceName], req.Count)
+			remaining[req.ServiceName] -= free
+			freeUsed[req.ServiceName] += free
+			units := int(min(packageAvailable[req.ServiceName], int64(req.Count-free)))
+			packageAvailable[req.ServiceName] -= int64(units)
+			packageUsed[req.ServiceName] += units
+
+			a := allocation{freeCount: free, packageCount: units, balanceCount: req.Count - free - units}
+			if a.balanceCount > 0 {
+				a.balanceDeducted = req.Cost * float64(a.balanceCount) / float64(req.Count) // 按比例计算余额扣费金额
+			}
+			totalBalanceDeducted += a.balanceDeducted
+			allocations[i] = a
+		}
+
+		// 3. 扣减余额（所有服务项合计）
+		if totalBalanceDeducted > 0 {
+			var balance model.UserBalance
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("uid = ?", userID).First(&balance).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
+				}
+				return pkgErrors.WrapErrorWithLang(ctx, err, pkgErrors.ErrCodeDatabaseError)
+			}
+			if balance.Balance-pendingBalance.Amount() < totalBalanceDeducted {
+				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
+			}
+
+			// 检查硬性预算（锁定预算行）
+			charges := make(map[string]float64, len(services))
+			for i, req := range reqs {
+				charges[req.ServiceName] += allocations[i].balanceDeducted
+			}
+			exceeded, err := exceedsBudgetDB(tx, userID, month, charges, pendingBalance.Amount(), true)
+			if err != nil {
 				return err
 			}
-			// 返回余额记录的ID（如果混合扣费）
-			if freeQuotaUsed > 0 {
-				recordID = balanceRecordID
+			if exceeded {
+				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)
+			}
+
+			// 检查成员消费上限（按成员合计）
+			memberCharges := make(map[string]float64)
+			memberLimits := make(map[string]float64)
+			for i, req := range reqs {
+				memberCharges[req.MemberID] += allocations[i].balanceDeducted
+				memberLimits[req.MemberID] = req.SpendLimit
+			}
+			for memberID, charge := range memberCharges {
+				exceeded, err := exceedsSpendLimitDB(tx, userID, memberID, month, memberLimits[memberID], charge, pendingBalance.Amount())
+				if err != nil {
+					return err
+				}
+				if exceeded {
+					return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)
+				}
+			}
+			if err := tx.Model(&balance).Update("balance", gorm.Expr("balance - ?", totalBalanceDeducted)).Error; err != nil {
+				return err
 			}
 		}
 
+		// 4. 扣减免费额度
+		for _, serviceName := range services {
+			if freeUsed[serviceName] == 0 {
+				continue
+			}
+			if err := tx.Model(&model.FreeQuota{}).
+				Where("uid = ? AND service_name = ? AND period = ?", userID, serviceName, periods[serviceName]).
+				Update("used_quota", gorm.Expr("used_quota + ?", freeUsed[serviceName])).Error; err != nil {
+				return err
+			}
+		}
+
+		// 5. 扣减用量包（跳过在途扣减占用的部分）
+		for _, serviceName := range services {
+			if packageUsed[serviceName] == 0 {
+				continue
+			}
+			skip := int64(pendingPackages[serviceName].Amount())
+			if _, err := consumePackages(tx, packages[serviceName], skip, int64(packageUsed[serviceName])); err != nil {
+				return err
+			}
+		}
+
+		// 6. 记录流水
+		for i, req := range reqs {
+			a := allocations[i]
+			recordID, err := createDeductRecords(tx, userID, req.MemberID, req.ServiceName, a.freeCount, a.packageCount, a.balanceCount, a.balanceDeducted, req.Metadata)
+			if err != nil {
+				return err
+			}
+			recordIDs[i] = recordID
+			usages = append(usages, liveUsage{
+				userID:      userID,
+				serviceName: req.ServiceName,
+				freeCount:   a.freeCount,
+				paidCount:   a.packageCount + a.balanceCount,
+				cost:        a.balanceDeducted,
+			})
+		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	// 事务提交成功后，更新 Redis 缓存（使用传入的 context，但设置较短的超时时间）
-	if err == nil {
-		// 使用独立的 context 更新缓存，避免阻塞主流程
-		// 设置较短的超时时间，如果缓存更新失败不影响主流程
-		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
-		defer cacheCancel()
+	// 事务提交成功后，失效 Redis 缓存，下次访问时按 DB 值 - 在途值回填
+	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cacheCancel()
 
-		if needUpdateQuotaCache {
-			quotaKey := fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyQuota, userID, serviceName, month)
-			if err := r.data.rdb.Set(cacheCtx, quotaKey, fmt.Sprintf("%d", quotaRemaining), 5*time.Minute).Err(); err != nil {
-				// 缓存更新失败不影响主流程，只记录日志
-				r.log.Warnf("failed to update quota cache: %v", err)
-			}
+	var keys []string
+	for _, serviceName := range services {
+		if freeUsed[serviceName] > 0 {
+			keys = append(keys, quotaCacheKey(userID, serviceName, periods[serviceName]))
 		}
-		if needUpdateBalanceCache {
-			balanceKey := fmt.Sprintf("%s%s", constants.RedisKeyBalance, userID)
-			if err := r.data.rdb.Set(cacheCtx, balanceKey, fmt.Sprintf("%.2f", newBalance), 5*time.Minute).Err(); err != nil {
-				// 缓存更新失败不影响主流程，只记录日志
-				r.log.Warnf("failed to update balance cache: %v", err)
+		if packageUsed[serviceName] > 0 {
+			keys = append(keys, packageCacheKey(userID, serviceName))
+		}
+	}
+	if totalBalanceDeducted > 0 {
+		keys = append(keys, balanceCacheKey(userID))
+		keys = append(keys, budgetSpentKey(userID, "", month.Key))
+		for _, serviceName := range services {
+			keys = append(keys, budgetSpentKey(userID, serviceName, month.Key))
+		}
+		for _, req := range reqs {
+			if req.MemberID != "" {
+				keys = append(keys, memberSpentKey(userID, req.MemberID, month.Key))
 			}
 		}
 	}
+	if err := r.data.invalidateDeductCache(cacheCtx, keys...); err != nil {
+		// 缓存失效失败不影响主流程，只记录日志
+		r.log.Warnf("failed to invalidate deduct cache: %v", err)
+	}
+	r.recordLiveUsage(usages...)
+	return recordIDs, nil
+}
+
+// recordLiveUsage 累加实时用量计数（扣费成功后调用），失败不影响扣费结果
+func (r *billingRepo) recordLiveUsage(usages ...liveUsage) {
+	if err := r.data.incrLiveUsage(usages...); err != nil {
+		r.log.Warnf("Failed to record live usage: %v", err)
+	}
+}
 
-	return recordID, err
+// lockDeduct 获取扣费分布式锁（按用户+服务+额度周期），返回解锁函数
+func (r *billingRepo) lockDeduct(userID, serviceName, period string) (func(), error) {
+	if r.sync == nil {
+		return func() {}, nil
+	}
+	lockKey := fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyDeductLock, userID, serviceName, period)
+	lockStartTime := time.Now()
+	mutex := r.sync.NewMutex(lockKey, redsync.WithExpiry(5*time.Second))
+	if err := mutex.Lock(); err != nil {
+		r.log.Errorf("Failed to acquire lock for deduct quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+		if r.metrics != nil {
+			r.metrics.LockAcquireTotal.WithLabelValues(constants.OrderStatusFailed).Inc()
+			r.metrics.LockAcquireDuration.Observe(time.Since(lockStartTime).Seconds())
+		}
+		return nil, pkgErrors.NewBizErrorWithLang(context.Background(), billingErrors.ErrCodeDeductLockFailed)
+	}
+	if r.metrics != nil {
+		r.metrics.LockAcquireTotal.WithLabelValues(constants.OrderStatusSuccess).Inc()
+		r.metrics.LockAcquireDuration.Observe(time.Since(lockStartTime).Seconds())
+	}
+	return func() {
+		if ok, err := mutex.Unlock(); !ok || err != nil {
+			r.log.Warnf("Failed to unlock for deduct quota: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+		}
+	}, nil
+}
+
+// createDeductRecords 记录一次扣费的流水，返回记录ID
+// 按免费额度、用量包、余额分别生成记录（只扣一种时生成一条），混合扣费时返回最后一条（余额 > 用量包 > 免费额度）的ID；
+// 携带来源信息时同时写入附表，同时累加用量汇总表；余额扣费同时累计合同消耗；memberID 为组织账户中实际使用的成员
+func createDeductRecords(tx *gorm.DB, userID, memberID, serviceName string, freeCount, packageCount, balanceCount int, balanceDeducted float64, meta *biz.DeductMetadata) (string, error) {
+	createdAt := time.Now()
+	parts := []struct {
+		recordType string
+		count      int
+		amount     float64
+	}{
+		{model.BillingTypeFree, freeCount, 0},
+		{model.BillingTypePackage, packageCount, 0},
+		{model.BillingTypeBalance, balanceCount, balanceDeducted},
+	}
+
+	var recordID string
+	var recordIDs []string
+	for _, part := range parts {
+		if part.count <= 0 {
+			continue
+		}
+		recordID = uuid.New().String()
+		record := model.BillingRecord{
+			BillingRecordID: recordID,
+			UID:             userID,
+			MemberUID:       memberID,
+			ServiceName:     serviceName,
+			Type:            part.recordType,
+			Amount:          part.amount,
+			Count:           part.count,
+			CreatedAt:       createdAt,
+		}
+		if err := tx.Create(&record).Error; err != nil {
+			return "", err
+		}
+		if err := addUsageRollup(tx, &record); err != nil {
+			return "", err
+		}
+		recordIDs = append(recordIDs, recordID)
+	}
+
+	if err := createRecordMetadata(tx, meta, userID, createdAt, recordIDs...); err != nil {
+		return "", err
+	}
+	if err := drawDownContract(tx, userID, balanceDeducted, createdAt); err != nil {
+		return "", err
+	}
+	return recordID, nil
 }
 
 // ========== 充值订单相关 ==========
@@ -573,17 +1180,12 @@ func (r *billingRepo) GetAllUserIDs(ctx context.Context) ([]string, error) {
 	return r.statsRepo.GetAllUserIDs(ctx)
 }
 
-// GetStatsToday 获取今日调用统计
-func (r *billingRepo) GetStatsToday(ctx context.Context, userID, serviceName string) (*biz.Stats, error) {
-	return r.statsRepo.GetStatsToday(ctx, userID, serviceName)
-}
-
-// GetStatsMonth 获取本月调用统计
-func (r *billingRepo) GetStatsMonth(ctx context.Context, userID, serviceName string) (*biz.Stats, error) {
-	return r.statsRepo.GetStatsMonth(ctx, userID, serviceName)
+// GetStats 获取 [start, end) 内的调用统计
+func (r *billingRepo) GetStats(ctx context.Context, userID, memberID, serviceName string, start, end time.Time) (*biz.Stats, error) {
+	return r.statsRepo.GetStats(ctx, userID, memberID, serviceName, start, end)
 }
 
-// GetStatsSummary 获取汇总统计（所有服务）
-func (r *billingRepo) GetStatsSummary(ctx context.Context, userID string) (*biz.StatsSummary, error) {
-	return r.statsRepo.GetStatsSummary(ctx, userID)
+// GetStatsSummary 获取 [start, end) 内的汇总统计（所有服务）
+func (r *billingRepo) GetStatsSummary(ctx context.Context, userID, memberID string, start, end time.Time) (*biz.StatsSummary, error) {
+	return r.statsRepo.GetStatsSummary(ctx, userID, memberID, start, end)
 }
@@ -0,0 +1,238 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// newTestDB 在 d 上挂载内存 SQLite，并按 model 建表
+// MySQL 的 enum 列在 SQLite 中按 text 建表
+func newTestDB(t *testing.T, d *Data, models ...interface{}) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每个连接都是独立的内存库，只保留一个连接
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	for _, m := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			t.Fatal(err)
+		}
+		for _, field := range stmt.Schema.Fields {
+			if strings.HasPrefix(string(field.DataType), "enum(") {
+				field.DataType = schema.String
+			}
+		}
+		if err := db.AutoMigrate(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d.db = db
+}
+
+// newTestBillingRepo 使用 miniredis + SQLite 的 billingRepo，只建扣费落库涉及的表
+func newTestBillingRepo(t *testing.T) (*billingRepo, *Data) {
+	t.Helper()
+	d, _ := newTestData(t)
+	newTestDB(t, d,
+		&model.BillingRecord{}, &model.BillingRecordMetadata{}, &model.FreeQuota{}, &model.UserBalance{},
+		&model.UserPackage{}, &model.Contract{}, &model.UsageHourly{}, &model.UsageDaily{}, &model.Budget{},
+	)
+	return &billingRepo{data: d, log: log.NewHelper(log.DefaultLogger)}, d
+}
+
+// TestBatchDeductQuotaRedelivery 同一批事件重复投递（消费失败重试、重新平衡）时只落库一次，在途计数只扣回一次
+func TestBatchDeductQuotaRedelivery(t *testing.T) {
+	ctx := context.Background()
+	r, d := newTestBillingRepo(t)
+	deductTime := time.Date(2025, 11, 5, 10, 30, 0, 0, time.UTC)
+	expiresAt := deductTime.AddDate(0, 1, 0)
+
+	seed := []interface{}{
+		&model.UserBalance{UserBalanceID: "b1", UID: testUserID, Balance: 100},
+		&model.FreeQuota{FreeQuotaID: "q1", UID: testUserID, ServiceName: testService, TotalQuota: 10, Period: testMonth},
+		&model.UserPackage{UserPackageID: "p1", UID: testUserID, ServiceName: testService, PackageID: "pkg", OrderID: "o1", TotalUnits: 50, ValidMonths: 1, ExpiresAt: &expiresAt},
+	}
+	for _, row := range seed {
+		if err := d.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	events := []*biz.DeductEvent{
+		// 纯余额扣费：记录ID沿用 RecordID
+		{RecordID: "rec-balance", UserID: testUserID, ServiceName: testService, Count: 2, Cost: 3, PaidCount: 2, BalanceDeducted: 3, DeductTime: deductTime, Period: testMonth},
+		// 混合扣费：记录ID由 RecordID 派生
+		{RecordID: "rec-mixed", UserID: testUserID, ServiceName: testService, Count: 9, Cost: 1.5, FreeCount: 4, PackageCount: 3, PaidCount: 2, BalanceDeducted: 1.5, DeductTime: deductTime, Period: testMonth,
+			Metadata: &biz.DeductMetadata{RequestID: "req-1"}},
+	}
+	// Lua 扣费时累加的在途计数
+	d.rdb.HSet(ctx, pendingQuotaKey(testUserID, testService, testMonth), pendingFieldIssued, 4)
+	d.rdb.HSet(ctx, pendingBalanceKey(testUserID), pendingFieldIssued, 4.5)
+	d.rdb.HSet(ctx, pendingPackageKey(testUserID, testService), pendingFieldIssued, 3)
+
+	for i := 0; i < 3; i++ {
+		if err := r.BatchDeductQuota(ctx, events); err != nil {
+			t.Fatalf("delivery %d: %v", i+1, err)
+		}
+	}
+
+	var balance model.UserBalance
+	d.db.First(&balance, "uid = ?", testUserID)
+	if balance.Balance != 95.5 {
+		t.Errorf("balance = %v, want 95.5", balance.Balance)
+	}
+	var quota model.FreeQuota
+	d.db.First(&quota, "uid = ?", testUserID)
+	if quota.UsedQuota != 4 {
+		t.Errorf("used quota = %d, want 4", quota.UsedQuota)
+	}
+	var pkg model.UserPackage
+	d.db.First(&pkg, "user_package_id = ?", "p1")
+	if pkg.UsedUnits != 3 {
+		t.Errorf("package used units = %d, want 3", pkg.UsedUnits)
+	}
+	var records, metadata int64
+	d.db.Model(&model.BillingRecord{}).Count(&records)
+	d.db.Model(&model.BillingRecordMetadata{}).Count(&metadata)
+	if records != 4 || metadata != 3 {
+		t.Errorf("records = %d, metadata = %d, want 4, 3", records, metadata)
+	}
+	var daily model.UsageDaily
+	d.db.First(&daily, "uid = ?", testUserID)
+	if daily.TotalCount != 11 || daily.TotalCost != 4.5 {
+		t.Errorf("daily rollup = %+v, want total_count 11, total_cost 4.5", daily)
+	}
+
+	for key, want := range map[string]float64{
+		pendingQuotaKey(testUserID, testService, testMonth): 4,
+		pendingBalanceKey(testUserID):                       4.5,
+		pendingPackageKey(testUserID, testService):          3,
+	} {
+		settled, err := d.rdb.HGet(ctx, key, pendingFieldSettled).Float64()
+		if err != nil || settled != want {
+			t.Errorf("%s settled = %v, err = %v, want %v", key, settled, err, want)
+		}
+	}
+}
+
+// TestBatchDeductQuotaSkipsAppliedInBatch 重试的批次中部分事件已落库时，只落库尚未处理的事件
+func TestBatchDeductQuotaSkipsAppliedInBatch(t *testing.T) {
+	ctx := context.Background()
+	r, d := newTestBillingRepo(t)
+	if err := d.db.Create(&model.UserBalance{UserBalanceID: "b1", UID: testUserID, Balance: 10}).Error; err != nil {
+		t.Fatal(err)
+	}
+	deductTime := time.Date(2025, 11, 5, 10, 30, 0, 0, time.UTC)
+	event := func(id string) *biz.DeductEvent {
+		return &biz.DeductEvent{RecordID: id, UserID: testUserID, ServiceName: testService, Count: 1, Cost: 1, PaidCount: 1, BalanceDeducted: 1, DeductTime: deductTime, Period: testMonth}
+	}
+
+	if err := r.BatchDeductQuota(ctx, []*biz.DeductEvent{event("e1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.BatchDeductQuota(ctx, []*biz.DeductEvent{event("e1"), event("e2"), event("e3")}); err != nil {
+		t.Fatal(err)
+	}
+
+	var balance model.UserBalance
+	d.db.First(&balance, "uid = ?", testUserID)
+	if balance.Balance != 7 {
+		t.Errorf("balance = %v, want 7", balance.Balance)
+	}
+	if settled, _ := d.rdb.HGet(ctx, pendingBalanceKey(testUserID), pendingFieldSettled).Float64(); settled != 0 {
+		// 在途计数不存在（已过期）时不累加 settled
+		t.Errorf("settled = %v, want 0 without pending key", settled)
+	}
+}
+
+func TestNewDeductRecordIDs(t *testing.T) {
+	pure := newDeductRecordIDs(&biz.DeductEvent{RecordID: "r1", FreeCount: 1})
+	if pure.Free != "r1" || pure.Package != "" || pure.Balance != "" {
+		t.Errorf("pure free ids = %+v", pure)
+	}
+
+	mixed := &biz.DeductEvent{RecordID: "r2", FreeCount: 1, PackageCount: 1, BalanceDeducted: 0.5}
+	ids := newDeductRecordIDs(mixed)
+	if len(ids.list()) != 3 || ids.Free == ids.Package || ids.Package == ids.Balance || ids.Free == "r2" {
+		t.Errorf("mixed ids = %+v", ids)
+	}
+	if again := newDeductRecordIDs(mixed); again != ids {
+		t.Errorf("mixed ids not stable: %+v vs %+v", again, ids)
+	}
+}
+
+// TestPublishDeductAtomicMessages 原子批量扣费默认逐条投递，开启 batch_events 后合并为一条消息；
+// 逐条投递中途失败时，未投递的事件直接落库
+func TestPublishDeductAtomicMessages(t *testing.T) {
+	ctx := context.Background()
+	reqs := []*biz.DeductRequest{
+		{UserID: testUserID, ServiceName: testService, Count: 1, Cost: 1, Period: testMonth},
+		{UserID: testUserID, ServiceName: testAtomicService, Count: 1, Cost: 2, Period: testMonth},
+	}
+	deducts := []*deductResult{
+		{Code: 1, PaidCount: 1, BalanceDeducted: 1},
+		{Code: 1, PaidCount: 1, BalanceDeducted: 2},
+	}
+
+	cases := []struct {
+		name         string
+		batchEvents  bool
+		failEvery    int64
+		wantMessages []int // 每条消息的事件数
+		wantRecords  int64 // 直接落库的记录数
+	}{
+		{name: "per event", failEvery: 100, wantMessages: []int{1, 1}},
+		{name: "batch", batchEvents: true, failEvery: 100, wantMessages: []int{2}},
+		{name: "per event publish failure", failEvery: 2, wantMessages: []int{1}, wantRecords: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, d := newTestBillingRepo(t)
+			if err := d.db.Create(&model.UserBalance{UserBalanceID: "b1", UID: testUserID, Balance: 10}).Error; err != nil {
+				t.Fatal(err)
+			}
+			producer := &failingProducer{failEvery: tc.failEvery, delivered: make(chan []*biz.DeductEvent, len(reqs))}
+			d.mq = producer
+			d.mqBatchEvents = tc.batchEvents
+
+			recordIDs, err := r.publishDeductAtomic(ctx, testUserID, reqs, deducts)
+			if err != nil || len(recordIDs) != len(reqs) {
+				t.Fatalf("record ids = %v, err = %v", recordIDs, err)
+			}
+			close(producer.delivered)
+			var messages []int
+			for events := range producer.delivered {
+				messages = append(messages, len(events))
+			}
+			if fmt.Sprint(messages) != fmt.Sprint(tc.wantMessages) {
+				t.Errorf("messages = %v, want %v", messages, tc.wantMessages)
+			}
+			var records int64
+			d.db.Model(&model.BillingRecord{}).Count(&records)
+			if records != tc.wantRecords {
+				t.Errorf("records = %d, want %d", records, tc.wantRecords)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package data
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"billing-service/internal/constants"
+	"billing-service/internal/metrics"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-redis/redis/v8"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// 熔断器：Redis / MySQL 故障时快速失败，避免请求堆积在超时上，由 biz 层按降级策略处理
+// 使用 Google SRE 自适应熔断（与 Kratos circuitbreaker 中间件一致），被本地拒绝的请求同样计入失败，
+// 保持拒绝比例，依赖恢复后按成功率逐步放量
+
+// redisBreakerHook go-redis 熔断 hook
+type redisBreakerHook struct {
+	breaker circuitbreaker.CircuitBreaker
+	metrics *metrics.BillingMetrics
+}
+
+func newRedisBreakerHook() *redisBreakerHook {
+	return &redisBreakerHook{
+		breaker: sre.NewBreaker(),
+		metrics: metrics.GetMetrics(),
+	}
+}
+
+func (h *redisBreakerHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return ctx, h.allow()
+}
+
+func (h *redisBreakerHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.mark(cmd.Err())
+	return nil
+}
+
+func (h *redisBreakerHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	return ctx, h.allow()
+}
+
+func (h *redisBreakerHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if err = cmd.Err(); isRedisFailure(err) || errors.Is(err, circuitbreaker.ErrNotAllowed) {
+			break
+		}
+	}
+	h.mark(err)
+	return nil
+}
+
+func (h *redisBreakerHook) allow() error {
+	if err := h.breaker.Allow(); err != nil {
+		if h.metrics != nil {
+			h.metrics.CircuitBreakerRejectedTotal.WithLabelValues(constants.DependencyRedis).Inc()
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *redisBreakerHook) mark(err error) {
+	if errors.Is(err, circuitbreaker.ErrNotAllowed) || isRedisFailure(err) {
+		h.breaker.MarkFailed()
+		return
+	}
+	h.breaker.MarkSuccess()
+}
+
+// isRedisFailure 判断是否为 Redis 不可用（网络错误、超时等）
+// redis.Nil 以及服务端返回的错误（如 WRONGTYPE、脚本错误）说明 Redis 可用，不计入失败
+func isRedisFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var redisErr redis.Error
+	return !errors.As(err, &redisErr)
+}
+
+// registerGormBreaker 为 GORM 注册熔断回调
+func registerGormBreaker(db *gorm.DB) error {
+	breaker := sre.NewBreaker()
+	m := metrics.GetMetrics()
+
+	before := func(tx *gorm.DB) {
+		if err := breaker.Allow(); err != nil {
+			if m != nil {
+				m.CircuitBreakerRejectedTotal.WithLabelValues(constants.DependencyMySQL).Inc()
+			}
+			// Statement 已有错误时 GORM 不再执行 SQL
+			_ = tx.AddError(err)
+		}
+	}
+	after := func(tx *gorm.DB) {
+		if errors.Is(tx.Error, circuitbreaker.ErrNotAllowed) || isMySQLFailure(tx.Error) {
+			breaker.MarkFailed()
+			return
+		}
+		breaker.MarkSuccess()
+	}
+
+	cb := db.Callback()
+	registers := []error{
+		cb.Create().Before("*").Register("breaker:before_create", before),
+		cb.Create().After("*").Register("breaker:after_create", after),
+		cb.Query().Before("*").Register("breaker:before_query", before),
+		cb.Query().After("*").Register("breaker:after_query", after),
+		cb.Update().Before("*").Register("breaker:before_update", before),
+		cb.Update().After("*").Register("breaker:after_update", after),
+		cb.Delete().Before("*").Register("breaker:before_delete", before),
+		cb.Delete().After("*").Register("breaker:after_delete", after),
+		cb.Row().Before("*").Register("breaker:before_row", before),
+		cb.Row().After("*").Register("breaker:after_row", after),
+		cb.Raw().Before("*").Register("breaker:before_raw", before),
+		cb.Raw().After("*").Register("breaker:after_raw", after),
+	}
+	return errors.Join(registers...)
+}
+
+// isMySQLFailure 判断是否为 MySQL 不可用（连接失败、连接断开、超时）
+// 记录不存在、唯一键冲突等 SQL 层错误说明 MySQL 可用，不计入失败
+func isMySQLFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netErr)
+}
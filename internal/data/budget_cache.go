@@ -0,0 +1,287 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 硬性消费预算
+//
+// 预算上限缓存在 budget:{uid} hash 中（loaded 字段表示已从 DB 加载，其余字段为各预算的上限），
+// 每个硬性预算对应一个本月消费缓存 budget:spent:{uid}:{field}:{month}，值为已落库消费 + 在途余额扣费。
+// 预算月份按账户时区的自然月，由 biz 层计算后传入。
+// Lua 扣费在扣减余额的同时检查并累加消费缓存；DB 事务扣费锁定预算行后按 DB 计算，提交后删除消费缓存。
+// 在途余额扣费按用户合计（不区分服务），服务预算回填时会把其他服务的在途扣费也计入，结果只会偏大（保守）。
+
+const (
+	budgetFieldLoaded        = "loaded"
+	budgetFieldAll           = "all"
+	budgetFieldServicePrefix = "service:"
+
+	// budgetEpsilon 比较金额时允许的浮点误差，避免恰好用满预算时被拒绝
+	budgetEpsilon = 1e-9
+)
+
+// budgetScriptFuncs 预算检查公共函数，拼接在使用它的 Lua 脚本之前
+const budgetScriptFuncs = `
+-- checkBudget 检查硬性预算：预算缓存缺失返回 -3，超出返回 2，否则返回 1
+-- 设置了上限的预算对应的消费缓存必须存在，缺失时同样返回 -3 由调用方回填
+local function checkBudget(budgetKey, serviceSpentKey, allSpentKey, serviceField, amount)
+    local limits = redis.call('HMGET', budgetKey, 'loaded', serviceField, 'all')
+    if not limits[1] then
+        return -3
+    end
+    local spentKeys = {serviceSpentKey, allSpentKey}
+    for i = 1, 2 do
+        local limit = limits[i + 1]
+        if limit then
+            local spent = redis.call('GET', spentKeys[i])
+            if not spent then
+                return -3
+            end
+            if tonumber(spent) + amount > tonumber(limit) + 1e-9 then
+                return 2
+            end
+        end
+    end
+    return 1
+end
+
+-- budgetRemaining 硬性预算剩余可消费金额（服务预算与全部服务预算中较小的）
+-- 返回 code, remaining：预算缓存缺失时 code 为 -3；没有硬性上限时 remaining 为 nil
+local function budgetRemaining(budgetKey, serviceSpentKey, allSpentKey, serviceField)
+    local limits = redis.call('HMGET', budgetKey, 'loaded', serviceField, 'all')
+    if not limits[1] then
+        return -3, nil
+    end
+    local remaining = nil
+    local spentKeys = {serviceSpentKey, allSpentKey}
+    for i = 1, 2 do
+        local limit = limits[i + 1]
+        if limit then
+            local spent = redis.call('GET', spentKeys[i])
+            if not spent then
+                return -3, nil
+            end
+            local left = tonumber(limit) - tonumber(spent)
+            if remaining == nil or left < remaining then
+                remaining = left
+            end
+        end
+    end
+    return 1, remaining
+end
+
+-- addBudgetSpent 累加消费缓存（未设置硬性上限的预算没有消费缓存，跳过）
+local function addBudgetSpent(serviceSpentKey, allSpentKey, amount)
+    for _, key in ipairs({serviceSpentKey, allSpentKey}) do
+        if redis.call('EXISTS', key) == 1 then
+            redis.call('INCRBYFLOAT', key, amount)
+        end
+    end
+end
+`
+
+// fillBudgetLimitsScript 写入预算上限缓存（已存在时不覆盖），ARGV[1] 为过期时间，其余为字段/值
+const fillBudgetLimitsScript = `
+if redis.call('EXISTS', KEYS[1]) == 1 then
+    return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+return 1
+`
+
+// budgetSnapshot 从 DB 读取的硬性预算与本月已落库消费
+type budgetSnapshot struct {
+	Month  string             // 预算月份
+	Limits map[string]float64 // 硬性预算上限，key 为预算缓存字段
+	Spent  map[string]float64 // 本月已落库的余额消费，key 同上（只包含本次扣费涉及的预算）
+}
+
+// budgetField 预算缓存字段，serviceName 为空表示全部服务合计
+func budgetField(serviceName string) string {
+	if serviceName == "" {
+		return budgetFieldAll
+	}
+	return budgetFieldServicePrefix + serviceName
+}
+
+func budgetCacheKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyBudget, userID)
+}
+
+func budgetSpentKey(userID, serviceName, month string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyBudgetSpent, userID, budgetField(serviceName), month)
+}
+
+// budgetSpentKeys 扣费涉及的消费缓存：服务预算、全部服务预算
+func budgetSpentKeys(userID, serviceName, month string) []string {
+	return []string{budgetSpentKey(userID, serviceName, month), budgetSpentKey(userID, "", month)}
+}
+
+// monthSpent 预算月份内已落库的余额消费，serviceName 为空表示全部服务
+func monthSpent(db *gorm.DB, userID, serviceName string, month biz.BillingPeriod) (float64, error) {
+	query := db.Model(&model.BillingRecord{}).
+		Where("uid = ? AND type = ? AND created_at >= ? AND created_at < ?", userID, model.BillingTypeBalance, month.Start, month.End)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+	var spent float64
+	if err := query.Select("COALESCE(SUM(amount), 0)").Scan(&spent).Error; err != nil {
+		return 0, err
+	}
+	return spent, nil
+}
+
+// loadBudgetSnapshot 读取用户的全部硬性预算，以及 serviceName 扣费涉及的预算的本月已落库消费
+func loadBudgetSnapshot(db *gorm.DB, userID, serviceName string, month biz.BillingPeriod) (*budgetSnapshot, error) {
+	var budgets []model.Budget
+	if err := db.Where("uid = ? AND hard_limit = ?", userID, true).Find(&budgets).Error; err != nil {
+		return nil, err
+	}
+	snapshot := &budgetSnapshot{Month: month.Key, Limits: make(map[string]float64), Spent: make(map[string]float64)}
+	for _, b := range budgets {
+		snapshot.Limits[budgetField(b.ServiceName)] = b.Amount
+		if b.ServiceName != "" && b.ServiceName != serviceName {
+			continue
+		}
+		spent, err := monthSpent(db, userID, b.ServiceName, month)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Spent[budgetField(b.ServiceName)] = spent
+	}
+	return snapshot, nil
+}
+
+// exceedsBudgetDB 按 DB 中的硬性预算判断按服务计费金额是否超出：本月已落库消费 + 在途余额扣费 + 本次金额
+// lock 为 true 时锁定相关预算行（DB 事务扣费使用），同一用户有预算时 DB 扣费按预算行串行
+func exceedsBudgetDB(db *gorm.DB, userID string, month biz.BillingPeriod, charges map[string]float64, pending float64, lock bool) (bool, error) {
+	var total float64
+	services := []string{""}
+	for serviceName, charge := range charges {
+		total += charge
+		services = append(services, serviceName)
+	}
+	if total <= 0 {
+		return false, nil
+	}
+
+	query := db.Where("uid = ? AND service_name IN ? AND hard_limit = ?", userID, services, true).Order("service_name")
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var budgets []model.Budget
+	if err := query.Find(&budgets).Error; err != nil {
+		return false, err
+	}
+	for _, b := range budgets {
+		charge := total
+		if b.ServiceName != "" {
+			charge = charges[b.ServiceName]
+		}
+		if charge <= 0 {
+			continue
+		}
+		spent, err := monthSpent(db, userID, b.ServiceName, month)
+		if err != nil {
+			return false, err
+		}
+		if spent+pending+charge > b.Amount+budgetEpsilon {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fillBudgetCache 回填预算上限缓存及 serviceName 扣费涉及的消费缓存，pending 为读取 DB 之前读取的在途余额扣费
+// snapshot 为 nil 表示没有硬性预算
+func (d *Data) fillBudgetCache(ctx context.Context, userID, serviceName string, snapshot *budgetSnapshot, pending pendingState) error {
+	if snapshot == nil {
+		snapshot = &budgetSnapshot{}
+	}
+	args := []interface{}{int(deductCacheTTL.Seconds()), budgetFieldLoaded, 1}
+	for field, limit := range snapshot.Limits {
+		args = append(args, field, strconv.FormatFloat(limit, 'f', -1, 64))
+	}
+	if err := d.rdb.Eval(ctx, fillBudgetLimitsScript, []string{budgetCacheKey(userID)}, args...).Err(); err != nil {
+		return err
+	}
+
+	for _, name := range []string{serviceName, ""} {
+		spent, ok := snapshot.Spent[budgetField(name)]
+		if !ok {
+			continue
+		}
+		keys := []string{budgetSpentKey(userID, name, snapshot.Month), pendingBalanceKey(userID)}
+		value := strconv.FormatFloat(spent+pending.Amount(), 'f', -1, 64)
+		if err := d.rdb.Eval(ctx, fillCacheScript, keys, value, pending.Issued, int(deductCacheTTL.Seconds())).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exceedsBudgetCache 按缓存判断按服务计费金额是否超出硬性预算，缓存缺失时 missing 为 true
+func (d *Data) exceedsBudgetCache(ctx context.Context, userID, month string, charges map[string]float64) (exceeded, missing bool, err error) {
+	var total float64
+	services := []string{""}
+	fields := []string{budgetFieldLoaded, budgetFieldAll}
+	for serviceName, charge := range charges {
+		total += charge
+		services = append(services, serviceName)
+		fields = append(fields, budgetField(serviceName))
+	}
+
+	limits, err := d.rdb.HMGet(ctx, budgetCacheKey(userID), fields...).Result()
+	if err != nil {
+		return false, false, err
+	}
+	if limits[0] == nil {
+		return false, true, nil
+	}
+	for i, serviceName := range services {
+		s, ok := limits[i+1].(string)
+		if !ok {
+			continue
+		}
+		charge := total
+		if serviceName != "" {
+			charge = charges[serviceName]
+		}
+		if charge <= 0 {
+			continue
+		}
+		limit, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return false, false, err
+		}
+		spent, err := d.rdb.Get(ctx, budgetSpentKey(userID, serviceName, month)).Float64()
+		if errors.Is(err, redis.Nil) {
+			return false, true, nil
+		}
+		if err != nil {
+			return false, false, err
+		}
+		if spent+charge > limit+budgetEpsilon {
+			return true, false, nil
+		}
+	}
+	return false, false, nil
+}
+
+// invalidateBudgetCache 预算变更后删除预算上限缓存及该预算本月（month）的消费缓存
+func (d *Data) invalidateBudgetCache(ctx context.Context, userID, serviceName, month string) error {
+	return d.rdb.Del(ctx, budgetCacheKey(userID), budgetSpentKey(userID, serviceName, month)).Err()
+}
@@ -0,0 +1,240 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// budgetRepo 消费预算数据访问
+type budgetRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewBudgetRepo 创建消费预算 repo（返回 biz.BudgetRepo 接口）
+func NewBudgetRepo(data *Data, logger log.Logger) biz.BudgetRepo {
+	return &budgetRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveBudget 创建或覆盖同一用户同一服务的预算
+// 金额、提醒阈值或硬性上限变化时重置本月提醒状态，保存后删除预算缓存
+func (r *budgetRepo) SaveBudget(ctx context.Context, budget *biz.Budget) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var m model.Budget
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ? AND service_name = ?", budget.UserID, budget.ServiceName).
+			Take(&m).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			m = model.Budget{
+				BudgetID:     uuid.New().String(),
+				UID:          budget.UserID,
+				ServiceName:  budget.ServiceName,
+				Amount:       budget.Amount,
+				AlertPercent: budget.AlertPercent,
+				HardLimit:    budget.HardLimit,
+			}
+			if err := tx.Create(&m).Error; err != nil {
+				return err
+			}
+			budget.BudgetID = m.BudgetID
+			budget.AlertedMonth = m.AlertedMonth
+			budget.UpdatedAt = m.UpdatedAt
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"amount":        budget.Amount,
+			"alert_percent": budget.AlertPercent,
+			"hard_limit":    budget.HardLimit,
+		}
+		if m.Amount != budget.Amount || m.AlertPercent != budget.AlertPercent || m.HardLimit != budget.HardLimit {
+			updates["alerted_month"] = ""
+			m.AlertedMonth = ""
+		}
+		if err := tx.Model(&m).Updates(updates).Error; err != nil {
+			return err
+		}
+		budget.BudgetID = m.BudgetID
+		budget.AlertedMonth = m.AlertedMonth
+		budget.UpdatedAt = m.UpdatedAt
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.invalidateCache(ctx, budget.UserID, budget.ServiceName, budget.Period)
+	return nil
+}
+
+// DeleteBudget 删除预算，不存在时返回 false
+func (r *budgetRepo) DeleteBudget(ctx context.Context, userID, serviceName, month string) (bool, error) {
+	res := r.data.db.WithContext(ctx).
+		Where("uid = ? AND service_name = ?", userID, serviceName).
+		Delete(&model.Budget{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidateCache(ctx, userID, serviceName, month)
+	return true, nil
+}
+
+// invalidateCache 删除预算缓存，失败时只记录日志（缓存最多在过期前按旧上限检查）
+func (r *budgetRepo) invalidateCache(ctx context.Context, userID, serviceName, month string) {
+	if err := r.data.invalidateBudgetCache(ctx, userID, serviceName, month); err != nil {
+		r.log.Warnf("failed to invalidate budget cache: user_id=%s, service=%s, error=%v", userID, serviceName, err)
+	}
+}
+
+// ListBudgets 获取用户的全部预算
+func (r *budgetRepo) ListBudgets(ctx context.Context, userID string) ([]*biz.Budget, error) {
+	var ms []model.Budget
+	if err := r.data.db.WithContext(ctx).Where("uid = ?", userID).Order("service_name").Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	budgets := make([]*biz.Budget, 0, len(ms))
+	for i := range ms {
+		budgets = append(budgets, toBizBudget(&ms[i]))
+	}
+	return budgets, nil
+}
+
+// GetMonthSpent 本月余额消费：已落库 + 在途扣费（在途扣费按用户合计，服务预算的结果可能略高）
+func (r *budgetRepo) GetMonthSpent(ctx context.Context, userID, serviceName string, month biz.BillingPeriod) (float64, error) {
+	pending, err := r.data.getPendingBalance(ctx, userID)
+	if err != nil {
+		r.log.Warnf("Failed to get pending balance: user_id=%s, error=%v", userID, err)
+	}
+	spent, err := monthSpent(r.data.db.WithContext(ctx), userID, serviceName, month)
+	if err != nil {
+		return 0, err
+	}
+	return spent + pending.Amount(), nil
+}
+
+// ExceedsHardLimit 按服务计费金额判断是否超出硬性预算，优先读缓存，缓存缺失时按 DB 计算
+func (r *budgetRepo) ExceedsHardLimit(ctx context.Context, userID string, month biz.BillingPeriod, charges map[string]float64) (bool, error) {
+	exceeded, missing, err := r.data.exceedsBudgetCache(ctx, userID, month.Key, charges)
+	if err == nil && !missing {
+		return exceeded, nil
+	}
+	if err != nil {
+		r.log.Warnf("Failed to check budget cache: user_id=%s, error=%v", userID, err)
+	}
+
+	pending, err := r.data.getPendingBalance(ctx, userID)
+	if err != nil {
+		r.log.Warnf("Failed to get pending balance: user_id=%s, error=%v", userID, err)
+	}
+	return exceedsBudgetDB(r.data.db.WithContext(ctx), userID, month, charges, pending.Amount(), false)
+}
+
+// ListAlertCandidates 获取设置了提醒阈值且提醒月份早于 month 的预算，按 BudgetID 分页
+func (r *budgetRepo) ListAlertCandidates(ctx context.Context, month, afterID string, limit int) ([]*biz.Budget, error) {
+	var ms []model.Budget
+	err := r.data.db.WithContext(ctx).
+		Where("alert_percent > 0 AND alerted_month < ? AND budget_id > ?", month, afterID).
+		Order("budget_id").Limit(limit).Find(&ms).Error
+	if err != nil {
+		return nil, err
+	}
+	budgets := make([]*biz.Budget, 0, len(ms))
+	for i := range ms {
+		budgets = append(budgets, toBizBudget(&ms[i]))
+	}
+	return budgets, nil
+}
+
+// MarkBudgetAlerted 通过带条件的 UPDATE 标记本月已提醒，多实例同时扫描时只有一个实例标记成功
+func (r *budgetRepo) MarkBudgetAlerted(ctx context.Context, budgetID, month string) (bool, error) {
+	res := r.data.db.WithContext(ctx).Model(&model.Budget{}).
+		Where("budget_id = ? AND alerted_month <> ?", budgetID, month).
+		UpdateColumn("alerted_month", month)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// UnmarkBudgetAlerted 撤销本月提醒标记
+func (r *budgetRepo) UnmarkBudgetAlerted(ctx context.Context, budgetID, month string) error {
+	return r.data.db.WithContext(ctx).Model(&model.Budget{}).
+		Where("budget_id = ? AND alerted_month = ?", budgetID, month).
+		UpdateColumn("alerted_month", "").Error
+}
+
+func toBizBudget(m *model.Budget) *biz.Budget {
+	return &biz.Budget{
+		BudgetID:     m.BudgetID,
+		UserID:       m.UID,
+		ServiceName:  m.ServiceName,
+		Amount:       m.Amount,
+		AlertPercent: m.AlertPercent,
+		HardLimit:    m.HardLimit,
+		AlertedMonth: m.AlertedMonth,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// budgetNotifier 通过 HTTP 回调通知服务发送预算提醒
+type budgetNotifier struct {
+	url    string
+	client *http.Client
+	log    *log.Helper
+}
+
+// NewBudgetNotifier 创建预算提醒通知（返回 biz.BudgetNotifier 接口），未配置通知地址时只记录日志
+func NewBudgetNotifier(conf *biz.BillingConfig, logger log.Logger) biz.BudgetNotifier {
+	return &budgetNotifier{
+		url:    conf.Budget.NotifyURL,
+		client: &http.Client{Timeout: conf.Budget.NotifyTimeout},
+		log:    log.NewHelper(logger),
+	}
+}
+
+// NotifyBudgetAlert 以 JSON POST 提醒内容，非 2xx 响应视为失败
+func (n *budgetNotifier) NotifyBudgetAlert(ctx context.Context, alert *biz.BudgetAlert) error {
+	if n.url == "" {
+		n.log.Infof("Budget alert (notify_url not configured): user_id=%s, service=%s, spent=%.4f, amount=%.4f",
+			alert.UserID, alert.ServiceName, alert.Spent, alert.Amount)
+		return nil
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("budget notify failed: status=%d", resp.StatusCode)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"billing-service/internal/conf"
+	"billing-service/internal/constants"
 	"context"
 	"time"
 
@@ -28,20 +29,46 @@ var ProviderSet = wire.NewSet(
 	NewRechargeOrderRepo,
 	NewStatsRepo,
 	NewBillingRepo,
+	NewLeaseRepo,
+	NewExportRepo,
+	NewAnalyticsRepo,
+	NewBudgetRepo,
+	NewBudgetNotifier,
+	NewRateLimitRepo,
+	NewAccountSettingRepo,
+	NewPackageRepo,
+	NewPricingRepo,
+	NewOrgRepo,
+	NewExportStorage,
+	NewDeferredChargeJournal,
 	NewPaymentServiceClient,
 )
 
 // Data .
 type Data struct {
-	db  *gorm.DB
-	rdb *redis.Client
-	mq  rocketmq.Producer
+	db                *gorm.DB
+	rdb               *redis.Client
+	mq                rocketmq.Producer
+	mqTopic           string // 扣费事件 topic
+	mqDeadLetterTopic string // 扣费事件死信 topic
+	mqEncoding        string // 扣费事件编码：json / protobuf
+	mqBatchEvents     bool   // 原子批量扣费的事件合并为一条消息
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	log := log.NewHelper(logger)
 
+	// 扣费事件编码在启动时校验，避免运行时每次投递都失败
+	mqEncoding := constants.EventEncodingJSON
+	if c.Rocketmq != nil && c.Rocketmq.Enabled {
+		encoding, err := parseEventEncoding(c.Rocketmq.EventEncoding)
+		if err != nil {
+			return nil, nil, err
+		}
+		mqEncoding = encoding
+	}
+
 	// MySQL
 	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{})
 	if err != nil {
@@ -75,20 +102,40 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		DialTimeout:  5 * time.Second, // 连接超时时间
 	})
 
+	// 熔断：Redis / MySQL 故障时快速失败，由 biz 层按降级策略处理
+	rdb.AddHook(newRedisBreakerHook())
+	if err := registerGormBreaker(db); err != nil {
+		return nil, nil, err
+	}
+
 	// Ping Redis to check connection
+	// Redis 不可用时仍然启动，请求按服务降级策略处理，Redis 恢复后自动重连
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if _, err := rdb.Ping(ctx).Result(); err != nil {
-		return nil, nil, err
+		log.Warnf("redis unavailable at startup, serving with degradation policies: %v", err)
 	}
 
 	// RocketMQ Producer
 	var mq rocketmq.Producer
+	mqTopic := constants.MQTopicDeduct
+	var mqDeadLetterTopic string
+	var mqBatchEvents bool
 	if c.Rocketmq != nil && c.Rocketmq.Enabled {
+		if c.Rocketmq.Topic != "" {
+			mqTopic = c.Rocketmq.Topic
+		}
+		mqDeadLetterTopic = mqTopic + constants.MQDeadLetterSuffix
+		if c.Rocketmq.DeadLetterTopic != "" {
+			mqDeadLetterTopic = c.Rocketmq.DeadLetterTopic
+		}
+		mqBatchEvents = c.Rocketmq.BatchEvents
 		p, err := rocketmq.NewProducer(
 			producer.WithNsResolver(primitive.NewPassthroughResolver(c.Rocketmq.NameServers)),
 			producer.WithRetry(int(c.Rocketmq.RetryTimes)),
 			producer.WithGroupName(c.Rocketmq.GroupName),
+			// 按 sharding key（uid）哈希选择队列，保证同一用户的扣费事件进入同一队列、按序消费
+			producer.WithQueueSelector(producer.NewHashQueueSelector()),
 		)
 		if err != nil {
 			return nil, nil, err
@@ -100,9 +147,13 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	}
 
 	d := &Data{
-		db:  db,
-		rdb: rdb,
-		mq:  mq,
+		db:                db,
+		rdb:               rdb,
+		mq:                mq,
+		mqTopic:           mqTopic,
+		mqDeadLetterTopic: mqDeadLetterTopic,
+		mqEncoding:        mqEncoding,
+		mqBatchEvents:     mqBatchEvents,
 	}
 
 	cleanup := func() {
@@ -0,0 +1,670 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// 在途扣费（read-your-writes）
+//
+// MQ 模式下 Lua 脚本先在 Redis 中扣减余额/额度缓存，扣费事件经 RocketMQ 异步落库，
+// 落库之前 DB 中仍是扣费前的旧值。如果缓存在此期间过期或被删除，直接用 DB 值回填会把
+// "已扣但未落库"的部分还给用户，造成超扣。
+//
+// 因此每个余额/额度/用量包缓存都对应一个在途计数（hash）：
+//   - issued:  Lua 扣费时累加，只增不减
+//   - settled: 消费端事务提交（或扣费撤销）后累加
+//
+// 在途值 = issued - settled，回填缓存时使用：
+//
+//	缓存值 = DB 值 - 在途值
+//
+// 回填时先读在途计数再读 DB：两次读取之间落库的事件会被重复扣除，结果只会偏小（保守），不会超扣。
+// 写入缓存前再比较 issued：读取之后如果有新的 Lua 扣费（说明期间缓存曾被其他请求回填并扣减），
+// 计算结果已过期，放弃回填。issued 单调递增，不会出现 ABA。
+
+const (
+	// deductCacheTTL 余额/额度缓存过期时间
+	deductCacheTTL = 5 * time.Minute
+	// pendingTTL 在途计数过期时间，远大于正常的消费堆积时长，仅用于兜底清理
+	pendingTTL = 24 * time.Hour
+)
+
+const (
+	pendingFieldIssued  = "issued"
+	pendingFieldSettled = "settled"
+)
+
+// deductScriptFuncs 扣费公共函数，拼接在使用它的 Lua 脚本之前，k 为 deductKeys 返回的 key
+// deduct 扣减缓存并累加在途计数，扣减顺序：免费额度 → 用量包 → 余额，需要扣减余额时先检查硬性预算与成员消费上限
+// 用量包缓存为该服务所有有效用量包的剩余合计，具体扣减哪个用量包由落库时按先到期先用分配
+// 返回 {code, freeUsed, packageUsed, paidCount, balanceDeducted}
+// code: 1 成功, 0 余额不足, 2 超出预算, 3 超出成员消费上限,
+// -1 额度缓存缺失, -2 余额缓存缺失, -3 预算缓存缺失, -4 用量包缓存缺失, -5 成员消费缓存缺失
+// revertDeduct 撤销一次扣费：回补缓存并扣回在途计数，缓存不存在时不回补，下次回填会按 DB 值 - 在途值重新计算
+const deductScriptFuncs = budgetScriptFuncs + spendLimitScriptFuncs + `
+local function deduct(k, count, totalCost, pendingTTL, serviceField, spendLimit)
+    local quotaKey = k[1]
+    local balanceKey = k[2]
+    local pendingQuotaKey = k[3]
+    local pendingBalanceKey = k[4]
+    local budgetKey = k[5]
+    local serviceSpentKey = k[6]
+    local allSpentKey = k[7]
+    local packageKey = k[8]
+    local pendingPackageKey = k[9]
+    local memberSpentKey = k[10]
+
+    -- Get remaining quota
+    local quota = redis.call('GET', quotaKey)
+    if not quota then
+        return {-1, 0, 0, 0, 0} -- Quota Cache Missing
+    end
+    quota = tonumber(quota)
+
+    -- Case 1: Quota enough
+    if quota >= count then
+        redis.call('DECRBY', quotaKey, count)
+        redis.call('HINCRBY', pendingQuotaKey, 'issued', count)
+        redis.call('EXPIRE', pendingQuotaKey, pendingTTL)
+        return {1, count, 0, 0, 0} -- Success (Free)
+    end
+
+    -- Case 2: Quota + Package
+    local package = redis.call('GET', packageKey)
+    if not package then
+        return {-4, 0, 0, 0, 0} -- Package Cache Missing
+    end
+    package = tonumber(package)
+
+    local freeUsed = quota
+    local packageUsed = math.min(package, count - quota)
+    local paidCount = count - quota - packageUsed
+
+    local function applyFreeAndPackage()
+        if freeUsed > 0 then
+            redis.call('DECRBY', quotaKey, freeUsed)
+            redis.call('HINCRBY', pendingQuotaKey, 'issued', freeUsed)
+            redis.call('EXPIRE', pendingQuotaKey, pendingTTL)
+        end
+        if packageUsed > 0 then
+            redis.call('DECRBY', packageKey, packageUsed)
+            redis.call('HINCRBY', pendingPackageKey, 'issued', packageUsed)
+            redis.call('EXPIRE', pendingPackageKey, pendingTTL)
+        end
+    end
+
+    if paidCount == 0 then
+        applyFreeAndPackage()
+        return {1, freeUsed, packageUsed, 0, 0} -- Success (Package)
+    end
+
+    -- Case 3: Mixed (Quota + Package + Balance)
+    local balance = redis.call('GET', balanceKey)
+    if not balance then
+        return {-2, 0, 0, 0, 0} -- Balance Cache Missing
+    end
+    balance = tonumber(balance)
+
+    local unitPrice = 0
+    if count > 0 then
+        unitPrice = totalCost / count
+    end
+    local needed = paidCount * unitPrice
+
+    if needed > 0 then
+        local budget = checkBudget(budgetKey, serviceSpentKey, allSpentKey, serviceField, needed)
+        if budget ~= 1 then
+            return {budget, 0, 0, 0, 0} -- Budget Exceeded / Budget Cache Missing
+        end
+        local limit = checkSpendLimit(memberSpentKey, spendLimit, needed)
+        if limit ~= 1 then
+            return {limit, 0, 0, 0, 0} -- Spend Limit Exceeded / Member Spent Cache Missing
+        end
+    end
+
+    if balance >= needed then
+        applyFreeAndPackage()
+        redis.call('INCRBYFLOAT', balanceKey, -needed)
+        redis.call('HINCRBYFLOAT', pendingBalanceKey, 'issued', needed)
+        redis.call('EXPIRE', pendingBalanceKey, pendingTTL)
+        addBudgetSpent(serviceSpentKey, allSpentKey, needed)
+        addMemberSpent(memberSpentKey, needed)
+        -- 浮点数以字符串返回，避免 Redis 将 Lua number 截断为整数
+        return {1, freeUsed, packageUsed, paidCount, tostring(needed)} -- Success (Mixed)
+    end
+
+    return {0, 0, 0, 0, 0} -- Insufficient
+end
+
+local function revertDeduct(k, freeUsed, balanceDeducted, packageUsed)
+    if packageUsed > 0 then
+        if redis.call('EXISTS', k[8]) == 1 then
+            redis.call('INCRBY', k[8], packageUsed)
+        end
+        if redis.call('EXISTS', k[9]) == 1 then
+            redis.call('HINCRBY', k[9], 'settled', packageUsed)
+        end
+    end
+    if freeUsed > 0 then
+        if redis.call('EXISTS', k[1]) == 1 then
+            redis.call('INCRBY', k[1], freeUsed)
+        end
+        if redis.call('EXISTS', k[3]) == 1 then
+            redis.call('HINCRBY', k[3], 'settled', freeUsed)
+        end
+    end
+    if balanceDeducted > 0 then
+        if redis.call('EXISTS', k[2]) == 1 then
+            redis.call('INCRBYFLOAT', k[2], balanceDeducted)
+        end
+        if redis.call('EXISTS', k[4]) == 1 then
+            redis.call('HINCRBYFLOAT', k[4], 'settled', balanceDeducted)
+        end
+        for _, i in ipairs({6, 7, 10}) do
+            if redis.call('EXISTS', k[i]) == 1 then
+                redis.call('INCRBYFLOAT', k[i], -balanceDeducted)
+            end
+        end
+    end
+end
+`
+
+// deductScript 执行一次扣费，返回值见 deductScriptFuncs
+const deductScript = deductScriptFuncs + `
+return deduct(KEYS, tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3]), ARGV[4], tonumber(ARGV[5]))
+`
+
+// deductAtomicScript 在一个脚本中依次扣减多个服务项，任一项失败时撤销此前已扣减的项，要么全部成功要么全部不扣
+// 每个服务项占用 deductKeys 的 10 个 key；ARGV[1] 为在途计数过期时间，之后每项依次为 count、cost、预算字段、成员消费上限
+// 返回 {code, index, 每项的 freeUsed, packageUsed, paidCount, balanceDeducted...}，index 为失败项的下标（从 0 开始）
+const deductAtomicScript = deductScriptFuncs + `
+local pendingTTL = tonumber(ARGV[1])
+local n = #KEYS / 10
+local done = {}
+local results = {1, 0}
+for i = 1, n do
+    local k = {}
+    for j = 1, 10 do
+        k[j] = KEYS[(i - 1) * 10 + j]
+    end
+    local base = 1 + (i - 1) * 4
+    local res = deduct(k, tonumber(ARGV[base + 1]), tonumber(ARGV[base + 2]), pendingTTL, ARGV[base + 3], tonumber(ARGV[base + 4]))
+    if res[1] ~= 1 then
+        for m = #done, 1, -1 do
+            local d = done[m]
+            revertDeduct(d.k, d.res[2], tonumber(d.res[5]), d.res[3])
+        end
+        return {res[1], i - 1}
+    end
+    done[#done + 1] = {k = k, res = res}
+    for j = 2, 5 do
+        results[#results + 1] = res[j]
+    end
+end
+return results
+`
+
+// settleScript 扣回在途计数
+// 在途计数已过期时不再累加 settled，否则会抵消之后新的 issued
+const settleScript = `
+local freeCount = tonumber(ARGV[1])
+local balanceDeducted = tonumber(ARGV[2])
+local packageCount = tonumber(ARGV[3])
+if freeCount > 0 and redis.call('EXISTS', KEYS[1]) == 1 then
+    redis.call('HINCRBY', KEYS[1], 'settled', freeCount)
+end
+if balanceDeducted > 0 and redis.call('EXISTS', KEYS[2]) == 1 then
+    redis.call('HINCRBYFLOAT', KEYS[2], 'settled', balanceDeducted)
+end
+if packageCount > 0 and redis.call('EXISTS', KEYS[3]) == 1 then
+    redis.call('HINCRBY', KEYS[3], 'settled', packageCount)
+end
+return 1
+`
+
+// revertDeductScript 撤销一次 Lua 扣费（事件未能投递时使用）
+const revertDeductScript = deductScriptFuncs + `
+revertDeduct(KEYS, tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3]))
+return 1
+`
+
+// fillCacheScript 在途感知的缓存回填（SET NX）
+// 读取在途计数之后如果 issued 变大，说明期间有新的 Lua 扣费，计算出的值已过期，放弃回填
+const fillCacheScript = `
+local issued = tonumber(redis.call('HGET', KEYS[2], 'issued') or '0')
+if issued > tonumber(ARGV[2]) then
+    return 0
+end
+if redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[3], 'NX') then
+    return 1
+end
+return 0
+`
+
+// deductResult Lua 扣费结果
+type deductResult struct {
+	Code            int
+	FreeUsed        int
+	PackageUsed     int
+	PaidCount       int
+	BalanceDeducted float64
+	Month           string // 扣费时的预算月份，撤销时按同一月份扣回消费缓存
+	MemberID        string // 扣费的组织成员，撤销时扣回成员消费缓存
+}
+
+// deductSnapshot 从 DB 读取的扣费相关数据
+type deductSnapshot struct {
+	HasQuota         bool // 是否存在免费额度记录
+	QuotaRemaining   int
+	PackageRemaining int64     // 有效用量包剩余合计
+	PackageExpiresAt time.Time // 最早到期的有效用量包的到期时间，没有有效用量包时为零值
+	Balance          float64
+	Budget           *budgetSnapshot      // 硬性预算，nil 表示没有
+	Member           *memberSpentSnapshot // 设置了消费上限的成员本月消费，nil 表示没有
+}
+
+func quotaCacheKey(userID, serviceName, period string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyQuota, userID, serviceName, period)
+}
+
+func balanceCacheKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyBalance, userID)
+}
+
+func pendingQuotaKey(userID, serviceName, period string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyPendingQuota, userID, serviceName, period)
+}
+
+func pendingBalanceKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyPendingBalance, userID)
+}
+
+func packageCacheKey(userID, serviceName string) string {
+	return fmt.Sprintf("%s%s:%s", constants.RedisKeyPackage, userID, serviceName)
+}
+
+func pendingPackageKey(userID, serviceName string) string {
+	return fmt.Sprintf("%s%s:%s", constants.RedisKeyPendingPackage, userID, serviceName)
+}
+
+// deductKeys 扣费脚本使用的 key，period 为额度周期标识，month 为预算月份，memberID 为组织账户中的成员（个人账户为空）
+func deductKeys(userID, memberID, serviceName, period, month string) []string {
+	return []string{
+		quotaCacheKey(userID, serviceName, period),
+		balanceCacheKey(userID),
+		pendingQuotaKey(userID, serviceName, period),
+		pendingBalanceKey(userID),
+		budgetCacheKey(userID),
+		budgetSpentKey(userID, serviceName, month),
+		budgetSpentKey(userID, "", month),
+		packageCacheKey(userID, serviceName),
+		pendingPackageKey(userID, serviceName),
+		memberSpentKey(userID, memberID, month),
+	}
+}
+
+// evalDeduct 执行 Lua 扣费脚本，period 为额度周期标识，month 为预算月份
+func (d *Data) evalDeduct(ctx context.Context, payer biz.Payer, serviceName, period, month string, count int, cost float64) (*deductResult, error) {
+	keys := deductKeys(payer.AccountID, payer.MemberID, serviceName, period, month)
+	res, err := d.rdb.Eval(ctx, deductScript, keys, count, cost, int(pendingTTL.Seconds()), budgetField(serviceName), payer.SpendLimit).Result()
+	if err != nil {
+		return nil, err
+	}
+	result, err := parseDeductResult(res)
+	if err != nil {
+		return nil, err
+	}
+	result.Month, result.MemberID = month, payer.MemberID
+	return result, nil
+}
+
+// evalDeductBatch 通过 pipeline 批量执行 Lua 扣费脚本，一次往返完成整批扣费
+// 返回的结果和错误均与 reqs 一一对应
+func (d *Data) evalDeductBatch(ctx context.Context, reqs []*biz.DeductRequest) ([]*deductResult, []error) {
+	cmds := make([]*redis.Cmd, len(reqs))
+	// 单条命令的错误在 cmd 上分别读取，这里只需执行 pipeline
+	_, _ = d.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, req := range reqs {
+			keys := deductKeys(req.UserID, req.MemberID, req.ServiceName, req.Period, req.BudgetMonth.Key)
+			cmds[i] = pipe.Eval(ctx, deductScript, keys, req.Count, req.Cost, int(pendingTTL.Seconds()), budgetField(req.ServiceName), req.SpendLimit)
+		}
+		return nil
+	})
+
+	results := make([]*deductResult, len(reqs))
+	errs := make([]error, len(reqs))
+	for i, cmd := range cmds {
+		res, err := cmd.Result()
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if results[i], errs[i] = parseDeductResult(res); errs[i] == nil {
+			results[i].Month, results[i].MemberID = reqs[i].BudgetMonth.Key, reqs[i].MemberID
+		}
+	}
+	return results, errs
+}
+
+// evalDeductAtomic 在一个 Lua 脚本中扣减同一用户的多个服务项，要么全部扣减要么全部不扣
+// 全部成功时 code 为 1，results 与 reqs 一一对应；否则 failed 为导致失败的服务项下标，code 含义同 deductScript
+func (d *Data) evalDeductAtomic(ctx context.Context, reqs []*biz.DeductRequest) (code, failed int, results []*deductResult, err error) {
+	keys := make([]string, 0, len(reqs)*10)
+	args := make([]interface{}, 0, 1+len(reqs)*4)
+	args = append(args, int(pendingTTL.Seconds()))
+	for _, req := range reqs {
+		keys = append(keys, deductKeys(req.UserID, req.MemberID, req.ServiceName, req.Period, req.BudgetMonth.Key)...)
+		args = append(args, req.Count, req.Cost, budgetField(req.ServiceName), req.SpendLimit)
+	}
+	res, err := d.rdb.Eval(ctx, deductAtomicScript, keys, args...).Result()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) < 2 {
+		return 0, 0, nil, fmt.Errorf("invalid deduct atomic script result: %v", res)
+	}
+	c, ok1 := vals[0].(int64)
+	idx, ok2 := vals[1].(int64)
+	if !ok1 || !ok2 {
+		return 0, 0, nil, fmt.Errorf("invalid deduct atomic script result: %v", res)
+	}
+	if c != 1 {
+		return int(c), int(idx), nil, nil
+	}
+	if len(vals) != 2+len(reqs)*4 {
+		return 0, 0, nil, fmt.Errorf("invalid deduct atomic script result: %v", res)
+	}
+	results = make([]*deductResult, len(reqs))
+	for i, req := range reqs {
+		item := append([]interface{}{int64(1)}, vals[2+i*4:6+i*4]...)
+		if results[i], err = parseDeductResult(item); err != nil {
+			return 0, 0, nil, err
+		}
+		results[i].Month, results[i].MemberID = req.BudgetMonth.Key, req.MemberID
+	}
+	return 1, 0, results, nil
+}
+
+// parseDeductResult 解析 Lua 扣费脚本返回值
+func parseDeductResult(res interface{}) (*deductResult, error) {
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) != 5 {
+		return nil, fmt.Errorf("invalid deduct script result: %v", res)
+	}
+	code, ok1 := vals[0].(int64)
+	freeUsed, ok2 := vals[1].(int64)
+	packageUsed, ok3 := vals[2].(int64)
+	paidCount, ok4 := vals[3].(int64)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return nil, fmt.Errorf("invalid deduct script result: %v", res)
+	}
+
+	result := &deductResult{Code: int(code), FreeUsed: int(freeUsed), PackageUsed: int(packageUsed), PaidCount: int(paidCount)}
+	if s, ok := vals[4].(string); ok {
+		var err error
+		if result.BalanceDeducted, err = strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("invalid deduct script result: %v", res)
+		}
+	}
+	return result, nil
+}
+
+// revertDeduct 撤销 Lua 扣费：回补缓存并扣回在途计数
+func (d *Data) revertDeduct(ctx context.Context, userID, serviceName, period string, res *deductResult) error {
+	keys := deductKeys(userID, res.MemberID, serviceName, period, res.Month)
+	return d.rdb.Eval(ctx, revertDeductScript, keys, res.FreeUsed, res.BalanceDeducted, res.PackageUsed).Err()
+}
+
+// settlePending 扣费事件落库后扣回在途计数
+func (d *Data) settlePending(ctx context.Context, events []*biz.DeductEvent) error {
+	_, err := d.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, event := range events {
+			keys := []string{
+				pendingQuotaKey(event.UserID, event.ServiceName, event.Period),
+				pendingBalanceKey(event.UserID),
+				pendingPackageKey(event.UserID, event.ServiceName),
+			}
+			pipe.Eval(ctx, settleScript, keys, event.FreeCount, event.BalanceDeducted, event.PackageCount)
+		}
+		return nil
+	})
+	return err
+}
+
+// pendingState 在途计数
+type pendingState struct {
+	Issued  float64
+	Settled float64
+}
+
+// Amount 在途值
+// 落库与扣回之间可能出现短暂的负值，按 0 处理（保守）
+func (p pendingState) Amount() float64 {
+	return max(p.Issued-p.Settled, 0)
+}
+
+// getPending 读取在途计数
+func (d *Data) getPending(ctx context.Context, key string) (pendingState, error) {
+	vals, err := d.rdb.HMGet(ctx, key, pendingFieldIssued, pendingFieldSettled).Result()
+	if err != nil {
+		return pendingState{}, err
+	}
+	var state pendingState
+	for i, dst := range []*float64{&state.Issued, &state.Settled} {
+		s, ok := vals[i].(string)
+		if !ok {
+			continue
+		}
+		if *dst, err = strconv.ParseFloat(s, 64); err != nil {
+			return pendingState{}, err
+		}
+	}
+	return state, nil
+}
+
+// getPendingQuota 获取在途免费额度
+func (d *Data) getPendingQuota(ctx context.Context, userID, serviceName, period string) (pendingState, error) {
+	return d.getPending(ctx, pendingQuotaKey(userID, serviceName, period))
+}
+
+// getPendingBalance 获取在途余额扣费
+func (d *Data) getPendingBalance(ctx context.Context, userID string) (pendingState, error) {
+	return d.getPending(ctx, pendingBalanceKey(userID))
+}
+
+// getPendingPackage 获取在途用量包扣减
+func (d *Data) getPendingPackage(ctx context.Context, userID, serviceName string) (pendingState, error) {
+	return d.getPending(ctx, pendingPackageKey(userID, serviceName))
+}
+
+// fillQuotaCache 回填额度缓存，pending 为计算 remaining 时读取的在途计数
+func (d *Data) fillQuotaCache(ctx context.Context, userID, serviceName, period string, remaining int, pending pendingState) error {
+	keys := []string{quotaCacheKey(userID, serviceName, period), pendingQuotaKey(userID, serviceName, period)}
+	return d.rdb.Eval(ctx, fillCacheScript, keys, max(remaining, 0), pending.Issued, int(deductCacheTTL.Seconds())).Err()
+}
+
+// fillBalanceCache 回填余额缓存，pending 为计算 balance 时读取的在途计数
+func (d *Data) fillBalanceCache(ctx context.Context, userID string, balance float64, pending pendingState) error {
+	keys := []string{balanceCacheKey(userID), pendingBalanceKey(userID)}
+	value := strconv.FormatFloat(max(balance, 0), 'f', -1, 64)
+	return d.rdb.Eval(ctx, fillCacheScript, keys, value, pending.Issued, int(deductCacheTTL.Seconds())).Err()
+}
+
+// fillPackageCache 回填用量包缓存，pending 为计算 remaining 时读取的在途计数
+// 缓存不能跨过最早到期的用量包的到期时间，否则过期的用量包仍会被扣减
+func (d *Data) fillPackageCache(ctx context.Context, userID, serviceName string, remaining int64, expiresAt time.Time, pending pendingState) error {
+	ttl := deductCacheTTL
+	if !expiresAt.IsZero() {
+		ttl = min(ttl, time.Until(expiresAt))
+	}
+	if ttl < time.Second {
+		// 即将到期：不回填，本次按缓存缺失处理（走 DB 事务扣费）
+		return nil
+	}
+	keys := []string{packageCacheKey(userID, serviceName), pendingPackageKey(userID, serviceName)}
+	return d.rdb.Eval(ctx, fillCacheScript, keys, max(remaining, 0), pending.Issued, int(ttl.Seconds())).Err()
+}
+
+// refillDeductCache 缓存缺失时按 DB 值 - 在途值回填额度、用量包、余额缓存，以及按 DB 值 + 在途值回填预算与成员消费缓存
+// load 负责从 DB 读取数据，必须在读取在途计数之后调用
+func (d *Data) refillDeductCache(ctx context.Context, userID, serviceName, period string, load func(ctx context.Context) (*deductSnapshot, error)) error {
+	pendingQuota, err := d.getPendingQuota(ctx, userID, serviceName, period)
+	if err != nil {
+		return err
+	}
+	pendingBalance, err := d.getPendingBalance(ctx, userID)
+	if err != nil {
+		return err
+	}
+	pendingPackage, err := d.getPendingPackage(ctx, userID, serviceName)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := load(ctx)
+	if err != nil {
+		return err
+	}
+
+	if snapshot.HasQuota {
+		remaining := snapshot.QuotaRemaining - int(pendingQuota.Amount())
+		if err := d.fillQuotaCache(ctx, userID, serviceName, period, remaining, pendingQuota); err != nil {
+			return err
+		}
+	}
+	remaining := snapshot.PackageRemaining - int64(pendingPackage.Amount())
+	if err := d.fillPackageCache(ctx, userID, serviceName, remaining, snapshot.PackageExpiresAt, pendingPackage); err != nil {
+		return err
+	}
+	if err := d.fillBalanceCache(ctx, userID, snapshot.Balance-pendingBalance.Amount(), pendingBalance); err != nil {
+		return err
+	}
+	if err := d.fillBudgetCache(ctx, userID, serviceName, snapshot.Budget, pendingBalance); err != nil {
+		return err
+	}
+	if snapshot.Member == nil {
+		return nil
+	}
+	return d.fillMemberSpentCache(ctx, userID, snapshot.Member, pendingBalance)
+}
+
+// loadDeductCache 回填 payer 扣费涉及的缓存（见 refillDeductCache），period 为额度周期标识，month 为预算月份
+// 成员消费缓存只在成员设置了消费上限时回填
+func (d *Data) loadDeductCache(ctx context.Context, payer biz.Payer, serviceName, period string, month biz.BillingPeriod) error {
+	userID := payer.AccountID
+	return d.refillDeductCache(ctx, userID, serviceName, period, func(ctx context.Context) (*deductSnapshot, error) {
+		snapshot := &deductSnapshot{}
+
+		// 加载 Quota
+		var quota model.FreeQuota
+		err := d.db.WithContext(ctx).
+			Where("uid = ? AND service_name = ? AND period = ?", userID, serviceName, period).
+			First(&quota).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			snapshot.HasQuota = true
+			snapshot.QuotaRemaining = quota.TotalQuota - quota.UsedQuota
+		}
+
+		// 加载有效用量包
+		if snapshot.PackageRemaining, snapshot.PackageExpiresAt, err = loadPackageRemaining(d.db.WithContext(ctx), userID, serviceName, time.Now()); err != nil {
+			return nil, err
+		}
+
+		// 加载 Balance
+		var balance model.UserBalance
+		err = d.db.WithContext(ctx).Where("uid = ?", userID).First(&balance).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		snapshot.Balance = balance.Balance
+
+		// 加载硬性预算
+		if snapshot.Budget, err = loadBudgetSnapshot(d.db.WithContext(ctx), userID, serviceName, month); err != nil {
+			return nil, err
+		}
+
+		// 加载成员本月消费
+		if payer.MemberID != "" && payer.SpendLimit > 0 {
+			if snapshot.Member, err = loadMemberSpentSnapshot(d.db.WithContext(ctx), userID, payer.MemberID, month); err != nil {
+				return nil, err
+			}
+		}
+		return snapshot, nil
+	})
+}
+
+// publishDeductEvent 投递扣费事件
+// 以 uid 作为 sharding key，同一用户的事件始终进入同一队列，消费端按队列顺序处理
+func (d *Data) publishDeductEvent(ctx context.Context, event *biz.DeductEvent) error {
+	msgBytes, contentType, err := encodeDeductEvent(event, d.mqEncoding)
+	if err != nil {
+		return err
+	}
+	msg := primitive.NewMessage(d.mqTopic, msgBytes).WithShardingKey(event.UserID)
+	msg.WithProperty(constants.MQPropertyContentType, contentType)
+	_, err = d.mq.SendSync(ctx, msg)
+	return err
+}
+
+// publishDeadLetter 将无法落库的扣费消息原样投递到死信 topic，reason 记录在消息属性中
+func (d *Data) publishDeadLetter(ctx context.Context, body []byte, contentType, reason string) error {
+	if d.mq == nil {
+		return errors.New("rocketmq producer is not enabled")
+	}
+	msg := primitive.NewMessage(d.mqDeadLetterTopic, body)
+	msg.WithProperty(constants.MQPropertyContentType, contentType)
+	msg.WithProperty(constants.MQPropertyDeadLetterReason, reason)
+	_, err := d.mq.SendSync(ctx, msg)
+	return err
+}
+
+// publishDeductEventBatch 将同一用户的多个扣费事件作为一条消息投递，消费端在同一事务中落库
+// 需要全部消费端都能解析批量消息，由 rocketmq.batch_events 开启
+func (d *Data) publishDeductEventBatch(ctx context.Context, events []*biz.DeductEvent) error {
+	msgBytes, contentType, err := encodeDeductEvents(events, d.mqEncoding)
+	if err != nil {
+		return err
+	}
+	msg := primitive.NewMessage(d.mqTopic, msgBytes).WithShardingKey(events[0].UserID)
+	msg.WithProperty(constants.MQPropertyContentType, contentType)
+	_, err = d.mq.SendSync(ctx, msg)
+	return err
+}
+
+// publishDeductEvents 按顺序逐条投递同一用户的扣费事件，遇到失败即停止
+// 返回成功投递的条数，之后的事件均未投递
+// 注意：RocketMQ 批量消息不保留 sharding key，会被随机分配队列，因此不能用批量发送
+func (d *Data) publishDeductEvents(ctx context.Context, events []*biz.DeductEvent) (int, error) {
+	for i, event := range events {
+		if err := d.publishDeductEvent(ctx, event); err != nil {
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+// invalidateDeductCache 删除余额/额度/用量包缓存，下次访问时按 DB 值 - 在途值重新回填
+// 用于 DB 直接变更（充值、发放用量包、DB 事务扣费）之后：缓存中可能包含尚未落库的 Lua 扣费，不能直接用 DB 值覆盖
+func (d *Data) invalidateDeductCache(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return d.rdb.Del(ctx, keys...).Err()
+}
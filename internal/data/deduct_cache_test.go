@@ -0,0 +1,759 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/apache/rocketmq-client-go/v2"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	testUserID  = "u_10001"
+	testService = "passport"
+	testMonth   = "2025-11"
+)
+
+// fakeLedger 模拟 MySQL 中的余额、免费额度与用量包，落库由消费端异步完成
+type fakeLedger struct {
+	mu         sync.Mutex
+	totalQuota int
+	usedQuota  int
+	packages   int64 // 有效用量包剩余合计
+	balance    float64
+}
+
+func (l *fakeLedger) snapshot(context.Context) (*deductSnapshot, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	snapshot := &deductSnapshot{
+		HasQuota:         true,
+		QuotaRemaining:   l.totalQuota - l.usedQuota,
+		PackageRemaining: l.packages,
+		Balance:          l.balance,
+	}
+	if l.packages > 0 {
+		snapshot.PackageExpiresAt = time.Now().Add(time.Hour)
+	}
+	return snapshot, nil
+}
+
+func (l *fakeLedger) apply(events []*biz.DeductEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, event := range events {
+		l.usedQuota += event.FreeCount
+		l.packages -= int64(event.PackageCount)
+		l.balance -= event.BalanceDeducted
+	}
+}
+
+func assertNoPending(t *testing.T, d *Data) {
+	t.Helper()
+	ctx := context.Background()
+	if q, err := d.getPendingQuota(ctx, testUserID, testService, testMonth); err != nil || q.Amount() != 0 {
+		t.Fatalf("pending quota = %+v, err = %v, want 0", q, err)
+	}
+	if b, err := d.getPendingBalance(ctx, testUserID); err != nil || b.Amount() != 0 {
+		t.Fatalf("pending balance = %+v, err = %v, want 0", b, err)
+	}
+	if p, err := d.getPendingPackage(ctx, testUserID, testService); err != nil || p.Amount() != 0 {
+		t.Fatalf("pending package = %+v, err = %v, want 0", p, err)
+	}
+}
+
+func newTestData(t *testing.T) (*Data, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return &Data{rdb: rdb}, mr
+}
+
+// TestRefillAfterExpiryKeepsInFlightDeductions 缓存过期后从尚未落库的 DB 回填，已扣费用不能被还回去
+func TestRefillAfterExpiryKeepsInFlightDeductions(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: 2, balance: 1}
+
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	res, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 4, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Code != 1 || res.FreeUsed != 2 || res.PaidCount != 2 || res.BalanceDeducted != 0.5 {
+		t.Fatalf("unexpected deduct result: %+v", res)
+	}
+
+	// 缓存过期，事件仍在 MQ 中
+	mr.Del(quotaCacheKey(testUserID, testService, testMonth))
+	mr.Del(balanceCacheKey(testUserID))
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(quotaCacheKey(testUserID, testService, testMonth)); got != "0" {
+		t.Fatalf("quota cache = %s, want 0", got)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "0.5" {
+		t.Fatalf("balance cache = %s, want 0.5", got)
+	}
+
+	// 落库后扣回在途计数，缓存值与 DB 一致
+	event := &biz.DeductEvent{UserID: testUserID, ServiceName: testService, Period: testMonth, FreeCount: res.FreeUsed, PaidCount: res.PaidCount, BalanceDeducted: res.BalanceDeducted}
+	ledger.apply([]*biz.DeductEvent{event})
+	if err := d.settlePending(ctx, []*biz.DeductEvent{event}); err != nil {
+		t.Fatal(err)
+	}
+	assertNoPending(t, d)
+}
+
+// TestRevertDeduct 事件投递失败时撤销 Lua 扣费
+func TestRevertDeduct(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: 1, balance: 2}
+
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	res, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 3, 1.5)
+	if err != nil || res.Code != 1 {
+		t.Fatalf("deduct: res=%+v, err=%v", res, err)
+	}
+	if err := d.revertDeduct(ctx, testUserID, testService, testMonth, res); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(quotaCacheKey(testUserID, testService, testMonth)); got != "1" {
+		t.Fatalf("quota cache = %s, want 1", got)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "2" {
+		t.Fatalf("balance cache = %s, want 2", got)
+	}
+	assertNoPending(t, d)
+}
+
+// TestPackageDeductOrder 扣减顺序：免费额度 → 用量包 → 余额，余额只按剩余部分计费；撤销时回补用量包
+func TestPackageDeductOrder(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: 1, packages: 2, balance: 10}
+
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	// 用量包足够覆盖免费额度不足的部分时不扣余额
+	res, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 2, 2)
+	if err != nil || res.Code != 1 || res.FreeUsed != 1 || res.PackageUsed != 1 || res.PaidCount != 0 || res.BalanceDeducted != 0 {
+		t.Fatalf("deduct: res=%+v, err=%v", res, err)
+	}
+	mixed, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 3, 3)
+	if err != nil || mixed.Code != 1 || mixed.FreeUsed != 0 || mixed.PackageUsed != 1 || mixed.PaidCount != 2 || mixed.BalanceDeducted != 2 {
+		t.Fatalf("mixed deduct: res=%+v, err=%v", mixed, err)
+	}
+	if got, _ := mr.Get(packageCacheKey(testUserID, testService)); got != "0" {
+		t.Fatalf("package cache = %s, want 0", got)
+	}
+
+	if err := d.revertDeduct(ctx, testUserID, testService, testMonth, mixed); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(packageCacheKey(testUserID, testService)); got != "1" {
+		t.Fatalf("package cache after revert = %s, want 1", got)
+	}
+
+	// 缓存过期后回填不能把在途的用量包扣减还回去
+	mr.Del(packageCacheKey(testUserID, testService))
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(packageCacheKey(testUserID, testService)); got != "1" {
+		t.Fatalf("package cache after refill = %s, want 1", got)
+	}
+
+	event := &biz.DeductEvent{UserID: testUserID, ServiceName: testService, Period: testMonth, FreeCount: res.FreeUsed, PackageCount: res.PackageUsed}
+	ledger.apply([]*biz.DeductEvent{event})
+	if err := d.settlePending(ctx, []*biz.DeductEvent{event}); err != nil {
+		t.Fatal(err)
+	}
+	assertNoPending(t, d)
+}
+
+// TestPackageCacheTTL 用量包缓存不能跨过最早到期的用量包的到期时间
+func TestPackageCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+
+	if err := d.fillPackageCache(ctx, testUserID, testService, 10, time.Now().Add(30*time.Second), pendingState{}); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := mr.TTL(packageCacheKey(testUserID, testService)); ttl <= 0 || ttl > 30*time.Second {
+		t.Fatalf("package cache ttl = %v, want (0, 30s]", ttl)
+	}
+
+	// 即将到期时不回填，由 DB 事务扣费处理
+	mr.Del(packageCacheKey(testUserID, testService))
+	if err := d.fillPackageCache(ctx, testUserID, testService, 10, time.Now().Add(100*time.Millisecond), pendingState{}); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(packageCacheKey(testUserID, testService)) {
+		t.Fatal("package cache filled for a package about to expire")
+	}
+}
+
+// TestBudgetHardLimit 超出硬性预算的余额扣费被拒绝，消费缓存随扣费与撤销同步变化
+func TestBudgetHardLimit(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	load := func(context.Context) (*deductSnapshot, error) {
+		return &deductSnapshot{
+			Balance: 10,
+			Budget: &budgetSnapshot{
+				Month:  testMonth,
+				Limits: map[string]float64{budgetFieldAll: 1},
+				Spent:  map[string]float64{budgetFieldAll: 0.4},
+			},
+		}, nil
+	}
+	// 服务未配置免费额度时额度缓存为 0
+	mr.Set(quotaCacheKey(testUserID, testService, testMonth), "0")
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, load); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || res.Code != 1 {
+		t.Fatalf("deduct: res=%+v, err=%v", res, err)
+	}
+	if got, _ := mr.Get(budgetSpentKey(testUserID, "", testMonth)); got != "0.9" {
+		t.Fatalf("budget spent = %s, want 0.9", got)
+	}
+
+	denied, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || denied.Code != 2 {
+		t.Fatalf("deduct over budget: res=%+v, err=%v", denied, err)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "9.5" {
+		t.Fatalf("balance cache = %s, want 9.5", got)
+	}
+
+	if err := d.revertDeduct(ctx, testUserID, testService, testMonth, res); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(budgetSpentKey(testUserID, "", testMonth)); got != "0.4" {
+		t.Fatalf("budget spent = %s, want 0.4", got)
+	}
+
+	// 消费缓存缺失时要求回填
+	mr.Del(budgetSpentKey(testUserID, "", testMonth))
+	missing, err := d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || missing.Code != -3 {
+		t.Fatalf("deduct without budget cache: res=%+v, err=%v", missing, err)
+	}
+}
+
+// TestMemberSpendLimit 成员消费缓存包含在途扣费，超出成员消费上限的余额扣费被拒绝，消费缓存随扣费与撤销同步变化
+func TestMemberSpendLimit(t *testing.T) {
+	const testMemberID = "u_20001"
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	load := func(context.Context) (*deductSnapshot, error) {
+		return &deductSnapshot{
+			Balance: 10,
+			Member:  &memberSpentSnapshot{MemberID: testMemberID, Month: testMonth, Spent: 0.4},
+		}, nil
+	}
+	// 服务未配置免费额度时额度缓存为 0；组织有 0.3 在途余额扣费尚未落库
+	mr.Set(quotaCacheKey(testUserID, testService, testMonth), "0")
+	mr.HSet(pendingBalanceKey(testUserID), pendingFieldIssued, "0.3")
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, load); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "0.7" {
+		t.Fatalf("member spent = %s, want 0.7", got)
+	}
+
+	// 已落库 0.4 + 在途 0.3 + 本次 0.5 超出上限 1
+	payer := biz.Payer{AccountID: testUserID, MemberID: testMemberID, SpendLimit: 1}
+	denied, err := d.evalDeduct(ctx, payer, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || denied.Code != 3 {
+		t.Fatalf("deduct over spend limit: res=%+v, err=%v", denied, err)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "9.7" {
+		t.Fatalf("balance cache = %s, want 9.7", got)
+	}
+
+	payer.SpendLimit = 1.2
+	res, err := d.evalDeduct(ctx, payer, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || res.Code != 1 {
+		t.Fatalf("deduct: res=%+v, err=%v", res, err)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "1.2" {
+		t.Fatalf("member spent = %s, want 1.2", got)
+	}
+
+	if err := d.revertDeduct(ctx, testUserID, testService, testMonth, res); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "0.7" {
+		t.Fatalf("member spent after revert = %s, want 0.7", got)
+	}
+
+	// 没有上限的成员不检查消费缓存，但仍累加已存在的缓存
+	unlimited := biz.Payer{AccountID: testUserID, MemberID: testMemberID}
+	if res, err := d.evalDeduct(ctx, unlimited, testService, testMonth, testMonth, 2, 0.5); err != nil || res.Code != 1 {
+		t.Fatalf("deduct without spend limit: res=%+v, err=%v", res, err)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "1.2" {
+		t.Fatalf("member spent = %s, want 1.2", got)
+	}
+
+	// 消费缓存缺失时要求回填
+	mr.Del(memberSpentKey(testUserID, testMemberID, testMonth))
+	missing, err := d.evalDeduct(ctx, payer, testService, testMonth, testMonth, 2, 0.5)
+	if err != nil || missing.Code != -5 {
+		t.Fatalf("deduct without member spent cache: res=%+v, err=%v", missing, err)
+	}
+}
+
+// TestConcurrentDeductNoOverspend 并发扣费 + 消费端延迟落库 + 缓存随机过期，不允许超扣
+func TestConcurrentDeductNoOverspend(t *testing.T) {
+	const (
+		workers    = 8
+		attempts   = 40
+		totalQuota = 100
+		packages   = 30
+		balance    = 50.0
+		unitCost   = 0.5
+	)
+
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: totalQuota, packages: packages, balance: balance}
+
+	// 消费端：攒批、延迟落库，再扣回在途计数
+	events := make(chan *biz.DeductEvent, workers*attempts)
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		var batch []*biz.DeductEvent
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+			ledger.apply(batch)
+			if err := d.settlePending(ctx, batch); err != nil {
+				t.Error(err)
+			}
+			batch = nil
+		}
+		for event := range events {
+			batch = append(batch, event)
+			if len(batch) >= 8 {
+				flush()
+			}
+		}
+		flush()
+	}()
+
+	// 模拟缓存过期
+	stopExpire := make(chan struct{})
+	expireDone := make(chan struct{})
+	go func() {
+		defer close(expireDone)
+		for {
+			select {
+			case <-stopExpire:
+				return
+			case <-time.After(2 * time.Millisecond):
+				mr.Del(quotaCacheKey(testUserID, testService, testMonth))
+				mr.Del(packageCacheKey(testUserID, testService))
+				mr.Del(balanceCacheKey(testUserID))
+			}
+		}
+	}()
+
+	var freeCharged, packageCharged, paidCharged atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < attempts; i++ {
+				var res *deductResult
+				for retry := 0; retry < 10; retry++ {
+					var err error
+					res, err = d.evalDeduct(ctx, biz.Payer{AccountID: testUserID}, testService, testMonth, testMonth, 1, unitCost)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					if res.Code >= 0 {
+						break
+					}
+					if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+				if res.Code != 1 {
+					continue
+				}
+				freeCharged.Add(int64(res.FreeUsed))
+				packageCharged.Add(int64(res.PackageUsed))
+				paidCharged.Add(int64(res.PaidCount))
+				events <- &biz.DeductEvent{
+					UserID:          testUserID,
+					ServiceName:     testService,
+					Period:          testMonth,
+					Count:           1,
+					FreeCount:       res.FreeUsed,
+					PackageCount:    res.PackageUsed,
+					PaidCount:       res.PaidCount,
+					BalanceDeducted: res.BalanceDeducted,
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(stopExpire)
+	<-expireDone
+	close(events)
+	<-consumerDone
+
+	if got := freeCharged.Load(); got > totalQuota {
+		t.Fatalf("free quota overspent: charged %d, total %d", got, totalQuota)
+	}
+	if got := packageCharged.Load(); got > packages {
+		t.Fatalf("packages overspent: charged %d, total %d", got, packages)
+	}
+	if got := float64(paidCharged.Load()) * unitCost; got > balance {
+		t.Fatalf("balance overspent: charged %v, balance %v", got, balance)
+	}
+	if ledger.usedQuota > ledger.totalQuota || ledger.packages < 0 || ledger.balance < 0 {
+		t.Fatalf("ledger overspent: used=%d/%d, packages=%d, balance=%v", ledger.usedQuota, ledger.totalQuota, ledger.packages, ledger.balance)
+	}
+	// 全部落库后在途计数归零
+	assertNoPending(t, d)
+}
+
+// failingProducer 每 failEvery 次投递失败一次，成功投递的事件交给消费端
+type failingProducer struct {
+	rocketmq.Producer
+	failEvery int64
+	sends     atomic.Int64
+	delivered chan []*biz.DeductEvent
+}
+
+func (p *failingProducer) SendSync(ctx context.Context, msgs ...*primitive.Message) (*primitive.SendResult, error) {
+	if p.sends.Add(1)%p.failEvery == 0 {
+		return nil, errors.New("broker unavailable")
+	}
+	for _, msg := range msgs {
+		events, err := decodeDeductEvents(msg.Body, msg.GetProperty(constants.MQPropertyContentType))
+		if err != nil {
+			return nil, err
+		}
+		p.delivered <- events
+	}
+	return &primitive.SendResult{Status: primitive.SendOK}, nil
+}
+
+// TestConcurrentDeductNoOverspendPublishFailure 部分事件投递失败时直接落库，
+// 与并发的 Lua 扣费交错时不超扣，扣费结果与落库记录一致（缓存过期回填见 TestConcurrentDeductNoOverspend）
+func TestConcurrentDeductNoOverspendPublishFailure(t *testing.T) {
+	const (
+		workers    = 8
+		attempts   = 40
+		totalQuota = 100
+		packages   = 30
+		balance    = 50.0
+		unitCost   = 0.5
+	)
+
+	ctx := context.Background()
+	r, d := newTestBillingRepo(t)
+	expiresAt := time.Now().Add(time.Hour)
+	seed := []interface{}{
+		&model.UserBalance{UserBalanceID: "b1", UID: testUserID, Balance: balance},
+		&model.FreeQuota{FreeQuotaID: "q1", UID: testUserID, ServiceName: testService, TotalQuota: totalQuota, Period: testMonth},
+		&model.UserPackage{UserPackageID: "p1", UID: testUserID, ServiceName: testService, PackageID: "pkg", OrderID: "o1", TotalUnits: packages, ValidMonths: 1, ExpiresAt: &expiresAt},
+	}
+	for _, row := range seed {
+		if err := d.db.Create(row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	producer := &failingProducer{failEvery: 3, delivered: make(chan []*biz.DeductEvent, workers*attempts)}
+	d.mq = producer
+
+	// 消费端：延迟落库
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		for events := range producer.delivered {
+			time.Sleep(time.Duration(rand.Intn(2)) * time.Millisecond)
+			if err := r.BatchDeductQuota(ctx, events); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	month := biz.BillingPeriod{Key: testMonth, Start: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)}
+	var charged atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < attempts; i++ {
+				_, err := r.DeductQuota(ctx, biz.Payer{AccountID: testUserID}, testService, 1, unitCost, testMonth, month, nil)
+				if err == nil {
+					charged.Add(1)
+					continue
+				}
+				if kratosErrors.FromError(err).Code != billingErrors.ErrCodeInsufficientBalance {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(producer.delivered)
+	<-consumerDone
+
+	if producer.sends.Load() < 3 {
+		t.Fatalf("sends = %d, want some publish failures", producer.sends.Load())
+	}
+	var quota model.FreeQuota
+	var pkg model.UserPackage
+	var userBalance model.UserBalance
+	d.db.First(&quota, "uid = ?", testUserID)
+	d.db.First(&pkg, "user_package_id = ?", "p1")
+	d.db.First(&userBalance, "uid = ?", testUserID)
+	if quota.UsedQuota > totalQuota || pkg.UsedUnits > packages || userBalance.Balance < 0 {
+		t.Fatalf("overspent: used=%d/%d, package=%d/%d, balance=%v", quota.UsedQuota, totalQuota, pkg.UsedUnits, packages, userBalance.Balance)
+	}
+	var recorded int64
+	d.db.Model(&model.BillingRecord{}).Select("COALESCE(SUM(count), 0)").Scan(&recorded)
+	if recorded != charged.Load() {
+		t.Fatalf("recorded count = %d, charged = %d", recorded, charged.Load())
+	}
+	// 容量 100 + 30 + 50/0.5 全部用完
+	if want := int64(totalQuota + packages + int(balance/unitCost)); charged.Load() != want {
+		t.Fatalf("charged = %d, want %d", charged.Load(), want)
+	}
+	assertNoPending(t, d)
+}
+
+const testAtomicService = "asset"
+
+// noQuotaSnapshot 第二个服务项：没有免费额度和用量包，与 ledger 共用余额
+func noQuotaSnapshot(ledger *fakeLedger) func(ctx context.Context) (*deductSnapshot, error) {
+	return func(ctx context.Context) (*deductSnapshot, error) {
+		snapshot, err := ledger.snapshot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.QuotaRemaining, snapshot.PackageRemaining, snapshot.PackageExpiresAt = 0, 0, time.Time{}
+		return snapshot, nil
+	}
+}
+
+func atomicTestReqs(count int, cost float64, assetCount int, assetCost float64) []*biz.DeductRequest {
+	month := biz.BillingPeriod{Key: testMonth}
+	return []*biz.DeductRequest{
+		{UserID: testUserID, ServiceName: testService, Count: count, Cost: cost, Period: testMonth, BudgetMonth: month},
+		{UserID: testUserID, ServiceName: testAtomicService, Count: assetCount, Cost: assetCost, Period: testMonth, BudgetMonth: month},
+	}
+}
+
+// TestDeductAtomicAllOrNothing 原子批量扣费任一服务项失败时，此前已扣减的服务项全部撤销
+func TestDeductAtomicAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: 2, packages: 1, balance: 3}
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.refillDeductCache(ctx, testUserID, testAtomicService, testMonth, noQuotaSnapshot(ledger)); err != nil {
+		t.Fatal(err)
+	}
+
+	// 第一项用完免费额度、用量包并扣余额 1，第二项需要余额 4，余额不足
+	code, failed, _, err := d.evalDeductAtomic(ctx, atomicTestReqs(4, 4, 4, 4))
+	if err != nil || code != 0 || failed != 1 {
+		t.Fatalf("deduct atomic: code=%d, failed=%d, err=%v, want insufficient at 1", code, failed, err)
+	}
+	if got, _ := mr.Get(quotaCacheKey(testUserID, testService, testMonth)); got != "2" {
+		t.Fatalf("quota cache = %s, want 2", got)
+	}
+	if got, _ := mr.Get(packageCacheKey(testUserID, testService)); got != "1" {
+		t.Fatalf("package cache = %s, want 1", got)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "3" {
+		t.Fatalf("balance cache = %s, want 3", got)
+	}
+	assertNoPending(t, d)
+
+	code, _, results, err := d.evalDeductAtomic(ctx, atomicTestReqs(4, 4, 2, 2))
+	if err != nil || code != 1 {
+		t.Fatalf("deduct atomic: code=%d, err=%v", code, err)
+	}
+	if r := results[0]; r.FreeUsed != 2 || r.PackageUsed != 1 || r.PaidCount != 1 || r.BalanceDeducted != 1 {
+		t.Fatalf("first item = %+v", r)
+	}
+	if r := results[1]; r.FreeUsed != 0 || r.PaidCount != 2 || r.BalanceDeducted != 2 || r.Month != testMonth {
+		t.Fatalf("second item = %+v", r)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "0" {
+		t.Fatalf("balance cache = %s, want 0", got)
+	}
+}
+
+// TestConcurrentDeductAtomicNoOverspend 原子批量扣费与单条扣费并发执行、缓存反复过期时不超扣，批量扣费要么全部成功要么全部不扣
+func TestConcurrentDeductAtomicNoOverspend(t *testing.T) {
+	const (
+		workers    = 8
+		attempts   = 20
+		totalQuota = 40
+		balance    = 60.0
+		unitCost   = 0.5
+	)
+
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	ledger := &fakeLedger{totalQuota: totalQuota, balance: balance}
+	refill := func() error {
+		if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, ledger.snapshot); err != nil {
+			return err
+		}
+		return d.refillDeductCache(ctx, testUserID, testAtomicService, testMonth, noQuotaSnapshot(ledger))
+	}
+
+	// 消费端：一条消息中的事件在同一批次落库
+	messages := make(chan []*biz.DeductEvent, workers*attempts)
+	consumerDone := make(chan struct{})
+	go func() {
+		defer close(consumerDone)
+		for events := range messages {
+			time.Sleep(time.Duration(rand.Intn(2)) * time.Millisecond)
+			ledger.apply(events)
+			if err := d.settlePending(ctx, events); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	// 模拟缓存过期
+	stopExpire := make(chan struct{})
+	expireDone := make(chan struct{})
+	go func() {
+		defer close(expireDone)
+		for {
+			select {
+			case <-stopExpire:
+				return
+			case <-time.After(2 * time.Millisecond):
+				mr.Del(quotaCacheKey(testUserID, testService, testMonth))
+				mr.Del(quotaCacheKey(testUserID, testAtomicService, testMonth))
+				mr.Del(balanceCacheKey(testUserID))
+			}
+		}
+	}()
+
+	toEvent := func(req *biz.DeductRequest, res *deductResult) *biz.DeductEvent {
+		return &biz.DeductEvent{
+			UserID:          req.UserID,
+			ServiceName:     req.ServiceName,
+			Period:          req.Period,
+			Count:           req.Count,
+			FreeCount:       res.FreeUsed,
+			PackageCount:    res.PackageUsed,
+			PaidCount:       res.PaidCount,
+			BalanceDeducted: res.BalanceDeducted,
+		}
+	}
+
+	var mu sync.Mutex
+	var freeCharged int
+	var balanceCharged float64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(atomicWorker bool) {
+			defer wg.Done()
+			for i := 0; i < attempts; i++ {
+				reqs := atomicTestReqs(2, 2*unitCost, 1, unitCost)
+				if !atomicWorker {
+					reqs = reqs[:1]
+				}
+				var code int
+				var results []*deductResult
+				for retry := 0; retry < 10; retry++ {
+					var err error
+					if code, _, results, err = d.evalDeductAtomic(ctx, reqs); err != nil {
+						t.Error(err)
+						return
+					}
+					if code >= 0 {
+						break
+					}
+					if err := refill(); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+				if code != 1 {
+					continue
+				}
+
+				events := make([]*biz.DeductEvent, len(reqs))
+				mu.Lock()
+				for j, req := range reqs {
+					res := results[j]
+					if res.FreeUsed+res.PackageUsed+res.PaidCount != req.Count {
+						t.Errorf("item %d partially charged: %+v", j, res)
+					}
+					freeCharged += res.FreeUsed
+					balanceCharged += res.BalanceDeducted
+					events[j] = toEvent(req, res)
+				}
+				mu.Unlock()
+				messages <- events
+			}
+		}(w%2 == 0)
+	}
+	wg.Wait()
+	close(stopExpire)
+	<-expireDone
+	close(messages)
+	<-consumerDone
+
+	if freeCharged > totalQuota {
+		t.Fatalf("free quota overspent: charged %d, total %d", freeCharged, totalQuota)
+	}
+	if balanceCharged > balance+1e-9 {
+		t.Fatalf("balance overspent: charged %v, balance %v", balanceCharged, balance)
+	}
+	if ledger.usedQuota > ledger.totalQuota || ledger.balance < -1e-9 {
+		t.Fatalf("ledger overspent: used=%d/%d, balance=%v", ledger.usedQuota, ledger.totalQuota, ledger.balance)
+	}
+	assertNoPending(t, d)
+}
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseEventEncoding 校验配置的扣费事件编码，未配置时使用旧版 JSON
+func parseEventEncoding(encoding string) (string, error) {
+	switch encoding {
+	case "":
+		return constants.EventEncodingJSON, nil
+	case constants.EventEncodingJSON, constants.EventEncodingProtobuf:
+		return encoding, nil
+	default:
+		return "", fmt.Errorf("unsupported rocketmq.event_encoding %q: must be %s or %s", encoding, constants.EventEncodingJSON, constants.EventEncodingProtobuf)
+	}
+}
+
+// encodeDeductEvent 按指定编码序列化扣费事件，返回消息体和对应的 content type
+// encoding 为空或 json 时使用旧版 JSON 格式；protobuf 时使用带版本的 EventEnvelope
+func encodeDeductEvent(event *biz.DeductEvent, encoding string) ([]byte, string, error) {
+	switch encoding {
+	case "", constants.EventEncodingJSON:
+		body, err := json.Marshal(event)
+		return body, constants.ContentTypeJSON, err
+	case constants.EventEncodingProtobuf:
+		envelope := &pb.EventEnvelope{
+			SchemaVersion: constants.EventSchemaVersion,
+			EventType:     constants.EventTypeDeduct,
+			ProducedAt:    timestamppb.Now(),
+			Payload:       &pb.EventEnvelope_Deduct{Deduct: deductEventToPB(event)},
+		}
+		body, err := proto.Marshal(envelope)
+		return body, constants.ContentTypeProtobuf, err
+	default:
+		return nil, "", fmt.Errorf("unsupported deduct event encoding: %s", encoding)
+	}
+}
+
+// encodeDeductEvents 将同一用户的多个扣费事件序列化为一条消息（原子批量扣费使用）
+// 只有一个事件时与 encodeDeductEvent 相同；多个事件时 JSON 编码为数组，protobuf 编码为 DeductEventBatch 信封
+// 注意：批量消息需要消费端先升级到能够解析批量事件的版本
+func encodeDeductEvents(events []*biz.DeductEvent, encoding string) ([]byte, string, error) {
+	if len(events) == 1 {
+		return encodeDeductEvent(events[0], encoding)
+	}
+	switch encoding {
+	case "", constants.EventEncodingJSON:
+		body, err := json.Marshal(events)
+		return body, constants.ContentTypeJSON, err
+	case constants.EventEncodingProtobuf:
+		batch := &pb.DeductEventBatch{Events: make([]*pb.DeductEvent, len(events))}
+		for i, event := range events {
+			batch.Events[i] = deductEventToPB(event)
+		}
+		envelope := &pb.EventEnvelope{
+			SchemaVersion: constants.EventSchemaVersion,
+			EventType:     constants.EventTypeDeductBatch,
+			ProducedAt:    timestamppb.Now(),
+			Payload:       &pb.EventEnvelope_DeductBatch{DeductBatch: batch},
+		}
+		body, err := proto.Marshal(envelope)
+		return body, constants.ContentTypeProtobuf, err
+	default:
+		return nil, "", fmt.Errorf("unsupported deduct event encoding: %s", encoding)
+	}
+}
+
+// decodeDeductEvents 反序列化扣费事件，同时兼容旧版 JSON 与 protobuf 信封，批量消息返回其中的全部事件
+// contentType 缺失时（旧版生产者不设置该属性）根据消息体内容判断编码
+func decodeDeductEvents(body []byte, contentType string) ([]*biz.DeductEvent, error) {
+	trimmed := bytes.TrimSpace(body)
+	if contentType == "" {
+		contentType = constants.ContentTypeProtobuf
+		if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+			contentType = constants.ContentTypeJSON
+		}
+	}
+
+	switch contentType {
+	case constants.ContentTypeJSON:
+		if len(trimmed) > 0 && trimmed[0] == '[' {
+			var events []*biz.DeductEvent
+			if err := json.Unmarshal(body, &events); err != nil {
+				return nil, err
+			}
+			return events, nil
+		}
+		var event biz.DeductEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, err
+		}
+		return []*biz.DeductEvent{&event}, nil
+	case constants.ContentTypeProtobuf:
+		var envelope pb.EventEnvelope
+		if err := proto.Unmarshal(body, &envelope); err != nil {
+			return nil, err
+		}
+		// 更高版本的事件只会新增字段，旧字段语义不变，因此可以按当前版本解析
+		switch {
+		case envelope.EventType == constants.EventTypeDeduct && envelope.GetDeduct() != nil:
+			return []*biz.DeductEvent{deductEventFromPB(envelope.GetDeduct())}, nil
+		case envelope.EventType == constants.EventTypeDeductBatch && envelope.GetDeductBatch() != nil:
+			events := make([]*biz.DeductEvent, len(envelope.GetDeductBatch().Events))
+			for i, event := range envelope.GetDeductBatch().Events {
+				events[i] = deductEventFromPB(event)
+			}
+			return events, nil
+		default:
+			return nil, fmt.Errorf("unexpected event: type=%s, schema_version=%d", envelope.EventType, envelope.SchemaVersion)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported deduct event content type: %s", contentType)
+	}
+}
+
+// deductEventToPB 领域事件转换为 protobuf 事件
+func deductEventToPB(event *biz.DeductEvent) *pb.DeductEvent {
+	return &pb.DeductEvent{
+		RecordId:        event.RecordID,
+		UserId:          event.UserID,
+		ServiceName:     event.ServiceName,
+		Count:           int32(event.Count),
+		Cost:            event.Cost,
+		FreeCount:       int32(event.FreeCount),
+		PaidCount:       int32(event.PaidCount),
+		BalanceDeducted: event.BalanceDeducted,
+		DeductTime:      timestamppb.New(event.DeductTime),
+		Month:           event.Period,
+		Metadata:        deductMetadataToPB(event.Metadata),
+		PackageCount:    int32(event.PackageCount),
+		MemberId:        event.MemberID,
+	}
+}
+
+// deductEventFromPB protobuf 事件转换为领域事件
+func deductEventFromPB(event *pb.DeductEvent) *biz.DeductEvent {
+	return &biz.DeductEvent{
+		RecordID:        event.RecordId,
+		UserID:          event.UserId,
+		ServiceName:     event.ServiceName,
+		Count:           int(event.Count),
+		Cost:            event.Cost,
+		FreeCount:       int(event.FreeCount),
+		PaidCount:       int(event.PaidCount),
+		BalanceDeducted: event.BalanceDeducted,
+		DeductTime:      event.DeductTime.AsTime(),
+		Period:          event.Month,
+		Metadata:        deductMetadataFromPB(event.Metadata),
+		PackageCount:    int(event.PackageCount),
+		MemberID:        event.MemberId,
+	}
+}
+
+// deductMetadataToPB 来源信息转换为 protobuf 事件字段
+func deductMetadataToPB(m *biz.DeductMetadata) *pb.DeductEventMetadata {
+	if m.IsEmpty() {
+		return nil
+	}
+	return &pb.DeductEventMetadata{
+		RequestId: m.RequestID,
+		ApiKeyId:  m.APIKeyID,
+		AppId:     m.AppID,
+		Operation: m.Operation,
+		Labels:    m.Labels,
+	}
+}
+
+// deductMetadataFromPB protobuf 事件字段转换为来源信息
+func deductMetadataFromPB(m *pb.DeductEventMetadata) *biz.DeductMetadata {
+	if m == nil {
+		return nil
+	}
+	return &biz.DeductMetadata{
+		RequestID: m.RequestId,
+		APIKeyID:  m.ApiKeyId,
+		AppID:     m.AppId,
+		Operation: m.Operation,
+		Labels:    m.Labels,
+	}
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// corpusEvent 兼容性语料中所有样本共同描述的扣费事件
+var corpusEvent = biz.DeductEvent{
+	RecordID:        "5f0c1a9e-3b2d-4c55-9a71-0d1e2f3a4b5c",
+	UserID:          "u_10001",
+	ServiceName:     "passport",
+	Count:           10,
+	Cost:            0.1,
+	FreeCount:       4,
+	PaidCount:       6,
+	BalanceDeducted: 0.06,
+	DeductTime:      time.Date(2025, 11, 20, 8, 30, 15, 0, time.UTC),
+	Period:          "2025-11",
+}
+
+func assertDeductEvent(t *testing.T, want, got *biz.DeductEvent) {
+	t.Helper()
+	if got.RecordID != want.RecordID || got.UserID != want.UserID || got.ServiceName != want.ServiceName ||
+		got.Count != want.Count || got.Cost != want.Cost || got.FreeCount != want.FreeCount ||
+		got.PaidCount != want.PaidCount || got.BalanceDeducted != want.BalanceDeducted || got.Period != want.Period ||
+		got.PackageCount != want.PackageCount {
+		t.Fatalf("event mismatch:\nwant %+v\ngot  %+v", want, got)
+	}
+	if !got.DeductTime.Equal(want.DeductTime) {
+		t.Fatalf("deduct_time mismatch: want %v, got %v", want.DeductTime, got.DeductTime)
+	}
+}
+
+func decodeSingleEvent(t *testing.T, body []byte, contentType string) *biz.DeductEvent {
+	t.Helper()
+	events, err := decodeDeductEvents(body, contentType)
+	if err != nil {
+		t.Fatalf("decode (content type %q): %v", contentType, err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("decoded %d events, want 1", len(events))
+	}
+	return events[0]
+}
+
+// TestDecodeDeductEventCorpus 兼容性语料：历史及未来版本生产者写出的消息都必须能被当前消费端解析
+// 语料文件一经提交不得修改，新增 schema 版本时追加新文件
+func TestDecodeDeductEventCorpus(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "deduct_event", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("empty deduct event corpus")
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			body, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			contentType := constants.ContentTypeJSON
+			if strings.HasSuffix(file, ".binpb") {
+				contentType = constants.ContentTypeProtobuf
+			}
+
+			// 携带 content type 属性（新版生产者）
+			assertDeductEvent(t, &corpusEvent, decodeSingleEvent(t, body, contentType))
+
+			// 不携带 content type 属性（旧版生产者），根据消息体判断编码
+			assertDeductEvent(t, &corpusEvent, decodeSingleEvent(t, body, ""))
+		})
+	}
+}
+
+func TestEncodeDeductEventRoundTrip(t *testing.T) {
+	for _, encoding := range []string{"", constants.EventEncodingJSON, constants.EventEncodingProtobuf} {
+		body, contentType, err := encodeDeductEvent(&corpusEvent, encoding)
+		if err != nil {
+			t.Fatalf("encode %q: %v", encoding, err)
+		}
+		assertDeductEvent(t, &corpusEvent, decodeSingleEvent(t, body, contentType))
+	}
+
+	if _, _, err := encodeDeductEvent(&corpusEvent, "xml"); err == nil {
+		t.Fatal("expected error for unsupported encoding")
+	}
+}
+
+// TestEncodeDeductEventPackageCount 用量包扣减量在两种编码下都能往返，旧版事件（无该字段）按 0 解析
+func TestEncodeDeductEventPackageCount(t *testing.T) {
+	event := corpusEvent
+	event.FreeCount, event.PackageCount, event.PaidCount, event.BalanceDeducted = 4, 5, 1, 0.01
+	for _, encoding := range []string{constants.EventEncodingJSON, constants.EventEncodingProtobuf} {
+		body, contentType, err := encodeDeductEvent(&event, encoding)
+		if err != nil {
+			t.Fatalf("encode %q: %v", encoding, err)
+		}
+		assertDeductEvent(t, &event, decodeSingleEvent(t, body, contentType))
+	}
+}
+
+// TestEncodeDeductEventsBatch 原子批量扣费的多个事件编码为一条消息，两种编码下都能按顺序解析出全部事件
+func TestEncodeDeductEventsBatch(t *testing.T) {
+	second := corpusEvent
+	second.RecordID, second.ServiceName, second.FreeCount, second.PackageCount = "rec-2", "asset", 0, 3
+	want := []*biz.DeductEvent{&corpusEvent, &second}
+	for _, encoding := range []string{constants.EventEncodingJSON, constants.EventEncodingProtobuf} {
+		body, contentType, err := encodeDeductEvents(want, encoding)
+		if err != nil {
+			t.Fatalf("encode %q: %v", encoding, err)
+		}
+		for _, ct := range []string{contentType, ""} {
+			got, err := decodeDeductEvents(body, ct)
+			if err != nil {
+				t.Fatalf("decode %q (content type %q): %v", encoding, ct, err)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("decode %q: %d events, want %d", encoding, len(got), len(want))
+			}
+			for i := range want {
+				assertDeductEvent(t, want[i], got[i])
+			}
+		}
+	}
+}
+
+// TestParseEventEncoding 未配置时使用 JSON，未知编码在启动时拒绝
+func TestParseEventEncoding(t *testing.T) {
+	cases := []struct {
+		encoding string
+		want     string
+		wantErr  bool
+	}{
+		{encoding: "", want: constants.EventEncodingJSON},
+		{encoding: constants.EventEncodingJSON, want: constants.EventEncodingJSON},
+		{encoding: constants.EventEncodingProtobuf, want: constants.EventEncodingProtobuf},
+		{encoding: "protobuff", wantErr: true},
+		{encoding: "JSON", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := parseEventEncoding(tc.encoding)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("parseEventEncoding(%q) = %q, %v, want %q, error %v", tc.encoding, got, err, tc.want, tc.wantErr)
+		}
+	}
+
+	// NewData 在连接 MySQL 之前拒绝
+	c := &conf.Data{Rocketmq: &conf.Data_RocketMQ{Enabled: true, EventEncoding: "avro"}}
+	if _, _, err := NewData(c, log.DefaultLogger); err == nil || !strings.Contains(err.Error(), "event_encoding") {
+		t.Errorf("NewData with unknown encoding: err = %v", err)
+	}
+}
+
+// TestEncodeDeductEventMetadata 来源信息在两种编码下都能往返，未携带来源信息时解析为 nil
+func TestEncodeDeductEventMetadata(t *testing.T) {
+	withMeta := corpusEvent
+	withMeta.Metadata = &biz.DeductMetadata{RequestID: "req-1", APIKeyID: "key-1", AppID: "app1", Operation: "/v1/verify", Labels: map[string]string{"env": "prod"}}
+	for _, encoding := range []string{constants.EventEncodingJSON, constants.EventEncodingProtobuf} {
+		for _, event := range []*biz.DeductEvent{&withMeta, &corpusEvent} {
+			body, contentType, err := encodeDeductEvent(event, encoding)
+			if err != nil {
+				t.Fatalf("encode %q: %v", encoding, err)
+			}
+			got := decodeSingleEvent(t, body, contentType).Metadata
+			if want := event.Metadata; want == nil {
+				if got != nil {
+					t.Errorf("%s: metadata = %+v, want nil", encoding, got)
+				}
+			} else if got == nil || got.RequestID != want.RequestID || got.APIKeyID != want.APIKeyID || got.AppID != want.AppID ||
+				got.Operation != want.Operation || !maps.Equal(got.Labels, want.Labels) {
+				t.Errorf("%s: metadata = %+v, want %+v", encoding, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultDeferredJournalPath 默认延迟扣费日志文件
+const defaultDeferredJournalPath = "./data/deferred_charges.journal"
+
+// 日志条目类型
+const (
+	journalOpAdd     = "add"
+	journalOpSettled = "settled"
+)
+
+// journalEntry 延迟扣费日志条目（每行一个 JSON）
+type journalEntry struct {
+	Op       string         `json:"op"`
+	Charge   *journalCharge `json:"charge,omitempty"`
+	RecordID string         `json:"record_id,omitempty"` // settled 条目
+}
+
+// journalCharge 日志中的延迟扣费，字段名固定以兼容升级前写入的日志
+type journalCharge struct {
+	RecordID    string            `json:"record_id"`
+	UserID      string            `json:"user_id"`
+	MemberID    string            `json:"member_id,omitempty"`
+	ServiceName string            `json:"service_name"`
+	Count       int               `json:"count"`
+	Cost        float64           `json:"cost"`
+	Period      string            `json:"period,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	Unrated     bool              `json:"unrated,omitempty"`
+	CallerCost  float64           `json:"caller_cost,omitempty"`
+	Unresolved  bool              `json:"unresolved,omitempty"`
+	RequestID   string            `json:"request_id,omitempty"`
+	APIKeyID    string            `json:"api_key_id,omitempty"`
+	AppID       string            `json:"app_id,omitempty"`
+	Operation   string            `json:"operation,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// fileDeferredJournal 本地追加写日志：放行时追加 add，处理后追加 settled，每轮结算后以未结算记录重写
+type fileDeferredJournal struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	log  *log.Helper
+}
+
+// NewDeferredChargeJournal 创建延迟扣费日志（返回 biz.DeferredChargeJournal 接口）
+func NewDeferredChargeJournal(c *conf.Data, logger log.Logger) (biz.DeferredChargeJournal, func(), error) {
+	path := defaultDeferredJournalPath
+	if c.DeferredJournalPath != "" {
+		path = c.DeferredJournalPath
+	}
+	j, err := newFileDeferredJournal(path, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		if err := j.f.Close(); err != nil {
+			j.log.Errorf("close deferred charge journal: %v", err)
+		}
+	}
+	return j, cleanup, nil
+}
+
+func newFileDeferredJournal(path string, logger log.Logger) (*fileDeferredJournal, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	// 上次退出时留下不完整的行：补换行，避免新条目接在其后无法解析
+	if body, err := os.ReadFile(path); err == nil && len(body) > 0 && body[len(body)-1] != '\n' {
+		if _, err := f.Write([]byte{'\n'}); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return &fileDeferredJournal{path: path, f: f, log: log.NewHelper(logger)}, nil
+}
+
+// Load 重放日志，返回未标记 settled 的记录
+// 进程在写入过程中退出可能留下不完整的行，跳过无法解析的行
+func (j *fileDeferredJournal) Load() ([]*biz.DeferredCharge, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	body, err := os.ReadFile(j.path)
+	if err != nil {
+		return nil, err
+	}
+	var order []string
+	charges := make(map[string]*biz.DeferredCharge)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			j.log.Warnf("skip invalid deferred charge journal line %d: %v", line, err)
+			continue
+		}
+		switch entry.Op {
+		case journalOpAdd:
+			if entry.Charge == nil {
+				continue
+			}
+			if _, ok := charges[entry.Charge.RecordID]; !ok {
+				order = append(order, entry.Charge.RecordID)
+			}
+			charges[entry.Charge.RecordID] = fromJournalCharge(entry.Charge)
+		case journalOpSettled:
+			delete(charges, entry.RecordID)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	pending := make([]*biz.DeferredCharge, 0, len(charges))
+	for _, id := range order {
+		if charge, ok := charges[id]; ok {
+			pending = append(pending, charge)
+			delete(charges, id)
+		}
+	}
+	return pending, nil
+}
+
+// Append 追加延迟扣费并落盘
+func (j *fileDeferredJournal) Append(charge *biz.DeferredCharge) error {
+	return j.write(&journalEntry{Op: journalOpAdd, Charge: toJournalCharge(charge)})
+}
+
+// MarkSettled 追加已处理标记并落盘
+func (j *fileDeferredJournal) MarkSettled(recordID string) error {
+	return j.write(&journalEntry{Op: journalOpSettled, RecordID: recordID})
+}
+
+func (j *fileDeferredJournal) write(entry *journalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.f.Write(line); err != nil {
+		return err
+	}
+	return j.f.Sync()
+}
+
+// Compact 写入临时文件后原子替换日志，未结算记录为空时得到空日志
+func (j *fileDeferredJournal) Compact(pending []*biz.DeferredCharge) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, charge := range pending {
+		if err := enc.Encode(&journalEntry{Op: journalOpAdd, Charge: toJournalCharge(charge)}); err != nil {
+			return err
+		}
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+
+	// 旧文件句柄指向替换前的文件，重新打开
+	next, err := os.OpenFile(j.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	j.f.Close()
+	j.f = next
+	return nil
+}
+
+func toJournalCharge(c *biz.DeferredCharge) *journalCharge {
+	jc := &journalCharge{
+		RecordID:    c.RecordID,
+		UserID:      c.UserID,
+		MemberID:    c.MemberID,
+		ServiceName: c.ServiceName,
+		Count:       c.Count,
+		Cost:        c.Cost,
+		Period:      c.Period,
+		CreatedAt:   c.CreatedAt,
+		Unrated:     c.Unrated,
+		CallerCost:  c.CallerCost,
+		Unresolved:  c.Unresolved,
+	}
+	if m := c.Metadata; !m.IsEmpty() {
+		jc.RequestID, jc.APIKeyID, jc.AppID, jc.Operation, jc.Labels = m.RequestID, m.APIKeyID, m.AppID, m.Operation, m.Labels
+	}
+	return jc
+}
+
+func fromJournalCharge(jc *journalCharge) *biz.DeferredCharge {
+	c := &biz.DeferredCharge{
+		RecordID:    jc.RecordID,
+		UserID:      jc.UserID,
+		MemberID:    jc.MemberID,
+		ServiceName: jc.ServiceName,
+		Count:       jc.Count,
+		Cost:        jc.Cost,
+		Period:      jc.Period,
+		CreatedAt:   jc.CreatedAt,
+		Unrated:     jc.Unrated,
+		CallerCost:  jc.CallerCost,
+		Unresolved:  jc.Unresolved,
+	}
+	m := &biz.DeductMetadata{RequestID: jc.RequestID, APIKeyID: jc.APIKeyID, AppID: jc.AppID, Operation: jc.Operation, Labels: jc.Labels}
+	if !m.IsEmpty() {
+		c.Metadata = m
+	}
+	return c
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestCharge(id string) *biz.DeferredCharge {
+	return &biz.DeferredCharge{
+		RecordID:    id,
+		UserID:      testUserID,
+		ServiceName: testService,
+		Count:       3,
+		Cost:        0.03,
+		Period:      testMonth,
+		CreatedAt:   time.Date(2025, 11, 20, 8, 30, 15, 0, time.UTC),
+		Metadata:    &biz.DeductMetadata{RequestID: "req-" + id},
+	}
+}
+
+func loadIDs(t *testing.T, path string) []string {
+	t.Helper()
+	j, err := newFileDeferredJournal(path, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.f.Close()
+	pending, err := j.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(pending))
+	for _, c := range pending {
+		ids = append(ids, c.RecordID)
+	}
+	return ids
+}
+
+func assertIDs(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("pending = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("pending = %v, want %v", got, want)
+		}
+	}
+}
+
+// TestDeferredJournalReplay 重启后重放未结算的延迟扣费，已标记的不再返回，不完整的尾行被跳过
+func TestDeferredJournalReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deferred.journal")
+	j, err := newFileDeferredJournal(path, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if err := j.Append(newTestCharge(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := j.MarkSettled("b"); err != nil {
+		t.Fatal(err)
+	}
+	// 模拟写入过程中进程退出
+	if _, err := j.f.WriteString(`{"op":"add","charge":{"record_id":"d"`); err != nil {
+		t.Fatal(err)
+	}
+	j.f.Close()
+
+	assertIDs(t, loadIDs(t, path), "a", "c")
+
+	// 重新打开后的追加不受不完整行影响
+	j, err = newFileDeferredJournal(path, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Append(newTestCharge("e")); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := j.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := newTestCharge("a")
+	got := pending[0]
+	if got.UserID != want.UserID || got.Count != want.Count || got.Cost != want.Cost || got.Period != want.Period ||
+		!got.CreatedAt.Equal(want.CreatedAt) || got.Metadata == nil || got.Metadata.RequestID != want.Metadata.RequestID {
+		t.Fatalf("replayed charge = %+v, want %+v", got, want)
+	}
+	if len(pending) != 3 || pending[2].RecordID != "e" {
+		t.Fatalf("pending after append = %d records, want a, c, e", len(pending))
+	}
+	j.f.Close()
+}
+
+// TestDeferredJournalCompact 重写后只保留未结算记录，之后的追加写入新文件
+func TestDeferredJournalCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deferred.journal")
+	j, err := newFileDeferredJournal(path, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.f.Close()
+	for _, id := range []string{"a", "b"} {
+		if err := j.Append(newTestCharge(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := j.MarkSettled("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Compact([]*biz.DeferredCharge{newTestCharge("b")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Append(newTestCharge("c")); err != nil {
+		t.Fatal(err)
+	}
+	assertIDs(t, loadIDs(t, path), "b", "c")
+
+	if err := j.Compact(nil); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != 0 {
+		t.Fatalf("compacted journal size = %v, err = %v, want empty", info, err)
+	}
+}
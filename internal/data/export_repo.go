@@ -0,0 +1,245 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// exportRepo 账单导出任务及账单数据访问
+type exportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewExportRepo 创建账单导出 repo（返回 biz.ExportRepo 接口）
+func NewExportRepo(data *Data, logger log.Logger) biz.ExportRepo {
+	return &exportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateExportJob 创建导出任务
+func (r *exportRepo) CreateExportJob(ctx context.Context, job *biz.ExportJob) error {
+	m := toExportJobModel(job)
+	return r.data.db.WithContext(ctx).Create(m).Error
+}
+
+// GetExportJob 获取导出任务，不存在时返回 nil
+func (r *exportRepo) GetExportJob(ctx context.Context, exportID string) (*biz.ExportJob, error) {
+	var m model.ExportJob
+	if err := r.data.db.WithContext(ctx).Where("export_id = ?", exportID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizExportJob(&m), nil
+}
+
+// UpdateExportJob 更新导出任务状态与结果
+func (r *exportRepo) UpdateExportJob(ctx context.Context, job *biz.ExportJob) error {
+	m := toExportJobModel(job)
+	return r.data.db.WithContext(ctx).Model(&model.ExportJob{}).Where("export_id = ?", job.ExportID).
+		Select("status", "file_key", "file_size", "row_count", "error_message", "started_at", "finished_at", "expires_at").
+		Updates(m).Error
+}
+
+// ClaimExportJobs 领取待执行的任务及执行超时的任务
+// 通过带状态条件的 UPDATE 抢占，多实例同时扫描时同一任务只会被一个实例领取
+func (r *exportRepo) ClaimExportJobs(ctx context.Context, staleBefore time.Time, limit int) ([]*biz.ExportJob, error) {
+	db := r.data.db.WithContext(ctx)
+	claimable := db.Where("status = ?", constants.ExportStatusPending).
+		Or("status = ? AND started_at < ?", constants.ExportStatusRunning, staleBefore)
+
+	var candidates []model.ExportJob
+	if err := db.Model(&model.ExportJob{}).Where(claimable).Order("created_at").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	var jobs []*biz.ExportJob
+	for i := range candidates {
+		m := &candidates[i]
+		now := time.Now()
+		res := db.Model(&model.ExportJob{}).
+			Where("export_id = ?", m.ExportID).
+			Where(db.Where("status = ?", constants.ExportStatusPending).
+				Or("status = ? AND started_at < ?", constants.ExportStatusRunning, staleBefore)).
+			Updates(map[string]interface{}{"status": constants.ExportStatusRunning, "started_at": now})
+		if res.Error != nil {
+			return jobs, res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 已被其他实例领取
+			continue
+		}
+		m.Status = constants.ExportStatusRunning
+		m.StartedAt = &now
+		jobs = append(jobs, toBizExportJob(m))
+	}
+	return jobs, nil
+}
+
+// ListExpiredExportJobs 获取文件已过期的已完成任务
+func (r *exportRepo) ListExpiredExportJobs(ctx context.Context, before time.Time, limit int) ([]*biz.ExportJob, error) {
+	var models []model.ExportJob
+	err := r.data.db.WithContext(ctx).
+		Where("status = ? AND expires_at < ?", constants.ExportStatusSuccess, before).
+		Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*biz.ExportJob, 0, len(models))
+	for i := range models {
+		jobs = append(jobs, toBizExportJob(&models[i]))
+	}
+	return jobs, nil
+}
+
+// CountStatementRows 统计时间范围内的消费记录与成功充值订单数
+func (r *exportRepo) CountStatementRows(ctx context.Context, userID string, start, end time.Time) (int64, error) {
+	var records, recharges int64
+	db := r.data.db.WithContext(ctx)
+	if err := db.Model(&model.BillingRecord{}).
+		Where("uid = ? AND created_at >= ? AND created_at < ?", userID, start, end).
+		Count(&records).Error; err != nil {
+		return 0, err
+	}
+	if err := db.Model(&model.RechargeOrder{}).
+		Where("uid = ? AND status = ? AND order_type IN ? AND updated_at >= ? AND updated_at < ?",
+			userID, model.RechargeStatusSuccess, balanceOrderTypes, start, end).
+		Count(&recharges).Error; err != nil {
+		return 0, err
+	}
+	return records + recharges, nil
+}
+
+// ScanStatementRecords 按 (created_at, billing_record_id) 正序 keyset 分批读取消费记录
+func (r *exportRepo) ScanStatementRecords(ctx context.Context, userID string, start, end time.Time, batchSize int, fn func([]*biz.BillingRecord) error) error {
+	var lastTime time.Time
+	var lastID string
+	for {
+		db := r.data.db.WithContext(ctx).
+			Where("uid = ? AND created_at >= ? AND created_at < ?", userID, start, end)
+		if lastID != "" {
+			db = db.Where("(created_at > ? OR (created_at = ? AND billing_record_id > ?))", lastTime, lastTime, lastID)
+		}
+		var models []model.BillingRecord
+		if err := db.Order("created_at, billing_record_id").Limit(batchSize).Find(&models).Error; err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			return nil
+		}
+
+		records := make([]*biz.BillingRecord, 0, len(models))
+		for _, m := range models {
+			records = append(records, &biz.BillingRecord{
+				ID:          m.BillingRecordID,
+				UID:         m.UID,
+				ServiceName: m.ServiceName,
+				Type:        m.Type,
+				Amount:      m.Amount,
+				Count:       m.Count,
+				CreatedAt:   m.CreatedAt,
+			})
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+		if len(models) < batchSize {
+			return nil
+		}
+		last := models[len(models)-1]
+		lastTime, lastID = last.CreatedAt, last.BillingRecordID
+	}
+}
+
+// ListStatementRecharges 获取时间范围内成功的充值订单（含合同预付，按到账时间正序），用量包订单不增加余额，不计入
+func (r *exportRepo) ListStatementRecharges(ctx context.Context, userID string, start, end time.Time) ([]*biz.RechargeOrder, error) {
+	var models []model.RechargeOrder
+	err := r.data.db.WithContext(ctx).
+		Where("uid = ? AND status = ? AND order_type IN ? AND updated_at >= ? AND updated_at < ?",
+			userID, model.RechargeStatusSuccess, balanceOrderTypes, start, end).
+		Order("updated_at, order_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*biz.RechargeOrder, 0, len(models))
+	for _, m := range models {
+		orders = append(orders, &biz.RechargeOrder{
+			OrderID:   m.OrderID,
+			UID:       m.UID,
+			Amount:    m.Amount,
+			PaymentID: m.PaymentID,
+			Status:    m.Status,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+		})
+	}
+	return orders, nil
+}
+
+func toExportJobModel(job *biz.ExportJob) *model.ExportJob {
+	return &model.ExportJob{
+		ExportID:     job.ExportID,
+		UID:          job.UserID,
+		Format:       job.Format,
+		Lang:         job.Lang,
+		StartTime:    job.StartTime,
+		EndTime:      job.EndTime,
+		Status:       job.Status,
+		FileKey:      job.FileKey,
+		FileSize:     job.FileSize,
+		RowCount:     job.RowCount,
+		ErrorMessage: job.ErrorMessage,
+		CreatedAt:    job.CreatedAt,
+		StartedAt:    timePtr(job.StartedAt),
+		FinishedAt:   timePtr(job.FinishedAt),
+		ExpiresAt:    timePtr(job.ExpiresAt),
+	}
+}
+
+func toBizExportJob(m *model.ExportJob) *biz.ExportJob {
+	job := &biz.ExportJob{
+		ExportID:     m.ExportID,
+		UserID:       m.UID,
+		Format:       m.Format,
+		Lang:         m.Lang,
+		StartTime:    m.StartTime,
+		EndTime:      m.EndTime,
+		Status:       m.Status,
+		FileKey:      m.FileKey,
+		FileSize:     m.FileSize,
+		RowCount:     m.RowCount,
+		ErrorMessage: m.ErrorMessage,
+		CreatedAt:    m.CreatedAt,
+	}
+	if m.StartedAt != nil {
+		job.StartedAt = *m.StartedAt
+	}
+	if m.FinishedAt != nil {
+		job.FinishedAt = *m.FinishedAt
+	}
+	if m.ExpiresAt != nil {
+		job.ExpiresAt = *m.ExpiresAt
+	}
+	return job
+}
+
+// timePtr 零值时间转为 NULL
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
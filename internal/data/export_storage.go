@@ -0,0 +1,118 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultExportDir 默认本地导出目录
+const defaultExportDir = "./data/exports"
+
+// NewExportStorage 按配置创建导出文件存储
+func NewExportStorage(c *conf.Data, logger log.Logger) (biz.ExportStorage, error) {
+	driver := constants.ExportStorageLocal
+	dir := defaultExportDir
+	if s := c.ExportStorage; s != nil {
+		if s.Driver != "" {
+			driver = s.Driver
+		}
+		if s.LocalDir != "" {
+			dir = s.LocalDir
+		}
+	}
+	switch driver {
+	case constants.ExportStorageLocal:
+		log.NewHelper(logger).Infof("Export storage: local, dir=%s", dir)
+		return newLocalExportStorage(dir)
+	}
+	return nil, fmt.Errorf("unsupported export storage driver: %s", driver)
+}
+
+// localExportStorage 本地文件存储，下载由服务的签名链接提供
+// 多实例部署时目录需为共享存储（如 NFS），否则只能从生成文件的实例下载
+type localExportStorage struct {
+	dir string
+}
+
+func newLocalExportStorage(dir string) (*localExportStorage, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &localExportStorage{dir: dir}, nil
+}
+
+// path 将 key 映射为目录内路径，拒绝越出目录的 key
+func (s *localExportStorage) path(key string) (string, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(p, filepath.Clean(s.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid export file key: %s", key)
+	}
+	return p, nil
+}
+
+// Save 先写临时文件再重命名，避免读到不完整的文件
+func (s *localExportStorage) Save(ctx context.Context, key string, r io.Reader) (int64, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return 0, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Open 打开文件
+func (s *localExportStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+// Delete 删除文件，文件不存在时不报错
+func (s *localExportStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// DownloadURL 本地存储不直接提供下载地址
+func (s *localExportStorage) DownloadURL(ctx context.Context, key string, expiresAt time.Time) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TestFreeQuotaRolloverRoundTrip 结转额度随额度记录保存与读取；已用额度包含在途扣费且不超过总额度，
+// 计算下一个周期的结转时在途扣费同样计入
+func TestFreeQuotaRolloverRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	newTestDB(t, d, &model.FreeQuota{})
+	r := &freeQuotaRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+
+	start := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	if err := r.CreateFreeQuota(ctx, &biz.FreeQuota{
+		UID: testUserID, ServiceName: testService, TotalQuota: 135, UsedQuota: 40, RolloverQuota: 35,
+		Period: testMonth, Cycle: constants.QuotaCycleMonthly, PeriodStart: start, PeriodEnd: start.AddDate(0, 1, 0),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	quota, err := r.GetFreeQuota(ctx, testUserID, testService, testMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.TotalQuota != 135 || quota.RolloverQuota != 35 || quota.UsedQuota != 40 || quota.RolloverUsed() != 35 {
+		t.Errorf("quota = %+v, rollover used = %d", *quota, quota.RolloverUsed())
+	}
+
+	// 10 次在途扣费尚未落库：发放部分已用 15，未用 85
+	mr.HSet(pendingQuotaKey(testUserID, testService, testMonth), pendingFieldIssued, "10")
+	quota, err = r.GetFreeQuota(ctx, testUserID, testService, testMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.UsedQuota != 50 || quota.RolloverEligible(50) != 42 {
+		t.Errorf("with pending: used = %d, eligible = %d, want 50, 42", quota.UsedQuota, quota.RolloverEligible(50))
+	}
+
+	mr.HSet(pendingQuotaKey(testUserID, testService, testMonth), pendingFieldIssued, "500")
+	quota, err = r.GetFreeQuota(ctx, testUserID, testService, testMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.UsedQuota != 135 || quota.RolloverEligible(100) != 0 {
+		t.Errorf("over-issued pending: used = %d, eligible = %d, want 135, 0", quota.UsedQuota, quota.RolloverEligible(100))
+	}
+}
@@ -0,0 +1,571 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// 额度租约
+//
+// 申请租约时在 Redis 中一次性预留 N 次调用：按免费额度 → 用量包 → 余额的顺序从缓存扣减，并累加在途计数 issued，
+// 因此 GetAccount / CheckQuota / DB 事务扣费都会把未结束的租约计入已用部分。
+// 网关上报的增量用量转为普通扣费事件（按同样的顺序计入预留的各部分），落库后按事件累加 settled；
+// 释放或过期回收时，未用部分回补缓存并累加 settled，在途计数归零。
+//
+// 租约保存在 lease:{lease_id} hash 中，过期时间同时写入 lease_expiry zset 供回收扫描。
+// 用量包部分落库时按上报时有效的用量包先到期先用扣减，租约期间用量包到期导致的不足与 Lua 扣费一样只告警。
+//
+// hash 中记录申请租约的内部调用方与服务，上报与释放须为同一调用方与服务，否则按租约不存在处理；
+// 升级前创建的租约没有 caller 与用量包字段，不校验调用方，用量包部分按 0 处理。
+//
+// 预留余额同样受硬性预算约束：授予的付费次数不超过预算剩余金额，预留金额计入本月消费缓存，
+// 释放或回收时从消费缓存扣回未用部分（与在途计数一致，回填后的消费缓存同样包含未结束租约的预留）。
+// 组织成员的消费上限与硬性预算相同：授予的付费次数不超过成员本月剩余可消费金额，预留金额计入成员消费缓存。
+
+// leaseKeyTTL 租约 hash 兜底过期时间，与在途计数一致（正常情况下由释放/回收删除）
+const leaseKeyTTL = pendingTTL
+
+// acquireLeaseScript 预留租约额度，额度/用量包/余额/预算不足时按可用部分授予
+// 返回 {code, freeGranted, packageGranted, paidGranted}
+// code: 1 成功, 0 无可授予次数, 2 超出预算（无可授予次数）, 3 超出成员消费上限（无可授予次数）,
+// -1 额度缓存缺失, -2 余额缓存缺失, -3 预算缓存缺失, -4 用量包缓存缺失, -5 成员消费缓存缺失
+const acquireLeaseScript = budgetScriptFuncs + spendLimitScriptFuncs + `
+local quotaKey = KEYS[1]
+local balanceKey = KEYS[2]
+local pendingQuotaKey = KEYS[3]
+local pendingBalanceKey = KEYS[4]
+local leaseKey = KEYS[5]
+local expiryKey = KEYS[6]
+local budgetKey = KEYS[7]
+local serviceSpentKey = KEYS[8]
+local allSpentKey = KEYS[9]
+local packageKey = KEYS[10]
+local pendingPackageKey = KEYS[11]
+local memberSpentKey = KEYS[12]
+local count = tonumber(ARGV[1])
+local unitPrice = tonumber(ARGV[2])
+local hasQuota = ARGV[3] == '1'
+local pendingTTL = tonumber(ARGV[4])
+local spendLimit = tonumber(ARGV[14])
+
+local free = 0
+if hasQuota then
+    local quota = redis.call('GET', quotaKey)
+    if not quota then
+        return {-1, 0, 0, 0}
+    end
+    free = math.max(math.min(tonumber(quota), count), 0)
+end
+
+local package = 0
+if free < count then
+    local packageRemaining = redis.call('GET', packageKey)
+    if not packageRemaining then
+        return {-4, 0, 0, 0}
+    end
+    package = math.max(math.min(tonumber(packageRemaining), count - free), 0)
+end
+
+local paid = 0
+if free + package < count then
+    if unitPrice <= 0 then
+        paid = count - free - package
+    else
+        local balance = redis.call('GET', balanceKey)
+        if not balance then
+            return {-2, 0, 0, 0}
+        end
+        paid = math.max(math.min(count - free - package, math.floor(tonumber(balance) / unitPrice)), 0)
+    end
+end
+
+-- 硬性预算：付费次数不超过预算剩余金额
+local budgetLimited = false
+if paid > 0 and unitPrice > 0 then
+    local code, remaining = budgetRemaining(budgetKey, serviceSpentKey, allSpentKey, ARGV[12])
+    if code ~= 1 then
+        return {code, 0, 0, 0}
+    end
+    if remaining ~= nil then
+        local allowed = math.max(math.floor((remaining + 1e-9) / unitPrice), 0)
+        if allowed < paid then
+            paid = allowed
+            budgetLimited = true
+        end
+    end
+end
+
+-- 成员消费上限：付费次数不超过成员本月剩余可消费金额
+local memberLimited = false
+if paid > 0 and unitPrice > 0 then
+    local code, remaining = spendLimitRemaining(memberSpentKey, spendLimit)
+    if code ~= 1 then
+        return {code, 0, 0, 0}
+    end
+    if remaining ~= nil then
+        local allowed = math.max(math.floor((remaining + 1e-9) / unitPrice), 0)
+        if allowed < paid then
+            paid = allowed
+            memberLimited = true
+        end
+    end
+end
+
+if free + package + paid == 0 then
+    if budgetLimited then
+        return {2, 0, 0, 0}
+    end
+    if memberLimited then
+        return {3, 0, 0, 0}
+    end
+    return {0, 0, 0, 0}
+end
+
+if free > 0 then
+    redis.call('DECRBY', quotaKey, free)
+    redis.call('HINCRBY', pendingQuotaKey, 'issued', free)
+    redis.call('EXPIRE', pendingQuotaKey, pendingTTL)
+end
+if package > 0 then
+    redis.call('DECRBY', packageKey, package)
+    redis.call('HINCRBY', pendingPackageKey, 'issued', package)
+    redis.call('EXPIRE', pendingPackageKey, pendingTTL)
+end
+local reserved = paid * unitPrice
+if reserved > 0 then
+    redis.call('INCRBYFLOAT', balanceKey, -reserved)
+    redis.call('HINCRBYFLOAT', pendingBalanceKey, 'issued', reserved)
+    redis.call('EXPIRE', pendingBalanceKey, pendingTTL)
+    addBudgetSpent(serviceSpentKey, allSpentKey, reserved)
+    addMemberSpent(memberSpentKey, reserved)
+end
+
+redis.call('HSET', leaseKey,
+    'uid', ARGV[5], 'service', ARGV[6], 'month', ARGV[7], 'unit_price', ARGV[2],
+    'free_granted', free, 'package_granted', package, 'paid_granted', paid,
+    'free_used', 0, 'package_used', 0, 'paid_used', 0,
+    'expires_at', ARGV[8], 'member_uid', ARGV[10], 'caller', ARGV[11], 'budget_month', ARGV[13])
+redis.call('EXPIRE', leaseKey, pendingTTL)
+redis.call('ZADD', expiryKey, ARGV[8], ARGV[9])
+return {1, free, package, paid}
+`
+
+// reportLeaseScript 记录增量用量（依次计入免费额度、用量包、余额部分），renewUntil > 0 时续期
+// 返回 {code, freeDelta, packageDelta, paidDelta}
+// code: 1 成功, -1 租约不存在（或不属于调用方）, -2 已过期无法续期, -3 用量超出剩余次数
+const reportLeaseScript = `
+local leaseKey = KEYS[1]
+local expiryKey = KEYS[2]
+if redis.call('EXISTS', leaseKey) == 0 then
+    return {-1, 0, 0, 0}
+end
+local owner = redis.call('HMGET', leaseKey, 'caller', 'service')
+if (owner[1] and owner[1] ~= ARGV[5]) or owner[2] ~= ARGV[6] then
+    return {-1, 0, 0, 0}
+end
+local l = redis.call('HMGET', leaseKey, 'free_granted', 'package_granted', 'paid_granted',
+    'free_used', 'package_used', 'paid_used', 'expires_at')
+local freeGranted = tonumber(l[1])
+local packageGranted = tonumber(l[2] or '0')
+local paidGranted = tonumber(l[3])
+local freeUsed = tonumber(l[4])
+local packageUsed = tonumber(l[5] or '0')
+local paidUsed = tonumber(l[6])
+local expiresAt = tonumber(l[7])
+local used = tonumber(ARGV[1])
+local now = tonumber(ARGV[2])
+local renewUntil = tonumber(ARGV[3])
+
+if renewUntil > 0 and expiresAt < now then
+    return {-2, 0, 0, 0}
+end
+if used > freeGranted + packageGranted + paidGranted - freeUsed - packageUsed - paidUsed then
+    return {-3, 0, 0, 0}
+end
+
+local freeDelta = math.min(used, freeGranted - freeUsed)
+local packageDelta = math.min(used - freeDelta, packageGranted - packageUsed)
+local paidDelta = used - freeDelta - packageDelta
+if freeDelta > 0 then
+    redis.call('HINCRBY', leaseKey, 'free_used', freeDelta)
+end
+if packageDelta > 0 then
+    redis.call('HINCRBY', leaseKey, 'package_used', packageDelta)
+end
+if paidDelta > 0 then
+    redis.call('HINCRBY', leaseKey, 'paid_used', paidDelta)
+end
+if renewUntil > 0 then
+    redis.call('HSET', leaseKey, 'expires_at', ARGV[3])
+    redis.call('ZADD', expiryKey, ARGV[3], ARGV[4])
+end
+return {1, freeDelta, packageDelta, paidDelta}
+`
+
+// rollbackLeaseUsageScript 撤销一次用量记录（扣费事件未能投递时使用）
+const rollbackLeaseUsageScript = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+    return 0
+end
+redis.call('HINCRBY', KEYS[1], 'free_used', -tonumber(ARGV[1]))
+if tonumber(ARGV[2]) > 0 then
+    redis.call('HINCRBY', KEYS[1], 'package_used', -tonumber(ARGV[2]))
+end
+redis.call('HINCRBY', KEYS[1], 'paid_used', -tonumber(ARGV[3]))
+return 1
+`
+
+// releaseLeaseScript 删除租约，未用部分回补缓存、扣回在途计数与预算消费缓存
+// 缓存不存在时不回补，下次回填会按 DB 值 - 在途值重新计算
+// ARGV[2] 为 1 时校验调用方与服务（网关释放），过期回收不校验
+// KEYS[9] 起为预算与成员消费缓存（升级前创建的租约没有预算月份，不传）
+// 返回 {code, freeUnused, packageUnused, paidUnused}，code: 1 成功, -1 租约不存在（或不属于调用方）
+const releaseLeaseScript = `
+local leaseKey = KEYS[1]
+local expiryKey = KEYS[2]
+if redis.call('EXISTS', leaseKey) == 0 then
+    redis.call('ZREM', expiryKey, ARGV[1])
+    return {-1, 0, 0, 0}
+end
+if ARGV[2] == '1' then
+    local owner = redis.call('HMGET', leaseKey, 'caller', 'service')
+    if (owner[1] and owner[1] ~= ARGV[3]) or owner[2] ~= ARGV[4] then
+        return {-1, 0, 0, 0}
+    end
+end
+local l = redis.call('HMGET', leaseKey, 'free_granted', 'package_granted', 'paid_granted',
+    'free_used', 'package_used', 'paid_used', 'unit_price')
+local freeUnused = tonumber(l[1]) - tonumber(l[4])
+local packageUnused = tonumber(l[2] or '0') - tonumber(l[5] or '0')
+local paidUnused = tonumber(l[3]) - tonumber(l[6])
+local refund = paidUnused * tonumber(l[7])
+
+if freeUnused > 0 then
+    if redis.call('EXISTS', KEYS[3]) == 1 then
+        redis.call('INCRBY', KEYS[3], freeUnused)
+    end
+    if redis.call('EXISTS', KEYS[5]) == 1 then
+        redis.call('HINCRBY', KEYS[5], 'settled', freeUnused)
+    end
+end
+if packageUnused > 0 then
+    if redis.call('EXISTS', KEYS[7]) == 1 then
+        redis.call('INCRBY', KEYS[7], packageUnused)
+    end
+    if redis.call('EXISTS', KEYS[8]) == 1 then
+        redis.call('HINCRBY', KEYS[8], 'settled', packageUnused)
+    end
+end
+if refund > 0 then
+    if redis.call('EXISTS', KEYS[4]) == 1 then
+        redis.call('INCRBYFLOAT', KEYS[4], refund)
+    end
+    if redis.call('EXISTS', KEYS[6]) == 1 then
+        redis.call('HINCRBYFLOAT', KEYS[6], 'settled', refund)
+    end
+    for i = 9, #KEYS do
+        if redis.call('EXISTS', KEYS[i]) == 1 then
+            redis.call('INCRBYFLOAT', KEYS[i], -refund)
+        end
+    end
+end
+
+redis.call('DEL', leaseKey)
+redis.call('ZREM', expiryKey, ARGV[1])
+return {1, freeUnused, packageUnused, paidUnused}
+`
+
+// leaseRepo 额度租约数据访问（Redis）
+type leaseRepo struct {
+	data        *Data
+	billingRepo biz.BillingRepo // MQ 未启用时直接落库租约用量
+	log         *log.Helper
+}
+
+// NewLeaseRepo 创建额度租约 repo（返回 biz.LeaseRepo 接口）
+func NewLeaseRepo(data *Data, billingRepo biz.BillingRepo, logger log.Logger) biz.LeaseRepo {
+	return &leaseRepo{
+		data:        data,
+		billingRepo: billingRepo,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func leaseKey(leaseID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyLease, leaseID)
+}
+
+// AcquireLease 预留租约额度
+func (r *leaseRepo) AcquireLease(ctx context.Context, lease *biz.Lease, count int) error {
+	keys := []string{
+		quotaCacheKey(lease.UserID, lease.ServiceName, lease.Period),
+		balanceCacheKey(lease.UserID),
+		pendingQuotaKey(lease.UserID, lease.ServiceName, lease.Period),
+		pendingBalanceKey(lease.UserID),
+		leaseKey(lease.LeaseID),
+		constants.RedisKeyLeaseExpiry,
+		budgetCacheKey(lease.UserID),
+		budgetSpentKey(lease.UserID, lease.ServiceName, lease.BudgetMonth.Key),
+		budgetSpentKey(lease.UserID, "", lease.BudgetMonth.Key),
+		packageCacheKey(lease.UserID, lease.ServiceName),
+		pendingPackageKey(lease.UserID, lease.ServiceName),
+		memberSpentKey(lease.UserID, lease.MemberID, lease.BudgetMonth.Key),
+	}
+	hasQuota := "1"
+
+	// 缓存缺失时按 DB 值 - 在途值回填后重试
+	for i := 0; i < 3; i++ {
+		vals, err := r.data.rdb.Eval(ctx, acquireLeaseScript, keys,
+			count,
+			strconv.FormatFloat(lease.UnitPrice, 'f', -1, 64),
+			hasQuota,
+			int(leaseKeyTTL.Seconds()),
+			lease.UserID,
+			lease.ServiceName,
+			lease.Period,
+			lease.ExpiresAt.UnixMilli(),
+			lease.LeaseID,
+			lease.MemberID,
+			lease.Caller,
+			budgetField(lease.ServiceName),
+			lease.BudgetMonth.Key,
+			lease.SpendLimit,
+		).Int64Slice()
+		if err != nil {
+			return err
+		}
+		if len(vals) != 4 {
+			return fmt.Errorf("invalid acquire lease script result: %v", vals)
+		}
+
+		switch vals[0] {
+		case 1:
+			lease.FreeGranted = int(vals[1])
+			lease.PackageGranted = int(vals[2])
+			lease.PaidGranted = int(vals[3])
+			return nil
+		case 0:
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInsufficientBalance)
+		case 2:
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeBudgetExceeded)
+		case 3:
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeMemberSpendLimitExceeded)
+		case -1:
+			// 服务未配置免费额度时没有额度记录，只占用用量包与余额
+			quota, err := r.billingRepo.GetFreeQuota(ctx, lease.UserID, lease.ServiceName, lease.Period)
+			if err != nil {
+				return err
+			}
+			if quota == nil {
+				hasQuota = "0"
+				continue
+			}
+		}
+		if err := r.data.loadDeductCache(ctx, lease.Payer(), lease.ServiceName, lease.Period, lease.BudgetMonth); err != nil {
+			return err
+		}
+	}
+	return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeDeductQuotaFailed)
+}
+
+// ReportLeaseUsage 记录增量用量并生成扣费事件
+// 事件投递失败时撤销本次用量记录并返回错误，网关可重试上报
+func (r *leaseRepo) ReportLeaseUsage(ctx context.Context, leaseID string, owner biz.LeaseOwner, used int, renewUntil time.Time) (*biz.Lease, error) {
+	var renewAt int64
+	if !renewUntil.IsZero() {
+		renewAt = renewUntil.UnixMilli()
+	}
+	keys := []string{leaseKey(leaseID), constants.RedisKeyLeaseExpiry}
+	vals, err := r.data.rdb.Eval(ctx, reportLeaseScript, keys, used, time.Now().UnixMilli(), renewAt, leaseID,
+		owner.Caller, owner.ServiceName).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 4 {
+		return nil, fmt.Errorf("invalid report lease script result: %v", vals)
+	}
+	switch vals[0] {
+	case -1:
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeLeaseNotFound)
+	case -2:
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeLeaseExpired)
+	case -3:
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeLeaseUsageExceeded)
+	}
+
+	lease, err := r.getLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+	freeDelta, packageDelta, paidDelta := int(vals[1]), int(vals[2]), int(vals[3])
+	count := freeDelta + packageDelta + paidDelta
+	if count == 0 {
+		return lease, nil
+	}
+
+	event := &biz.DeductEvent{
+		RecordID:        uuid.New().String(),
+		UserID:          lease.UserID,
+		MemberID:        lease.MemberID,
+		ServiceName:     lease.ServiceName,
+		Count:           count,
+		Cost:            float64(count) * lease.UnitPrice,
+		FreeCount:       freeDelta,
+		PackageCount:    packageDelta,
+		PaidCount:       paidDelta,
+		BalanceDeducted: float64(paidDelta) * lease.UnitPrice,
+		DeductTime:      time.Now(),
+		Period:          lease.Period,
+	}
+	if err := r.applyUsage(ctx, event); err != nil {
+		r.log.Errorf("Apply lease usage failed: lease_id=%s, error=%v", leaseID, err)
+		if rbErr := r.data.rdb.Eval(context.Background(), rollbackLeaseUsageScript, []string{leaseKey(leaseID)}, freeDelta, packageDelta, paidDelta).Err(); rbErr != nil {
+			// 撤销失败时该部分用量既不会落库也不会归还，在途计数偏大（保守），直到过期
+			r.log.Errorf("Rollback lease usage failed: lease_id=%s, error=%v", leaseID, rbErr)
+		}
+		return nil, err
+	}
+	return lease, nil
+}
+
+// applyUsage 租约用量落库：MQ 启用时投递扣费事件，否则直接批量落库
+// 预留时已累加在途计数，落库后由 BatchDeductQuota 扣回；实时用量按上报时间计数（预留时不计入）
+func (r *leaseRepo) applyUsage(ctx context.Context, event *biz.DeductEvent) error {
+	var err error
+	if r.data.mq == nil {
+		err = r.billingRepo.BatchDeductQuota(ctx, []*biz.DeductEvent{event})
+	} else {
+		err = r.data.publishDeductEvent(ctx, event)
+	}
+	if err != nil {
+		return err
+	}
+	if err := r.data.incrLiveUsage(liveUsage{
+		userID:      event.UserID,
+		serviceName: event.ServiceName,
+		freeCount:   event.FreeCount,
+		paidCount:   event.PaidCount + event.PackageCount,
+		cost:        event.BalanceDeducted,
+	}); err != nil {
+		r.log.Warnf("Failed to record live usage: %v", err)
+	}
+	return nil
+}
+
+// ReleaseLease 删除租约并归还未用部分，owner 为 nil 时不校验调用方（过期回收）
+func (r *leaseRepo) ReleaseLease(ctx context.Context, leaseID string, owner *biz.LeaseOwner) (*biz.Lease, error) {
+	lease, err := r.getLease(ctx, leaseID)
+	if err != nil {
+		if kratosErrors.FromError(err).Code == billingErrors.ErrCodeLeaseNotFound {
+			// 租约 hash 已不存在，清理过期索引
+			r.data.rdb.ZRem(ctx, constants.RedisKeyLeaseExpiry, leaseID)
+		}
+		return nil, err
+	}
+
+	keys := []string{
+		leaseKey(leaseID),
+		constants.RedisKeyLeaseExpiry,
+		quotaCacheKey(lease.UserID, lease.ServiceName, lease.Period),
+		balanceCacheKey(lease.UserID),
+		pendingQuotaKey(lease.UserID, lease.ServiceName, lease.Period),
+		pendingBalanceKey(lease.UserID),
+		packageCacheKey(lease.UserID, lease.ServiceName),
+		pendingPackageKey(lease.UserID, lease.ServiceName),
+	}
+	if lease.BudgetMonth.Key != "" {
+		keys = append(keys, budgetSpentKey(lease.UserID, lease.ServiceName, lease.BudgetMonth.Key), budgetSpentKey(lease.UserID, "", lease.BudgetMonth.Key))
+		if lease.MemberID != "" {
+			keys = append(keys, memberSpentKey(lease.UserID, lease.MemberID, lease.BudgetMonth.Key))
+		}
+	}
+	verify, caller, serviceName := "0", "", ""
+	if owner != nil {
+		verify, caller, serviceName = "1", owner.Caller, owner.ServiceName
+	}
+	vals, err := r.data.rdb.Eval(ctx, releaseLeaseScript, keys, leaseID, verify, caller, serviceName).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 4 {
+		return nil, fmt.Errorf("invalid release lease script result: %v", vals)
+	}
+	if vals[0] != 1 {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeLeaseNotFound)
+	}
+	// 以脚本执行时的用量为准（读取租约之后可能仍有上报）
+	lease.FreeUsed = lease.FreeGranted - int(vals[1])
+	lease.PackageUsed = lease.PackageGranted - int(vals[2])
+	lease.PaidUsed = lease.PaidGranted - int(vals[3])
+	return lease, nil
+}
+
+// ListExpiredLeases 获取过期时间早于 before 的租约ID
+func (r *leaseRepo) ListExpiredLeases(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	return r.data.rdb.ZRangeByScore(ctx, constants.RedisKeyLeaseExpiry, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(before.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+}
+
+// getLease 读取租约
+func (r *leaseRepo) getLease(ctx context.Context, leaseID string) (*biz.Lease, error) {
+	m, err := r.data.rdb.HGetAll(ctx, leaseKey(leaseID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeLeaseNotFound)
+	}
+
+	lease := &biz.Lease{
+		LeaseID:     leaseID,
+		UserID:      m["uid"],
+		MemberID:    m["member_uid"], // 升级前创建的租约没有该字段，为空
+		Caller:      m["caller"],
+		ServiceName: m["service"],
+		Period:      m["month"], // 字段名沿用 month，兼容升级前创建的租约
+	}
+	lease.BudgetMonth.Key = m["budget_month"] // 升级前创建的租约没有该字段，为空
+	// 升级前创建的租约没有用量包字段，按 0 处理
+	for field, dst := range map[string]*int{"package_granted": &lease.PackageGranted, "package_used": &lease.PackageUsed} {
+		if v, ok := m[field]; ok {
+			if *dst, err = strconv.Atoi(v); err != nil {
+				return nil, fmt.Errorf("invalid lease field %s: %w", field, err)
+			}
+		}
+	}
+	ints := map[string]*int{
+		"free_granted": &lease.FreeGranted,
+		"paid_granted": &lease.PaidGranted,
+		"free_used":    &lease.FreeUsed,
+		"paid_used":    &lease.PaidUsed,
+	}
+	for field, dst := range ints {
+		if *dst, err = strconv.Atoi(m[field]); err != nil {
+			return nil, fmt.Errorf("invalid lease field %s: %w", field, err)
+		}
+	}
+	if lease.UnitPrice, err = strconv.ParseFloat(m["unit_price"], 64); err != nil {
+		return nil, fmt.Errorf("invalid lease field unit_price: %w", err)
+	}
+	expiresAt, err := strconv.ParseInt(m["expires_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lease field expires_at: %w", err)
+	}
+	lease.ExpiresAt = time.UnixMilli(expiresAt)
+	return lease, nil
+}
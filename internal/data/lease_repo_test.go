@@ -0,0 +1,331 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+	billingErrors "billing-service/internal/errors"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testCaller = "gateway"
+
+// fakeBillingRepo 租约用量直接落库（MQ 未启用）：写入 fakeLedger 后扣回在途计数
+type fakeBillingRepo struct {
+	biz.BillingRepo
+	d      *Data
+	ledger *fakeLedger
+}
+
+func (f *fakeBillingRepo) BatchDeductQuota(ctx context.Context, events []*biz.DeductEvent) error {
+	f.ledger.apply(events)
+	return f.d.settlePending(ctx, events)
+}
+
+func newTestLeaseRepo(t *testing.T, ledger *fakeLedger) (*leaseRepo, *Data) {
+	t.Helper()
+	d, _ := newTestData(t)
+	if err := d.refillDeductCache(context.Background(), testUserID, testService, testMonth, ledger.snapshot); err != nil {
+		t.Fatal(err)
+	}
+	repo := NewLeaseRepo(d, &fakeBillingRepo{d: d, ledger: ledger}, log.DefaultLogger).(*leaseRepo)
+	return repo, d
+}
+
+func acquireTestLease(t *testing.T, repo *leaseRepo, count int, expiresAt time.Time) *biz.Lease {
+	t.Helper()
+	lease := &biz.Lease{
+		LeaseID:     "lease-" + time.Now().Format("150405.000000000"),
+		UserID:      testUserID,
+		Caller:      testCaller,
+		ServiceName: testService,
+		Period:      testMonth,
+		UnitPrice:   1,
+		ExpiresAt:   expiresAt,
+	}
+	if err := repo.AcquireLease(context.Background(), lease, count); err != nil {
+		t.Fatal(err)
+	}
+	return lease
+}
+
+func assertCache(t *testing.T, d *Data, wantQuota, wantBalance string) {
+	t.Helper()
+	ctx := context.Background()
+	if got, _ := d.rdb.Get(ctx, quotaCacheKey(testUserID, testService, testMonth)).Result(); got != wantQuota {
+		t.Fatalf("quota cache = %s, want %s", got, wantQuota)
+	}
+	if got, _ := d.rdb.Get(ctx, balanceCacheKey(testUserID)).Result(); got != wantBalance {
+		t.Fatalf("balance cache = %s, want %s", got, wantBalance)
+	}
+}
+
+func assertLeaseNotFound(t *testing.T, err error) {
+	t.Helper()
+	if kratosErrors.FromError(err).Code != billingErrors.ErrCodeLeaseNotFound {
+		t.Fatalf("err = %v, want lease not found", err)
+	}
+}
+
+// TestLeaseReclaimExpired 过期租约回收未用部分：已上报的用量落库，未用的额度与余额回补缓存，在途计数归零
+func TestLeaseReclaimExpired(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedger{totalQuota: 5, balance: 10}
+	repo, d := newTestLeaseRepo(t, ledger)
+
+	lease := acquireTestLease(t, repo, 8, time.Now().Add(-time.Second))
+	if lease.FreeGranted != 5 || lease.PaidGranted != 3 {
+		t.Fatalf("granted free=%d paid=%d, want 5/3", lease.FreeGranted, lease.PaidGranted)
+	}
+	assertCache(t, d, "0", "7")
+
+	owner := biz.LeaseOwner{Caller: testCaller, ServiceName: testService}
+	if _, err := repo.ReportLeaseUsage(ctx, lease.LeaseID, owner, 6, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	uc := biz.NewLeaseUseCase(repo, &biz.BillingConfig{}, log.DefaultLogger)
+	reclaimed, err := uc.ReclaimExpired(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reclaimed) != 1 || reclaimed[0].Remaining() != 2 {
+		t.Fatalf("reclaimed = %+v, want 1 lease with 2 unused", reclaimed)
+	}
+	// 免费额度 5 次全部用完，余额用 1 次，归还 2 次
+	assertCache(t, d, "0", "9")
+	if ledger.usedQuota != 5 || ledger.balance != 9 {
+		t.Fatalf("ledger used=%d balance=%v, want 5/9", ledger.usedQuota, ledger.balance)
+	}
+	assertNoPending(t, d)
+
+	// 再次扫描不会重复回收
+	if reclaimed, err := uc.ReclaimExpired(ctx); err != nil || len(reclaimed) != 0 {
+		t.Fatalf("second reclaim = %+v, err = %v", reclaimed, err)
+	}
+}
+
+// TestLeaseDoubleRelease 重复释放返回租约不存在，未用部分只归还一次
+func TestLeaseDoubleRelease(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedger{totalQuota: 2, balance: 10}
+	repo, d := newTestLeaseRepo(t, ledger)
+
+	lease := acquireTestLease(t, repo, 4, time.Now().Add(time.Minute))
+	assertCache(t, d, "0", "8")
+
+	owner := &biz.LeaseOwner{Caller: testCaller, ServiceName: testService}
+	released, err := repo.ReleaseLease(ctx, lease.LeaseID, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released.Remaining() != 4 {
+		t.Fatalf("released remaining = %d, want 4", released.Remaining())
+	}
+	assertCache(t, d, "2", "10")
+
+	_, err = repo.ReleaseLease(ctx, lease.LeaseID, owner)
+	assertLeaseNotFound(t, err)
+	_, err = repo.ReleaseLease(ctx, lease.LeaseID, nil)
+	assertLeaseNotFound(t, err)
+	assertCache(t, d, "2", "10")
+	assertNoPending(t, d)
+}
+
+// TestLeaseOwnerBinding 其他调用方或服务不能上报/释放租约，升级前创建的租约不校验调用方
+func TestLeaseOwnerBinding(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedger{totalQuota: 10, balance: 10}
+	repo, d := newTestLeaseRepo(t, ledger)
+
+	lease := acquireTestLease(t, repo, 4, time.Now().Add(time.Minute))
+	for _, owner := range []biz.LeaseOwner{
+		{Caller: "asset-service", ServiceName: testService},
+		{Caller: testCaller, ServiceName: "asset"},
+		{ServiceName: testService},
+	} {
+		_, err := repo.ReportLeaseUsage(ctx, lease.LeaseID, owner, 1, time.Time{})
+		assertLeaseNotFound(t, err)
+		_, err = repo.ReleaseLease(ctx, lease.LeaseID, &owner)
+		assertLeaseNotFound(t, err)
+	}
+	if got, err := repo.getLease(ctx, lease.LeaseID); err != nil || got.FreeUsed != 0 || got.Caller != testCaller {
+		t.Fatalf("lease after rejected calls = %+v, err = %v", got, err)
+	}
+
+	d.rdb.HDel(ctx, leaseKey(lease.LeaseID), "caller")
+	legacy := biz.LeaseOwner{Caller: "asset-service", ServiceName: testService}
+	if _, err := repo.ReportLeaseUsage(ctx, lease.LeaseID, legacy, 1, time.Time{}); err != nil {
+		t.Fatalf("report legacy lease: %v", err)
+	}
+	if _, err := repo.ReleaseLease(ctx, lease.LeaseID, &biz.LeaseOwner{Caller: "asset-service", ServiceName: "asset"}); err == nil {
+		t.Fatal("release legacy lease with another service should fail")
+	}
+	if _, err := repo.ReleaseLease(ctx, lease.LeaseID, &legacy); err != nil {
+		t.Fatalf("release legacy lease: %v", err)
+	}
+	assertNoPending(t, d)
+}
+
+// TestLeaseAcquireHardBudget 租约授予的付费次数不超过硬性预算剩余金额，预留计入消费缓存，释放时扣回
+func TestLeaseAcquireHardBudget(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	load := func(context.Context) (*deductSnapshot, error) {
+		return &deductSnapshot{
+			Balance: 10,
+			Budget: &budgetSnapshot{
+				Month:  testMonth,
+				Limits: map[string]float64{budgetFieldAll: 1},
+				Spent:  map[string]float64{budgetFieldAll: 0.4},
+			},
+		}, nil
+	}
+	// 服务未配置免费额度时额度缓存为 0
+	mr.Set(quotaCacheKey(testUserID, testService, testMonth), "0")
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, load); err != nil {
+		t.Fatal(err)
+	}
+	repo := NewLeaseRepo(d, &fakeBillingRepo{d: d, ledger: &fakeLedger{}}, log.DefaultLogger).(*leaseRepo)
+	newLease := func(id string) *biz.Lease {
+		return &biz.Lease{
+			LeaseID:     id,
+			UserID:      testUserID,
+			Caller:      testCaller,
+			ServiceName: testService,
+			Period:      testMonth,
+			BudgetMonth: biz.BillingPeriod{Key: testMonth},
+			UnitPrice:   0.25,
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}
+	}
+
+	// 预算剩余 0.6，只能授予 2 次
+	lease := newLease("lease-budget-1")
+	if err := repo.AcquireLease(ctx, lease, 10); err != nil {
+		t.Fatal(err)
+	}
+	if lease.FreeGranted != 0 || lease.PaidGranted != 2 {
+		t.Fatalf("granted free=%d paid=%d, want 0/2", lease.FreeGranted, lease.PaidGranted)
+	}
+	if got, _ := mr.Get(budgetSpentKey(testUserID, "", testMonth)); got != "0.9" {
+		t.Fatalf("budget spent = %s, want 0.9", got)
+	}
+
+	// 剩余 0.1 不足一次
+	err := repo.AcquireLease(ctx, newLease("lease-budget-2"), 1)
+	if kratosErrors.FromError(err).Code != billingErrors.ErrCodeBudgetExceeded {
+		t.Fatalf("err = %v, want budget exceeded", err)
+	}
+
+	if _, err := repo.ReleaseLease(ctx, lease.LeaseID, &biz.LeaseOwner{Caller: testCaller, ServiceName: testService}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(budgetSpentKey(testUserID, "", testMonth)); got != "0.4" {
+		t.Fatalf("budget spent after release = %s, want 0.4", got)
+	}
+	if got, _ := mr.Get(balanceCacheKey(testUserID)); got != "10" {
+		t.Fatalf("balance cache after release = %s, want 10", got)
+	}
+	assertNoPending(t, d)
+}
+
+// TestLeaseAcquireMemberSpendLimit 组织成员的租约授予的付费次数不超过成员剩余可消费金额，预留计入成员消费缓存，释放时扣回
+func TestLeaseAcquireMemberSpendLimit(t *testing.T) {
+	const testMemberID = "u_20001"
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	load := func(context.Context) (*deductSnapshot, error) {
+		return &deductSnapshot{
+			Balance: 10,
+			Member:  &memberSpentSnapshot{MemberID: testMemberID, Month: testMonth, Spent: 0.4},
+		}, nil
+	}
+	mr.Set(quotaCacheKey(testUserID, testService, testMonth), "0")
+	if err := d.refillDeductCache(ctx, testUserID, testService, testMonth, load); err != nil {
+		t.Fatal(err)
+	}
+	repo := NewLeaseRepo(d, &fakeBillingRepo{d: d, ledger: &fakeLedger{}}, log.DefaultLogger).(*leaseRepo)
+	newLease := func(id string) *biz.Lease {
+		return &biz.Lease{
+			LeaseID:     id,
+			UserID:      testUserID,
+			MemberID:    testMemberID,
+			SpendLimit:  1,
+			Caller:      testCaller,
+			ServiceName: testService,
+			Period:      testMonth,
+			BudgetMonth: biz.BillingPeriod{Key: testMonth},
+			UnitPrice:   0.25,
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}
+	}
+
+	// 成员剩余 0.6，只能授予 2 次
+	lease := newLease("lease-member-1")
+	if err := repo.AcquireLease(ctx, lease, 10); err != nil {
+		t.Fatal(err)
+	}
+	if lease.PaidGranted != 2 {
+		t.Fatalf("granted paid=%d, want 2", lease.PaidGranted)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "0.9" {
+		t.Fatalf("member spent = %s, want 0.9", got)
+	}
+
+	// 剩余 0.1 不足一次
+	err := repo.AcquireLease(ctx, newLease("lease-member-2"), 1)
+	if kratosErrors.FromError(err).Code != billingErrors.ErrCodeMemberSpendLimitExceeded {
+		t.Fatalf("err = %v, want member spend limit exceeded", err)
+	}
+
+	if _, err := repo.ReleaseLease(ctx, lease.LeaseID, &biz.LeaseOwner{Caller: testCaller, ServiceName: testService}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.Get(memberSpentKey(testUserID, testMemberID, testMonth)); got != "0.4" {
+		t.Fatalf("member spent after release = %s, want 0.4", got)
+	}
+	assertNoPending(t, d)
+}
+
+// TestLeasePackageUnits 租约依次预留免费额度、用量包、余额，上报的用量按同样的顺序落库，释放时归还未用的用量包
+func TestLeasePackageUnits(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedger{totalQuota: 2, packages: 3, balance: 10}
+	repo, d := newTestLeaseRepo(t, ledger)
+
+	lease := acquireTestLease(t, repo, 8, time.Now().Add(time.Minute))
+	if lease.FreeGranted != 2 || lease.PackageGranted != 3 || lease.PaidGranted != 3 {
+		t.Fatalf("granted free=%d package=%d paid=%d, want 2/3/3", lease.FreeGranted, lease.PackageGranted, lease.PaidGranted)
+	}
+	assertCache(t, d, "0", "7")
+	if got, _ := d.rdb.Get(ctx, packageCacheKey(testUserID, testService)).Result(); got != "0" {
+		t.Fatalf("package cache = %s, want 0", got)
+	}
+
+	owner := biz.LeaseOwner{Caller: testCaller, ServiceName: testService}
+	if _, err := repo.ReportLeaseUsage(ctx, lease.LeaseID, owner, 4, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	// 免费额度 2 次，用量包 2 次
+	if ledger.usedQuota != 2 || ledger.packages != 1 || ledger.balance != 10 {
+		t.Fatalf("ledger used=%d packages=%d balance=%v, want 2/1/10", ledger.usedQuota, ledger.packages, ledger.balance)
+	}
+
+	released, err := repo.ReleaseLease(ctx, lease.LeaseID, &owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released.PackageUsed != 2 || released.Remaining() != 4 {
+		t.Fatalf("released package used=%d remaining=%d, want 2/4", released.PackageUsed, released.Remaining())
+	}
+	assertCache(t, d, "0", "10")
+	if got, _ := d.rdb.Get(ctx, packageCacheKey(testUserID, testService)).Result(); got != "1" {
+		t.Fatalf("package cache after release = %s, want 1", got)
+	}
+	assertNoPending(t, d)
+}
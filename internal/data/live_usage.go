@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 实时用量计数
+//
+// 扣费成功后按 (用户, 服务, 分钟/小时) 累加 Redis hash，同时累加该用户全部服务的合计，
+// 供控制台实时展示。计数在扣费成功时写入（不等待 MQ 落库），尽力而为：
+// Redis 故障时只丢失实时计数，不影响扣费，历史统计以 billing_record / 汇总表为准。
+
+const (
+	// liveUsageMinuteTTL 分钟计数保留时长（需覆盖最大查询窗口）
+	liveUsageMinuteTTL = 3 * time.Hour
+	// liveUsageHourTTL 小时计数保留时长（需覆盖最大查询窗口）
+	liveUsageHourTTL = 49 * time.Hour
+	// liveUsageAllServices 全部服务合计使用的服务名
+	liveUsageAllServices = "_all"
+	// liveUsageWriteTimeout 写入计数的超时时间，避免 Redis 抖动拖慢扣费
+	liveUsageWriteTimeout = 200 * time.Millisecond
+)
+
+const (
+	liveFieldTotal = "total"
+	liveFieldFree  = "free"
+	liveFieldPaid  = "paid"
+	liveFieldCost  = "cost"
+)
+
+// liveUsage 一次扣费的实时用量增量
+type liveUsage struct {
+	userID      string
+	serviceName string
+	freeCount   int
+	paidCount   int
+	cost        float64 // 余额扣费金额
+}
+
+// liveUsageKey 实时用量计数 key：usage:live:{uid}:{service}:{m|h}:{bucket unix 秒}
+func liveUsageKey(userID, serviceName string, slot time.Duration, bucket time.Time) string {
+	unit := "m"
+	if slot == time.Hour {
+		unit = "h"
+	}
+	return fmt.Sprintf("%s%s:%s:%s:%d", constants.RedisKeyLiveUsage, userID, serviceName, unit, bucket.Unix())
+}
+
+// incrLiveUsage 通过一次 pipeline 累加实时用量计数，失败时只返回错误由调用方记录日志
+func (d *Data) incrLiveUsage(usages ...liveUsage) error {
+	if d.rdb == nil || len(usages) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), liveUsageWriteTimeout)
+	defer cancel()
+
+	now := time.Now()
+	_, err := d.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, u := range usages {
+			if u.freeCount+u.paidCount == 0 {
+				continue
+			}
+			for _, serviceName := range []string{u.serviceName, liveUsageAllServices} {
+				for _, slot := range []struct {
+					size time.Duration
+					ttl  time.Duration
+				}{{time.Minute, liveUsageMinuteTTL}, {time.Hour, liveUsageHourTTL}} {
+					key := liveUsageKey(u.userID, serviceName, slot.size, now.Truncate(slot.size))
+					pipe.HIncrBy(ctx, key, liveFieldTotal, int64(u.freeCount+u.paidCount))
+					if u.freeCount > 0 {
+						pipe.HIncrBy(ctx, key, liveFieldFree, int64(u.freeCount))
+					}
+					if u.paidCount > 0 {
+						pipe.HIncrBy(ctx, key, liveFieldPaid, int64(u.paidCount))
+						pipe.HIncrByFloat(ctx, key, liveFieldCost, u.cost)
+					}
+					pipe.Expire(ctx, key, slot.ttl)
+				}
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// GetLiveUsage 读取从 start 开始连续 points 个时间片的实时用量（slot 为 1 分钟或 1 小时），无数据的时间片为 0
+func (r *statsRepo) GetLiveUsage(ctx context.Context, userID, serviceName string, slot time.Duration, start time.Time, points int) ([]*biz.UsagePoint, error) {
+	if serviceName == "" {
+		serviceName = liveUsageAllServices
+	}
+	cmds := make([]*redis.StringStringMapCmd, points)
+	if _, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i := range cmds {
+			cmds[i] = pipe.HGetAll(ctx, liveUsageKey(userID, serviceName, slot, start.Add(time.Duration(i)*slot)))
+		}
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	result := make([]*biz.UsagePoint, points)
+	for i, cmd := range cmds {
+		p := &biz.UsagePoint{StartTime: start.Add(time.Duration(i) * slot)}
+		vals := cmd.Val()
+		p.TotalCount, _ = strconv.Atoi(vals[liveFieldTotal])
+		p.FreeCount, _ = strconv.Atoi(vals[liveFieldFree])
+		p.PaidCount, _ = strconv.Atoi(vals[liveFieldPaid])
+		p.TotalCost, _ = strconv.ParseFloat(vals[liveFieldCost], 64)
+		result[i] = p
+	}
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TestIncrLiveUsage 按 (用户, 服务) 与 (用户, _all) 累加分钟/小时计数，key 按时间片起点，TTL 按粒度
+func TestIncrLiveUsage(t *testing.T) {
+	d, mr := newTestData(t)
+	now := time.Now()
+
+	if err := d.incrLiveUsage(
+		liveUsage{userID: testUserID, serviceName: testService, freeCount: 2},
+		liveUsage{userID: testUserID, serviceName: testService, paidCount: 3, cost: 1.5},
+		liveUsage{userID: testUserID, serviceName: "asset", paidCount: 1, cost: 2},
+		liveUsage{userID: testUserID, serviceName: "asset"}, // 无用量不写入
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		serviceName string
+		slot        time.Duration
+		ttl         time.Duration
+		want        map[string]string
+	}{
+		{testService, time.Minute, liveUsageMinuteTTL, map[string]string{"total": "5", "free": "2", "paid": "3", "cost": "1.5"}},
+		{testService, time.Hour, liveUsageHourTTL, map[string]string{"total": "5", "free": "2", "paid": "3", "cost": "1.5"}},
+		{"asset", time.Minute, liveUsageMinuteTTL, map[string]string{"total": "1", "paid": "1", "cost": "2"}},
+		{liveUsageAllServices, time.Minute, liveUsageMinuteTTL, map[string]string{"total": "6", "free": "2", "paid": "4", "cost": "3.5"}},
+		{liveUsageAllServices, time.Hour, liveUsageHourTTL, map[string]string{"total": "6", "free": "2", "paid": "4", "cost": "3.5"}},
+	}
+	for _, tc := range cases {
+		key := liveUsageKey(testUserID, tc.serviceName, tc.slot, now.Truncate(tc.slot))
+		for field, want := range tc.want {
+			if got := mr.HGet(key, field); got != want {
+				t.Errorf("%s %s = %q, want %q", key, field, got, want)
+			}
+		}
+		if fields, _ := mr.HKeys(key); len(fields) != len(tc.want) {
+			t.Errorf("%s fields = %v, want %d fields", key, fields, len(tc.want))
+		}
+		if ttl := mr.TTL(key); ttl != tc.ttl {
+			t.Errorf("%s ttl = %s, want %s", key, ttl, tc.ttl)
+		}
+	}
+}
+
+func TestLiveUsageKey(t *testing.T) {
+	bucket := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	if got, want := liveUsageKey(testUserID, testService, time.Minute, bucket), "usage:live:u_10001:passport:m:1762336800"; got != want {
+		t.Errorf("minute key = %s, want %s", got, want)
+	}
+	if got, want := liveUsageKey(testUserID, liveUsageAllServices, time.Hour, bucket), "usage:live:u_10001:_all:h:1762336800"; got != want {
+		t.Errorf("hour key = %s, want %s", got, want)
+	}
+}
+
+// TestGetLiveUsage 按时间片连续读取，无数据的时间片为 0，未指定服务时读取全部服务合计
+func TestGetLiveUsage(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestData(t)
+	repo := &statsRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+	start := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	mr.HSet(liveUsageKey(testUserID, testService, time.Minute, start), "total", "3", "free", "1", "paid", "2", "cost", "0.8")
+	mr.HSet(liveUsageKey(testUserID, liveUsageAllServices, time.Minute, start.Add(2*time.Minute)), "total", "4", "paid", "4", "cost", "2")
+
+	points, err := repo.GetLiveUsage(ctx, testUserID, testService, time.Minute, start, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 3 {
+		t.Fatalf("points = %d, want 3", len(points))
+	}
+	if p := points[0]; !p.StartTime.Equal(start) || p.TotalCount != 3 || p.FreeCount != 1 || p.PaidCount != 2 || p.TotalCost != 0.8 {
+		t.Errorf("point 0 = %+v", p)
+	}
+	for i, p := range points[1:] {
+		if !p.StartTime.Equal(start.Add(time.Duration(i+1)*time.Minute)) || p.TotalCount != 0 || p.TotalCost != 0 {
+			t.Errorf("point %d = %+v, want empty", i+1, p)
+		}
+	}
+
+	all, err := repo.GetLiveUsage(ctx, testUserID, "", time.Minute, start, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all[0].TotalCount != 0 || all[2].TotalCount != 4 || all[2].TotalCost != 2 {
+		t.Errorf("all services = %+v, %+v", all[0], all[2])
+	}
+}
+
+// TestLeaseReportRecordsLiveUsage 租约上报的用量计入实时用量，预留时不计入
+func TestLeaseReportRecordsLiveUsage(t *testing.T) {
+	ctx := context.Background()
+	ledger := &fakeLedger{totalQuota: 2, balance: 10}
+	repo, d := newTestLeaseRepo(t, ledger)
+	stats := &statsRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+
+	// 读取两个时间片的合计，避免跨分钟时落在下一个时间片
+	start := time.Now().Truncate(time.Minute)
+	liveTotal := func() biz.UsagePoint {
+		points, err := stats.GetLiveUsage(ctx, testUserID, testService, time.Minute, start, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sum biz.UsagePoint
+		for _, p := range points {
+			sum.TotalCount += p.TotalCount
+			sum.FreeCount += p.FreeCount
+			sum.PaidCount += p.PaidCount
+			sum.TotalCost += p.TotalCost
+		}
+		return sum
+	}
+
+	lease := acquireTestLease(t, repo, 5, time.Now().Add(time.Minute))
+	if p := liveTotal(); p.TotalCount != 0 {
+		t.Fatalf("after acquire: live usage = %+v, want empty", p)
+	}
+
+	owner := biz.LeaseOwner{Caller: testCaller, ServiceName: testService}
+	if _, err := repo.ReportLeaseUsage(ctx, lease.LeaseID, owner, 3, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if p := liveTotal(); p.TotalCount != 3 || p.FreeCount != 2 || p.PaidCount != 1 || p.TotalCost != lease.UnitPrice {
+		t.Errorf("after report: point = %+v, want total 3, free 2, paid 1, cost %v", p, lease.UnitPrice)
+	}
+}
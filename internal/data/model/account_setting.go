@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// AccountSetting 账户计费设置表
+type AccountSetting struct {
+	UID         string    `gorm:"column:uid;primaryKey;type:varchar(36)"`
+	CycleAnchor time.Time `gorm:"type:datetime;not null"`               // 周年周期锚点
+	Timezone    string    `gorm:"type:varchar(64);not null;default:''"` // 计费时区（IANA 名称），为空表示默认时区
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (AccountSetting) TableName() string {
+	return "billing_account_setting"
+}
@@ -9,17 +9,21 @@ import (
 const (
 	BillingTypeFree    = constants.BillingTypeFree    // 免费额度
 	BillingTypeBalance = constants.BillingTypeBalance // 余额扣费
+	BillingTypePackage = constants.BillingTypePackage // 用量包
+	BillingTypeTrueUp  = constants.BillingTypeTrueUp  // 合同补差
 )
 
 // BillingRecord 消费流水表
+// 列表按 (created_at, billing_record_id) 倒序分页，InnoDB 二级索引隐含主键列，各索引均可直接支持该排序
 type BillingRecord struct {
 	BillingRecordID string    `gorm:"primaryKey;type:varchar(36)"`
-	UID             string    `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_date,priority:1"`
-	ServiceName     string    `gorm:"type:varchar(32);not null"`
-	Type            string    `gorm:"type:enum('free','balance');not null"` // free:免费额度, balance:余额扣费
+	UID             string    `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_date,priority:1;index:idx_uid_service_date,priority:1;index:idx_uid_type_date,priority:1;index:idx_uid_member_date,priority:1"`
+	MemberUID       string    `gorm:"column:member_uid;type:varchar(36);not null;default:'';index:idx_uid_member_date,priority:2"` // 组织账户的扣费记录中实际使用的成员，个人账户为空
+	ServiceName     string    `gorm:"type:varchar(32);not null;index:idx_uid_service_date,priority:2"`
+	Type            string    `gorm:"type:enum('free','balance','package','true_up');not null;index:idx_uid_type_date,priority:2"` // free:免费额度, balance:余额扣费, package:用量包（金额为 0）, true_up:合同补差（服务名为空，次数为 0）
 	Amount          float64   `gorm:"type:decimal(10,4);default:0.0000"`
 	Count           int       `gorm:"default:1"`
-	CreatedAt       time.Time `gorm:"autoCreateTime;index:idx_uid_date,priority:2"`
+	CreatedAt       time.Time `gorm:"autoCreateTime;index:idx_uid_date,priority:2;index:idx_uid_service_date,priority:3;index:idx_uid_type_date,priority:3;index:idx_uid_member_date,priority:3"`
 }
 
 // TableName 指定表名
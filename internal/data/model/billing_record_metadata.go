@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// BillingRecordMetadata 消费流水来源信息表（billing_record 的附表）
+// 扣费时携带来源信息才写入，每条 billing_record 对应一行（混合扣费的两条记录各一行）
+type BillingRecordMetadata struct {
+	BillingRecordID string    `gorm:"primaryKey;type:varchar(36)"`
+	UID             string    `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_request,priority:1;index:idx_uid_app_date,priority:1"`
+	RequestID       string    `gorm:"type:varchar(64);not null;default:'';index:idx_uid_request,priority:2"`
+	APIKeyID        string    `gorm:"column:api_key_id;type:varchar(64);not null;default:''"`
+	AppID           string    `gorm:"type:varchar(64);not null;default:'';index:idx_app_id_date,priority:1;index:idx_uid_app_date,priority:2"`
+	Operation       string    `gorm:"type:varchar(128);not null;default:''"`
+	Labels          string    `gorm:"type:text"` // JSON 对象
+	CreatedAt       time.Time `gorm:"autoCreateTime;index:idx_app_id_date,priority:2;index:idx_uid_app_date,priority:3"`
+}
+
+// TableName 指定表名
+func (BillingRecordMetadata) TableName() string {
+	return "billing_record_metadata"
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Budget 用户消费预算表
+// service_name 为空表示全部服务合计
+type Budget struct {
+	BudgetID     string    `gorm:"primaryKey;type:varchar(36)"`
+	UID          string    `gorm:"column:uid;type:varchar(36);not null;uniqueIndex:uk_user_service,priority:1"`
+	ServiceName  string    `gorm:"type:varchar(32);not null;default:'';uniqueIndex:uk_user_service,priority:2"`
+	Amount       float64   `gorm:"type:decimal(10,4);not null"`
+	AlertPercent float64   `gorm:"type:decimal(5,2);not null;default:0.00"` // 0 表示不提醒
+	HardLimit    bool      `gorm:"not null;default:false"`
+	AlertedMonth string    `gorm:"type:varchar(7);not null;default:''"` // 最近一次发送提醒的月份
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Budget) TableName() string {
+	return "billing_budget"
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"billing-service/internal/constants"
+	"time"
+)
+
+// 合同状态常量（引用 constants 包中的常量，保持一致性）
+const (
+	ContractStatusActive  = constants.ContractStatusActive  // 生效中（含未开始）
+	ContractStatusSettled = constants.ContractStatusSettled // 已结算
+)
+
+// PriceRule 账户价格规则表
+// unit_price > 0 为单价覆盖，否则按 discount_percent 打折；contract_id 非空为合同价，随合同创建
+type PriceRule struct {
+	PriceRuleID     string     `gorm:"primaryKey;type:varchar(36)"`
+	UID             string     `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_ends,priority:1"`
+	ServiceName     string     `gorm:"type:varchar(32);not null"` // "*" 表示全部服务
+	UnitPrice       float64    `gorm:"type:decimal(12,6);not null;default:0"`
+	DiscountPercent float64    `gorm:"type:decimal(5,2);not null;default:0"`
+	ContractID      string     `gorm:"type:varchar(36);not null;default:'';index:idx_contract"`
+	StartsAt        time.Time  `gorm:"not null"`
+	EndsAt          *time.Time `gorm:"index:idx_uid_ends,priority:2"` // 为空表示长期有效
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName 指定表名
+func (PriceRule) TableName() string {
+	return "billing_price_rule"
+}
+
+// Contract 承诺消费合同表
+// drawn_amount 在扣费事务中累计（合同期内的余额扣费），到期结算时写入 true_up_amount / true_up_charged
+type Contract struct {
+	ContractID    string     `gorm:"primaryKey;type:varchar(36)"`
+	UID           string     `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_status,priority:1"`
+	CommitAmount  float64    `gorm:"type:decimal(12,2);not null"`
+	DrawnAmount   float64    `gorm:"type:decimal(14,4);not null;default:0"`
+	StartsAt      time.Time  `gorm:"not null"`
+	EndsAt        time.Time  `gorm:"not null;index:idx_status_ends,priority:2"`
+	Status        string     `gorm:"type:enum('active','settled');not null;default:'active';index:idx_uid_status,priority:2;index:idx_status_ends,priority:1"`
+	TrueUpAmount  float64    `gorm:"type:decimal(12,4);not null;default:0"` // 应补差额
+	TrueUpCharged float64    `gorm:"type:decimal(12,4);not null;default:0"` // 实际扣款（余额不足时小于应补差额）
+	SettledAt     *time.Time // 结算时间
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Contract) TableName() string {
+	return "billing_contract"
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// ExportJob 账单导出任务表
+type ExportJob struct {
+	ExportID     string     `gorm:"primaryKey;type:varchar(36)"`
+	UID          string     `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_created,priority:1"`
+	Format       string     `gorm:"type:varchar(8);not null"`  // csv / xlsx / pdf
+	Lang         string     `gorm:"type:varchar(16);not null"` // zh-CN / en-US
+	StartTime    time.Time  `gorm:"not null"`
+	EndTime      time.Time  `gorm:"not null"`
+	Status       string     `gorm:"type:enum('pending','running','success','failed','expired');not null;default:'pending';index:idx_status_started,priority:1;index:idx_status_expires,priority:1"`
+	FileKey      string     `gorm:"type:varchar(255);not null;default:''"`
+	FileSize     int64      `gorm:"not null;default:0"`
+	RowCount     int        `gorm:"not null;default:0"`
+	ErrorMessage string     `gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime;index:idx_uid_created,priority:2"`
+	StartedAt    *time.Time `gorm:"index:idx_status_started,priority:2"`
+	FinishedAt   *time.Time
+	ExpiresAt    *time.Time `gorm:"index:idx_status_expires,priority:2"` // 文件过期时间
+}
+
+// TableName 指定表名
+func (ExportJob) TableName() string {
+	return "billing_export_job"
+}
@@ -6,14 +6,18 @@ import (
 
 // FreeQuota 免费额度表
 type FreeQuota struct {
-	FreeQuotaID string    `gorm:"primaryKey;type:varchar(36)"`
-	UID         string    `gorm:"column:uid;type:varchar(36);not null;uniqueIndex:uk_user_service_month,priority:1"`
-	ServiceName string    `gorm:"type:varchar(32);not null;uniqueIndex:uk_user_service_month,priority:2"`
-	TotalQuota  int       `gorm:"default:0"`
-	UsedQuota   int       `gorm:"default:0"`
-	ResetMonth  string    `gorm:"type:varchar(7);not null;uniqueIndex:uk_user_service_month,priority:3"` // 2024-11
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	FreeQuotaID   string    `gorm:"primaryKey;type:varchar(36)"`
+	UID           string    `gorm:"column:uid;type:varchar(36);not null;uniqueIndex:uk_user_service_period,priority:1"`
+	ServiceName   string    `gorm:"type:varchar(32);not null;uniqueIndex:uk_user_service_period,priority:2"`
+	TotalQuota    int       `gorm:"default:0"`
+	UsedQuota     int       `gorm:"default:0"`
+	RolloverQuota int       `gorm:"not null;default:0"`                                                      // 上期结转的额度（已计入 total_quota，优先使用，本周期结束时过期）
+	Period        string    `gorm:"type:varchar(16);not null;uniqueIndex:uk_user_service_period,priority:3"` // 周期标识：2024-11 / D2024-11-05 / W2024-11-04 / A2024-11-15
+	Cycle         string    `gorm:"type:varchar(16);not null;default:monthly"`                               // daily / weekly / monthly / anniversary
+	PeriodStart   time.Time `gorm:"type:datetime"`                                                           // 周期开始时间（含）
+	PeriodEnd     time.Time `gorm:"type:datetime"`                                                           // 周期结束时间（不含）
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
 }
 
 // TableName 指定表名
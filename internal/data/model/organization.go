@@ -0,0 +1,49 @@
+package model
+
+import (
+	"billing-service/internal/constants"
+	"time"
+)
+
+// 组织成员角色常量（引用 constants 包中的常量，保持一致性）
+const (
+	OrgRoleOwner  = constants.OrgRoleOwner  // 所有者
+	OrgRoleAdmin  = constants.OrgRoleAdmin  // 管理员
+	OrgRoleMember = constants.OrgRoleMember // 普通成员
+
+	OrgMemberStatusInvited = constants.OrgMemberStatusInvited // 已邀请，等待接受
+	OrgMemberStatusActive  = constants.OrgMemberStatusActive  // 已加入
+)
+
+// Organization 组织表
+// 组织ID即共享钱包的账户ID，余额、免费额度、用量包、预算等沿用按 uid 存储的各表
+type Organization struct {
+	OrgID     string    `gorm:"primaryKey;type:varchar(36)"`
+	Name      string    `gorm:"type:varchar(64);not null"`
+	OwnerUID  string    `gorm:"column:owner_uid;type:varchar(36);not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (Organization) TableName() string {
+	return "billing_org"
+}
+
+// OrgMember 组织成员表
+// uid 为主键：每个用户最多属于一个组织（含未接受的邀请）；spend_limit 为成员每月余额消费上限，0 表示不限制
+// status 为 invited 时用户尚未接受邀请，不由组织支付、没有组织权限；升级前的成员为 active
+type OrgMember struct {
+	UID        string    `gorm:"column:uid;primaryKey;type:varchar(36)"`
+	OrgID      string    `gorm:"type:varchar(36);not null;index:idx_org"`
+	Role       string    `gorm:"type:enum('owner','admin','member');not null;default:'member'"`
+	Status     string    `gorm:"type:enum('invited','active');not null;default:'active'"`
+	SpendLimit float64   `gorm:"type:decimal(12,2);not null;default:0"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (OrgMember) TableName() string {
+	return "billing_org_member"
+}
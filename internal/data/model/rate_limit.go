@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// UserRateLimit 用户限流设置表：限流套餐及按服务覆盖的规则
+type UserRateLimit struct {
+	UID       string    `gorm:"column:uid;primaryKey;type:varchar(36)"`
+	Plan      string    `gorm:"type:varchar(32);not null;default:''"` // 为空表示默认套餐
+	Overrides string    `gorm:"type:text"`                            // JSON 对象：服务名 -> {perSecond, perDay}
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (UserRateLimit) TableName() string {
+	return "billing_user_rate_limit"
+}
@@ -12,15 +12,16 @@ const (
 	RechargeStatusFailed  = constants.OrderStatusFailed  // 支付失败
 )
 
-// RechargeOrder 充值订单表（用于幂等性保证）
+// RechargeOrder 充值订单表（用于幂等性保证），购买用量包的订单与合同预付同样记录在此表
 type RechargeOrder struct {
 	OrderID   string    `gorm:"primaryKey;column:order_id;type:varchar(64)"` // 订单号（billing-service生成，传给payment-service作为业务订单号order_id）
 	UID       string    `gorm:"column:uid;type:varchar(36);not null;index:idx_uid"`
 	Amount    float64   `gorm:"type:decimal(10,2);not null"`
-	PaymentID string    `gorm:"column:payment_id;type:varchar(64);uniqueIndex"`                     // 支付流水号（payment-service返回的payment_id）
-	Status    string    `gorm:"type:enum('pending','success','failed');not null;default:'pending'"` // pending:待支付, success:支付成功, failed:支付失败
+	PaymentID string    `gorm:"column:payment_id;type:varchar(64);uniqueIndex"`                                                         // 支付流水号（payment-service返回的payment_id）
+	Status    string    `gorm:"type:enum('pending','success','failed');not null;default:'pending';index:idx_status_updated,priority:1"` // pending:待支付, success:支付成功, failed:支付失败
+	OrderType string    `gorm:"type:enum('recharge','package','contract');not null;default:'recharge'"`                                 // recharge:余额充值, package:购买用量包（支付成功后发放用量包，不增加余额）, contract:合同预付（创建合同时计入余额）
 	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;index:idx_status_updated,priority:2"`
 }
 
 // TableName 指定表名
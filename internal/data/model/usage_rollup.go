@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// UsageHourly 用量小时汇总表
+// 与 billing_record 在同一事务中增量维护，bucket_start 为 UTC 整点
+type UsageHourly struct {
+	UID         string    `gorm:"column:uid;primaryKey;type:varchar(36)"`
+	ServiceName string    `gorm:"primaryKey;type:varchar(32)"`
+	BucketStart time.Time `gorm:"primaryKey"`
+	TotalCount  int64     `gorm:"not null;default:0"`
+	FreeCount   int64     `gorm:"not null;default:0"`
+	PaidCount   int64     `gorm:"not null;default:0"`
+	TotalCost   float64   `gorm:"type:decimal(16,4);not null;default:0.0000"` // 余额扣费金额
+}
+
+// TableName 指定表名
+func (UsageHourly) TableName() string {
+	return "billing_usage_hourly"
+}
+
+// UsageDaily 用量日汇总表
+// 与 billing_record 在同一事务中增量维护，bucket_start 为 UTC 零点
+type UsageDaily struct {
+	UID         string    `gorm:"column:uid;primaryKey;type:varchar(36)"`
+	ServiceName string    `gorm:"primaryKey;type:varchar(32)"`
+	BucketStart time.Time `gorm:"primaryKey;index:idx_bucket"`
+	TotalCount  int64     `gorm:"not null;default:0"`
+	FreeCount   int64     `gorm:"not null;default:0"`
+	PaidCount   int64     `gorm:"not null;default:0"`
+	TotalCost   float64   `gorm:"type:decimal(16,4);not null;default:0.0000"` // 余额扣费金额
+}
+
+// TableName 指定表名
+func (UsageDaily) TableName() string {
+	return "billing_usage_daily"
+}
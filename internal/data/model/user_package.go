@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// UserPackage 用户用量包表
+// 下单时按目录快照创建（expires_at 为空），支付成功后设置 expires_at 即为发放；扣减时先到期的先用
+type UserPackage struct {
+	UserPackageID string     `gorm:"primaryKey;type:varchar(36)"`
+	UID           string     `gorm:"column:uid;type:varchar(36);not null;index:idx_uid_service_expires,priority:1"`
+	ServiceName   string     `gorm:"type:varchar(32);not null;index:idx_uid_service_expires,priority:2"`
+	PackageID     string     `gorm:"type:varchar(64);not null"`                             // 目录中的用量包ID
+	Name          string     `gorm:"type:varchar(128);not null;default:''"`                 // 下单时的展示名称
+	OrderID       string     `gorm:"column:order_id;type:varchar(64);not null;uniqueIndex"` // 对应的 recharge_order 订单号
+	TotalUnits    int64      `gorm:"not null"`
+	UsedUnits     int64      `gorm:"not null;default:0"`
+	ValidMonths   int        `gorm:"not null"`                                 // 有效期（月），发放时据此计算 expires_at
+	ExpiresAt     *time.Time `gorm:"index:idx_uid_service_expires,priority:3"` // 到期时间（不含），未发放时为空
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName 指定表名
+func (UserPackage) TableName() string {
+	return "billing_user_package"
+}
@@ -0,0 +1,314 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// orgRepo 组织数据访问
+type orgRepo struct {
+	data     *Data
+	cacheTTL time.Duration
+	log      *log.Helper
+}
+
+// NewOrgRepo 创建组织 repo（返回 biz.OrgRepo 接口）
+func NewOrgRepo(data *Data, conf *biz.BillingConfig, logger log.Logger) biz.OrgRepo {
+	return &orgRepo{
+		data:     data,
+		cacheTTL: conf.Organization.MembershipCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func orgMemberKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyOrgMember, userID)
+}
+
+// CreateOrganization 创建组织并将所有者加入为成员（同一事务），所有者尚未接受的邀请视为拒绝
+func (r *orgRepo) CreateOrganization(ctx context.Context, org *biz.Organization) error {
+	org.ID = uuid.New().String()
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		m := &model.Organization{OrgID: org.ID, Name: org.Name, OwnerUID: org.OwnerID}
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		org.CreatedAt = m.CreatedAt
+		if err := tx.Where("uid = ? AND status = ?", org.OwnerID, model.OrgMemberStatusInvited).Delete(&model.OrgMember{}).Error; err != nil {
+			return err
+		}
+		owner := &model.OrgMember{UID: org.OwnerID, OrgID: org.ID, Role: model.OrgRoleOwner, Status: model.OrgMemberStatusActive}
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(owner)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberExists)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.invalidateMember(ctx, org.OwnerID)
+	return nil
+}
+
+// GetOrganization 获取组织，不存在时返回 nil
+func (r *orgRepo) GetOrganization(ctx context.Context, orgID string) (*biz.Organization, error) {
+	var m model.Organization
+	err := r.data.db.WithContext(ctx).Where("org_id = ?", orgID).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.Organization{
+		ID:        m.OrgID,
+		Name:      m.Name,
+		OwnerID:   m.OwnerUID,
+		CreatedAt: m.CreatedAt,
+	}, nil
+}
+
+// GetMembership 获取用户的组织成员身份，先读缓存，缓存缺失时读 DB 并缓存（非成员缓存 null）
+func (r *orgRepo) GetMembership(ctx context.Context, userID string) (*biz.OrgMember, error) {
+	key := orgMemberKey(userID)
+	cached, err := r.data.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var member *biz.OrgMember
+		if err := json.Unmarshal(cached, &member); err == nil {
+			if member != nil {
+				member.UserID = userID
+				if member.Status == "" {
+					// 升级前缓存的成员身份没有状态，均为已加入
+					member.Status = constants.OrgMemberStatusActive
+				}
+			}
+			return member, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	var member *biz.OrgMember
+	var m model.OrgMember
+	err = r.data.db.WithContext(ctx).Where("uid = ?", userID).First(&m).Error
+	if err == nil {
+		member = toOrgMember(&m)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if b, err := json.Marshal(member); err == nil {
+		if err := r.data.rdb.Set(ctx, key, b, r.cacheTTL).Err(); err != nil {
+			r.log.Warnf("failed to cache org membership: user_id=%s, error=%v", userID, err)
+		}
+	}
+	return member, nil
+}
+
+// ListMembers 获取组织成员，按加入时间正序
+func (r *orgRepo) ListMembers(ctx context.Context, orgID string) ([]*biz.OrgMember, error) {
+	var models []model.OrgMember
+	if err := r.data.db.WithContext(ctx).Where("org_id = ?", orgID).
+		Order("created_at ASC, uid ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	members := make([]*biz.OrgMember, 0, len(models))
+	for i := range models {
+		members = append(members, toOrgMember(&models[i]))
+	}
+	return members, nil
+}
+
+// CountMembers 组织已加入的成员数
+func (r *orgRepo) CountMembers(ctx context.Context, orgID string) (int, error) {
+	var count int64
+	if err := r.data.db.WithContext(ctx).Model(&model.OrgMember{}).
+		Where("org_id = ? AND status = ?", orgID, model.OrgMemberStatusActive).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// AddMember 添加成员：锁定组织行后检查成员数（含未接受的邀请），保证并发添加不超过上限
+func (r *orgRepo) AddMember(ctx context.Context, member *biz.OrgMember, maxMembers int) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var org model.Organization
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("org_id = ?", member.OrgID).First(&org).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgNotFound)
+		}
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&model.OrgMember{}).Where("org_id = ?", member.OrgID).Count(&count).Error; err != nil {
+			return err
+		}
+		if maxMembers > 0 && int(count) >= maxMembers {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberCountExceeded)
+		}
+
+		m := &model.OrgMember{
+			UID:        member.UserID,
+			OrgID:      member.OrgID,
+			Role:       member.Role,
+			Status:     member.Status,
+			SpendLimit: member.SpendLimit,
+		}
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(m)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeOrgMemberExists)
+		}
+		member.CreatedAt = m.CreatedAt
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.invalidateMember(ctx, member.UserID)
+	return nil
+}
+
+// AcceptInvitation 接受邀请
+func (r *orgRepo) AcceptInvitation(ctx context.Context, orgID, userID string) (bool, error) {
+	res := r.data.db.WithContext(ctx).Model(&model.OrgMember{}).
+		Where("uid = ? AND org_id = ? AND status = ?", userID, orgID, model.OrgMemberStatusInvited).
+		Updates(map[string]interface{}{
+			"status":     model.OrgMemberStatusActive,
+			"updated_at": time.Now(),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidateMember(ctx, userID)
+	return true, nil
+}
+
+// UpdateMember 更新成员角色与消费上限（所有者不能通过此方法修改）
+func (r *orgRepo) UpdateMember(ctx context.Context, member *biz.OrgMember) (bool, error) {
+	db := r.data.db.WithContext(ctx)
+	res := db.Model(&model.OrgMember{}).
+		Where("uid = ? AND org_id = ? AND role <> ?", member.UserID, member.OrgID, model.OrgRoleOwner).
+		Updates(map[string]interface{}{
+			"role":        member.Role,
+			"spend_limit": member.SpendLimit,
+			"updated_at":  time.Now(),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		// 值未变化时 MySQL 不计入影响行数，再确认成员是否存在
+		var count int64
+		if err := db.Model(&model.OrgMember{}).
+			Where("uid = ? AND org_id = ? AND role <> ?", member.UserID, member.OrgID, model.OrgRoleOwner).
+			Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count == 0 {
+			return false, nil
+		}
+	}
+	r.invalidateMember(ctx, member.UserID)
+	return true, nil
+}
+
+// RemoveMember 移除成员（所有者不能被移除）
+func (r *orgRepo) RemoveMember(ctx context.Context, orgID, userID string) (bool, error) {
+	res := r.data.db.WithContext(ctx).
+		Where("uid = ? AND org_id = ? AND role <> ?", userID, orgID, model.OrgRoleOwner).
+		Delete(&model.OrgMember{})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidateMember(ctx, userID)
+	return true, nil
+}
+
+// GetMemberSpent 成员在 [start, end) 内记入组织账户的余额消费
+func (r *orgRepo) GetMemberSpent(ctx context.Context, orgID string, start, end time.Time, memberIDs ...string) (map[string]float64, error) {
+	query := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).
+		Select("member_uid, SUM(amount) as spent").
+		Where("uid = ? AND created_at >= ? AND created_at < ? AND type = ?", orgID, start, end, model.BillingTypeBalance)
+	if len(memberIDs) > 0 {
+		query = query.Where("member_uid IN ?", memberIDs)
+	} else {
+		query = query.Where("member_uid <> ''")
+	}
+	var rows []struct {
+		MemberUID string
+		Spent     float64
+	}
+	if err := query.Group("member_uid").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	spent := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		spent[row.MemberUID] = row.Spent
+	}
+	return spent, nil
+}
+
+// ExceedsSpendLimit 成员本月消费加上本次金额是否超出上限，优先读缓存，缓存缺失时按 DB 计算（已落库 + 在途余额扣费）
+func (r *orgRepo) ExceedsSpendLimit(ctx context.Context, orgID, memberID string, month biz.BillingPeriod, limit, cost float64) (bool, error) {
+	exceeded, missing, err := r.data.exceedsSpendLimitCache(ctx, orgID, memberID, month.Key, limit, cost)
+	if err == nil && !missing {
+		return exceeded, nil
+	}
+	if err != nil {
+		r.log.Warnf("Failed to check member spent cache: org_id=%s, member_uid=%s, error=%v", orgID, memberID, err)
+	}
+
+	pending, err := r.data.getPendingBalance(ctx, orgID)
+	if err != nil {
+		r.log.Warnf("Failed to get pending balance: user_id=%s, error=%v", orgID, err)
+	}
+	return exceedsSpendLimitDB(r.data.db.WithContext(ctx), orgID, memberID, month, limit, cost, pending.Amount())
+}
+
+// invalidateMember 删除用户的组织成员身份缓存
+func (r *orgRepo) invalidateMember(ctx context.Context, userID string) {
+	if err := r.data.rdb.Del(ctx, orgMemberKey(userID)).Err(); err != nil {
+		// 删除失败时旧成员身份最多在缓存时间内继续生效
+		r.log.Warnf("failed to invalidate org membership cache: user_id=%s, error=%v", userID, err)
+	}
+}
+
+func toOrgMember(m *model.OrgMember) *biz.OrgMember {
+	return &biz.OrgMember{
+		OrgID:      m.OrgID,
+		UserID:     m.UID,
+		Role:       m.Role,
+		Status:     m.Status,
+		SpendLimit: m.SpendLimit,
+		CreatedAt:  m.CreatedAt,
+	}
+}
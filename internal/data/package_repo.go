@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// packageRepo 用量包相关数据访问
+type packageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPackageRepo 创建用量包 repo（返回 biz.PackageRepo 接口）
+func NewPackageRepo(data *Data, logger log.Logger) biz.PackageRepo {
+	return &packageRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListUserPackages 获取已发放的用量包，按到期时间正序
+// 在途扣减按服务分摊到未过期的用量包（先到期先用，与落库时的分配顺序一致）
+func (r *packageRepo) ListUserPackages(ctx context.Context, userID, serviceName string, includeExpired bool) ([]*biz.UserPackage, error) {
+	now := time.Now()
+
+	// 先读取在途扣减再查询数据库（顺序不能颠倒，见 deduct_cache.go）
+	services := []string{serviceName}
+	if serviceName == "" {
+		if err := r.data.db.WithContext(ctx).Model(&model.UserPackage{}).
+			Where("uid = ? AND expires_at > ?", userID, now).
+			Distinct().Pluck("service_name", &services).Error; err != nil {
+			return nil, err
+		}
+	}
+	pending := make(map[string]int64, len(services))
+	for _, svc := range services {
+		state, err := r.data.getPendingPackage(ctx, userID, svc)
+		if err != nil {
+			r.log.Warnf("ListUserPackages failed to get pending package: userID=%s, service=%s, error=%v", userID, svc, err)
+		}
+		pending[svc] = int64(state.Amount())
+	}
+
+	db := r.data.db.WithContext(ctx).Where("uid = ? AND expires_at IS NOT NULL", userID)
+	if serviceName != "" {
+		db = db.Where("service_name = ?", serviceName)
+	}
+	if !includeExpired {
+		db = db.Where("expires_at > ? AND used_units < total_units", now)
+	}
+	var models []model.UserPackage
+	if err := db.Order("expires_at, user_package_id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	packages := make([]*biz.UserPackage, 0, len(models))
+	for _, m := range models {
+		p := toBizUserPackage(&m)
+		if n := min(pending[p.ServiceName], p.Remaining(now)); n > 0 {
+			p.UsedUnits += n
+			pending[p.ServiceName] -= n
+		}
+		packages = append(packages, p)
+	}
+	return packages, nil
+}
+
+// GetPackageRemaining 服务有效用量包的剩余用量合计（已扣除在途扣减）
+func (r *packageRepo) GetPackageRemaining(ctx context.Context, userID, serviceName string) (int64, error) {
+	// 先读取在途扣减再查询数据库（顺序不能颠倒，见 deduct_cache.go）
+	pending, err := r.data.getPendingPackage(ctx, userID, serviceName)
+	if err != nil {
+		r.log.Warnf("GetPackageRemaining failed to get pending package: userID=%s, service=%s, error=%v", userID, serviceName, err)
+	}
+	remaining, _, err := loadPackageRemaining(r.data.db.WithContext(ctx), userID, serviceName, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return max(remaining-int64(pending.Amount()), 0), nil
+}
+
+// loadPackageRemaining 统计 now 时有效的用量包剩余合计，以及其中最早的到期时间（没有时为零值）
+func loadPackageRemaining(db *gorm.DB, userID, serviceName string, now time.Time) (int64, time.Time, error) {
+	var models []model.UserPackage
+	if err := db.Where("uid = ? AND service_name = ? AND expires_at > ? AND used_units < total_units", userID, serviceName, now).
+		Order("expires_at").
+		Find(&models).Error; err != nil {
+		return 0, time.Time{}, err
+	}
+	var remaining int64
+	var expiresAt time.Time
+	for i, m := range models {
+		if i == 0 {
+			expiresAt = *m.ExpiresAt
+		}
+		remaining += m.TotalUnits - m.UsedUnits
+	}
+	return remaining, expiresAt, nil
+}
+
+// lockActivePackages 锁定 at 时有效且有剩余的用量包（FOR UPDATE），按先到期先用排序
+func lockActivePackages(tx *gorm.DB, userID, serviceName string, at time.Time) ([]model.UserPackage, error) {
+	var models []model.UserPackage
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("uid = ? AND service_name = ? AND expires_at > ? AND used_units < total_units", userID, serviceName, at).
+		Order("expires_at, user_package_id").
+		Find(&models).Error
+	return models, err
+}
+
+// packagesRemaining 已锁定用量包的剩余合计
+func packagesRemaining(packages []model.UserPackage) int64 {
+	var remaining int64
+	for _, p := range packages {
+		remaining += p.TotalUnits - p.UsedUnits
+	}
+	return remaining
+}
+
+// consumePackages 按顺序从已锁定的用量包扣减 units，先跳过 skip（在途扣减占用的部分），返回实际扣减量
+func consumePackages(tx *gorm.DB, packages []model.UserPackage, skip, units int64) (int64, error) {
+	var consumed int64
+	for _, p := range packages {
+		if consumed >= units {
+			break
+		}
+		available := p.TotalUnits - p.UsedUnits
+		if skip >= available {
+			skip -= available
+			continue
+		}
+		n := min(available-skip, units-consumed)
+		skip = 0
+		if err := tx.Model(&model.UserPackage{}).
+			Where("user_package_id = ?", p.UserPackageID).
+			Update("used_units", gorm.Expr("used_units + ?", n)).Error; err != nil {
+			return consumed, err
+		}
+		consumed += n
+	}
+	return consumed, nil
+}
+
+func toBizUserPackage(m *model.UserPackage) *biz.UserPackage {
+	p := &biz.UserPackage{
+		ID:          m.UserPackageID,
+		UID:         m.UID,
+		ServiceName: m.ServiceName,
+		PackageID:   m.PackageID,
+		Name:        m.Name,
+		OrderID:     m.OrderID,
+		TotalUnits:  m.TotalUnits,
+		UsedUnits:   m.UsedUnits,
+		ValidMonths: m.ValidMonths,
+		CreatedAt:   m.CreatedAt,
+	}
+	if m.ExpiresAt != nil {
+		p.ExpiresAt = *m.ExpiresAt
+	}
+	return p
+}
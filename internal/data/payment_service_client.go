@@ -2,15 +2,19 @@ package data
 
 import (
 	"context"
+	"errors"
 
 	"billing-service/internal/biz"
 	"billing-service/internal/conf"
 	"billing-service/internal/constants"
 	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
 	paymentv1 "xinyuan_tech/payment-service/api/payment/v1"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/circuitbreaker"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
@@ -40,6 +44,8 @@ func NewPaymentServiceClient(c *conf.PaymentService, logger log.Logger) (Payment
 		grpc.WithTimeout(c.Timeout.AsDuration()),
 		grpc.WithMiddleware(
 			recovery.Recovery(),
+			breakerRejectedCounter(constants.DependencyPaymentService),
+			circuitbreaker.Client(),
 		),
 	)
 	if err != nil {
@@ -55,6 +61,20 @@ func NewPaymentServiceClient(c *conf.PaymentService, logger log.Logger) (Payment
 	}, nil
 }
 
+// breakerRejectedCounter 统计被熔断器拒绝的请求数（需放在 circuitbreaker.Client 之前）
+func breakerRejectedCounter(dependency string) middleware.Middleware {
+	m := metrics.GetMetrics()
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			reply, err := handler(ctx, req)
+			if m != nil && errors.Is(err, circuitbreaker.ErrNotAllowed) {
+				m.CircuitBreakerRejectedTotal.WithLabelValues(dependency).Inc()
+			}
+			return reply, err
+		}
+	}
+}
+
 // CreatePayment 创建支付订单（实现 biz.PaymentServiceClient 接口）
 func (c *paymentServiceClient) CreatePayment(ctx context.Context, req *biz.CreatePaymentRequest) (*biz.CreatePaymentReply, error) {
 
@@ -0,0 +1,403 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// pricingRepo 账户价格规则与合同数据访问
+type pricingRepo struct {
+	data     *Data
+	cacheTTL time.Duration
+	log      *log.Helper
+}
+
+// NewPricingRepo 创建账户价格 repo（返回 biz.PricingRepo 接口）
+func NewPricingRepo(data *Data, conf *biz.BillingConfig, logger log.Logger) biz.PricingRepo {
+	return &pricingRepo{
+		data:     data,
+		cacheTTL: conf.AccountPricing.RuleCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func priceRulesKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyPriceRules, userID)
+}
+
+// GetUserPriceRules 获取账户未到期的价格规则，先读缓存，缓存缺失时读 DB 并缓存（没有规则时同样缓存，避免每次扣费都查 DB）
+// 缓存期间到期的规则由 biz 按生效时间过滤
+func (r *pricingRepo) GetUserPriceRules(ctx context.Context, userID string) ([]*biz.PriceRule, error) {
+	key := priceRulesKey(userID)
+	cached, err := r.data.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var rules []*biz.PriceRule
+		if err := json.Unmarshal(cached, &rules); err == nil {
+			for _, rule := range rules {
+				rule.UserID = userID
+			}
+			return rules, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	var models []model.PriceRule
+	if err := r.data.db.WithContext(ctx).
+		Where("uid = ? AND (ends_at IS NULL OR ends_at > ?)", userID, time.Now()).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	rules := make([]*biz.PriceRule, 0, len(models))
+	for i := range models {
+		rules = append(rules, toBizPriceRule(&models[i]))
+	}
+	if b, err := json.Marshal(rules); err == nil {
+		if err := r.data.rdb.Set(ctx, key, b, r.cacheTTL).Err(); err != nil {
+			r.log.Warnf("failed to cache price rules: user_id=%s, error=%v", userID, err)
+		}
+	}
+	return rules, nil
+}
+
+// ListPriceRules 获取账户价格规则，按开始时间倒序
+func (r *pricingRepo) ListPriceRules(ctx context.Context, userID string, includeExpired bool) ([]*biz.PriceRule, error) {
+	db := r.data.db.WithContext(ctx).Where("uid = ?", userID)
+	if !includeExpired {
+		db = db.Where("ends_at IS NULL OR ends_at > ?", time.Now())
+	}
+	var models []model.PriceRule
+	if err := db.Order("starts_at DESC, price_rule_id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	rules := make([]*biz.PriceRule, 0, len(models))
+	for i := range models {
+		rules = append(rules, toBizPriceRule(&models[i]))
+	}
+	return rules, nil
+}
+
+// CreatePriceRule 创建价格规则并失效缓存
+func (r *pricingRepo) CreatePriceRule(ctx context.Context, rule *biz.PriceRule) error {
+	m := toPriceRuleModel(rule)
+	if err := r.data.db.WithContext(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	rule.ID, rule.CreatedAt = m.PriceRuleID, m.CreatedAt
+	r.invalidatePriceRules(rule.UserID)
+	return nil
+}
+
+// DeletePriceRule 删除单独设置的价格规则并失效缓存，合同价不能单独删除
+func (r *pricingRepo) DeletePriceRule(ctx context.Context, userID, ruleID string) (bool, error) {
+	result := r.data.db.WithContext(ctx).
+		Where("price_rule_id = ? AND uid = ? AND contract_id = ''", ruleID, userID).
+		Delete(&model.PriceRule{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	r.invalidatePriceRules(userID)
+	return true, nil
+}
+
+// invalidatePriceRules 删除账户价格规则缓存，失败时旧规则最多保留一个缓存周期
+func (r *pricingRepo) invalidatePriceRules(userID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	if err := r.data.rdb.Del(ctx, priceRulesKey(userID)).Err(); err != nil {
+		r.log.Warnf("failed to invalidate price rules cache: user_id=%s, error=%v", userID, err)
+	}
+}
+
+// CreateContract 创建合同及合同价，预付的承诺金额计入余额并记录为合同预付订单（order_type=contract）
+// 锁定余额行串行化同一账户的合同创建，保证期限重叠检查有效
+func (r *pricingRepo) CreateContract(ctx context.Context, contract *biz.Contract) error {
+	contract.ID = uuid.New().String()
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 锁定余额行（不存在时创建）
+		var balance model.UserBalance
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uid = ?", contract.UserID).
+			First(&balance).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeUserBalanceGetFailed)
+			}
+			balance = model.UserBalance{UserBalanceID: uuid.New().String(), UID: contract.UserID}
+			if err := tx.Create(&balance).Error; err != nil {
+				return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeUserBalanceCreateFailed)
+			}
+		}
+
+		// 2. 检查与未结算合同的期限是否重叠
+		var overlapping int64
+		if err := tx.Model(&model.Contract{}).
+			Where("uid = ? AND status = ? AND starts_at < ? AND ends_at > ?",
+				contract.UserID, model.ContractStatusActive, contract.EndsAt, contract.StartsAt).
+			Count(&overlapping).Error; err != nil {
+			return err
+		}
+		if overlapping > 0 {
+			return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeContractOverlap)
+		}
+
+		// 3. 创建合同及合同价
+		m := model.Contract{
+			ContractID:   contract.ID,
+			UID:          contract.UserID,
+			CommitAmount: contract.CommitAmount,
+			StartsAt:     contract.StartsAt,
+			EndsAt:       contract.EndsAt,
+			Status:       contract.Status,
+		}
+		if err := tx.Create(&m).Error; err != nil {
+			return err
+		}
+		contract.CreatedAt = m.CreatedAt
+		for _, rate := range contract.Rates {
+			rate.ContractID = contract.ID
+			rm := toPriceRuleModel(rate)
+			if err := tx.Create(rm).Error; err != nil {
+				return err
+			}
+			rate.ID, rate.CreatedAt = rm.PriceRuleID, rm.CreatedAt
+		}
+
+		// 4. 预付承诺金额计入余额（不经过 payment-service，payment_id 使用合同ID）
+		order := model.RechargeOrder{
+			OrderID:   constants.OrderIDPrefixContract + contract.ID,
+			UID:       contract.UserID,
+			Amount:    contract.CommitAmount,
+			PaymentID: contract.ID,
+			Status:    model.RechargeStatusSuccess,
+			OrderType: constants.OrderTypeContract,
+		}
+		if err := tx.Create(&order).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&balance).Update("balance", gorm.Expr("balance + ?", contract.CommitAmount)).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeUserBalanceUpdateFailed)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后失效余额与价格规则缓存
+	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cacheCancel()
+	if err := r.data.invalidateDeductCache(cacheCtx, balanceCacheKey(contract.UserID)); err != nil {
+		r.log.Warnf("failed to invalidate balance cache in CreateContract: %v", err)
+	}
+	r.invalidatePriceRules(contract.UserID)
+	return nil
+}
+
+// ListContracts 获取账户合同（含合同价），按开始时间倒序
+func (r *pricingRepo) ListContracts(ctx context.Context, userID string, includeSettled bool) ([]*biz.Contract, error) {
+	db := r.data.db.WithContext(ctx).Where("uid = ?", userID)
+	if !includeSettled {
+		db = db.Where("status = ?", model.ContractStatusActive)
+	}
+	var models []model.Contract
+	if err := db.Order("starts_at DESC, contract_id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(models))
+	for i := range models {
+		ids = append(ids, models[i].ContractID)
+	}
+	var rates []model.PriceRule
+	if err := r.data.db.WithContext(ctx).Where("contract_id IN ?", ids).Order("service_name").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+	byContract := make(map[string][]*biz.PriceRule, len(models))
+	for i := range rates {
+		byContract[rates[i].ContractID] = append(byContract[rates[i].ContractID], toBizPriceRule(&rates[i]))
+	}
+
+	contracts := make([]*biz.Contract, 0, len(models))
+	for i := range models {
+		c := toBizContract(&models[i])
+		c.Rates = byContract[c.ID]
+		contracts = append(contracts, c)
+	}
+	return contracts, nil
+}
+
+// ListContractsToSettle 获取到期时间早于 before 的未结算合同，按到期时间正序
+func (r *pricingRepo) ListContractsToSettle(ctx context.Context, before time.Time, limit int) ([]*biz.Contract, error) {
+	var models []model.Contract
+	if err := r.data.db.WithContext(ctx).
+		Where("status = ? AND ends_at <= ?", model.ContractStatusActive, before).
+		Order("ends_at, contract_id").Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	contracts := make([]*biz.Contract, 0, len(models))
+	for i := range models {
+		contracts = append(contracts, toBizContract(&models[i]))
+	}
+	return contracts, nil
+}
+
+// SettleContract 合同 true-up 结算，已结算时返回 nil
+// 未用完的承诺金额从余额扣除（余额不足时扣至 0），记录为补差流水（服务名为空、次数为 0，不计入用量汇总）
+func (r *pricingRepo) SettleContract(ctx context.Context, contractID string, now time.Time) (*biz.Contract, error) {
+	var settled *biz.Contract
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 锁定合同（幂等：已结算时直接返回）
+		var m model.Contract
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("contract_id = ?", contractID).
+			First(&m).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeContractNotFound)
+			}
+			return err
+		}
+		if m.Status != model.ContractStatusActive {
+			return nil
+		}
+
+		// 2. 扣除补差
+		contract := toBizContract(&m)
+		trueUp := contract.Remaining()
+		var charged float64
+		if trueUp > 0 {
+			var balance model.UserBalance
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("uid = ?", m.UID).First(&balance).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeUserBalanceGetFailed)
+			}
+			charged = contract.TrueUpCharge(balance.Balance)
+		}
+		if charged > 0 {
+			if err := tx.Model(&model.UserBalance{}).Where("uid = ?", m.UID).
+				Update("balance", gorm.Expr("balance - ?", charged)).Error; err != nil {
+				return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeContractSettleFailed)
+			}
+			record := model.BillingRecord{
+				BillingRecordID: uuid.New().String(),
+				UID:             m.UID,
+				Type:            model.BillingTypeTrueUp,
+				Amount:          charged,
+				Count:           0,
+				CreatedAt:       now,
+			}
+			if err := tx.Create(&record).Error; err != nil {
+				return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeContractSettleFailed)
+			}
+		}
+
+		// 3. 合同置为已结算
+		if err := tx.Model(&m).Updates(map[string]interface{}{
+			"status":          model.ContractStatusSettled,
+			"true_up_amount":  trueUp,
+			"true_up_charged": charged,
+			"settled_at":      now,
+		}).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeContractSettleFailed)
+		}
+		settled = toBizContract(&m)
+		return nil
+	})
+	if err != nil || settled == nil {
+		return nil, err
+	}
+
+	if settled.TrueUpCharged > 0 {
+		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cacheCancel()
+		if err := r.data.invalidateDeductCache(cacheCtx, balanceCacheKey(settled.UserID)); err != nil {
+			r.log.Warnf("failed to invalidate balance cache in SettleContract: %v", err)
+		}
+	}
+	return settled, nil
+}
+
+// drawDownContract 合同期内的余额扣费累计为合同消耗，必须与扣减余额在同一事务中调用
+// 同一账户未结算合同的期限不重叠，最多更新一条；已结算的合同不再累计
+func drawDownContract(tx *gorm.DB, userID string, amount float64, at time.Time) error {
+	if amount <= 0 {
+		return nil
+	}
+	return tx.Model(&model.Contract{}).
+		Where("uid = ? AND status = ? AND starts_at <= ? AND ends_at > ?", userID, model.ContractStatusActive, at, at).
+		Update("drawn_amount", gorm.Expr("drawn_amount + ?", amount)).Error
+}
+
+func toPriceRuleModel(rule *biz.PriceRule) *model.PriceRule {
+	m := &model.PriceRule{
+		PriceRuleID:     uuid.New().String(),
+		UID:             rule.UserID,
+		ServiceName:     rule.ServiceName,
+		UnitPrice:       rule.UnitPrice,
+		DiscountPercent: rule.DiscountPercent,
+		ContractID:      rule.ContractID,
+		StartsAt:        rule.StartsAt,
+	}
+	if !rule.EndsAt.IsZero() {
+		endsAt := rule.EndsAt
+		m.EndsAt = &endsAt
+	}
+	return m
+}
+
+func toBizPriceRule(m *model.PriceRule) *biz.PriceRule {
+	rule := &biz.PriceRule{
+		ID:              m.PriceRuleID,
+		UserID:          m.UID,
+		ServiceName:     m.ServiceName,
+		UnitPrice:       m.UnitPrice,
+		DiscountPercent: m.DiscountPercent,
+		ContractID:      m.ContractID,
+		StartsAt:        m.StartsAt,
+		CreatedAt:       m.CreatedAt,
+	}
+	if m.EndsAt != nil {
+		rule.EndsAt = *m.EndsAt
+	}
+	return rule
+}
+
+func toBizContract(m *model.Contract) *biz.Contract {
+	c := &biz.Contract{
+		ID:            m.ContractID,
+		UserID:        m.UID,
+		CommitAmount:  m.CommitAmount,
+		DrawnAmount:   m.DrawnAmount,
+		StartsAt:      m.StartsAt,
+		EndsAt:        m.EndsAt,
+		Status:        m.Status,
+		TrueUpAmount:  m.TrueUpAmount,
+		TrueUpCharged: m.TrueUpCharged,
+		CreatedAt:     m.CreatedAt,
+	}
+	if m.SettledAt != nil {
+		c.SettledAt = *m.SettledAt
+	}
+	return c
+}
@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// rateLimitScript 原子检查多个服务的限流规则，全部通过时才计入
+// KEYS[2i-1] 为第 i 个服务的令牌桶（hash: tokens, ts），KEYS[2i] 为当日计数
+// ARGV[1] 当前时间（毫秒），ARGV[2] 距次日零点（毫秒），ARGV[2i+1] / ARGV[2i+2] 为每秒 / 每日上限（0 表示不限）
+// 返回 {1, 0, 0, 空字符串} 通过；{0, 建议等待毫秒数, 服务下标, 'second' / 'day'} 被限流（多条规则超限时取等待最久的）
+const rateLimitScript = `
+local now = tonumber(ARGV[1])
+local dayRemaining = tonumber(ARGV[2])
+local n = #KEYS / 2
+local buckets = {}
+local waitMs, index, limit = 0, 0, ''
+
+for i = 1, n do
+    local perSecond = tonumber(ARGV[2 * i + 1])
+    local perDay = tonumber(ARGV[2 * i + 2])
+    if perSecond > 0 then
+        local state = redis.call('HMGET', KEYS[2 * i - 1], 'tokens', 'ts')
+        local tokens = tonumber(state[1]) or perSecond
+        local ts = tonumber(state[2]) or now
+        if now > ts then
+            tokens = math.min(perSecond, tokens + (now - ts) * perSecond / 1000)
+            ts = now
+        end
+        buckets[i] = {tokens, ts}
+        if tokens < 1 then
+            local wait = math.ceil((1 - tokens) * 1000 / perSecond)
+            if wait > waitMs then
+                waitMs, index, limit = wait, i, 'second'
+            end
+        end
+    end
+    if perDay > 0 then
+        local used = tonumber(redis.call('GET', KEYS[2 * i]) or '0')
+        if used >= perDay and dayRemaining > waitMs then
+            waitMs, index, limit = dayRemaining, i, 'day'
+        end
+    end
+end
+if index > 0 then
+    return {0, waitMs, index, limit}
+end
+
+for i = 1, n do
+    if buckets[i] then
+        -- 令牌桶 1 秒即可补满，过期后等同于满桶
+        redis.call('HSET', KEYS[2 * i - 1], 'tokens', tostring(buckets[i][1] - 1), 'ts', buckets[i][2])
+        redis.call('PEXPIRE', KEYS[2 * i - 1], 2000)
+    end
+    if tonumber(ARGV[2 * i + 2]) > 0 then
+        if redis.call('INCR', KEYS[2 * i]) == 1 then
+            redis.call('PEXPIRE', KEYS[2 * i], dayRemaining + 60000)
+        end
+    end
+end
+return {1, 0, 0, ''}
+`
+
+// rateLimitRepo 请求频率限制数据访问
+type rateLimitRepo struct {
+	data     *Data
+	cacheTTL time.Duration
+	log      *log.Helper
+}
+
+// NewRateLimitRepo 创建请求频率限制 repo（返回 biz.RateLimitRepo 接口）
+func NewRateLimitRepo(data *Data, conf *biz.BillingConfig, logger log.Logger) biz.RateLimitRepo {
+	return &rateLimitRepo{
+		data:     data,
+		cacheTTL: conf.RateLimit.UserCacheTTL,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func rateLimitUserKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.RedisKeyRateLimitUser, userID)
+}
+
+func rateLimitBucketKey(userID, serviceName string) string {
+	return fmt.Sprintf("%s%s:%s", constants.RedisKeyRateLimitBucket, userID, serviceName)
+}
+
+func rateLimitDayKey(userID, serviceName, day string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyRateLimitDay, userID, serviceName, day)
+}
+
+// GetUserRateLimit 获取用户限流设置，先读缓存，缓存缺失时读 DB 并缓存（未设置时同样缓存，避免每次检查都查 DB）
+func (r *rateLimitRepo) GetUserRateLimit(ctx context.Context, userID string) (*biz.UserRateLimit, error) {
+	key := rateLimitUserKey(userID)
+	cached, err := r.data.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var limit *biz.UserRateLimit
+		if err := json.Unmarshal(cached, &limit); err == nil {
+			if limit != nil {
+				limit.UserID = userID
+			}
+			return limit, nil
+		}
+	} else if !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	limit, err := r.loadUserRateLimit(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if b, err := json.Marshal(limit); err == nil {
+		if err := r.data.rdb.Set(ctx, key, b, r.cacheTTL).Err(); err != nil {
+			r.log.Warnf("failed to cache user rate limit: user_id=%s, error=%v", userID, err)
+		}
+	}
+	return limit, nil
+}
+
+// loadUserRateLimit 从 DB 读取用户限流设置，未设置时返回 nil
+func (r *rateLimitRepo) loadUserRateLimit(ctx context.Context, userID string) (*biz.UserRateLimit, error) {
+	var m model.UserRateLimit
+	if err := r.data.db.WithContext(ctx).Where("uid = ?", userID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	limit := &biz.UserRateLimit{UserID: m.UID, Plan: m.Plan, UpdatedAt: m.UpdatedAt}
+	if m.Overrides != "" {
+		if err := json.Unmarshal([]byte(m.Overrides), &limit.Overrides); err != nil {
+			return nil, err
+		}
+	}
+	return limit, nil
+}
+
+// SaveUserRateLimit 保存用户限流设置并删除缓存
+func (r *rateLimitRepo) SaveUserRateLimit(ctx context.Context, limit *biz.UserRateLimit) error {
+	overrides, err := json.Marshal(limit.Overrides)
+	if err != nil {
+		return err
+	}
+	limit.UpdatedAt = time.Now()
+	m := &model.UserRateLimit{
+		UID:       limit.UserID,
+		Plan:      limit.Plan,
+		Overrides: string(overrides),
+		UpdatedAt: limit.UpdatedAt,
+	}
+	err = r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "uid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"plan", "overrides", "updated_at"}),
+	}).Create(m).Error
+	if err != nil {
+		return err
+	}
+	if err := r.data.rdb.Del(ctx, rateLimitUserKey(limit.UserID)).Err(); err != nil {
+		// 删除失败时旧设置最多在缓存时间内继续生效
+		r.log.Warnf("failed to invalidate user rate limit cache: user_id=%s, error=%v", limit.UserID, err)
+	}
+	return nil
+}
+
+// Acquire 原子检查并计入各服务的限流规则，每日窗口按本地时区自然日
+func (r *rateLimitRepo) Acquire(ctx context.Context, userID string, checks []*biz.RateLimitCheck, now time.Time) (*biz.RateLimitDecision, error) {
+	day := now.Format(constants.TimeFormatDate)
+	y, m, d := now.Date()
+	dayRemaining := time.Date(y, m, d+1, 0, 0, 0, 0, now.Location()).Sub(now)
+
+	keys := make([]string, 0, 2*len(checks))
+	args := []interface{}{now.UnixMilli(), dayRemaining.Milliseconds()}
+	for _, check := range checks {
+		keys = append(keys, rateLimitBucketKey(userID, check.ServiceName), rateLimitDayKey(userID, check.ServiceName, day))
+		args = append(args, max(check.Rule.PerSecond, 0), max(check.Rule.PerDay, 0))
+	}
+	res, err := r.data.rdb.Eval(ctx, rateLimitScript, keys, args...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	vals, ok := res.([]interface{})
+	if !ok || len(vals) != 4 {
+		return nil, fmt.Errorf("invalid rate limit script result: %v", res)
+	}
+	allowed, ok1 := vals[0].(int64)
+	waitMs, ok2 := vals[1].(int64)
+	index, ok3 := vals[2].(int64)
+	limit, ok4 := vals[3].(string)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return nil, fmt.Errorf("invalid rate limit script result: %v", res)
+	}
+	if allowed == 1 {
+		return &biz.RateLimitDecision{Allowed: true}, nil
+	}
+	if index < 1 || int(index) > len(checks) {
+		return nil, fmt.Errorf("invalid rate limit script result: %v", res)
+	}
+	return &biz.RateLimitDecision{
+		RetryAfter:  time.Duration(waitMs) * time.Millisecond,
+		ServiceName: checks[index-1].ServiceName,
+		Limit:       limit,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有系统时区数据库
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestRateLimitRepo(t *testing.T) (*rateLimitRepo, *Data) {
+	t.Helper()
+	d, _ := newTestData(t)
+	return &rateLimitRepo{data: d, log: log.NewHelper(log.DefaultLogger)}, d
+}
+
+func acquireOnce(t *testing.T, r *rateLimitRepo, now time.Time, checks ...*biz.RateLimitCheck) *biz.RateLimitDecision {
+	t.Helper()
+	decision, err := r.Acquire(context.Background(), testUserID, checks, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decision
+}
+
+// TestRateLimitTokenBucket 令牌耗尽后限流，建议等待补充一个令牌的时间，等待后放行
+func TestRateLimitTokenBucket(t *testing.T) {
+	r, _ := newTestRateLimitRepo(t)
+	check := &biz.RateLimitCheck{ServiceName: testService, Rule: biz.RateLimitRule{PerSecond: 2}}
+	now := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if d := acquireOnce(t, r, now, check); !d.Allowed {
+			t.Fatalf("request %d: decision = %+v, want allowed", i+1, d)
+		}
+	}
+	d := acquireOnce(t, r, now.Add(100*time.Millisecond), check)
+	// 100ms 补充 0.2 个令牌，还需 0.8 个：400ms
+	if d.Allowed || d.Limit != constants.RateLimitPerSecond || d.ServiceName != testService || d.RetryAfter != 400*time.Millisecond {
+		t.Fatalf("exhausted: decision = %+v, want second limit, retry after 400ms", d)
+	}
+	if d := acquireOnce(t, r, now.Add(500*time.Millisecond), check); !d.Allowed {
+		t.Errorf("after retry-after: decision = %+v, want allowed", d)
+	}
+	if d := acquireOnce(t, r, now.Add(500*time.Millisecond), check); d.Allowed {
+		t.Errorf("refilled one token only: decision = %+v, want limited", d)
+	}
+}
+
+// TestRateLimitDailyReset 每日限额按 now 所在时区的自然日计算，零点后重新计数
+func TestRateLimitDailyReset(t *testing.T) {
+	r, d := newTestRateLimitRepo(t)
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := &biz.RateLimitCheck{ServiceName: testService, Rule: biz.RateLimitRule{PerDay: 2}}
+	// UTC 11-05 15:59:30，上海时间 11-05 23:59:30
+	now := time.Date(2025, 11, 5, 15, 59, 30, 0, time.UTC).In(shanghai)
+
+	for i := 0; i < 2; i++ {
+		if decision := acquireOnce(t, r, now, check); !decision.Allowed {
+			t.Fatalf("request %d: decision = %+v, want allowed", i+1, decision)
+		}
+	}
+	decision := acquireOnce(t, r, now, check)
+	if decision.Allowed || decision.Limit != constants.RateLimitPerDay || decision.RetryAfter != 30*time.Second {
+		t.Fatalf("exhausted: decision = %+v, want day limit, retry after 30s", decision)
+	}
+	dayKey := rateLimitDayKey(testUserID, testService, "2025-11-05")
+	if ttl := d.rdb.PTTL(context.Background(), dayKey).Val(); ttl != 90*time.Second {
+		t.Errorf("day key ttl = %s, want time to midnight + 1m", ttl)
+	}
+
+	// 上海零点（UTC 仍为 11-05）
+	if decision := acquireOnce(t, r, now.Add(30*time.Second), check); !decision.Allowed {
+		t.Errorf("after local midnight: decision = %+v, want allowed", decision)
+	}
+	if used := d.rdb.Get(context.Background(), rateLimitDayKey(testUserID, testService, "2025-11-06")).Val(); used != "1" {
+		t.Errorf("new day count = %q, want 1", used)
+	}
+}
+
+// TestRateLimitAllOrNothing 任一服务被限流时整批不计入；多条规则超限时返回等待最久的
+func TestRateLimitAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	r, d := newTestRateLimitRepo(t)
+	now := time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+	passport := &biz.RateLimitCheck{ServiceName: testService, Rule: biz.RateLimitRule{PerSecond: 10, PerDay: 100}}
+	asset := &biz.RateLimitCheck{ServiceName: testAtomicService, Rule: biz.RateLimitRule{PerSecond: 1, PerDay: 1}}
+
+	if decision := acquireOnce(t, r, now, passport, asset); !decision.Allowed {
+		t.Fatalf("first: decision = %+v, want allowed", decision)
+	}
+	decision := acquireOnce(t, r, now, passport, asset)
+	// asset 同时超出每秒与每日上限，取等待到次日零点
+	if decision.Allowed || decision.ServiceName != testAtomicService || decision.Limit != constants.RateLimitPerDay || decision.RetryAfter != 14*time.Hour {
+		t.Fatalf("second: decision = %+v, want asset day limit, retry after 14h", decision)
+	}
+
+	day := now.Format(constants.TimeFormatDate)
+	if used := d.rdb.Get(ctx, rateLimitDayKey(testUserID, testService, day)).Val(); used != "1" {
+		t.Errorf("passport day count = %q, want 1", used)
+	}
+	if tokens := d.rdb.HGet(ctx, rateLimitBucketKey(testUserID, testService), "tokens").Val(); tokens != "9" {
+		t.Errorf("passport tokens = %q, want 9", tokens)
+	}
+
+	// 只请求 passport 时不受 asset 限制
+	if decision := acquireOnce(t, r, now, passport); !decision.Allowed {
+		t.Errorf("passport only: decision = %+v, want allowed", decision)
+	}
+}
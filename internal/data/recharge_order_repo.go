@@ -3,7 +3,6 @@ package data
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"billing-service/internal/biz"
@@ -18,6 +17,9 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+// balanceOrderTypes 增加余额的订单类型（充值报表与账单充值明细的统计范围）
+var balanceOrderTypes = []string{constants.OrderTypeRecharge, constants.OrderTypeContract}
+
 // rechargeOrderRepo 充值订单相关数据访问
 type rechargeOrderRepo struct {
 	data *Data
@@ -35,10 +37,11 @@ func NewRechargeOrderRepo(data *Data, logger log.Logger) biz.RechargeOrderRepo {
 // CreateRechargeOrder 创建充值订单记录
 func (r *rechargeOrderRepo) CreateRechargeOrder(ctx context.Context, orderID, userID string, amount float64) error {
 	order := model.RechargeOrder{
-		OrderID: orderID,
-		UID:     userID,
-		Amount:  amount,
-		Status:  model.RechargeStatusPending,
+		OrderID:   orderID,
+		UID:       userID,
+		Amount:    amount,
+		Status:    model.RechargeStatusPending,
+		OrderType: constants.OrderTypeRecharge,
 	}
 	return r.data.db.WithContext(ctx).Create(&order).Error
 }
@@ -59,6 +62,7 @@ func (r *rechargeOrderRepo) GetRechargeOrderByID(ctx context.Context, orderID st
 		Amount:    m.Amount,
 		PaymentID: m.PaymentID,
 		Status:    m.Status,
+		OrderType: m.OrderType,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}, nil
@@ -80,6 +84,7 @@ func (r *rechargeOrderRepo) GetRechargeOrderByPaymentID(ctx context.Context, pay
 		Amount:    m.Amount,
 		PaymentID: m.PaymentID,
 		Status:    m.Status,
+		OrderType: m.OrderType,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}, nil
@@ -101,7 +106,8 @@ func (r *rechargeOrderRepo) UpdateRechargeOrderStatus(ctx context.Context, order
 
 // RechargeWithIdempotency 带幂等性保证的充值
 func (r *rechargeOrderRepo) RechargeWithIdempotency(ctx context.Context, orderID, paymentID string, amount float64) error {
-	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	var uid string
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. 锁定订单记录
 		var order model.RechargeOrder
 		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
@@ -110,6 +116,8 @@ func (r *rechargeOrderRepo) RechargeWithIdempotency(ctx context.Context, orderID
 			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeRechargeOrderGetFailed)
 		}
 
+		uid = order.UID
+
 		// 2. 检查订单状态（幂等性）
 		if order.Status == model.RechargeStatusSuccess {
 			r.log.Infof("Recharge already processed: order_id=%s", orderID)
@@ -149,16 +157,97 @@ func (r *rechargeOrderRepo) RechargeWithIdempotency(ctx context.Context, orderID
 			}
 		}
 
-		// 5. 更新 Redis 缓存（设置超时避免阻塞）
-		balanceKey := fmt.Sprintf("%s%s", constants.RedisKeyBalance, order.UID)
-		newBalance := balance.Balance + amount
-		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
-		defer cacheCancel()
-		if err := r.data.rdb.Set(cacheCtx, balanceKey, fmt.Sprintf("%.2f", newBalance), 5*time.Minute).Err(); err != nil {
-			// 缓存更新失败不影响主流程，只记录日志
-			r.log.Warnf("failed to update balance cache in RechargeWithIdempotency: %v", err)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 5. 事务提交后失效 Redis 缓存（设置超时避免阻塞）
+	// 缓存中可能包含尚未落库的扣费，不能直接用 DB 值覆盖，下次访问时按 DB 值 - 在途值回填
+	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cacheCancel()
+	if err := r.data.invalidateDeductCache(cacheCtx, balanceCacheKey(uid)); err != nil {
+		// 缓存更新失败不影响主流程，只记录日志
+		r.log.Warnf("failed to invalidate balance cache in RechargeWithIdempotency: %v", err)
+	}
+	return nil
+}
+
+// CreatePackageOrder 创建用量包订单，同时按目录快照创建待发放的用量包（expires_at 为空）
+// 快照保证支付前目录调整（改价、调整用量或下架）不影响已下单的订单
+func (r *rechargeOrderRepo) CreatePackageOrder(ctx context.Context, orderID, userID string, pkg *biz.UsagePackage) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		order := model.RechargeOrder{
+			OrderID:   orderID,
+			UID:       userID,
+			Amount:    pkg.Price,
+			Status:    model.RechargeStatusPending,
+			OrderType: constants.OrderTypePackage,
+		}
+		if err := tx.Create(&order).Error; err != nil {
+			return err
 		}
+		return tx.Create(&model.UserPackage{
+			UserPackageID: uuid.New().String(),
+			UID:           userID,
+			ServiceName:   pkg.ServiceName,
+			PackageID:     pkg.ID,
+			Name:          pkg.Name,
+			OrderID:       orderID,
+			TotalUnits:    pkg.Units,
+			ValidMonths:   pkg.ValidMonths,
+		}).Error
+	})
+}
 
+// GrantPackageWithIdempotency 带幂等性保证的用量包发放：订单标记为成功，用量包有效期从支付成功时起算
+func (r *rechargeOrderRepo) GrantPackageWithIdempotency(ctx context.Context, orderID, paymentID string) error {
+	var pkg model.UserPackage
+	granted := false
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 锁定订单记录
+		var order model.RechargeOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ?", orderID).
+			First(&order).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeRechargeOrderGetFailed)
+		}
+
+		// 2. 检查订单状态（幂等性）
+		if order.Status == model.RechargeStatusSuccess {
+			r.log.Infof("Package already granted: order_id=%s", orderID)
+			return nil
+		}
+
+		// 3. 更新订单状态和 payment_id
+		if err := tx.Model(&order).Updates(map[string]interface{}{
+			"payment_id": paymentID,
+			"status":     model.RechargeStatusSuccess,
+		}).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeRechargeOrderUpdateFailed)
+		}
+
+		// 4. 发放用量包
+		if err := tx.Where("order_id = ?", orderID).First(&pkg).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodePackageGrantFailed)
+		}
+		expiresAt := time.Now().AddDate(0, pkg.ValidMonths, 0)
+		if err := tx.Model(&pkg).Update("expires_at", expiresAt).Error; err != nil {
+			return pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodePackageGrantFailed)
+		}
+		granted = true
 		return nil
 	})
+	if err != nil || !granted {
+		return err
+	}
+
+	// 5. 事务提交后失效用量包缓存（设置超时避免阻塞），下次扣费时按 DB 值 - 在途值回填
+	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cacheCancel()
+	if err := r.data.invalidateDeductCache(cacheCtx, packageCacheKey(pkg.UID, pkg.ServiceName)); err != nil {
+		r.log.Warnf("failed to invalidate package cache in GrantPackageWithIdempotency: %v", err)
+	}
+	return nil
 }
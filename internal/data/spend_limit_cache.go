@@ -0,0 +1,124 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	"billing-service/internal/data/model"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// 组织成员每月消费上限
+//
+// 上限由 biz 层随扣费请求传入（成员身份缓存中的 SpendLimit），不单独缓存。
+// 设置了上限的成员对应一个本月消费缓存 org:spent:{org_id}:{member_uid}:{month}，值为该成员已落库消费 + 组织在途余额扣费。
+// 月份与硬性预算相同，按组织账户时区的自然月。
+// Lua 扣费与租约预留在扣减余额的同时检查并累加消费缓存；DB 事务扣费锁定余额行后按 DB 计算，提交后删除消费缓存。
+// 在途余额扣费按组织合计（不区分成员），回填时会把其他成员的在途扣费也计入，结果只会偏大（保守）。
+
+// spendLimitScriptFuncs 成员消费上限检查公共函数，拼接在使用它的 Lua 脚本之前
+const spendLimitScriptFuncs = `
+-- checkSpendLimit 检查成员消费上限（limit <= 0 表示不限制）：消费缓存缺失返回 -5，超出返回 3，否则返回 1
+local function checkSpendLimit(memberSpentKey, limit, amount)
+    if limit <= 0 then
+        return 1
+    end
+    local spent = redis.call('GET', memberSpentKey)
+    if not spent then
+        return -5
+    end
+    if tonumber(spent) + amount > limit + 1e-9 then
+        return 3
+    end
+    return 1
+end
+
+-- spendLimitRemaining 成员本月剩余可消费金额
+-- 返回 code, remaining：消费缓存缺失时 code 为 -5；不限制时 remaining 为 nil
+local function spendLimitRemaining(memberSpentKey, limit)
+    if limit <= 0 then
+        return 1, nil
+    end
+    local spent = redis.call('GET', memberSpentKey)
+    if not spent then
+        return -5, nil
+    end
+    return 1, limit - tonumber(spent)
+end
+
+-- addMemberSpent 累加成员消费缓存（缓存不存在时跳过，下次回填按 DB 值 + 在途值计算）
+local function addMemberSpent(memberSpentKey, amount)
+    if redis.call('EXISTS', memberSpentKey) == 1 then
+        redis.call('INCRBYFLOAT', memberSpentKey, amount)
+    end
+end
+`
+
+// memberSpentSnapshot 从 DB 读取的成员本月已落库消费
+type memberSpentSnapshot struct {
+	MemberID string
+	Month    string
+	Spent    float64
+}
+
+func memberSpentKey(orgID, memberID, month string) string {
+	return fmt.Sprintf("%s%s:%s:%s", constants.RedisKeyMemberSpent, orgID, memberID, month)
+}
+
+// memberMonthSpent 成员在预算月份内记入组织账户的已落库余额消费
+func memberMonthSpent(db *gorm.DB, orgID, memberID string, month biz.BillingPeriod) (float64, error) {
+	var spent float64
+	err := db.Model(&model.BillingRecord{}).
+		Where("uid = ? AND member_uid = ? AND type = ? AND created_at >= ? AND created_at < ?", orgID, memberID, model.BillingTypeBalance, month.Start, month.End).
+		Select("COALESCE(SUM(amount), 0)").Scan(&spent).Error
+	if err != nil {
+		return 0, err
+	}
+	return spent, nil
+}
+
+// loadMemberSpentSnapshot 读取成员本月已落库消费
+func loadMemberSpentSnapshot(db *gorm.DB, orgID, memberID string, month biz.BillingPeriod) (*memberSpentSnapshot, error) {
+	spent, err := memberMonthSpent(db, orgID, memberID, month)
+	if err != nil {
+		return nil, err
+	}
+	return &memberSpentSnapshot{MemberID: memberID, Month: month.Key, Spent: spent}, nil
+}
+
+// exceedsSpendLimitDB 按 DB 判断成员消费是否超出上限：本月已落库消费 + 在途余额扣费 + 本次金额
+func exceedsSpendLimitDB(db *gorm.DB, orgID, memberID string, month biz.BillingPeriod, limit, charge, pending float64) (bool, error) {
+	if memberID == "" || limit <= 0 || charge <= 0 {
+		return false, nil
+	}
+	spent, err := memberMonthSpent(db, orgID, memberID, month)
+	if err != nil {
+		return false, err
+	}
+	return spent+pending+charge > limit+budgetEpsilon, nil
+}
+
+// fillMemberSpentCache 回填成员消费缓存，pending 为读取 DB 之前读取的在途余额扣费
+func (d *Data) fillMemberSpentCache(ctx context.Context, orgID string, snapshot *memberSpentSnapshot, pending pendingState) error {
+	keys := []string{memberSpentKey(orgID, snapshot.MemberID, snapshot.Month), pendingBalanceKey(orgID)}
+	value := strconv.FormatFloat(snapshot.Spent+pending.Amount(), 'f', -1, 64)
+	return d.rdb.Eval(ctx, fillCacheScript, keys, value, pending.Issued, int(deductCacheTTL.Seconds())).Err()
+}
+
+// exceedsSpendLimitCache 按缓存判断成员消费加上本次金额是否超出上限，缓存缺失时 missing 为 true
+func (d *Data) exceedsSpendLimitCache(ctx context.Context, orgID, memberID, month string, limit, charge float64) (exceeded, missing bool, err error) {
+	spent, err := d.rdb.Get(ctx, memberSpentKey(orgID, memberID, month)).Float64()
+	if errors.Is(err, redis.Nil) {
+		return false, true, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return spent+charge > limit+budgetEpsilon, false, nil
+}
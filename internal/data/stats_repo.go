@@ -12,6 +12,7 @@ import (
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 // statsRepo 统计相关数据访问
@@ -67,119 +68,29 @@ func (r *statsRepo) GetAllUserIDs(ctx context.Context) ([]string, error) {
 	return userIDs, nil
 }
 
-// GetStatsToday 获取今日调用统计
-func (r *statsRepo) GetStatsToday(ctx context.Context, userID, serviceName string) (*biz.Stats, error) {
-	// 获取今日开始时间
-	now := time.Now()
-	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	todayEnd := todayStart.Add(24 * time.Hour)
-
-	// 构建查询条件
-	query := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).
-		Where("uid = ? AND created_at >= ? AND created_at < ?", userID, todayStart, todayEnd)
-
-	// 如果指定了服务名称，添加过滤条件
-	if serviceName != "" {
-		query = query.Where("service_name = ?", serviceName)
-	}
-
-	// 统计总调用次数和总费用
-	var result struct {
-		TotalCount int
-		TotalCost  float64
-		FreeCount  int
-		PaidCount  int
-	}
-
-	if err := query.Select(
-		"SUM(count) as total_count",
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN amount ELSE 0 END) as total_cost", constants.BillingTypeBalance),
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as free_count", constants.BillingTypeFree),
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as paid_count", constants.BillingTypeBalance),
-	).Scan(&result).Error; err != nil {
-		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
-	}
-
-	return &biz.Stats{
-		UID:         userID,
-		ServiceName: serviceName,
-		TotalCount:  result.TotalCount,
-		TotalCost:   result.TotalCost,
-		FreeCount:   result.FreeCount,
-		PaidCount:   result.PaidCount,
-		Period:      constants.StatsPeriodToday,
-	}, nil
-}
-
-// GetStatsMonth 获取本月调用统计
-func (r *statsRepo) GetStatsMonth(ctx context.Context, userID, serviceName string) (*biz.Stats, error) {
-	// 获取本月开始时间
-	now := time.Now()
-	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	nextMonthStart := monthStart.AddDate(0, 1, 0)
-
-	// 构建查询条件
-	query := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).
-		Where("uid = ? AND created_at >= ? AND created_at < ?", userID, monthStart, nextMonthStart)
-
-	// 如果指定了服务名称，添加过滤条件
-	if serviceName != "" {
-		query = query.Where("service_name = ?", serviceName)
-	}
-
-	// 统计总调用次数和总费用
-	var result struct {
-		TotalCount int
-		TotalCost  float64
-		FreeCount  int
-		PaidCount  int
-	}
-
-	if err := query.Select(
-		"SUM(count) as total_count",
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN amount ELSE 0 END) as total_cost", constants.BillingTypeBalance),
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as free_count", constants.BillingTypeFree),
-		fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as paid_count", constants.BillingTypeBalance),
-	).Scan(&result).Error; err != nil {
-		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+// GetStats 获取 [start, end) 内的调用统计，memberID 非空时只统计该组织成员
+func (r *statsRepo) GetStats(ctx context.Context, userID, memberID, serviceName string, start, end time.Time) (*biz.Stats, error) {
+	result, err := r.sumUsage(ctx, userID, memberID, serviceName, start, end)
+	if err != nil {
+		return nil, err
 	}
 
 	return &biz.Stats{
 		UID:         userID,
+		MemberID:    memberID,
 		ServiceName: serviceName,
 		TotalCount:  result.TotalCount,
 		TotalCost:   result.TotalCost,
 		FreeCount:   result.FreeCount,
 		PaidCount:   result.PaidCount,
-		Period:      constants.StatsPeriodMonth,
 	}, nil
 }
 
-// GetStatsSummary 获取汇总统计（所有服务）
-func (r *statsRepo) GetStatsSummary(ctx context.Context, userID string) (*biz.StatsSummary, error) {
-	// 获取本月开始时间
-	now := time.Now()
-	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	nextMonthStart := monthStart.AddDate(0, 1, 0)
-
+// GetStatsSummary 获取 [start, end) 内的汇总统计（所有服务），memberID 非空时只统计该组织成员
+func (r *statsRepo) GetStatsSummary(ctx context.Context, userID, memberID string, start, end time.Time) (*biz.StatsSummary, error) {
 	// 按服务名称分组统计
-	var serviceStats []struct {
-		ServiceName string
-		TotalCount  int
-		TotalCost   float64
-		FreeCount   int
-		PaidCount   int
-	}
-
-	if err := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).
-		Where("uid = ? AND created_at >= ? AND created_at < ?", userID, monthStart, nextMonthStart).
-		Select(
-			"service_name",
-			"SUM(count) as total_count",
-			fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN amount ELSE 0 END) as total_cost", constants.BillingTypeBalance),
-			fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as free_count", constants.BillingTypeFree),
-			fmt.Sprintf("SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as paid_count", constants.BillingTypeBalance),
-		).
+	var serviceStats []usageSum
+	if err := r.usageQuery(ctx, userID, memberID, "", start, end, "service_name").
 		Group("service_name").
 		Scan(&serviceStats).Error; err != nil {
 		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
@@ -204,8 +115,173 @@ func (r *statsRepo) GetStatsSummary(ctx context.Context, userID string) (*biz.St
 
 	return &biz.StatsSummary{
 		UID:        userID,
+		MemberID:   memberID,
 		TotalCount: totalCount,
 		TotalCost:  totalCost,
 		Services:   services,
 	}, nil
 }
+
+// GetUsageSlots 按时间片聚合用量
+// slotSize 为 1 天/1 小时时查询日/小时汇总表，否则按 15 分钟聚合原始记录
+// 原始记录的时间片以 UTC 对齐的 origin 为基准用 TIMESTAMPDIFF 计算，与数据库会话时区无关
+// 汇总表不区分组织成员，memberID 非空时始终聚合原始记录（时间片起点仍为 15 分钟对齐，由 biz 层归入时间桶）
+func (r *statsRepo) GetUsageSlots(ctx context.Context, userID, memberID, serviceName string, start, end time.Time, slotSize time.Duration) ([]*biz.UsageSlot, error) {
+	var rows []struct {
+		BucketStart time.Time
+		Slot        int64
+		Usage       usageSum `gorm:"embedded"` // gorm 不解析未导出的匿名字段，需以导出字段嵌入
+	}
+
+	origin := start.Truncate(biz.UsageSlotSize)
+	if memberID != "" {
+		slotSize = biz.UsageSlotSize
+	}
+	var query *gorm.DB
+	switch slotSize {
+	case usageDailySlot, usageHourlySlot:
+		table := model.UsageHourly{}.TableName()
+		if slotSize == usageDailySlot {
+			table = model.UsageDaily{}.TableName()
+		}
+		query = r.rollupQuery(ctx, table, userID, serviceName, start, end, "bucket_start").
+			Group("bucket_start").
+			Order("bucket_start")
+	default:
+		query = r.rawUsageQuery(ctx, userID, memberID, serviceName, start, end, "TIMESTAMPDIFF(MINUTE, ?, created_at) DIV ? as slot", origin, int(biz.UsageSlotSize/time.Minute)).
+			Group("slot").
+			Order("slot")
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+
+	slots := make([]*biz.UsageSlot, 0, len(rows))
+	for _, row := range rows {
+		slotStart := row.BucketStart
+		if slotSize != usageDailySlot && slotSize != usageHourlySlot {
+			slotStart = origin.Add(time.Duration(row.Slot) * biz.UsageSlotSize)
+		}
+		slots = append(slots, &biz.UsageSlot{
+			StartTime:  slotStart,
+			TotalCount: row.Usage.TotalCount,
+			TotalCost:  row.Usage.TotalCost,
+			FreeCount:  row.Usage.FreeCount,
+			PaidCount:  row.Usage.PaidCount,
+		})
+	}
+	return slots, nil
+}
+
+// RebuildUsageRollups 从原始消费记录重建用户 [start, end) 内的小时/日汇总（start、end 为 UTC 零点）
+// 在同一事务中删除并重新聚合；可重复执行。合同补差不是用量，与增量维护一致不计入。
+// 事务内 INSERT ... SELECT 对扫描到的消费记录加锁，与增量维护并发时新写入的记录会等待重建提交后再累加，结果不会重复或遗漏
+func (r *statsRepo) RebuildUsageRollups(ctx context.Context, userID string, start, end time.Time) (int64, error) {
+	hourly := model.UsageHourly{}.TableName()
+	daily := model.UsageDaily{}.TableName()
+	var rows int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("uid = ? AND bucket_start >= ? AND bucket_start < ?", userID, start, end).
+			Delete(&model.UsageHourly{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("uid = ? AND bucket_start >= ? AND bucket_start < ?", userID, start, end).
+			Delete(&model.UsageDaily{}).Error; err != nil {
+			return err
+		}
+
+		res := tx.Exec(fmt.Sprintf(
+			"INSERT INTO %s (uid, service_name, bucket_start, total_count, free_count, paid_count, total_cost) "+
+				"SELECT uid, service_name, DATE_ADD(?, INTERVAL TIMESTAMPDIFF(HOUR, ?, created_at) HOUR) AS bucket, %s "+
+				"FROM %s WHERE uid = ? AND created_at >= ? AND created_at < ? AND type <> ? "+
+				"GROUP BY uid, service_name, bucket",
+			hourly, rawUsageColumns, model.BillingRecord{}.TableName()),
+			start, start, userID, start, end, model.BillingTypeTrueUp)
+		if res.Error != nil {
+			return res.Error
+		}
+		rows = res.RowsAffected
+
+		return tx.Exec(fmt.Sprintf(
+			"INSERT INTO %s (uid, service_name, bucket_start, total_count, free_count, paid_count, total_cost) "+
+				"SELECT uid, service_name, DATE_ADD(?, INTERVAL TIMESTAMPDIFF(DAY, ?, bucket_start) DAY) AS bucket, %s "+
+				"FROM %s WHERE uid = ? AND bucket_start >= ? AND bucket_start < ? "+
+				"GROUP BY uid, service_name, bucket",
+			daily, rollupUsageColumns, hourly),
+			start, start, userID, start, end).Error
+	})
+	if err != nil {
+		return 0, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+	return rows, nil
+}
+
+// usageSum 用量合计（列名与汇总表一致）
+type usageSum struct {
+	ServiceName string
+	TotalCount  int
+	TotalCost   float64
+	FreeCount   int
+	PaidCount   int
+}
+
+var (
+	// rawUsageColumns 从原始消费记录聚合用量（顺序与汇总表列一致：total_count, free_count, paid_count, total_cost）
+	// 用量包（已预付）与余额扣费都计入 paid_count，与汇总表一致；金额只统计余额扣费
+	rawUsageColumns = fmt.Sprintf("SUM(count) as total_count, "+
+		"SUM(CASE WHEN type = '%s' THEN count ELSE 0 END) as free_count, "+
+		"SUM(CASE WHEN type <> '%s' THEN count ELSE 0 END) as paid_count, "+
+		"SUM(CASE WHEN type = '%s' THEN amount ELSE 0 END) as total_cost",
+		constants.BillingTypeFree, constants.BillingTypeFree, constants.BillingTypeBalance)
+	// rollupUsageColumns 从汇总表聚合用量
+	rollupUsageColumns = "SUM(total_count) as total_count, SUM(free_count) as free_count, " +
+		"SUM(paid_count) as paid_count, SUM(total_cost) as total_cost"
+)
+
+// sumUsage 统计 [start, end) 内的用量合计
+func (r *statsRepo) sumUsage(ctx context.Context, userID, memberID, serviceName string, start, end time.Time) (*usageSum, error) {
+	var result usageSum
+	if err := r.usageQuery(ctx, userID, memberID, serviceName, start, end, "").Scan(&result).Error; err != nil {
+		return nil, pkgErrors.WrapErrorWithLang(ctx, err, billingErrors.ErrCodeGetStatsFailed)
+	}
+	return &result, nil
+}
+
+// usageQuery 构建 [start, end) 内的用量聚合查询，边界对齐到小时时查询汇总表，否则查询原始消费记录
+// extraColumn 为附加的选择列（用于分组）；汇总表不区分组织成员，memberID 非空时始终查询原始记录
+func (r *statsRepo) usageQuery(ctx context.Context, userID, memberID, serviceName string, start, end time.Time, extraColumn string) *gorm.DB {
+	if table := usageRollupTable(start, end); table != "" && memberID == "" {
+		return r.rollupQuery(ctx, table, userID, serviceName, start, end, extraColumn)
+	}
+	return r.rawUsageQuery(ctx, userID, memberID, serviceName, start, end, extraColumn)
+}
+
+// rollupQuery 从汇总表聚合用量
+func (r *statsRepo) rollupQuery(ctx context.Context, table, userID, serviceName string, start, end time.Time, extraColumn string) *gorm.DB {
+	query := r.data.db.WithContext(ctx).Table(table).
+		Where("uid = ? AND bucket_start >= ? AND bucket_start < ?", userID, start, end)
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+	return query.Select(joinColumns(extraColumn, rollupUsageColumns))
+}
+
+// rawUsageQuery 从原始消费记录聚合用量（合同补差不是用量，与汇总表一致不计入）
+func (r *statsRepo) rawUsageQuery(ctx context.Context, userID, memberID, serviceName string, start, end time.Time, extraColumn string, args ...interface{}) *gorm.DB {
+	query := r.data.db.WithContext(ctx).Model(&model.BillingRecord{}).
+		Where("uid = ? AND created_at >= ? AND created_at < ? AND type <> ?", userID, start, end, model.BillingTypeTrueUp)
+	if memberID != "" {
+		query = query.Where("member_uid = ?", memberID)
+	}
+	if serviceName != "" {
+		query = query.Where("service_name = ?", serviceName)
+	}
+	return query.Select(joinColumns(extraColumn, rawUsageColumns), args...)
+}
+
+func joinColumns(extraColumn, columns string) string {
+	if extraColumn == "" {
+		return columns
+	}
+	return extraColumn + ", " + columns
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"time"
+
+	"billing-service/internal/data/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// usageHourlySlot 小时汇总粒度
+	usageHourlySlot = time.Hour
+	// usageDailySlot 日汇总粒度（UTC 零点对齐）
+	usageDailySlot = 24 * time.Hour
+)
+
+// usageRollupKey 汇总表主键
+var usageRollupKey = []clause.Column{{Name: "uid"}, {Name: "service_name"}, {Name: "bucket_start"}}
+
+// usageDelta 单个汇总桶的增量
+type usageDelta struct {
+	uid         string
+	serviceName string
+	bucketStart time.Time
+	totalCount  int64
+	freeCount   int64
+	paidCount   int64
+	totalCost   float64
+}
+
+// addUsageRollup 将新写入的消费记录累加到小时/日汇总表，必须与写入消费记录在同一事务中调用
+func addUsageRollup(tx *gorm.DB, records ...*model.BillingRecord) error {
+	for _, slot := range []time.Duration{usageHourlySlot, usageDailySlot} {
+		deltas := make(map[string]*usageDelta)
+		var order []string
+		for _, rec := range records {
+			bucket := rec.CreatedAt.Truncate(slot)
+			key := rec.UID + "|" + rec.ServiceName + "|" + bucket.String()
+			d, ok := deltas[key]
+			if !ok {
+				d = &usageDelta{uid: rec.UID, serviceName: rec.ServiceName, bucketStart: bucket}
+				deltas[key] = d
+				order = append(order, key)
+			}
+			d.totalCount += int64(rec.Count)
+			if rec.Type == model.BillingTypeFree {
+				d.freeCount += int64(rec.Count)
+			} else {
+				d.paidCount += int64(rec.Count)
+				d.totalCost += rec.Amount
+			}
+		}
+		for _, key := range order {
+			if err := upsertUsageDelta(tx, slot, deltas[key]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// upsertUsageDelta 插入或累加一个汇总桶
+func upsertUsageDelta(tx *gorm.DB, slot time.Duration, d *usageDelta) error {
+	onConflict := clause.OnConflict{
+		Columns: usageRollupKey,
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"total_count": gorm.Expr("total_count + ?", d.totalCount),
+			"free_count":  gorm.Expr("free_count + ?", d.freeCount),
+			"paid_count":  gorm.Expr("paid_count + ?", d.paidCount),
+			"total_cost":  gorm.Expr("total_cost + ?", d.totalCost),
+		}),
+	}
+	var row interface{}
+	if slot == usageDailySlot {
+		row = &model.UsageDaily{
+			UID:         d.uid,
+			ServiceName: d.serviceName,
+			BucketStart: d.bucketStart,
+			TotalCount:  d.totalCount,
+			FreeCount:   d.freeCount,
+			PaidCount:   d.paidCount,
+			TotalCost:   d.totalCost,
+		}
+	} else {
+		row = &model.UsageHourly{
+			UID:         d.uid,
+			ServiceName: d.serviceName,
+			BucketStart: d.bucketStart,
+			TotalCount:  d.totalCount,
+			FreeCount:   d.freeCount,
+			PaidCount:   d.paidCount,
+			TotalCost:   d.totalCost,
+		}
+	}
+	return tx.Clauses(onConflict).Create(row).Error
+}
+
+// usageRollupTable 返回可精确覆盖 [start, end) 的汇总表，边界未对齐到小时时返回空（需查询原始记录）
+// 优先使用日汇总表，其次小时汇总表
+func usageRollupTable(start, end time.Time) string {
+	switch {
+	case start.Truncate(usageDailySlot).Equal(start) && end.Truncate(usageDailySlot).Equal(end):
+		return model.UsageDaily{}.TableName()
+	case start.Truncate(usageHourlySlot).Equal(start) && end.Truncate(usageHourlySlot).Equal(end):
+		return model.UsageHourly{}.TableName()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"billing-service/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// TestAddUsageRollupUpsert 消费记录按 UTC 小时/日累加到汇总表，同一桶多次写入时累加；用量包计入付费用量但不计金额
+func TestAddUsageRollupUpsert(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestData(t)
+	newTestDB(t, d, &model.UsageHourly{}, &model.UsageDaily{})
+	at := func(hour, minute int) time.Time { return time.Date(2025, 11, 5, hour, minute, 0, 0, time.UTC) }
+	record := func(service, recordType string, count int, amount float64, createdAt time.Time) *model.BillingRecord {
+		return &model.BillingRecord{UID: testUserID, ServiceName: service, Type: recordType, Count: count, Amount: amount, CreatedAt: createdAt}
+	}
+
+	batches := [][]*model.BillingRecord{
+		{
+			record(testService, model.BillingTypeFree, 3, 0, at(10, 5)),
+			record(testService, model.BillingTypeBalance, 2, 0.5, at(10, 40)),
+			record(testAtomicService, model.BillingTypeBalance, 1, 2, at(10, 41)),
+		},
+		{
+			record(testService, model.BillingTypeBalance, 4, 1, at(10, 59)),
+			record(testService, model.BillingTypePackage, 5, 0, at(11, 0)),
+		},
+		{
+			record(testService, model.BillingTypeBalance, 1, 0.25, at(23, 59).Add(59*time.Second)),
+		},
+	}
+	for _, batch := range batches {
+		if err := d.db.Transaction(func(tx *gorm.DB) error { return addUsageRollup(tx, batch...) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type bucket struct {
+		total, free, paid int64
+		cost              float64
+	}
+	hourly := map[time.Time]bucket{}
+	var hourlyRows []model.UsageHourly
+	d.db.Where("service_name = ?", testService).Find(&hourlyRows)
+	for _, row := range hourlyRows {
+		hourly[row.BucketStart.UTC()] = bucket{row.TotalCount, row.FreeCount, row.PaidCount, row.TotalCost}
+	}
+	wantHourly := map[time.Time]bucket{
+		at(10, 0): {9, 3, 6, 1.5},
+		at(11, 0): {5, 0, 5, 0},
+		at(23, 0): {1, 0, 1, 0.25},
+	}
+	if len(hourly) != len(wantHourly) {
+		t.Errorf("hourly buckets = %v, want %v", hourly, wantHourly)
+	}
+	for start, want := range wantHourly {
+		if hourly[start] != want {
+			t.Errorf("hourly %s = %+v, want %+v", start.Format(time.TimeOnly), hourly[start], want)
+		}
+	}
+
+	var daily []model.UsageDaily
+	d.db.Order("service_name").Find(&daily)
+	if len(daily) != 2 {
+		t.Fatalf("daily rows = %d, want 2", len(daily))
+	}
+	if got := daily[1]; got.ServiceName != testService || !got.BucketStart.Equal(at(0, 0)) ||
+		got.TotalCount != 15 || got.FreeCount != 3 || got.PaidCount != 12 || got.TotalCost != 1.75 {
+		t.Errorf("daily %s = %+v", testService, got)
+	}
+
+	// 按小时 / 天读取汇总表
+	stats := &statsRepo{data: d, log: log.NewHelper(log.DefaultLogger)}
+	slots, err := stats.GetUsageSlots(ctx, testUserID, "", testService, at(0, 0), at(0, 0).Add(24*time.Hour), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 3 {
+		t.Fatalf("hourly slots = %d, want 3", len(slots))
+	}
+	if !slots[0].StartTime.Equal(at(10, 0)) || slots[0].TotalCount != 9 || slots[2].TotalCost != 0.25 {
+		t.Errorf("hourly slots = %+v, %+v, %+v", *slots[0], *slots[1], *slots[2])
+	}
+	slots, err = stats.GetUsageSlots(ctx, testUserID, "", "", at(0, 0), at(0, 0).Add(24*time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 1 {
+		t.Fatalf("daily slots = %d, want 1", len(slots))
+	}
+	if slots[0].TotalCount != 16 || slots[0].PaidCount != 13 || slots[0].TotalCost != 3.75 {
+		t.Errorf("daily slot = %+v", *slots[0])
+	}
+}
+
+// TestUsageRollupTable 边界对齐到 UTC 零点时使用日汇总，对齐到整点时使用小时汇总，否则查询原始记录
+func TestUsageRollupTable(t *testing.T) {
+	day := time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		start, end time.Time
+		want       string
+	}{
+		{day, day.AddDate(0, 0, 7), model.UsageDaily{}.TableName()},
+		{day, day.Add(13 * time.Hour), model.UsageHourly{}.TableName()},
+		{day.Add(-8 * time.Hour), day.Add(16 * time.Hour), model.UsageHourly{}.TableName()}, // UTC+8 自然日
+		{day.Add(-330 * time.Minute), day.Add(18*time.Hour + 30*time.Minute), ""},           // UTC+5:30 自然日
+		{day, day.Add(90 * time.Minute), ""},
+	}
+	for _, tc := range cases {
+		if got := usageRollupTable(tc.start, tc.end); got != tc.want {
+			t.Errorf("usageRollupTable(%s, %s) = %q, want %q", tc.start, tc.end, got, tc.want)
+		}
+	}
+}
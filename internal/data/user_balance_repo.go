@@ -49,7 +49,12 @@ func (r *userBalanceRepo) GetUserBalance(ctx context.Context, userID string) (*b
 		}
 	}
 
-	// 缓存未命中，从数据库查询
+	// 缓存未命中，先读取在途扣费再查询数据库（顺序不能颠倒，见 deduct_cache.go）
+	pending, err := r.data.getPendingBalance(ctx, userID)
+	if err != nil {
+		r.log.Warnf("GetUserBalance failed to get pending balance: userID=%s, error=%v", userID, err)
+	}
+
 	var m model.UserBalance
 	if err := r.data.db.WithContext(ctx).Where("uid = ?", userID).First(&m).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -60,9 +65,10 @@ func (r *userBalanceRepo) GetUserBalance(ctx context.Context, userID string) (*b
 		return nil, fmt.Errorf("failed to query user balance from database: %w", err)
 	}
 
+	// 扣除已在 Redis 扣减但尚未落库的部分，保证刚完成的扣费立即可见
 	result := &biz.UserBalance{
 		UID:       m.UID,
-		Balance:   m.Balance,
+		Balance:   max(m.Balance-pending.Amount(), 0),
 		UpdatedAt: m.UpdatedAt,
 	}
 
@@ -70,7 +76,7 @@ func (r *userBalanceRepo) GetUserBalance(ctx context.Context, userID string) (*b
 	go func() {
 		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
 		defer cacheCancel()
-		if err := r.data.rdb.Set(cacheCtx, balanceKey, fmt.Sprintf("%.2f", m.Balance), 5*time.Minute).Err(); err != nil {
+		if err := r.data.fillBalanceCache(cacheCtx, userID, m.Balance-pending.Amount(), pending); err != nil {
 			// 缓存更新失败不影响主流程，只记录日志（异步操作，使用默认 logger）
 			// 注意：这里不能使用 r.log，因为是在 goroutine 中
 		}
@@ -81,7 +87,7 @@ func (r *userBalanceRepo) GetUserBalance(ctx context.Context, userID string) (*b
 
 // Recharge 充值（简单逻辑：如果不存在则创建，存在则增加）
 func (r *userBalanceRepo) Recharge(ctx context.Context, userID string, amount float64) error {
-	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var m model.UserBalance
 		if err := tx.Where("uid = ?", userID).First(&m).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -94,18 +100,19 @@ func (r *userBalanceRepo) Recharge(ctx context.Context, userID string, amount fl
 			}
 			return err
 		}
-		if err := tx.Model(&m).Update("balance", gorm.Expr("balance + ?", amount)).Error; err != nil {
-			return err
-		}
-		// 更新 Redis 缓存（设置超时避免阻塞）
-		balanceKey := fmt.Sprintf("%s%s", constants.RedisKeyBalance, userID)
-		newBalance := m.Balance + amount
-		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
-		defer cacheCancel()
-		if err := r.data.rdb.Set(cacheCtx, balanceKey, fmt.Sprintf("%.2f", newBalance), 5*time.Minute).Err(); err != nil {
-			// 缓存更新失败不影响主流程，只记录日志
-			r.log.Warnf("failed to update balance cache in Recharge: %v", err)
-		}
-		return nil
+		return tx.Model(&m).Update("balance", gorm.Expr("balance + ?", amount)).Error
 	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后失效 Redis 缓存（设置超时避免阻塞）
+	// 缓存中可能包含尚未落库的扣费，不能直接用 DB 值覆盖
+	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cacheCancel()
+	if err := r.data.invalidateDeductCache(cacheCtx, balanceCacheKey(userID)); err != nil {
+		// 缓存更新失败不影响主流程，只记录日志
+		r.log.Warnf("failed to invalidate balance cache in Recharge: %v", err)
+	}
+	return nil
 }
@@ -23,7 +23,16 @@ func init() {
 //   03: 充值模块
 //   04: 扣费模块
 //   05: 订单模块
-//   06-99: 预留扩展
+//   06: 统计模块
+//   07: 通用数据访问
+//   08: 导出模块
+//   09: 认证与权限模块
+//   10: 预算模块
+//   11: 限流模块
+//   12: 账户设置模块
+//   13: 用量包模块
+//   14: 账户价格与合同模块
+//   15-99: 预留扩展
 
 // 余额模块错误码 (190100-190199)
 const (
@@ -69,6 +78,26 @@ const (
 	ErrCodeDeductQuotaFailed = 190401
 	// ErrCodeDeductLockFailed 获取扣费锁失败
 	ErrCodeDeductLockFailed = 190402
+	// ErrCodeDeductDegraded 计费依赖不可用，按降级策略拒绝扣费
+	ErrCodeDeductDegraded = 190403
+	// ErrCodeLeaseNotFound 租约不存在或已回收
+	ErrCodeLeaseNotFound = 190404
+	// ErrCodeLeaseExpired 租约已过期，无法续期
+	ErrCodeLeaseExpired = 190405
+	// ErrCodeLeaseUsageExceeded 上报用量超出租约剩余次数
+	ErrCodeLeaseUsageExceeded = 190406
+	// ErrCodeInvalidLeaseCount 租约申请次数无效
+	ErrCodeInvalidLeaseCount = 190407
+	// ErrCodeInvalidQuotaItems 批量检查/扣费的服务项为空、数量无效或超出上限
+	ErrCodeInvalidQuotaItems = 190408
+	// ErrCodeUsageUnitMismatch 用量单位与服务计量单位不一致
+	ErrCodeUsageUnitMismatch = 190409
+	// ErrCodeCallerCostOutOfBounds 调用方传入的费用超出服务允许的范围
+	ErrCodeCallerCostOutOfBounds = 190410
+	// ErrCodeInvalidDeductMetadata 扣费来源信息超出长度或个数限制
+	ErrCodeInvalidDeductMetadata = 190411
+	// ErrCodeInvalidRecordQuery 消费记录查询条件或分页游标无效
+	ErrCodeInvalidRecordQuery = 190412
 )
 
 // 订单模块错误码 (190500-190599)
@@ -87,6 +116,10 @@ const (
 	ErrCodeGetAllUserIDsFailed = 190601
 	// ErrCodeGetStatsFailed 获取统计失败
 	ErrCodeGetStatsFailed = 190602
+	// ErrCodeInvalidUsageSeriesQuery 用量时间序列查询参数无效（时间范围、粒度或时区）
+	ErrCodeInvalidUsageSeriesQuery = 190603
+	// ErrCodeInvalidReportQuery 报表查询参数无效（时间范围、粒度、排序或条数）
+	ErrCodeInvalidReportQuery = 190604
 )
 
 // 通用数据访问错误码 (190700-190799)
@@ -106,3 +139,95 @@ const (
 	// ErrCodeInvalidUserID 无效的用户ID
 	ErrCodeInvalidUserID = 190709
 )
+
+// 导出模块错误码 (190800-190899)
+const (
+	// ErrCodeExportNotFound 导出任务不存在
+	ErrCodeExportNotFound = 190801
+	// ErrCodeInvalidExportFormat 不支持的导出格式
+	ErrCodeInvalidExportFormat = 190802
+	// ErrCodeInvalidExportRange 导出时间范围无效
+	ErrCodeInvalidExportRange = 190803
+	// ErrCodeExportTooLarge 导出数据量超过上限
+	ErrCodeExportTooLarge = 190804
+	// ErrCodeExportLinkInvalid 下载链接无效或已过期
+	ErrCodeExportLinkInvalid = 190805
+	// ErrCodeExportNotReady 导出文件尚未生成或已过期
+	ErrCodeExportNotReady = 190806
+)
+
+// 认证与权限模块错误码 (190900-190999)
+const (
+	// ErrCodeUnauthenticated 未认证或凭证无效
+	ErrCodeUnauthenticated = 190901
+	// ErrCodePermissionDenied 无权访问
+	ErrCodePermissionDenied = 190902
+	// ErrCodeAuthUnavailable 认证服务暂不可用（会话令牌内省失败）
+	ErrCodeAuthUnavailable = 190903
+)
+
+// 预算模块错误码 (191000-191099)
+const (
+	// ErrCodeBudgetExceeded 超出消费预算（硬性上限）
+	ErrCodeBudgetExceeded = 191001
+	// ErrCodeInvalidBudget 预算参数无效（服务、金额或提醒阈值）
+	ErrCodeInvalidBudget = 191002
+	// ErrCodeBudgetNotFound 预算不存在
+	ErrCodeBudgetNotFound = 191003
+)
+
+// 限流模块错误码 (191100-191199)
+const (
+	// ErrCodeInvalidRateLimit 限流规则无效（服务名称或上限）
+	ErrCodeInvalidRateLimit = 191101
+	// ErrCodeUnknownRateLimitPlan 限流套餐不存在
+	ErrCodeUnknownRateLimitPlan = 191102
+)
+
+// 账户设置模块错误码 (191200-191299)
+const (
+	// ErrCodeInvalidTimezone 时区无效（不是 IANA 时区名称）
+	ErrCodeInvalidTimezone = 191201
+)
+
+// 用量包模块错误码 (191300-191399)
+const (
+	// ErrCodePackageNotFound 用量包不存在（不在目录中或已下架）
+	ErrCodePackageNotFound = 191301
+	// ErrCodePackageGrantFailed 用量包发放失败
+	ErrCodePackageGrantFailed = 191302
+)
+
+// 账户价格与合同模块错误码 (191400-191499)
+const (
+	// ErrCodeInvalidPriceRule 价格规则无效（服务、单价、折扣或有效期）
+	ErrCodeInvalidPriceRule = 191401
+	// ErrCodePriceRuleNotFound 价格规则不存在
+	ErrCodePriceRuleNotFound = 191402
+	// ErrCodeInvalidContract 合同无效（承诺金额或期限）
+	ErrCodeInvalidContract = 191403
+	// ErrCodeContractOverlap 合同期限与账户其他未结算合同重叠
+	ErrCodeContractOverlap = 191404
+	// ErrCodeContractNotFound 合同不存在
+	ErrCodeContractNotFound = 191405
+	// ErrCodeContractSettleFailed 合同结算失败
+	ErrCodeContractSettleFailed = 191406
+)
+
+// 组织模块错误码 (191500-191599)
+const (
+	// ErrCodeInvalidOrganization 组织参数无效（名称、成员角色或消费上限）
+	ErrCodeInvalidOrganization = 191501
+	// ErrCodeOrgNotFound 组织不存在
+	ErrCodeOrgNotFound = 191502
+	// ErrCodeOrgMemberExists 用户已属于某个组织（每个用户最多属于一个组织）
+	ErrCodeOrgMemberExists = 191503
+	// ErrCodeOrgMemberNotFound 组织成员不存在
+	ErrCodeOrgMemberNotFound = 191504
+	// ErrCodeOrgPermissionDenied 无权查看或管理该组织（非成员、角色不足或变更所有者）
+	ErrCodeOrgPermissionDenied = 191505
+	// ErrCodeOrgMemberCountExceeded 组织成员数达到上限
+	ErrCodeOrgMemberCountExceeded = 191506
+	// ErrCodeMemberSpendLimitExceeded 超出组织成员每月消费上限
+	ErrCodeMemberSpendLimitExceeded = 191507
+)
@@ -38,6 +38,40 @@ type BillingMetrics struct {
 	// 分布式锁相关指标
 	LockAcquireTotal    *prometheus.CounterVec // 锁获取总数（按结果）
 	LockAcquireDuration prometheus.Histogram  // 锁获取耗时
+
+	// 消息队列相关指标
+	MQConsumerLag *prometheus.GaugeVec // 扣费事件消费堆积（按 topic、broker、队列）
+
+	// 降级与熔断相关指标
+	CircuitBreakerRejectedTotal *prometheus.CounterVec // 熔断器拒绝总数（按依赖）
+	DegradedRequestTotal        *prometheus.CounterVec // 降级处理的请求总数（按服务、策略、结果）
+	DeferredChargePending       prometheus.Gauge       // 待结算的延迟扣费数
+	DeferredChargeSettledTotal  *prometheus.CounterVec // 延迟扣费结算总数（按结果）
+	DeferredChargeRejectedTotal *prometheus.CounterVec // 策略放行但未能记录的延迟扣费总数（按原因）
+
+	// 额度租约相关指标
+	LeaseOperationTotal *prometheus.CounterVec // 租约操作总数（按操作、结果）
+	LeaseGrantedCount   *prometheus.CounterVec // 租约授予的调用次数（按服务）
+	LeaseReclaimedCount *prometheus.CounterVec // 租约回收的未用次数（按服务）
+
+	// 流式扣费相关指标
+	StreamDeductBatchSize prometheus.Histogram // 流式扣费每批合并的请求数
+	StreamDeductInFlight  prometheus.Gauge     // 流式扣费在途请求数（所有流）
+
+	// 账单导出相关指标
+	ExportJobTotal    *prometheus.CounterVec   // 导出任务总数（按格式、结果）
+	ExportJobDuration *prometheus.HistogramVec // 导出文件生成耗时（按格式）
+	ExportRowCount    prometheus.Histogram     // 单次导出行数
+
+	// 内部接口鉴权相关指标
+	InternalAuthDeniedTotal *prometheus.CounterVec // 内部接口拒绝总数（按调用方、操作、原因）
+
+	// 消费预算相关指标
+	BudgetDeniedTotal *prometheus.CounterVec // 超出硬性预算被拒绝的检查/扣费总数（按服务、操作）
+	BudgetAlertTotal  *prometheus.CounterVec // 预算提醒通知总数（按结果）
+
+	// 限流相关指标
+	RateLimitedTotal *prometheus.CounterVec // 被限流的配额检查总数（按服务、限制类型）
 }
 
 // NewBillingMetrics 创建计费服务指标
@@ -181,6 +215,147 @@ func NewBillingMetrics() *BillingMetrics {
 				Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0}, // 毫秒级
 			},
 		),
+
+		// 消息队列指标
+		MQConsumerLag: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "billing_mq_consumer_lag",
+				Help: "Number of deduct events not yet consumed per message queue",
+			},
+			[]string{"topic", "broker", "queue"},
+		),
+
+		// 降级与熔断指标
+		CircuitBreakerRejectedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_circuit_breaker_rejected_total",
+				Help: "Total number of calls rejected by circuit breakers",
+			},
+			[]string{"dependency"}, // dependency: redis/mysql/payment_service
+		),
+		DegradedRequestTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_degraded_request_total",
+				Help: "Total number of requests handled by degradation policies",
+			},
+			[]string{"service", "policy", "result"}, // result: allowed/denied
+		),
+		DeferredChargePending: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "billing_deferred_charge_pending",
+				Help: "Number of deferred charges waiting for settlement",
+			},
+		),
+		DeferredChargeSettledTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_deferred_charge_settled_total",
+				Help: "Total number of deferred charge settlements",
+			},
+			[]string{"result"}, // result: success/failed
+		),
+		DeferredChargeRejectedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_deferred_charge_rejected_total",
+				Help: "Total number of admitted deferred charges rejected before being recorded",
+			},
+			[]string{"reason"}, // reason: queue_full/journal_error
+		),
+
+		// 额度租约指标
+		LeaseOperationTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_lease_operation_total",
+				Help: "Total number of quota lease operations",
+			},
+			[]string{"operation", "result"}, // operation: acquire/report/release/reclaim
+		),
+		LeaseGrantedCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_lease_granted_count_total",
+				Help: "Total number of calls granted through quota leases",
+			},
+			[]string{"service"},
+		),
+		LeaseReclaimedCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_lease_reclaimed_count_total",
+				Help: "Total number of unused leased calls returned to balances and quotas",
+			},
+			[]string{"service"},
+		),
+
+		// 流式扣费指标
+		StreamDeductBatchSize: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "billing_stream_deduct_batch_size",
+				Help:    "Number of deduct requests merged into one stream batch",
+				Buckets: prometheus.ExponentialBuckets(1, 2, 10), // 1 ~ 512
+			},
+		),
+		StreamDeductInFlight: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "billing_stream_deduct_in_flight",
+				Help: "Number of stream deduct requests received but not yet answered",
+			},
+		),
+
+		// 账单导出指标
+		ExportJobTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_export_job_total",
+				Help: "Total number of statement export jobs by format and result",
+			},
+			[]string{"format", "status"},
+		),
+		ExportJobDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "billing_export_job_duration_seconds",
+				Help:    "Time spent generating statement export files",
+				Buckets: prometheus.ExponentialBuckets(0.05, 2, 12), // 50ms ~ 100s
+			},
+			[]string{"format"},
+		),
+		ExportRowCount: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "billing_export_row_count",
+				Help:    "Number of rows per statement export",
+				Buckets: prometheus.ExponentialBuckets(10, 4, 10), // 10 ~ 2.6M
+			},
+		),
+
+		// 内部接口鉴权指标
+		InternalAuthDeniedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_internal_auth_denied_total",
+				Help: "Total number of denied internal API calls by caller, operation and reason",
+			},
+			[]string{"caller", "operation", "reason"}, // caller: 未认证时为 unknown
+		),
+
+		// 消费预算指标
+		BudgetDeniedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_budget_denied_total",
+				Help: "Total number of quota checks and deductions denied by hard spending budgets",
+			},
+			[]string{"service", "operation"}, // operation: check, deduct
+		),
+		BudgetAlertTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_budget_alert_total",
+				Help: "Total number of budget alert notifications by result",
+			},
+			[]string{"result"}, // success, failed
+		),
+
+		// 限流指标
+		RateLimitedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "billing_rate_limited_total",
+				Help: "Total number of quota checks throttled by per-second or per-day rate limits",
+			},
+			[]string{"service", "limit"}, // limit: second, day
+		),
 	}
 }
 
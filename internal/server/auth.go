@@ -0,0 +1,338 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// adminOperationPrefix 管理服务的 operation 前缀（HTTP 与 gRPC 一致）
+	adminOperationPrefix = "/billing.v1.BillingAdminService/"
+	// userOperationPrefix 外部服务的 operation 前缀
+	userOperationPrefix = "/billing.v1.BillingService/"
+	// liveUsageStreamPath 实时用量推送（自定义路由，operation 为路由模板）
+	liveUsageStreamPath = "/api/v1/billing/stats/live/stream"
+
+	// jwtLeeway JWT 过期/生效时间允许的时钟偏差
+	jwtLeeway = 30 * time.Second
+)
+
+// errTokenUnsupported 令牌类型未配置校验方式（非 JWT 且未配置会话内省，或未配置 JWT 校验）
+var errTokenUnsupported = errors.New("token type is not supported")
+
+// authStatusMapping 认证错误码对应的 HTTP 状态码
+var authStatusMapping = map[int]int{
+	billingErrors.ErrCodeUnauthenticated:  401,
+	billingErrors.ErrCodePermissionDenied: 403,
+	billingErrors.ErrCodeAuthUnavailable:  503,
+}
+
+// Identity 认证后的终端用户身份
+type Identity struct {
+	UID    string
+	Scopes []string
+}
+
+// hasScope 是否拥有指定权限范围
+func (id *Identity) hasScope(scope string) bool {
+	for _, s := range id.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator 接口鉴权
+// 外部接口（BillingService）要求 passport 签发的 JWT 或会话令牌，用户ID从令牌中获取；
+// 管理接口（BillingAdminService）接受配置的管理员 Token 或带管理员权限范围的用户令牌；
+// 内部接口（BillingInternalService）要求调用方签发的服务令牌，并按调用方策略检查方法与 serviceName
+type Authenticator struct {
+	adminDigests [][sha256.Size]byte
+	adminScope   string
+
+	jwtParser    *jwt.Parser // nil 表示未配置 JWT 校验
+	jwtSecret    []byte
+	jwtPublicKey crypto.PublicKey
+	uidClaim     string
+	scopeClaim   string
+
+	session *sessionIntrospector // nil 表示未配置会话令牌校验
+
+	internalCallers    map[string]*internalCaller // 按调用方名称（服务令牌 iss）索引
+	serviceTokenParser *jwt.Parser
+	serviceTokenMaxTTL time.Duration // 0 表示不限制
+
+	log     *log.Helper
+	audit   *log.Helper
+	metrics *metrics.BillingMetrics
+}
+
+// NewAuthenticator 创建接口鉴权器
+func NewAuthenticator(c *conf.Server, logger log.Logger) (*Authenticator, error) {
+	cfg := c.GetAuth()
+	a := &Authenticator{
+		adminScope: cfg.GetAdminScope(),
+		uidClaim:   cfg.GetJwt().GetUidClaim(),
+		scopeClaim: cfg.GetJwt().GetScopeClaim(),
+		log:        log.NewHelper(logger),
+		audit:      log.NewHelper(log.With(logger, "audit", "internal_auth")),
+		metrics:    metrics.GetMetrics(),
+	}
+	if a.adminScope == "" {
+		a.adminScope = constants.DefaultAdminScope
+	}
+	if a.uidClaim == "" {
+		a.uidClaim = constants.DefaultJWTUIDClaim
+	}
+	if a.scopeClaim == "" {
+		a.scopeClaim = constants.DefaultJWTScopeClaim
+	}
+	for _, token := range cfg.GetAdminTokens() {
+		if token != "" {
+			a.adminDigests = append(a.adminDigests, sha256.Sum256([]byte(token)))
+		}
+	}
+
+	if err := a.initJWT(cfg.GetJwt()); err != nil {
+		return nil, err
+	}
+	if url := cfg.GetSession().GetIntrospectUrl(); url != "" {
+		a.session = newSessionIntrospector(cfg.GetSession())
+	}
+	if a.jwtParser == nil && a.session == nil {
+		a.log.Warn("server.auth.jwt and server.auth.session are not configured, user APIs are disabled")
+	}
+	if err := a.initInternalCallers(cfg); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// initJWT 根据配置的共享密钥/公钥确定允许的签名算法
+func (a *Authenticator) initJWT(c *conf.Server_Auth_JWT) error {
+	var methods []string
+	if c.GetSecret() != "" {
+		a.jwtSecret = []byte(c.GetSecret())
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if pem := c.GetPublicKey(); pem != "" {
+		if key, err := jwt.ParseRSAPublicKeyFromPEM([]byte(pem)); err == nil {
+			a.jwtPublicKey = key
+			methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512")
+		} else if key, err := jwt.ParseECPublicKeyFromPEM([]byte(pem)); err == nil {
+			a.jwtPublicKey = key
+			methods = append(methods, "ES256", "ES384", "ES512")
+		} else {
+			return fmt.Errorf("parse server.auth.jwt.public_key: %w", err)
+		}
+	}
+	if len(methods) == 0 {
+		return nil
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if c.GetIssuer() != "" {
+		opts = append(opts, jwt.WithIssuer(c.GetIssuer()))
+	}
+	if c.GetAudience() != "" {
+		opts = append(opts, jwt.WithAudience(c.GetAudience()))
+	}
+	a.jwtParser = jwt.NewParser(opts...)
+	return nil
+}
+
+// UserMiddleware 外部接口鉴权中间件
+// 请求中的 userId 为空时使用令牌中的用户ID；与令牌不一致时需要管理员权限范围
+func (a *Authenticator) UserMiddleware() middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			id, err := a.authenticateRequest(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err := a.bindUserID(ctx, id, req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}).Prefix(userOperationPrefix).Path(liveUsageStreamPath).Build()
+}
+
+// AdminMiddleware 管理接口鉴权中间件
+// 管理员 Token 只保存 SHA-256 摘要并做常量时间比较；不匹配时按用户令牌校验并要求管理员权限范围
+func (a *Authenticator) AdminMiddleware() middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			token, ok := requestToken(ctx)
+			if !ok {
+				return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnauthenticated)
+			}
+			if a.isAdminToken(token) {
+				return handler(ctx, req)
+			}
+
+			id, err := a.authenticate(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			if !id.hasScope(a.adminScope) {
+				a.log.Warnf("admin auth denied: uid=%s, operation=%s", id.UID, operation(ctx))
+				return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePermissionDenied)
+			}
+			return handler(ctx, req)
+		}
+	}).Prefix(adminOperationPrefix).Build()
+}
+
+// isAdminToken 是否为配置的管理员 Token
+func (a *Authenticator) isAdminToken(token string) bool {
+	sum := sha256.Sum256([]byte(token))
+	matched := 0
+	for _, digest := range a.adminDigests {
+		matched |= subtle.ConstantTimeCompare(sum[:], digest[:])
+	}
+	return matched == 1
+}
+
+// authenticateRequest 校验请求头中的用户令牌
+func (a *Authenticator) authenticateRequest(ctx context.Context) (*Identity, error) {
+	token, ok := requestToken(ctx)
+	if !ok {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnauthenticated)
+	}
+	return a.authenticate(ctx, token)
+}
+
+// authenticate 校验用户令牌：三段式令牌按 JWT 本地验签，否则按会话令牌内省
+func (a *Authenticator) authenticate(ctx context.Context, token string) (*Identity, error) {
+	var (
+		id  *Identity
+		err error
+	)
+	switch {
+	case strings.Count(token, ".") == 2 && a.jwtParser != nil:
+		id, err = a.verifyJWT(token)
+	case a.session != nil:
+		id, err = a.session.introspect(ctx, token, a.uidClaim, a.scopeClaim)
+		if err != nil && !errors.Is(err, errSessionInactive) {
+			a.log.Errorf("session introspection failed: operation=%s, error=%v", operation(ctx), err)
+			return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeAuthUnavailable)
+		}
+	default:
+		err = errTokenUnsupported
+	}
+	if err != nil {
+		a.log.Warnf("user auth failed: operation=%s, error=%v", operation(ctx), err)
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeUnauthenticated)
+	}
+	return id, nil
+}
+
+// verifyJWT 校验 JWT 签名、过期时间及 iss/aud
+func (a *Authenticator) verifyJWT(token string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := a.jwtParser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			return a.jwtSecret, nil
+		}
+		return a.jwtPublicKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return identityFromClaims(claims, a.uidClaim, a.scopeClaim)
+}
+
+// bindUserID 将令牌中的用户ID绑定到请求的 userId 字段
+func (a *Authenticator) bindUserID(ctx context.Context, id *Identity, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("userId")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return nil
+	}
+
+	switch userID := m.Get(fd).String(); {
+	case userID == "":
+		m.Set(fd, protoreflect.ValueOfString(id.UID))
+	case userID == id.UID:
+	case id.hasScope(a.adminScope):
+		a.log.Infof("admin query: uid=%s, user_id=%s, operation=%s", id.UID, userID, operation(ctx))
+	default:
+		a.log.Warnf("user auth denied: uid=%s, user_id=%s, operation=%s", id.UID, userID, operation(ctx))
+		return pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodePermissionDenied)
+	}
+	return nil
+}
+
+// identityFromClaims 从 JWT claims / 内省结果中解析用户ID与权限范围
+func identityFromClaims(claims map[string]interface{}, uidClaim, scopeClaim string) (*Identity, error) {
+	uid, _ := claims[uidClaim].(string)
+	if uid == "" {
+		return nil, fmt.Errorf("claim %q is missing", uidClaim)
+	}
+
+	id := &Identity{UID: uid}
+	switch scopes := claims[scopeClaim].(type) {
+	case string:
+		id.Scopes = strings.Fields(scopes)
+	case []interface{}:
+		for _, s := range scopes {
+			if s, ok := s.(string); ok {
+				id.Scopes = append(id.Scopes, s)
+			}
+		}
+	}
+	return id, nil
+}
+
+// requestToken 读取 Authorization: Bearer <token>
+func requestToken(ctx context.Context) (string, bool) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", false
+	}
+	const prefix = "Bearer "
+	header := tr.RequestHeader().Get("Authorization")
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(header[len(prefix):])
+	return token, token != ""
+}
+
+// operation 当前请求的 operation（用于日志）
+func operation(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.Operation()
+	}
+	return ""
+}
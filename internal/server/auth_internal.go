@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/peer"
+)
+
+// internalOperationPrefix 内部服务的 operation 前缀（HTTP 与 gRPC 一致）
+const internalOperationPrefix = "/billing.v1.BillingInternalService/"
+
+// 内部接口拒绝原因（审计日志与指标）
+const (
+	denyReasonMissingToken = "missing_token"
+	denyReasonInvalidToken = "invalid_token"
+	denyReasonOperation    = "operation_not_allowed"
+	denyReasonService      = "service_not_allowed"
+)
+
+// internalCaller 内部调用方策略
+type internalCaller struct {
+	name       string
+	keys       jwt.VerificationKeySet
+	operations map[string]bool
+	services   map[string]bool
+}
+
+func (c *internalCaller) allowOperation(op string) bool {
+	return c.operations["*"] || c.operations[op]
+}
+
+func (c *internalCaller) allowService(serviceName string) bool {
+	return c.services["*"] || c.services[serviceName]
+}
+
+// initInternalCallers 加载内部调用方策略表
+func (a *Authenticator) initInternalCallers(c *conf.Server_Auth) error {
+	a.internalCallers = make(map[string]*internalCaller)
+	for _, cc := range c.GetInternalCallers() {
+		if cc.GetName() == "" || len(cc.GetSecrets()) == 0 {
+			return fmt.Errorf("server.auth.internal_callers: name and secrets are required")
+		}
+		if _, ok := a.internalCallers[cc.GetName()]; ok {
+			return fmt.Errorf("server.auth.internal_callers: duplicate caller %q", cc.GetName())
+		}
+		caller := &internalCaller{
+			name:       cc.GetName(),
+			operations: make(map[string]bool),
+			services:   make(map[string]bool),
+		}
+		for _, secret := range cc.GetSecrets() {
+			caller.keys.Keys = append(caller.keys.Keys, []byte(secret))
+		}
+		for _, op := range cc.GetOperations() {
+			caller.operations[op] = true
+		}
+		for _, s := range cc.GetServices() {
+			caller.services[s] = true
+		}
+		a.internalCallers[caller.name] = caller
+	}
+	if len(a.internalCallers) == 0 {
+		a.log.Warn("server.auth.internal_callers is empty, internal APIs are disabled")
+	}
+	if c.GetServiceTokenMaxTtl() != nil {
+		a.serviceTokenMaxTTL = c.GetServiceTokenMaxTtl().AsDuration()
+	}
+	a.serviceTokenParser = jwt.NewParser(
+		jwt.WithValidMethods([]string{"HS256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithAudience(constants.ServiceTokenAudience),
+		jwt.WithLeeway(jwtLeeway),
+	)
+	return nil
+}
+
+// InternalMiddleware 内部接口鉴权中间件（一元调用）
+// 校验服务令牌并按策略表检查调用方可调用的方法及 serviceName，拒绝时记录审计日志；
+// 通过后将调用方写入 context（租约绑定申请的调用方）
+func (a *Authenticator) InternalMiddleware() middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			caller, err := a.authorizeInternal(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return handler(biz.NewCallerContext(ctx, caller.name), req)
+		}
+	}).Prefix(internalOperationPrefix).Build()
+}
+
+// InternalStreamMiddleware 内部接口鉴权中间件（gRPC 流，kratos 对每条收发的消息执行）
+// 消息在 handler 中读取，读取后再按策略检查；不允许的 serviceName 使 Recv 返回错误并结束整个流
+func (a *Authenticator) InternalStreamMiddleware() middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, msg interface{}) (interface{}, error) {
+			if _, ok := msg.(*v1.StreamDeductRequest); !ok {
+				// 发送的响应消息不检查
+				return handler(ctx, msg)
+			}
+			reply, err := handler(ctx, msg)
+			if err != nil {
+				return reply, err
+			}
+			if _, err := a.authorizeInternal(ctx, msg); err != nil {
+				return nil, err
+			}
+			return reply, nil
+		}
+	}).Prefix(internalOperationPrefix).Build()
+}
+
+// authorizeInternal 校验服务令牌、调用方法与请求中的 serviceName
+func (a *Authenticator) authorizeInternal(ctx context.Context, req interface{}) (*internalCaller, error) {
+	op := operation(ctx)
+	method := op[strings.LastIndex(op, "/")+1:]
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil, a.denyInternal(ctx, req, nil, method, denyReasonMissingToken, nil, billingErrors.ErrCodeUnauthenticated)
+	}
+	token := strings.TrimSpace(tr.RequestHeader().Get(constants.ServiceTokenHeader))
+	if token == "" {
+		return nil, a.denyInternal(ctx, req, nil, method, denyReasonMissingToken, nil, billingErrors.ErrCodeUnauthenticated)
+	}
+	caller, err := a.verifyServiceToken(token)
+	if err != nil {
+		return nil, a.denyInternal(ctx, req, nil, method, denyReasonInvalidToken, err, billingErrors.ErrCodeUnauthenticated)
+	}
+
+	if !caller.allowOperation(method) {
+		return nil, a.denyInternal(ctx, req, caller, method, denyReasonOperation, nil, billingErrors.ErrCodePermissionDenied)
+	}
+	for _, serviceName := range requestServiceNames(req) {
+		if !caller.allowService(serviceName) {
+			return nil, a.denyInternal(ctx, req, caller, method, denyReasonService,
+				fmt.Errorf("service_name=%s", serviceName), billingErrors.ErrCodePermissionDenied)
+		}
+	}
+	return caller, nil
+}
+
+// verifyServiceToken 按 iss 找到调用方，用其任一密钥校验签名、aud 与 exp
+func (a *Authenticator) verifyServiceToken(token string) (*internalCaller, error) {
+	var caller *internalCaller
+	claims := &jwt.RegisteredClaims{}
+	_, err := a.serviceTokenParser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		iss, _ := t.Claims.GetIssuer()
+		c, ok := a.internalCallers[iss]
+		if !ok {
+			return nil, fmt.Errorf("unknown caller %q", iss)
+		}
+		caller = c
+		return c.keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a.serviceTokenMaxTTL > 0 && time.Until(claims.ExpiresAt.Time) > a.serviceTokenMaxTTL {
+		return nil, errors.New("token lifetime exceeds service_token_max_ttl")
+	}
+	return caller, nil
+}
+
+// denyInternal 记录审计日志与指标，返回对应的业务错误
+func (a *Authenticator) denyInternal(ctx context.Context, req interface{}, caller *internalCaller, method, reason string, cause error, code int32) error {
+	callerName := "unknown"
+	if caller != nil {
+		callerName = caller.name
+	}
+	userID := ""
+	if r, ok := req.(interface{ GetUserId() string }); ok {
+		userID = r.GetUserId()
+	}
+	detail := ""
+	if cause != nil {
+		detail = cause.Error()
+	}
+	a.audit.Warnw(
+		"event", "internal_call_denied",
+		"caller", callerName,
+		"operation", method,
+		"user_id", userID,
+		"reason", reason,
+		"detail", detail,
+		"remote_addr", remoteAddr(ctx),
+	)
+	a.metrics.InternalAuthDeniedTotal.WithLabelValues(callerName, method, reason).Inc()
+	return pkgErrors.NewBizErrorWithLang(ctx, code)
+}
+
+// requestServiceNames 请求中涉及的 serviceName，批量请求返回每个 item 的 serviceName
+// 租约上报/释放检查请求中租约的 serviceName（与申请时的绑定由租约校验）；
+// 充值回调不涉及 serviceName，返回 nil，只按方法检查
+func requestServiceNames(req interface{}) []string {
+	switch r := req.(type) {
+	case interface{ GetItems() []*v1.QuotaItem }:
+		names := make([]string, 0, len(r.GetItems()))
+		for _, item := range r.GetItems() {
+			names = append(names, item.GetServiceName())
+		}
+		return names
+	case interface{ GetServiceName() string }:
+		return []string{r.GetServiceName()}
+	}
+	return nil
+}
+
+// remoteAddr 调用方地址（用于审计日志）
+func remoteAddr(ctx context.Context) string {
+	if ht, ok := khttp.RequestFromServerContext(ctx); ok {
+		return ht.RemoteAddr
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return ""
+}
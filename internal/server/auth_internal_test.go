@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	testGatewaySecret = "gateway-secret"
+	testGatewayNext   = "gateway-secret-next"
+	testPaymentSecret = "payment-secret"
+)
+
+func newTestInternalAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+	a, err := NewAuthenticator(&conf.Server{Auth: &conf.Server_Auth{
+		InternalCallers: []*conf.Server_Auth_InternalCaller{
+			{
+				Name:       "gateway",
+				Secrets:    []string{testGatewaySecret, testGatewayNext},
+				Operations: []string{"DeductQuota", "BatchDeductQuota", "ReportLeaseUsage", "ReleaseLease", "StreamDeduct"},
+				Services:   []string{"passport"},
+			},
+			{Name: "payment-service", Secrets: []string{testPaymentSecret}, Operations: []string{"RechargeCallback"}},
+			{Name: "ops", Secrets: []string{"ops-secret"}, Operations: []string{"*"}, Services: []string{"*"}},
+		},
+		ServiceTokenMaxTtl: durationpb.New(10 * time.Minute),
+	}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// serviceToken 签发服务令牌，claims 为空时使用默认的 aud 与 exp
+func serviceToken(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	if _, ok := claims["aud"]; !ok {
+		claims["aud"] = constants.ServiceTokenAudience
+	}
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(time.Minute).Unix()
+	}
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func internalContext(method, token string) context.Context {
+	header := testHeader{}
+	if token != "" {
+		header.Set(constants.ServiceTokenHeader, token)
+	}
+	return transport.NewServerContext(context.Background(), &testTransport{operation: internalOperationPrefix + method, header: header})
+}
+
+// TestInternalMiddleware 服务令牌校验（HS256、iss 对应调用方密钥、aud、exp、最长有效期）与调用方策略（方法、serviceName）
+func TestInternalMiddleware(t *testing.T) {
+	a := newTestInternalAuthenticator(t)
+	gateway := func(claims jwt.MapClaims) string {
+		claims["iss"] = "gateway"
+		return serviceToken(t, jwt.SigningMethodHS256, testGatewaySecret, claims)
+	}
+	deduct := &v1.DeductQuotaRequest{UserId: "u_10001", ServiceName: "passport"}
+
+	cases := []struct {
+		name     string
+		method   string
+		token    string
+		req      interface{}
+		wantCode int
+	}{
+		{name: "allowed", method: "DeductQuota", token: gateway(jwt.MapClaims{}), req: deduct},
+		{name: "rotated secret", method: "DeductQuota", token: serviceToken(t, jwt.SigningMethodHS256, testGatewayNext, jwt.MapClaims{"iss": "gateway"}), req: deduct},
+		{name: "missing token", method: "DeductQuota", req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "wrong secret", method: "DeductQuota", token: serviceToken(t, jwt.SigningMethodHS256, "other", jwt.MapClaims{"iss": "gateway"}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "issuer signed with another caller's secret", method: "DeductQuota", token: serviceToken(t, jwt.SigningMethodHS256, testGatewaySecret, jwt.MapClaims{"iss": "payment-service"}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "unknown issuer", method: "DeductQuota", token: serviceToken(t, jwt.SigningMethodHS256, testGatewaySecret, jwt.MapClaims{"iss": "unknown"}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "non HS256", method: "DeductQuota", token: serviceToken(t, jwt.SigningMethodHS512, testGatewaySecret, jwt.MapClaims{"iss": "gateway"}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "wrong audience", method: "DeductQuota", token: gateway(jwt.MapClaims{"aud": "other-service"}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "expired", method: "DeductQuota", token: gateway(jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "lifetime exceeds max ttl", method: "DeductQuota", token: gateway(jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}), req: deduct, wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "operation not allowed", method: "AcquireLease", token: gateway(jwt.MapClaims{}), req: deduct, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "service not allowed", method: "DeductQuota", token: gateway(jwt.MapClaims{}), req: &v1.DeductQuotaRequest{ServiceName: "asset"}, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "batch item not allowed", method: "BatchDeductQuota", token: gateway(jwt.MapClaims{}),
+			req: &v1.BatchDeductQuotaRequest{Items: []*v1.QuotaItem{{ServiceName: "passport"}, {ServiceName: "asset"}}}, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "batch allowed", method: "BatchDeductQuota", token: gateway(jwt.MapClaims{}),
+			req: &v1.BatchDeductQuotaRequest{Items: []*v1.QuotaItem{{ServiceName: "passport"}, {ServiceName: "passport"}}}},
+		{name: "lease report service not allowed", method: "ReportLeaseUsage", token: gateway(jwt.MapClaims{}), req: &v1.ReportLeaseUsageRequest{LeaseId: "l1", ServiceName: "asset"}, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "lease release service not allowed", method: "ReleaseLease", token: gateway(jwt.MapClaims{}), req: &v1.ReleaseLeaseRequest{LeaseId: "l1", ServiceName: "asset"}, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "lease release allowed", method: "ReleaseLease", token: gateway(jwt.MapClaims{}), req: &v1.ReleaseLeaseRequest{LeaseId: "l1", ServiceName: "passport"}},
+		{name: "recharge callback method only", method: "RechargeCallback", token: serviceToken(t, jwt.SigningMethodHS256, testPaymentSecret, jwt.MapClaims{"iss": "payment-service"}), req: &v1.RechargeCallbackRequest{}},
+		{name: "recharge callback other caller", method: "RechargeCallback", token: gateway(jwt.MapClaims{}), req: &v1.RechargeCallbackRequest{}, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "wildcard policy", method: "AcquireLease", token: serviceToken(t, jwt.SigningMethodHS256, "ops-secret", jwt.MapClaims{"iss": "ops"}), req: &v1.AcquireLeaseRequest{ServiceName: "asset"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			called, err := callMiddleware(a.InternalMiddleware(), internalContext(tc.method, tc.token), tc.req)
+			assertAuthCode(t, err, tc.wantCode)
+			if called != (tc.wantCode == 0) {
+				t.Fatalf("handler called = %v, want %v", called, tc.wantCode == 0)
+			}
+		})
+	}
+}
+
+// TestInternalMiddlewareCallerContext 通过校验后调用方写入 context，供租约绑定
+func TestInternalMiddlewareCallerContext(t *testing.T) {
+	a := newTestInternalAuthenticator(t)
+	token := serviceToken(t, jwt.SigningMethodHS256, testGatewaySecret, jwt.MapClaims{"iss": "gateway"})
+	var caller string
+	_, err := a.InternalMiddleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller = biz.CallerFromContext(ctx)
+		return nil, nil
+	})(internalContext("DeductQuota", token), &v1.DeductQuotaRequest{ServiceName: "passport"})
+	if err != nil || caller != "gateway" {
+		t.Fatalf("caller = %q, err = %v, want gateway", caller, err)
+	}
+}
+
+// TestInternalStreamMiddleware 流式扣费：响应消息不检查，每条请求消息读取后校验令牌与 serviceName
+func TestInternalStreamMiddleware(t *testing.T) {
+	a := newTestInternalAuthenticator(t)
+	mw := a.InternalStreamMiddleware()
+	token := serviceToken(t, jwt.SigningMethodHS256, testGatewaySecret, jwt.MapClaims{"iss": "gateway"})
+
+	if _, err := callMiddleware(mw, internalContext("StreamDeduct", ""), &v1.StreamDeductReply{}); err != nil {
+		t.Fatalf("reply message: %v", err)
+	}
+	if _, err := callMiddleware(mw, internalContext("StreamDeduct", token), &v1.StreamDeductRequest{ServiceName: "passport"}); err != nil {
+		t.Fatalf("allowed message: %v", err)
+	}
+	_, err := callMiddleware(mw, internalContext("StreamDeduct", token), &v1.StreamDeductRequest{ServiceName: "asset"})
+	assertAuthCode(t, err, billingErrors.ErrCodePermissionDenied)
+	_, err = callMiddleware(mw, internalContext("StreamDeduct", ""), &v1.StreamDeductRequest{ServiceName: "passport"})
+	assertAuthCode(t, err, billingErrors.ErrCodeUnauthenticated)
+}
+
+// TestRequestServiceNames 策略检查的 serviceName：单条、批量、租约上报/释放；充值回调只按方法检查
+func TestRequestServiceNames(t *testing.T) {
+	cases := []struct {
+		req  interface{}
+		want []string
+	}{
+		{&v1.DeductQuotaRequest{ServiceName: "passport"}, []string{"passport"}},
+		{&v1.BatchDeductQuotaRequest{Items: []*v1.QuotaItem{{ServiceName: "passport"}, {ServiceName: "asset"}}}, []string{"passport", "asset"}},
+		{&v1.ReportLeaseUsageRequest{ServiceName: "passport"}, []string{"passport"}},
+		{&v1.ReleaseLeaseRequest{ServiceName: "asset"}, []string{"asset"}},
+		{&v1.RechargeCallbackRequest{}, nil},
+	}
+	for _, tc := range cases {
+		got := requestServiceNames(tc.req)
+		if len(got) != len(tc.want) {
+			t.Fatalf("requestServiceNames(%T) = %v, want %v", tc.req, got, tc.want)
+		}
+		for i := range tc.want {
+			if got[i] != tc.want[i] {
+				t.Fatalf("requestServiceNames(%T) = %v, want %v", tc.req, got, tc.want)
+			}
+		}
+	}
+}
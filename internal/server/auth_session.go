@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"billing-service/internal/conf"
+)
+
+const (
+	// defaultIntrospectTimeout 会话令牌内省请求默认超时（需小于服务端请求超时）
+	defaultIntrospectTimeout = 500 * time.Millisecond
+	// defaultSessionCacheTTL 有效会话默认本地缓存时间
+	defaultSessionCacheTTL = time.Minute
+	// maxSessionCacheEntries 本地缓存的会话数上限，超过时清理过期条目
+	maxSessionCacheEntries = 10000
+)
+
+// errSessionInactive 会话令牌无效或已过期（内省接口返回 active=false）
+var errSessionInactive = errors.New("session token is inactive")
+
+// cachedSession 本地缓存的有效会话
+type cachedSession struct {
+	id        *Identity
+	expiresAt time.Time
+}
+
+// sessionIntrospector 通过 passport 令牌内省接口（RFC 7662）校验会话令牌
+// 有效会话按令牌摘要缓存 cache_ttl（不超过令牌过期时间），注销后最多在缓存时间内仍可使用
+type sessionIntrospector struct {
+	url          string
+	clientID     string
+	clientSecret string
+	cacheTTL     time.Duration
+	client       *http.Client
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedSession
+}
+
+func newSessionIntrospector(c *conf.Server_Auth_Session) *sessionIntrospector {
+	timeout := defaultIntrospectTimeout
+	if c.GetTimeout() != nil {
+		timeout = c.GetTimeout().AsDuration()
+	}
+	cacheTTL := defaultSessionCacheTTL
+	if c.GetCacheTtl() != nil {
+		cacheTTL = c.GetCacheTtl().AsDuration()
+	}
+	return &sessionIntrospector{
+		url:          c.GetIntrospectUrl(),
+		clientID:     c.GetClientId(),
+		clientSecret: c.GetClientSecret(),
+		cacheTTL:     cacheTTL,
+		client:       &http.Client{Timeout: timeout},
+		cache:        make(map[[sha256.Size]byte]cachedSession),
+	}
+}
+
+// introspect 校验会话令牌，返回 errSessionInactive 表示令牌无效，其他错误表示内省接口不可用
+func (s *sessionIntrospector) introspect(ctx context.Context, token, uidClaim, scopeClaim string) (*Identity, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	s.mu.Lock()
+	cached, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.id, nil
+	}
+
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if s.clientID != "" {
+		req.SetBasicAuth(s.clientID, s.clientSecret)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection endpoint returned %d", resp.StatusCode)
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode introspection response: %w", err)
+	}
+	if active, _ := result["active"].(bool); !active {
+		return nil, errSessionInactive
+	}
+	id, err := identityFromClaims(result, uidClaim, scopeClaim)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errSessionInactive, err)
+	}
+
+	expiresAt := now.Add(s.cacheTTL)
+	if exp, ok := result["exp"].(float64); ok && time.Unix(int64(exp), 0).Before(expiresAt) {
+		expiresAt = time.Unix(int64(exp), 0)
+	}
+	s.store(key, cachedSession{id: id, expiresAt: expiresAt}, now)
+	return id, nil
+}
+
+// store 写入缓存，条目数超过上限时先清理过期条目，仍超过时清空
+func (s *sessionIntrospector) store(key [sha256.Size]byte, session cachedSession, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.cache) >= maxSessionCacheEntries {
+		for k, v := range s.cache {
+			if !now.Before(v.expiresAt) {
+				delete(s.cache, k)
+			}
+		}
+		if len(s.cache) >= maxSessionCacheEntries {
+			s.cache = make(map[[sha256.Size]byte]cachedSession)
+		}
+	}
+	s.cache[key] = session
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "billing-service/api/billing/v1"
+	"billing-service/internal/conf"
+	billingErrors "billing-service/internal/errors"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testJWTSecret  = "test-jwt-secret"
+	testAdminToken = "test-admin-token"
+	testUID        = "u_10001"
+	testOtherUID   = "u_20002"
+)
+
+// testHeader 请求头（实现 transport.Header）
+type testHeader http.Header
+
+func (h testHeader) Get(key string) string      { return http.Header(h).Get(key) }
+func (h testHeader) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h testHeader) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h testHeader) Values(key string) []string { return http.Header(h).Values(key) }
+func (h testHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// testTransport 服务端 transport（实现 transport.Transporter）
+type testTransport struct {
+	operation string
+	header    testHeader
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return testHeader{} }
+
+// serverContext 构造携带 operation 与 Bearer 令牌的请求上下文，token 为空时不带 Authorization
+func serverContext(operation, token string) context.Context {
+	header := testHeader{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return transport.NewServerContext(context.Background(), &testTransport{operation: operation, header: header})
+}
+
+// signUserJWT 签发 HS256 用户令牌
+func signUserJWT(t *testing.T, uid, scope string, expiresAt time.Time) string {
+	t.Helper()
+	claims := jwt.MapClaims{"sub": uid, "exp": expiresAt.Unix()}
+	if scope != "" {
+		claims["scope"] = scope
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// newTestIntrospectServer 模拟 passport 令牌内省接口：按令牌返回响应，未知令牌返回 active=false
+func newTestIntrospectServer(t *testing.T, sessions map[string]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		token := r.PostForm.Get("token")
+		if token == "sess-unavailable" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		result, ok := sessions[token]
+		if !ok {
+			result = map[string]interface{}{"active": false}
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestAuthenticator(t *testing.T, introspectURL string) *Authenticator {
+	t.Helper()
+	a, err := NewAuthenticator(&conf.Server{Auth: &conf.Server_Auth{
+		AdminTokens: []string{testAdminToken},
+		Jwt:         &conf.Server_Auth_JWT{Secret: testJWTSecret},
+		Session:     &conf.Server_Auth_Session{IntrospectUrl: introspectURL},
+	}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// callMiddleware 经中间件调用，返回 handler 是否被调用
+func callMiddleware(mw middleware.Middleware, ctx context.Context, req interface{}) (bool, error) {
+	called := false
+	_, err := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	})(ctx, req)
+	return called, err
+}
+
+func assertAuthCode(t *testing.T, err error, code int) {
+	t.Helper()
+	if code == 0 {
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		return
+	}
+	if got := kratosErrors.FromError(err).Code; int(got) != code {
+		t.Fatalf("err = %v (code %d), want code %d", err, got, code)
+	}
+}
+
+// TestUserMiddleware 外部接口：userId 为空时绑定令牌用户，查询其他用户需要管理员权限范围，过期/注销的令牌与内省故障均被拒绝
+func TestUserMiddleware(t *testing.T) {
+	introspect := newTestIntrospectServer(t, map[string]map[string]interface{}{
+		"sess-active": {"active": true, "sub": testUID},
+		"sess-admin":  {"active": true, "sub": testUID, "scope": "billing:read billing:admin"},
+	})
+	a := newTestAuthenticator(t, introspect.URL)
+	mw := a.UserMiddleware()
+	op := userOperationPrefix + "GetAccount"
+
+	cases := []struct {
+		name       string
+		token      string
+		userID     string
+		wantCode   int
+		wantUserID string
+	}{
+		{name: "jwt binds empty userId", token: signUserJWT(t, testUID, "", time.Now().Add(time.Hour)), wantUserID: testUID},
+		{name: "jwt own userId", token: signUserJWT(t, testUID, "", time.Now().Add(time.Hour)), userID: testUID, wantUserID: testUID},
+		{name: "jwt other userId without admin scope", token: signUserJWT(t, testUID, "billing:read", time.Now().Add(time.Hour)), userID: testOtherUID, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "jwt other userId with admin scope", token: signUserJWT(t, testUID, "billing:admin", time.Now().Add(time.Hour)), userID: testOtherUID, wantUserID: testOtherUID},
+		{name: "jwt expired", token: signUserJWT(t, testUID, "billing:admin", time.Now().Add(-time.Hour)), wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "jwt wrong signature", token: signUserJWT(t, testUID, "", time.Now().Add(time.Hour)) + "x", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "missing token", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "session binds empty userId", token: "sess-active", wantUserID: testUID},
+		{name: "session other userId without admin scope", token: "sess-active", userID: testOtherUID, wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "session other userId with admin scope", token: "sess-admin", userID: testOtherUID, wantUserID: testOtherUID},
+		{name: "session expired or revoked", token: "sess-revoked", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "introspection failure", token: "sess-unavailable", wantCode: billingErrors.ErrCodeAuthUnavailable},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &v1.GetAccountRequest{UserId: tc.userID}
+			called, err := callMiddleware(mw, serverContext(op, tc.token), req)
+			assertAuthCode(t, err, tc.wantCode)
+			if called != (tc.wantCode == 0) {
+				t.Fatalf("handler called = %v, want %v", called, tc.wantCode == 0)
+			}
+			if tc.wantCode == 0 && req.UserId != tc.wantUserID {
+				t.Fatalf("userId = %q, want %q", req.UserId, tc.wantUserID)
+			}
+		})
+	}
+}
+
+// TestUserMiddlewareIntrospectionUnreachable 内省接口不可达时返回错误，不放行
+func TestUserMiddlewareIntrospectionUnreachable(t *testing.T) {
+	introspect := newTestIntrospectServer(t, nil)
+	a := newTestAuthenticator(t, introspect.URL)
+	introspect.Close()
+
+	called, err := callMiddleware(a.UserMiddleware(), serverContext(userOperationPrefix+"GetAccount", "sess-active"), &v1.GetAccountRequest{})
+	assertAuthCode(t, err, billingErrors.ErrCodeAuthUnavailable)
+	if called {
+		t.Fatal("handler called after introspection failure")
+	}
+}
+
+// TestAdminMiddleware 管理接口：接受管理员 Token 或带管理员权限范围的用户令牌
+func TestAdminMiddleware(t *testing.T) {
+	introspect := newTestIntrospectServer(t, map[string]map[string]interface{}{
+		"sess-active": {"active": true, "sub": testUID},
+		"sess-admin":  {"active": true, "sub": testUID, "scope": []interface{}{"billing:admin"}},
+	})
+	a := newTestAuthenticator(t, introspect.URL)
+	mw := a.AdminMiddleware()
+	op := adminOperationPrefix + "ListUsers"
+
+	cases := []struct {
+		name     string
+		token    string
+		wantCode int
+	}{
+		{name: "admin token", token: testAdminToken},
+		{name: "wrong admin token", token: testAdminToken + "x", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "missing token", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "jwt with admin scope", token: signUserJWT(t, testUID, "billing:read billing:admin", time.Now().Add(time.Hour))},
+		{name: "jwt without admin scope", token: signUserJWT(t, testUID, "billing:read", time.Now().Add(time.Hour)), wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "jwt expired", token: signUserJWT(t, testUID, "billing:admin", time.Now().Add(-time.Hour)), wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "session with admin scope", token: "sess-admin"},
+		{name: "session without admin scope", token: "sess-active", wantCode: billingErrors.ErrCodePermissionDenied},
+		{name: "session expired or revoked", token: "sess-revoked", wantCode: billingErrors.ErrCodeUnauthenticated},
+		{name: "introspection failure", token: "sess-unavailable", wantCode: billingErrors.ErrCodeAuthUnavailable},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			called, err := callMiddleware(mw, serverContext(op, tc.token), &v1.GetAccountRequest{})
+			assertAuthCode(t, err, tc.wantCode)
+			if called != (tc.wantCode == 0) {
+				t.Fatalf("handler called = %v, want %v", called, tc.wantCode == 0)
+			}
+		})
+	}
+}
+
+// TestSessionCacheHonoursExpiry 有效会话的缓存不超过令牌过期时间，过期后重新内省
+func TestSessionCacheHonoursExpiry(t *testing.T) {
+	sessions := map[string]map[string]interface{}{
+		"sess-short": {"active": true, "sub": testUID, "exp": float64(time.Now().Add(-time.Second).Unix())},
+	}
+	introspect := newTestIntrospectServer(t, sessions)
+	s := newSessionIntrospector(&conf.Server_Auth_Session{IntrospectUrl: introspect.URL})
+
+	if _, err := s.introspect(context.Background(), "sess-short", "sub", "scope"); err != nil {
+		t.Fatal(err)
+	}
+	// 内省接口随后将令牌标记为无效（注销）：缓存已过期，不能继续使用
+	sessions["sess-short"] = map[string]interface{}{"active": false}
+	if _, err := s.introspect(context.Background(), "sess-short", "sub", "scope"); err != errSessionInactive {
+		t.Fatalf("err = %v, want errSessionInactive", err)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// BudgetAlertServer 定时扫描消费预算并发送提醒
+// 多实例同时运行时由带条件的标记保证同一预算每月只提醒一次
+type BudgetAlertServer struct {
+	uc       *biz.BillingUseCase
+	interval time.Duration
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewBudgetAlertServer 创建预算提醒服务
+func NewBudgetAlertServer(uc *biz.BillingUseCase, conf *biz.BillingConfig, logger log.Logger) *BudgetAlertServer {
+	return &BudgetAlertServer{
+		uc:       uc,
+		interval: conf.Budget.AlertInterval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start starts the alert loop
+func (s *BudgetAlertServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.log.Infof("Starting BudgetAlertServer, interval: %s", s.interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n, err := s.uc.SendBudgetAlerts(ctx)
+				if err != nil {
+					s.log.Errorf("Send budget alerts failed: %v", err)
+				}
+				if n > 0 {
+					s.log.Infof("Sent %d budget alerts", n)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the alert loop
+func (s *BudgetAlertServer) Stop(ctx context.Context) error {
+	s.log.Info("Stopping BudgetAlertServer")
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DeferredSettlementServer 定时结算降级期间放行的延迟扣费
+type DeferredSettlementServer struct {
+	uc       *biz.BillingUseCase
+	interval time.Duration
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDeferredSettlementServer 创建延迟扣费结算服务
+func NewDeferredSettlementServer(uc *biz.BillingUseCase, conf *biz.BillingConfig, logger log.Logger) *DeferredSettlementServer {
+	return &DeferredSettlementServer{
+		uc:       uc,
+		interval: conf.DeferredSettleInterval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start starts the settlement loop
+func (s *DeferredSettlementServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.log.Infof("Starting DeferredSettlementServer, interval: %s", s.interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if n := s.uc.SettleDeferredCharges(ctx); n > 0 {
+					s.log.Infof("Settled %d deferred charges", n)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the settlement loop and makes a final attempt to settle remaining charges
+func (s *DeferredSettlementServer) Stop(ctx context.Context) error {
+	s.log.Info("Stopping DeferredSettlementServer")
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	// 进程退出前尽量结算，未结算的延迟扣费保留在日志中，下次启动时重放
+	if n := s.uc.SettleDeferredCharges(ctx); n > 0 {
+		s.log.Infof("Settled %d deferred charges before shutdown", n)
+	}
+	return nil
+}
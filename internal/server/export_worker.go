@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ExportWorkerServer 定时执行异步账单导出任务并清理过期文件
+// 多实例同时运行时由任务表的状态条件更新保证同一任务只执行一次
+type ExportWorkerServer struct {
+	uc       *biz.BillingUseCase
+	interval time.Duration
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewExportWorkerServer 创建账单导出任务服务
+func NewExportWorkerServer(uc *biz.BillingUseCase, conf *biz.BillingConfig, logger log.Logger) *ExportWorkerServer {
+	return &ExportWorkerServer{
+		uc:       uc,
+		interval: conf.Export.PollInterval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start starts the export loop
+func (s *ExportWorkerServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.log.Infof("Starting ExportWorkerServer, interval: %s", s.interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n, err := s.uc.ProcessExportJobs(ctx)
+				if err != nil {
+					s.log.Errorf("Process export jobs failed: %v", err)
+				}
+				if n > 0 {
+					s.log.Infof("Processed %d export jobs", n)
+				}
+				n, err = s.uc.CleanupExpiredExports(ctx)
+				if err != nil {
+					s.log.Errorf("Cleanup expired exports failed: %v", err)
+				}
+				if n > 0 {
+					s.log.Infof("Cleaned up %d expired exports", n)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the export loop
+func (s *ExportWorkerServer) Stop(ctx context.Context) error {
+	s.log.Info("Stopping ExportWorkerServer")
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
@@ -13,14 +13,22 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, billing *service.BillingService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, billing *service.BillingService, admin *service.AdminService, auth *Authenticator, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			// 添加 app_id 中间件（优先于其他中间件，确保 app_id 在 Context 中可用）
 			// 用于从 gRPC metadata 提取 appId，确保调用 payment-service 时能传递 appId
 			app_id.Middleware(),
+			// 外部接口用户鉴权（JWT / 会话令牌，绑定 userId）
+			auth.UserMiddleware(),
+			// 管理接口鉴权（仅 BillingAdminService）
+			auth.AdminMiddleware(),
+			// 内部接口服务令牌鉴权（仅 BillingInternalService，按调用方策略检查方法与 serviceName）
+			auth.InternalMiddleware(),
 		),
+		// 流式扣费（StreamDeduct）逐条消息鉴权
+		grpc.StreamMiddleware(auth.InternalStreamMiddleware()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -39,5 +47,8 @@ func NewGRPCServer(c *conf.Server, billing *service.BillingService, logger log.L
 	// 注册内部服务（面向 Gateway/Payment）
 	v1.RegisterBillingInternalServiceServer(srv, billing)
 
+	// 注册管理服务（面向运营/财务，需管理员凭证）
+	v1.RegisterBillingAdminServiceServer(srv, admin)
+
 	return srv
 }
@@ -17,7 +17,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, billing *service.BillingService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, billing *service.BillingService, admin *service.AdminService, auth *Authenticator, logger log.Logger) *http.Server {
 	// 响应中间件配置
 	responseConfig := &response.Config{
 		EnableUnifiedResponse: true,
@@ -26,8 +26,8 @@ func NewHTTPServer(c *conf.Server, billing *service.BillingService, logger log.L
 		IncludeTraceId:        true,
 	}
 
-	// 使用默认错误处理器（已支持 Kratos errors 的 HTTP 状态码映射）
-	errorHandler := response.NewDefaultErrorHandler()
+	// 使用默认错误处理器（已支持 Kratos errors 的 HTTP 状态码映射），认证错误映射为 401/403
+	errorHandler := response.NewDefaultErrorHandler(response.WithStatusMapping(authStatusMapping))
 
 	var opts = []http.ServerOption{
 		http.Middleware(
@@ -36,6 +36,12 @@ func NewHTTPServer(c *conf.Server, billing *service.BillingService, logger log.L
 			app_id.Middleware(),
 			// 添加 i18n 中间件
 			i18n.Middleware(),
+			// 外部接口用户鉴权（JWT / 会话令牌，绑定 userId）
+			auth.UserMiddleware(),
+			// 管理接口鉴权（仅 BillingAdminService）
+			auth.AdminMiddleware(),
+			// 内部接口服务令牌鉴权（仅 BillingInternalService，按调用方策略检查方法与 serviceName）
+			auth.InternalMiddleware(),
 		),
 		// 使用自定义响应编码器统一响应格式
 		http.ResponseEncoder(response.NewResponseEncoder(errorHandler, responseConfig)),
@@ -59,6 +65,15 @@ func NewHTTPServer(c *conf.Server, billing *service.BillingService, logger log.L
 	// 注册内部服务路由（面向 Gateway/Payment）
 	v1.RegisterBillingInternalServiceHTTPServer(srv, billing)
 
+	// 注册管理服务路由（面向运营/财务，需管理员凭证）
+	v1.RegisterBillingAdminServiceHTTPServer(srv, admin)
+
+	// 注册账单导出文件下载端点（签名链接）
+	srv.Route("/").GET("/api/v1/billing/exports/{exportId}/download", billing.DownloadExport)
+
+	// 注册实时用量推送端点（SSE）
+	srv.Route("/").GET("/api/v1/billing/stats/live/stream", billing.StreamLiveUsage)
+
 	// 注册健康检查端点
 	srv.Route("/").GET("/health", func(ctx http.Context) error {
 		return ctx.Result(200, health.NewResponse("billing-service"))
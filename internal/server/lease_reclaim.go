@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LeaseReclaimServer 定时回收过期租约的未用部分
+// 多实例同时运行时由 Lua 脚本保证同一租约只回收一次
+type LeaseReclaimServer struct {
+	uc       *biz.BillingUseCase
+	interval time.Duration
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewLeaseReclaimServer 创建过期租约回收服务
+func NewLeaseReclaimServer(uc *biz.BillingUseCase, conf *biz.BillingConfig, logger log.Logger) *LeaseReclaimServer {
+	return &LeaseReclaimServer{
+		uc:       uc,
+		interval: conf.Lease.ReclaimInterval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start starts the reclaim loop
+func (s *LeaseReclaimServer) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.log.Infof("Starting LeaseReclaimServer, interval: %s", s.interval)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n, err := s.uc.ReclaimExpiredLeases(ctx)
+				if err != nil {
+					s.log.Errorf("Reclaim expired leases failed: %v", err)
+				}
+				if n > 0 {
+					s.log.Infof("Reclaimed %d expired leases", n)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the reclaim loop
+func (s *LeaseReclaimServer) Stop(ctx context.Context) error {
+	s.log.Info("Stopping LeaseReclaimServer")
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
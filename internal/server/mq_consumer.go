@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"billing-service/internal/biz"
 	"billing-service/internal/conf"
+	"billing-service/internal/constants"
+	"billing-service/internal/metrics"
 
 	"github.com/apache/rocketmq-client-go/v2"
 	"github.com/apache/rocketmq-client-go/v2/consumer"
@@ -18,8 +21,18 @@ type MQConsumerServer struct {
 	c       rocketmq.PushConsumer
 	repo    biz.BillingRepo
 	conf    *conf.Data
+	topic   string // 与 data 层生产者一致：未配置时使用默认 topic
 	log     *log.Helper
+	metrics *metrics.BillingMetrics
 	enabled bool
+
+	maxReconsumeTimes int32 // 单条消息最多重试次数，超过后转入死信 topic
+}
+
+// deductMessage 已解析的扣费消息
+type deductMessage struct {
+	msg    *primitive.MessageExt
+	events []*biz.DeductEvent
 }
 
 // NewMQConsumerServer creates a RocketMQ consumer server
@@ -33,6 +46,9 @@ func NewMQConsumerServer(c *conf.Data, repo biz.BillingRepo, logger log.Logger)
 		consumer.WithGroupName(c.Rocketmq.GroupName),
 		consumer.WithRetry(int(c.Rocketmq.RetryTimes)),
 		consumer.WithConsumeMessageBatchMaxSize(100), // Process up to 100 messages at once
+		// 顺序消费：每个队列同一时刻只由一个 goroutine 处理，同一用户的事件按发送顺序落库，
+		// 也避免了不同消费线程的 BatchDeductQuota 事务在同一 user_balance 行上互相死锁
+		consumer.WithConsumerOrder(true),
 	)
 	if err != nil {
 		log.NewHelper(logger).Errorf("init consumer error: %v", err)
@@ -43,8 +59,18 @@ func NewMQConsumerServer(c *conf.Data, repo biz.BillingRepo, logger log.Logger)
 		c:       r,
 		repo:    repo,
 		conf:    c,
+		topic:   constants.MQTopicDeduct,
 		log:     log.NewHelper(logger),
+		metrics: metrics.GetMetrics(),
 		enabled: true,
+
+		maxReconsumeTimes: constants.MQMaxReconsumeTimes,
+	}
+	if c.Rocketmq.Topic != "" {
+		s.topic = c.Rocketmq.Topic
+	}
+	if c.Rocketmq.MaxReconsumeTimes > 0 {
+		s.maxReconsumeTimes = c.Rocketmq.MaxReconsumeTimes
 	}
 	return s
 }
@@ -61,12 +87,12 @@ func (s *MQConsumerServer) Start(ctx context.Context) error {
 		return nil
 	}
 
-	s.log.Infof("Starting MQConsumerServer, topic: %s", s.conf.Rocketmq.Topic)
+	s.log.Infof("Starting MQConsumerServer, topic: %s", s.topic)
 
 	// Subscribe
-	err := s.c.Subscribe(s.conf.Rocketmq.Topic, consumer.MessageSelector{}, s.handler)
+	err := s.c.Subscribe(s.topic, consumer.MessageSelector{}, s.handler)
 	if err != nil {
-		s.log.Errorf("Failed to subscribe to topic %s: %v", s.conf.Rocketmq.Topic, err)
+		s.log.Errorf("Failed to subscribe to topic %s: %v", s.topic, err)
 		// 不返回错误，避免导致整个应用启动失败
 		// 在开发环境中，RocketMQ 可能不可用
 		return nil
@@ -96,28 +122,80 @@ func (s *MQConsumerServer) handler(ctx context.Context, msgs ...*primitive.Messa
 		return consumer.ConsumeSuccess, nil
 	}
 
+	s.recordLag(msgs)
+
+	var batch []deductMessage
 	var events []*biz.DeductEvent
 	for _, msg := range msgs {
-		var event biz.DeductEvent
-		if err := json.Unmarshal(msg.Body, &event); err != nil {
-			s.log.Errorf("Unmarshal message failed: %v, body: %s", err, string(msg.Body))
+		// 迁移期间同时兼容旧版 JSON 与 protobuf 信封编码
+		// 原子批量扣费的一条消息包含多个事件，与其他事件在同一事务中落库
+		decoded, err := s.repo.DecodeDeductEvents(msg.Body, msg.GetProperty(constants.MQPropertyContentType))
+		if err != nil {
+			s.log.Errorf("Decode message failed: %v, msg_id: %s", err, msg.MsgId)
+			// 无法解析的消息重试也不会成功，转入死信 topic 保留扣费数据
+			if !s.deadLetter(ctx, msg, fmt.Sprintf("decode: %v", err)) {
+				return consumer.SuspendCurrentQueueAMoment, nil
+			}
 			continue
 		}
-		events = append(events, &event)
+		batch = append(batch, deductMessage{msg: msg, events: decoded})
+		events = append(events, decoded...)
+	}
+	if len(events) == 0 {
+		return consumer.ConsumeSuccess, nil
 	}
 
-	if len(events) > 0 {
-		// Call BatchDeductQuota
-		// Use a context with timeout for DB operations?
-		// We can use the passed ctx? No, primitive.MessageExt doesn't give context for the whole batch?
-		// Use background context with timeout
-		// Note: handler ctx is usually valid.
+	err := s.repo.BatchDeductQuota(ctx, events)
+	if err == nil {
+		return consumer.ConsumeSuccess, nil
+	}
+	s.log.Errorf("BatchDeductQuota failed: %v", err)
 
-		err := s.repo.BatchDeductQuota(ctx, events)
-		if err != nil {
-			s.log.Errorf("BatchDeductQuota failed: %v", err)
-			return consumer.ConsumeRetryLater, nil
+	// 整批事务失败后逐条落库，避免一条坏消息拖住整批；已落库的事件重试时会被跳过
+	for _, m := range batch {
+		err := s.repo.BatchDeductQuota(ctx, m.events)
+		if err == nil {
+			continue
+		}
+		if m.msg.ReconsumeTimes < s.maxReconsumeTimes {
+			s.log.Errorf("Deduct message failed: %v, msg_id: %s, reconsume_times: %d", err, m.msg.MsgId, m.msg.ReconsumeTimes)
+			// 顺序消费模式下挂起当前队列稍后重试，保证后续事件不会越过失败的事件
+			return consumer.SuspendCurrentQueueAMoment, nil
+		}
+		// 重试耗尽，转入死信 topic，继续处理后续消息
+		if !s.deadLetter(ctx, m.msg, fmt.Sprintf("deduct: %v", err)) {
+			return consumer.SuspendCurrentQueueAMoment, nil
 		}
 	}
 	return consumer.ConsumeSuccess, nil
 }
+
+// deadLetter 将消息转入死信 topic，失败时返回 false（调用方挂起队列稍后重试，避免丢失扣费数据）
+func (s *MQConsumerServer) deadLetter(ctx context.Context, msg *primitive.MessageExt, reason string) bool {
+	if err := s.repo.DeadLetterDeductMessage(ctx, msg.Body, msg.GetProperty(constants.MQPropertyContentType), reason); err != nil {
+		s.log.Errorf("Dead letter message failed: %v, msg_id: %s", err, msg.MsgId)
+		return false
+	}
+	s.log.Warnf("Message moved to dead letter topic: msg_id: %s, reason: %s", msg.MsgId, reason)
+	return true
+}
+
+// recordLag 按队列记录消费堆积：队列最大 offset 与当前批次最后一条消息 offset 的差值
+func (s *MQConsumerServer) recordLag(msgs []*primitive.MessageExt) {
+	if s.metrics == nil {
+		return
+	}
+	last := msgs[len(msgs)-1]
+	if last.Queue == nil {
+		return
+	}
+	maxOffset, err := strconv.ParseInt(last.GetProperty(primitive.PropertyMaxOffset), 10, 64)
+	if err != nil {
+		return
+	}
+	lag := maxOffset - last.QueueOffset - 1
+	if lag < 0 {
+		lag = 0
+	}
+	s.metrics.MQConsumerLag.WithLabelValues(last.Queue.Topic, last.Queue.BrokerName, strconv.Itoa(last.Queue.QueueId)).Set(float64(lag))
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"billing-service/internal/biz"
+
+	"github.com/apache/rocketmq-client-go/v2/consumer"
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeDeductRepo 消息体即 RecordID，"bad" 无法解析，"poison" 落库总是失败
+type fakeDeductRepo struct {
+	biz.BillingRepo
+	applied    map[string]int
+	dead       []string
+	deadLetter error
+}
+
+func (r *fakeDeductRepo) DecodeDeductEvents(body []byte, _ string) ([]*biz.DeductEvent, error) {
+	if string(body) == "bad" {
+		return nil, errors.New("malformed")
+	}
+	return []*biz.DeductEvent{{RecordID: string(body)}}, nil
+}
+
+// BatchDeductQuota 整批原子落库，已落库的事件跳过
+func (r *fakeDeductRepo) BatchDeductQuota(_ context.Context, events []*biz.DeductEvent) error {
+	for _, event := range events {
+		if event.RecordID == "poison" {
+			return errors.New("constraint violation")
+		}
+	}
+	for _, event := range events {
+		if r.applied[event.RecordID] == 0 {
+			r.applied[event.RecordID]++
+		}
+	}
+	return nil
+}
+
+func (r *fakeDeductRepo) DeadLetterDeductMessage(_ context.Context, body []byte, _, _ string) error {
+	if r.deadLetter != nil {
+		return r.deadLetter
+	}
+	r.dead = append(r.dead, string(body))
+	return nil
+}
+
+func newTestConsumer(repo *fakeDeductRepo) *MQConsumerServer {
+	return &MQConsumerServer{repo: repo, log: log.NewHelper(log.DefaultLogger), maxReconsumeTimes: 2}
+}
+
+func testMessages(bodies ...string) []*primitive.MessageExt {
+	msgs := make([]*primitive.MessageExt, 0, len(bodies))
+	for _, body := range bodies {
+		msgs = append(msgs, &primitive.MessageExt{Message: primitive.Message{Body: []byte(body)}, MsgId: body})
+	}
+	return msgs
+}
+
+// TestMQConsumerPoisonMessage 坏消息挂起队列重试，重试耗尽后转入死信，前后的消息各落库一次
+func TestMQConsumerPoisonMessage(t *testing.T) {
+	repo := &fakeDeductRepo{applied: map[string]int{}}
+	s := newTestConsumer(repo)
+	msgs := testMessages("e1", "poison", "e2")
+
+	// 顺序消费挂起后客户端重新投递同一批消息，并累加 ReconsumeTimes
+	for attempt := int32(0); attempt < 2; attempt++ {
+		result, _ := s.handler(context.Background(), msgs...)
+		if result != consumer.SuspendCurrentQueueAMoment {
+			t.Fatalf("attempt %d: result = %v, want suspend", attempt, result)
+		}
+		if repo.applied["e2"] != 0 {
+			t.Fatalf("attempt %d: e2 applied before the failed message", attempt)
+		}
+		for _, msg := range msgs {
+			msg.ReconsumeTimes++
+		}
+	}
+
+	result, _ := s.handler(context.Background(), msgs...)
+	if result != consumer.ConsumeSuccess {
+		t.Fatalf("result = %v, want success", result)
+	}
+	if len(repo.dead) != 1 || repo.dead[0] != "poison" {
+		t.Errorf("dead letters = %v, want [poison]", repo.dead)
+	}
+	if repo.applied["e1"] != 1 || repo.applied["e2"] != 1 {
+		t.Errorf("applied = %v, want e1 and e2 once", repo.applied)
+	}
+}
+
+// TestMQConsumerDecodeFailure 无法解析的消息转入死信，其余消息正常落库
+func TestMQConsumerDecodeFailure(t *testing.T) {
+	repo := &fakeDeductRepo{applied: map[string]int{}}
+	s := newTestConsumer(repo)
+
+	result, _ := s.handler(context.Background(), testMessages("e1", "bad")...)
+	if result != consumer.ConsumeSuccess {
+		t.Fatalf("result = %v, want success", result)
+	}
+	if len(repo.dead) != 1 || repo.dead[0] != "bad" {
+		t.Errorf("dead letters = %v, want [bad]", repo.dead)
+	}
+	if repo.applied["e1"] != 1 {
+		t.Errorf("applied = %v, want e1", repo.applied)
+	}
+}
+
+// TestMQConsumerDeadLetterFailure 死信投递失败时挂起队列，不丢弃消息
+func TestMQConsumerDeadLetterFailure(t *testing.T) {
+	repo := &fakeDeductRepo{applied: map[string]int{}, deadLetter: errors.New("broker unavailable")}
+	s := newTestConsumer(repo)
+
+	if result, _ := s.handler(context.Background(), testMessages("bad")...); result != consumer.SuspendCurrentQueueAMoment {
+		t.Errorf("decode failure: result = %v, want suspend", result)
+	}
+	msgs := testMessages("poison")
+	msgs[0].ReconsumeTimes = 2
+	if result, _ := s.handler(context.Background(), msgs...); result != consumer.SuspendCurrentQueueAMoment {
+		t.Errorf("poison message: result = %v, want suspend", result)
+	}
+}
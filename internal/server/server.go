@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewMQConsumerServer)
+var ProviderSet = wire.NewSet(NewAuthenticator, NewGRPCServer, NewHTTPServer, NewMQConsumerServer, NewDeferredSettlementServer, NewLeaseReclaimServer, NewExportWorkerServer, NewBudgetAlertServer)
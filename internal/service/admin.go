@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminService 计费管理服务（平台级报表，鉴权由 server 层管理接口中间件完成）
+type AdminService struct {
+	pb.UnimplementedBillingAdminServiceServer
+
+	uc  *biz.BillingUseCase
+	log *log.Helper
+}
+
+func NewAdminService(uc *biz.BillingUseCase, logger log.Logger) *AdminService {
+	return &AdminService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// GetRevenueReport 收入报表
+func (s *AdminService) GetRevenueReport(ctx context.Context, req *pb.GetRevenueReportRequest) (*pb.GetRevenueReportReply, error) {
+	start, end := reportRange(req.StartTime, req.EndTime)
+	report, err := s.uc.GetRevenueReport(ctx, start, end, req.Granularity, req.ServiceName, req.GroupByService)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pb.RevenueItem, 0, len(report.Items))
+	for _, item := range report.Items {
+		items = append(items, &pb.RevenueItem{
+			PeriodStart: timestamppb.New(item.PeriodStart),
+			ServiceName: item.ServiceName,
+			Revenue:     item.Revenue,
+			TotalCount:  item.TotalCount,
+			FreeCount:   item.FreeCount,
+			PaidCount:   item.PaidCount,
+		})
+	}
+	return &pb.GetRevenueReportReply{
+		Granularity:  report.Granularity,
+		Items:        items,
+		TotalRevenue: report.TotalRevenue,
+		TotalCount:   report.TotalCount,
+		FreeCount:    report.FreeCount,
+		PaidCount:    report.PaidCount,
+	}, nil
+}
+
+// GetRechargeReport 充值报表
+func (s *AdminService) GetRechargeReport(ctx context.Context, req *pb.GetRechargeReportRequest) (*pb.GetRechargeReportReply, error) {
+	start, end := reportRange(req.StartTime, req.EndTime)
+	report, err := s.uc.GetRechargeReport(ctx, start, end, req.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pb.RechargeItem, 0, len(report.Items))
+	for _, item := range report.Items {
+		items = append(items, &pb.RechargeItem{
+			PeriodStart: timestamppb.New(item.PeriodStart),
+			Amount:      item.Amount,
+			OrderCount:  item.OrderCount,
+			UserCount:   item.UserCount,
+		})
+	}
+	return &pb.GetRechargeReportReply{
+		Granularity: report.Granularity,
+		Items:       items,
+		TotalAmount: report.TotalAmount,
+		TotalOrders: report.TotalOrders,
+		TotalUsers:  report.TotalUsers,
+	}, nil
+}
+
+// GetUserActivityReport 用户活跃报表
+func (s *AdminService) GetUserActivityReport(ctx context.Context, req *pb.GetUserActivityReportRequest) (*pb.GetUserActivityReportReply, error) {
+	start, end := reportRange(req.StartTime, req.EndTime)
+	activity, err := s.uc.GetUserActivityReport(ctx, start, end, req.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserActivityReportReply{
+		ActiveUsers:    activity.ActiveUsers,
+		PayingUsers:    activity.PayingUsers,
+		NewPayingUsers: activity.NewPayingUsers,
+		ConversionRate: activity.ConversionRate,
+	}, nil
+}
+
+// ListTopConsumers 消费排行
+func (s *AdminService) ListTopConsumers(ctx context.Context, req *pb.ListTopConsumersRequest) (*pb.ListTopConsumersReply, error) {
+	start, end := reportRange(req.StartTime, req.EndTime)
+	consumers, err := s.uc.ListTopConsumers(ctx, start, end, req.ServiceName, req.OrderBy, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	pbConsumers := make([]*pb.TopConsumer, 0, len(consumers))
+	for _, c := range consumers {
+		pbConsumers = append(pbConsumers, &pb.TopConsumer{
+			UserId:     c.UID,
+			Revenue:    c.Revenue,
+			TotalCount: c.TotalCount,
+			PaidCount:  c.PaidCount,
+		})
+	}
+	return &pb.ListTopConsumersReply{Consumers: pbConsumers}, nil
+}
+
+// GetBalanceLiability 余额负债
+func (s *AdminService) GetBalanceLiability(ctx context.Context, req *pb.GetBalanceLiabilityRequest) (*pb.GetBalanceLiabilityReply, error) {
+	liability, err := s.uc.GetBalanceLiability(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBalanceLiabilityReply{
+		TotalBalance:   liability.TotalBalance,
+		Accounts:       liability.Accounts,
+		FundedAccounts: liability.FundedAccounts,
+		AsOf:           timestamppb.New(liability.AsOf),
+	}, nil
+}
+
+// reportRange 将请求中的可选时间转换为 time.Time（未传时为零值，由 biz 层校验）
+func reportRange(startTime, endTime *timestamppb.Timestamp) (time.Time, time.Time) {
+	var start, end time.Time
+	if startTime != nil {
+		start = startTime.AsTime()
+	}
+	if endTime != nil {
+		end = endTime.AsTime()
+	}
+	return start, end
+}
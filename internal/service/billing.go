@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"time"
 
 	pb "billing-service/api/billing/v1"
 	"billing-service/internal/biz"
 	"billing-service/internal/constants"
 	billingErrors "billing-service/internal/errors"
+	"billing-service/internal/metrics"
 
 	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/gaoyong06/go-pkg/middleware/app_id"
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -17,18 +20,22 @@ type BillingService struct {
 	pb.UnimplementedBillingServiceServer
 	pb.UnimplementedBillingInternalServiceServer
 
-	uc  *biz.BillingUseCase
-	log *log.Helper
+	uc      *biz.BillingUseCase
+	conf    *biz.BillingConfig
+	metrics *metrics.BillingMetrics
+	log     *log.Helper
 }
 
-func NewBillingService(uc *biz.BillingUseCase, logger log.Logger) *BillingService {
+func NewBillingService(uc *biz.BillingUseCase, conf *biz.BillingConfig, logger log.Logger) *BillingService {
 	return &BillingService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:      uc,
+		conf:    conf,
+		metrics: metrics.GetMetrics(),
+		log:     log.NewHelper(logger),
 	}
 }
 
-// GetAccount 获取账户资产信息
+// GetAccount 获取账户资产信息，orgId 非空时为组织共享钱包（memberId 非空时同时返回该成员）
 func (s *BillingService) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountReply, error) {
 	// 验证必填字段
 	if req.UserId == "" {
@@ -36,29 +43,79 @@ func (s *BillingService) GetAccount(ctx context.Context, req *pb.GetAccountReque
 		return nil, pkgErrors.NewBizErrorWithLang(ctx, pkgErrors.ErrCodeMissingRequiredField)
 	}
 
-	balance, quotas, err := s.uc.GetAccount(ctx, req.UserId)
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
 	if err != nil {
-		s.log.Errorf("GetAccount failed: userId=%s, error=%v", req.UserId, err)
+		return nil, err
+	}
+	accountID := scope.AccountID
+
+	balance, quotas, err := s.uc.GetAccount(ctx, accountID)
+	if err != nil {
+		s.log.Errorf("GetAccount failed: userId=%s, error=%v", accountID, err)
 		return nil, err
 	}
 
 	pbQuotas := make([]*pb.FreeQuota, 0, len(quotas))
 	for _, q := range quotas {
-		pbQuotas = append(pbQuotas, &pb.FreeQuota{
+		pbQuota := &pb.FreeQuota{
 			ServiceName: q.ServiceName,
 			TotalQuota:  int32(q.TotalQuota),
 			UsedQuota:   int32(q.UsedQuota),
-			ResetMonth:  q.ResetMonth,
-		})
+			ResetMonth:  q.Period,
+			Unit:        s.conf.Unit(q.ServiceName),
+			Cycle:       q.Cycle,
+			PeriodStart: timestamppb.New(q.PeriodStart),
+			PeriodEnd:   timestamppb.New(q.PeriodEnd),
+		}
+		if q.RolloverQuota > 0 {
+			pbQuota.Rollover = &pb.FreeQuotaRollover{
+				Quota:     int32(q.RolloverQuota),
+				Used:      int32(q.RolloverUsed()),
+				ExpiresAt: timestamppb.New(q.PeriodEnd),
+			}
+		}
+		pbQuotas = append(pbQuotas, pbQuota)
+	}
+
+	timezone, err := s.uc.GetAccountTimezone(ctx, accountID)
+	if err != nil {
+		s.log.Errorf("GetAccount timezone failed: userId=%s, error=%v", accountID, err)
+		return nil, err
+	}
+
+	packages, err := s.uc.ListUserPackages(ctx, accountID, "", false)
+	if err != nil {
+		s.log.Errorf("GetAccount packages failed: userId=%s, error=%v", accountID, err)
+		return nil, err
+	}
+
+	rates, contracts, err := s.accountPricing(ctx, accountID)
+	if err != nil {
+		s.log.Errorf("GetAccount pricing failed: userId=%s, error=%v", accountID, err)
+		return nil, err
 	}
 
 	return &pb.GetAccountReply{
-		UserId:  balance.UID,
-		Balance: balance.Balance,
-		Quotas:  pbQuotas,
+		UserId:    balance.UID,
+		Balance:   balance.Balance,
+		Quotas:    pbQuotas,
+		Timezone:  timezone,
+		Packages:  toPBUserPackages(packages, time.Now()),
+		Rates:     rates,
+		Contracts: contracts,
+		Member:    toPBOrgMember(scope.Member),
 	}, nil
 }
 
+// SetAccountTimezone 设置账户计费时区
+func (s *BillingService) SetAccountTimezone(ctx context.Context, req *pb.SetAccountTimezoneRequest) (*pb.SetAccountTimezoneReply, error) {
+	if err := s.uc.SetAccountTimezone(ctx, req.UserId, req.Timezone); err != nil {
+		s.log.Errorf("SetAccountTimezone failed: userId=%s, timezone=%s, error=%v", req.UserId, req.Timezone, err)
+		return nil, err
+	}
+	return &pb.SetAccountTimezoneReply{Timezone: req.Timezone}, nil
+}
+
 // Recharge 发起充值
 func (s *BillingService) Recharge(ctx context.Context, req *pb.RechargeRequest) (*pb.RechargeReply, error) {
 	// 将 payment_method 字符串转换为 PaymentMethod 枚举
@@ -87,7 +144,7 @@ func (s *BillingService) Recharge(ctx context.Context, req *pb.RechargeRequest)
 	returnURL := "" // 从配置中获取
 	notifyURL := "" // 从配置中获取
 
-	orderID, payURL, err := s.uc.Recharge(ctx, req.UserId, req.Amount, method, req.Currency, returnURL, notifyURL)
+	orderID, payURL, err := s.uc.Recharge(ctx, req.UserId, req.OrgId, req.Amount, method, req.Currency, returnURL, notifyURL)
 	if err != nil {
 		return nil, err
 	}
@@ -99,20 +156,38 @@ func (s *BillingService) Recharge(ctx context.Context, req *pb.RechargeRequest)
 
 // ListRecords 获取消费流水
 func (s *BillingService) ListRecords(ctx context.Context, req *pb.ListRecordsRequest) (*pb.ListRecordsReply, error) {
-	records, total, err := s.uc.ListRecords(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	filter, err := toRecordFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	page, err := s.uc.ListRecords(ctx, scope, req.RequestId, &biz.RecordQuery{
+		Filter:    filter,
+		Cursor:    req.Cursor,
+		Page:      int(req.Page),
+		PageSize:  int(req.PageSize),
+		WithTotal: req.WithTotal,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	pbRecords := make([]*pb.BillingRecord, 0, len(records))
-	for _, r := range records {
+	pbRecords := make([]*pb.BillingRecord, 0, len(page.Records))
+	for _, r := range page.Records {
 		// 将字符串类型转换为 int32（兼容 proto 定义）
-		// "free" -> 1, "balance" -> 2
+		// "free" -> 1, "balance" -> 2, "package" -> 3, "true_up" -> 4
 		var typeInt int32
 		if r.Type == constants.BillingTypeFree {
 			typeInt = 1
 		} else if r.Type == constants.BillingTypeBalance {
 			typeInt = 2
+		} else if r.Type == constants.BillingTypePackage {
+			typeInt = 3
+		} else if r.Type == constants.BillingTypeTrueUp {
+			typeInt = 4
 		}
 		pbRecords = append(pbRecords, &pb.BillingRecord{
 			Id:          r.ID,
@@ -121,30 +196,68 @@ func (s *BillingService) ListRecords(ctx context.Context, req *pb.ListRecordsReq
 			Amount:      r.Amount,
 			Count:       int32(r.Count),
 			CreatedAt:   timestamppb.New(r.CreatedAt),
+			Metadata:    fromDeductMetadata(r.Metadata),
+			MemberId:    r.MemberID,
 		})
 	}
 
 	return &pb.ListRecordsReply{
-		Records: pbRecords,
-		Total:   int32(total),
+		Records:    pbRecords,
+		Total:      int32(page.Total),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
 	}, nil
 }
 
+// toRecordFilter 转换消费记录过滤条件，type 与 BillingRecord.type 取值一致（1:免费额度, 2:余额扣费, 3:用量包, 4:合同补差）
+func toRecordFilter(ctx context.Context, req *pb.ListRecordsRequest) (biz.RecordFilter, error) {
+	filter := biz.RecordFilter{
+		ServiceName: req.ServiceName,
+		MinAmount:   req.MinAmount,
+		AppID:       req.AppId,
+	}
+	switch req.Type {
+	case 0:
+	case 1:
+		filter.Type = constants.BillingTypeFree
+	case 2:
+		filter.Type = constants.BillingTypeBalance
+	case 3:
+		filter.Type = constants.BillingTypePackage
+	case 4:
+		filter.Type = constants.BillingTypeTrueUp
+	default:
+		return filter, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidRecordQuery)
+	}
+	if req.StartTime != nil {
+		filter.StartTime = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		filter.EndTime = req.EndTime.AsTime()
+	}
+	return filter, nil
+}
+
 // CheckQuota 检查并预扣费
 func (s *BillingService) CheckQuota(ctx context.Context, req *pb.CheckQuotaRequest) (*pb.CheckQuotaReply, error) {
-	allowed, reason, err := s.uc.CheckQuota(ctx, req.UserId, req.ServiceName, int(req.Count))
+	check, err := s.uc.CheckQuota(ctx, req.UserId, req.ServiceName, int(req.Count))
 	if err != nil {
 		return nil, err
 	}
 	return &pb.CheckQuotaReply{
-		Allowed: allowed,
-		Reason:  reason,
+		Allowed:      check.Allowed,
+		Reason:       check.Reason,
+		RetryAfterMs: check.RetryAfter.Milliseconds(),
 	}, nil
 }
 
 // DeductQuota 确认扣费
 func (s *BillingService) DeductQuota(ctx context.Context, req *pb.DeductQuotaRequest) (*pb.DeductQuotaReply, error) {
-	recordID, err := s.uc.DeductQuota(ctx, req.UserId, req.ServiceName, int(req.Count))
+	recordID, err := s.uc.DeductQuota(ctx, req.UserId, req.ServiceName, biz.Usage{
+		Quantity:   int(req.Count),
+		Unit:       req.Unit,
+		CallerCost: req.Cost,
+	}, toDeductMetadata(ctx, req.Metadata))
 	if err != nil {
 		// 记录错误日志，便于排查问题
 		s.log.Errorf("DeductQuota failed: user_id=%s, service=%s, count=%d, error=%v",
@@ -157,6 +270,90 @@ func (s *BillingService) DeductQuota(ctx context.Context, req *pb.DeductQuotaReq
 	}, nil
 }
 
+// BatchCheckQuota 批量检查配额
+func (s *BillingService) BatchCheckQuota(ctx context.Context, req *pb.BatchCheckQuotaRequest) (*pb.BatchCheckQuotaReply, error) {
+	check, err := s.uc.BatchCheckQuota(ctx, req.UserId, toQuotaItems(req.Items))
+	if err != nil {
+		s.log.Errorf("BatchCheckQuota failed: user_id=%s, items=%d, error=%v", req.UserId, len(req.Items), err)
+		return nil, err
+	}
+	return &pb.BatchCheckQuotaReply{
+		Allowed:      check.Allowed,
+		Reason:       check.Reason,
+		RetryAfterMs: check.RetryAfter.Milliseconds(),
+	}, nil
+}
+
+// BatchDeductQuota 批量扣费（全部成功或全部失败）
+func (s *BillingService) BatchDeductQuota(ctx context.Context, req *pb.BatchDeductQuotaRequest) (*pb.BatchDeductQuotaReply, error) {
+	recordIDs, err := s.uc.BatchDeductQuota(ctx, req.UserId, toQuotaItems(req.Items), toDeductMetadata(ctx, req.Metadata))
+	if err != nil {
+		s.log.Errorf("BatchDeductQuota failed: user_id=%s, items=%d, error=%v", req.UserId, len(req.Items), err)
+		return &pb.BatchDeductQuotaReply{Success: false}, err
+	}
+	return &pb.BatchDeductQuotaReply{
+		Success:   true,
+		RecordIds: recordIDs,
+	}, nil
+}
+
+func toQuotaItems(items []*pb.QuotaItem) []*biz.QuotaItem {
+	result := make([]*biz.QuotaItem, len(items))
+	for i, item := range items {
+		result[i] = &biz.QuotaItem{
+			ServiceName: item.ServiceName,
+			Count:       int(item.Count),
+			Unit:        item.Unit,
+			CallerCost:  item.Cost,
+		}
+	}
+	return result
+}
+
+// AcquireLease 申请额度租约
+func (s *BillingService) AcquireLease(ctx context.Context, req *pb.AcquireLeaseRequest) (*pb.AcquireLeaseReply, error) {
+	lease, err := s.uc.AcquireLease(ctx, req.UserId, req.ServiceName, int(req.Count), int(req.TtlSeconds))
+	if err != nil {
+		s.log.Errorf("AcquireLease failed: user_id=%s, service=%s, count=%d, error=%v",
+			req.UserId, req.ServiceName, req.Count, err)
+		return nil, err
+	}
+	return &pb.AcquireLeaseReply{
+		LeaseId:      lease.LeaseID,
+		GrantedCount: int32(lease.Granted()),
+		FreeCount:    int32(lease.FreeGranted),
+		PaidCount:    int32(lease.PaidGranted),
+		PackageCount: int32(lease.PackageGranted),
+		ExpiresAt:    timestamppb.New(lease.ExpiresAt),
+	}, nil
+}
+
+// ReportLeaseUsage 上报租约用量
+func (s *BillingService) ReportLeaseUsage(ctx context.Context, req *pb.ReportLeaseUsageRequest) (*pb.ReportLeaseUsageReply, error) {
+	lease, err := s.uc.ReportLeaseUsage(ctx, req.LeaseId, req.ServiceName, int(req.UsedCount), req.Renew, int(req.TtlSeconds))
+	if err != nil {
+		s.log.Errorf("ReportLeaseUsage failed: lease_id=%s, used=%d, error=%v", req.LeaseId, req.UsedCount, err)
+		return nil, err
+	}
+	return &pb.ReportLeaseUsageReply{
+		RemainingCount: int32(lease.Remaining()),
+		ExpiresAt:      timestamppb.New(lease.ExpiresAt),
+	}, nil
+}
+
+// ReleaseLease 释放租约
+func (s *BillingService) ReleaseLease(ctx context.Context, req *pb.ReleaseLeaseRequest) (*pb.ReleaseLeaseReply, error) {
+	reclaimed, err := s.uc.ReleaseLease(ctx, req.LeaseId, req.ServiceName, int(req.UsedCount))
+	if err != nil {
+		s.log.Errorf("ReleaseLease failed: lease_id=%s, used=%d, error=%v", req.LeaseId, req.UsedCount, err)
+		return &pb.ReleaseLeaseReply{Success: false}, err
+	}
+	return &pb.ReleaseLeaseReply{
+		Success:        true,
+		ReclaimedCount: int32(reclaimed),
+	}, nil
+}
+
 // RechargeCallback 充值回调
 func (s *BillingService) RechargeCallback(ctx context.Context, req *pb.RechargeCallbackRequest) (*pb.RechargeCallbackReply, error) {
 	// 验证支付状态
@@ -173,7 +370,11 @@ func (s *BillingService) RechargeCallback(ctx context.Context, req *pb.RechargeC
 
 // GetStatsToday 获取今日调用统计
 func (s *BillingService) GetStatsToday(ctx context.Context, req *pb.GetStatsTodayRequest) (*pb.GetStatsReply, error) {
-	stats, err := s.uc.GetStatsToday(ctx, req.UserId, req.ServiceName)
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.uc.GetStatsToday(ctx, scope, req.ServiceName)
 	if err != nil {
 		return nil, err
 	}
@@ -186,12 +387,17 @@ func (s *BillingService) GetStatsToday(ctx context.Context, req *pb.GetStatsToda
 		FreeCount:   int32(stats.FreeCount),
 		PaidCount:   int32(stats.PaidCount),
 		Period:      stats.Period,
+		MemberId:    stats.MemberID,
 	}, nil
 }
 
 // GetStatsMonth 获取本月调用统计
 func (s *BillingService) GetStatsMonth(ctx context.Context, req *pb.GetStatsMonthRequest) (*pb.GetStatsReply, error) {
-	stats, err := s.uc.GetStatsMonth(ctx, req.UserId, req.ServiceName)
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.uc.GetStatsMonth(ctx, scope, req.ServiceName)
 	if err != nil {
 		return nil, err
 	}
@@ -204,12 +410,17 @@ func (s *BillingService) GetStatsMonth(ctx context.Context, req *pb.GetStatsMont
 		FreeCount:   int32(stats.FreeCount),
 		PaidCount:   int32(stats.PaidCount),
 		Period:      stats.Period,
+		MemberId:    stats.MemberID,
 	}, nil
 }
 
 // GetStatsSummary 获取汇总统计（所有服务）
 func (s *BillingService) GetStatsSummary(ctx context.Context, req *pb.GetStatsSummaryRequest) (*pb.GetStatsSummaryReply, error) {
-	summary, err := s.uc.GetStatsSummary(ctx, req.UserId)
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.uc.GetStatsSummary(ctx, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -230,5 +441,89 @@ func (s *BillingService) GetStatsSummary(ctx context.Context, req *pb.GetStatsSu
 		TotalCount: int32(summary.TotalCount),
 		TotalCost:  summary.TotalCost,
 		Services:   pbServices,
+		MemberId:   summary.MemberID,
 	}, nil
 }
+
+// toDeductMetadata 转换扣费来源信息，请求未指定 appId 时使用请求头中的应用ID
+func toDeductMetadata(ctx context.Context, m *pb.DeductMetadata) *biz.DeductMetadata {
+	meta := &biz.DeductMetadata{AppID: app_id.GetAppIDFromContext(ctx)}
+	if m != nil {
+		meta.RequestID = m.RequestId
+		meta.APIKeyID = m.ApiKeyId
+		meta.Operation = m.Operation
+		meta.Labels = m.Labels
+		if m.AppId != "" {
+			meta.AppID = m.AppId
+		}
+	}
+	if meta.IsEmpty() {
+		return nil
+	}
+	return meta
+}
+
+// fromDeductMetadata 转换为 proto 的扣费来源信息
+func fromDeductMetadata(m *biz.DeductMetadata) *pb.DeductMetadata {
+	if m == nil {
+		return nil
+	}
+	return &pb.DeductMetadata{
+		RequestId: m.RequestID,
+		ApiKeyId:  m.APIKeyID,
+		AppId:     m.AppID,
+		Operation: m.Operation,
+		Labels:    m.Labels,
+	}
+}
+
+// GetUsageSeries 获取用量时间序列
+func (s *BillingService) GetUsageSeries(ctx context.Context, req *pb.GetUsageSeriesRequest) (*pb.GetUsageSeriesReply, error) {
+	var start, end time.Time
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	scope, err := s.uc.ResolveAccountScope(ctx, req.UserId, req.OrgId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.uc.GetUsageSeries(ctx, scope, req.ServiceName, start, end, req.Granularity, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return toPBUsageSeries(series), nil
+}
+
+// GetLiveUsage 获取实时用量
+func (s *BillingService) GetLiveUsage(ctx context.Context, req *pb.GetLiveUsageRequest) (*pb.GetUsageSeriesReply, error) {
+	series, err := s.uc.GetLiveUsage(ctx, req.UserId, req.ServiceName, req.Granularity, int(req.Points))
+	if err != nil {
+		return nil, err
+	}
+	return toPBUsageSeries(series), nil
+}
+
+func toPBUsageSeries(series *biz.UsageSeries) *pb.GetUsageSeriesReply {
+	points := make([]*pb.UsagePoint, 0, len(series.Points))
+	for _, p := range series.Points {
+		points = append(points, &pb.UsagePoint{
+			StartTime:  timestamppb.New(p.StartTime),
+			TotalCount: int32(p.TotalCount),
+			TotalCost:  p.TotalCost,
+			FreeCount:  int32(p.FreeCount),
+			PaidCount:  int32(p.PaidCount),
+		})
+	}
+
+	return &pb.GetUsageSeriesReply{
+		UserId:      series.UID,
+		ServiceName: series.ServiceName,
+		Granularity: series.Granularity,
+		Timezone:    series.Timezone,
+		Points:      points,
+		MemberId:    series.MemberID,
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetBudget 设置消费预算
+func (s *BillingService) SetBudget(ctx context.Context, req *pb.SetBudgetRequest) (*pb.SetBudgetReply, error) {
+	budget, err := s.uc.SetBudget(ctx, &biz.Budget{
+		UserID:       req.UserId,
+		ServiceName:  req.ServiceName,
+		Amount:       req.Amount,
+		AlertPercent: req.AlertPercent,
+		HardLimit:    req.HardLimit,
+	})
+	if err != nil {
+		s.log.Errorf("SetBudget failed: user_id=%s, service=%s, error=%v", req.UserId, req.ServiceName, err)
+		return nil, err
+	}
+	return &pb.SetBudgetReply{Budget: toPBBudget(budget)}, nil
+}
+
+// ListBudgets 获取消费预算及本月已消费金额
+func (s *BillingService) ListBudgets(ctx context.Context, req *pb.ListBudgetsRequest) (*pb.ListBudgetsReply, error) {
+	budgets, err := s.uc.ListBudgets(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListBudgetsReply{Budgets: make([]*pb.Budget, 0, len(budgets))}
+	for _, budget := range budgets {
+		reply.Budgets = append(reply.Budgets, toPBBudget(budget))
+	}
+	return reply, nil
+}
+
+// DeleteBudget 删除消费预算
+func (s *BillingService) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetReply, error) {
+	if err := s.uc.DeleteBudget(ctx, req.UserId, req.ServiceName); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteBudgetReply{Success: true}, nil
+}
+
+func toPBBudget(b *biz.Budget) *pb.Budget {
+	return &pb.Budget{
+		ServiceName:  b.ServiceName,
+		Amount:       b.Amount,
+		AlertPercent: b.AlertPercent,
+		HardLimit:    b.HardLimit,
+		Period:       b.Period,
+		Spent:        b.Spent,
+		Alerted:      b.Period != "" && b.AlertedMonth == b.Period,
+		UpdatedAt:    timestamppb.New(b.UpdatedAt),
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportContentTypes 导出文件的 Content-Type
+var exportContentTypes = map[string]string{
+	constants.ExportFormatCSV:  "text/csv; charset=utf-8",
+	constants.ExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	constants.ExportFormatPDF:  "application/pdf",
+}
+
+// CreateExport 创建账单导出任务
+func (s *BillingService) CreateExport(ctx context.Context, req *pb.CreateExportRequest) (*pb.CreateExportReply, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeInvalidExportRange)
+	}
+	job, err := s.uc.CreateExport(ctx, req.UserId, req.Format, req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		s.log.Errorf("CreateExport failed: user_id=%s, format=%s, error=%v", req.UserId, req.Format, err)
+		return nil, err
+	}
+	if job.Status != constants.ExportStatusSuccess {
+		return &pb.CreateExportReply{Export: toPBExportJob(job, "", nil)}, nil
+	}
+	// 同步生成完成，直接返回下载链接
+	job, url, expiresAt, err := s.uc.GetExport(ctx, req.UserId, job.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateExportReply{Export: toPBExportJob(job, url, timestamppb.New(expiresAt))}, nil
+}
+
+// GetExport 查询账单导出任务
+func (s *BillingService) GetExport(ctx context.Context, req *pb.GetExportRequest) (*pb.GetExportReply, error) {
+	job, url, expiresAt, err := s.uc.GetExport(ctx, req.UserId, req.ExportId)
+	if err != nil {
+		return nil, err
+	}
+	var urlExpiresAt *timestamppb.Timestamp
+	if url != "" {
+		urlExpiresAt = timestamppb.New(expiresAt)
+	}
+	return &pb.GetExportReply{Export: toPBExportJob(job, url, urlExpiresAt)}, nil
+}
+
+// DownloadExport 下载导出文件（签名链接，GET /api/v1/billing/exports/{exportId}/download?expires=&signature=）
+func (s *BillingService) DownloadExport(ctx http.Context) error {
+	exportID := ctx.Vars().Get("exportId")
+	expires, _ := strconv.ParseInt(ctx.Query().Get("expires"), 10, 64)
+	signature := ctx.Query().Get("signature")
+
+	// 经过服务端中间件，错误消息按请求语言返回
+	h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		job, file, err := s.uc.OpenExport(c, exportID, expires, signature)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		w := ctx.Response()
+		w.Header().Set("Content-Type", exportContentTypes[job.Format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, job.FileName()))
+		if job.FileSize > 0 {
+			w.Header().Set("Content-Length", strconv.FormatInt(job.FileSize, 10))
+		}
+		if _, err := io.Copy(w, file); err != nil {
+			// 响应已开始写出，只记录日志
+			s.log.Errorf("Download export failed: export_id=%s, error=%v", exportID, err)
+		}
+		return nil, nil
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+func toPBExportJob(job *biz.ExportJob, downloadURL string, downloadURLExpiresAt *timestamppb.Timestamp) *pb.ExportJob {
+	reply := &pb.ExportJob{
+		ExportId:             job.ExportID,
+		Format:               job.Format,
+		Status:               job.Status,
+		StartTime:            timestamppb.New(job.StartTime),
+		EndTime:              timestamppb.New(job.EndTime),
+		RowCount:             int32(job.RowCount),
+		FileSize:             job.FileSize,
+		DownloadUrl:          downloadURL,
+		DownloadUrlExpiresAt: downloadURLExpiresAt,
+		ErrorMessage:         job.ErrorMessage,
+		CreatedAt:            timestamppb.New(job.CreatedAt),
+	}
+	if !job.FinishedAt.IsZero() {
+		reply.FinishedAt = timestamppb.New(job.FinishedAt)
+	}
+	if !job.ExpiresAt.IsZero() {
+		reply.FileExpiresAt = timestamppb.New(job.ExpiresAt)
+	}
+	return reply
+}
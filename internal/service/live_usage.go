@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	stdhttp "net/http"
+	"time"
+
+	pb "billing-service/api/billing/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// liveUsageUpdatePoints 增量推送的时间片数（上一个时间片 + 当前时间片，跨时间片时上一个时间片的最终值也会推送）
+const liveUsageUpdatePoints = 2
+
+var liveUsageMarshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// StreamLiveUsage 实时用量推送（SSE，GET /api/v1/billing/stats/live/stream，参数同 GetLiveUsage）
+// 连接建立后先推送一次完整窗口（event: snapshot），之后每个推送间隔推送最近两个时间片（event: usage），
+// 客户端按 startTime 覆盖更新。连接最长保持 stream_max_duration，之后由客户端重连
+func (s *BillingService) StreamLiveUsage(ctx http.Context) error {
+	var req pb.GetLiveUsageRequest
+	if err := ctx.BindQuery(&req); err != nil {
+		return err
+	}
+
+	// 经过服务端中间件（用户鉴权会绑定 userId），错误消息按请求语言返回
+	h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		// 服务端请求超时只约束建立连接，推送期间不受其限制；客户端断开时写入失败后退出
+		streamCtx := context.WithoutCancel(c)
+		series, err := s.GetLiveUsage(streamCtx, &req)
+		if err != nil {
+			return nil, err
+		}
+
+		w := ctx.Response()
+		rc := stdhttp.NewResponseController(w)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(stdhttp.StatusOK)
+		if err := writeLiveUsageEvent(w, rc, "snapshot", series); err != nil {
+			return nil, nil
+		}
+
+		ticker := time.NewTicker(s.conf.LiveStats.StreamInterval)
+		defer ticker.Stop()
+		deadline := time.NewTimer(s.conf.LiveStats.StreamMaxDuration)
+		defer deadline.Stop()
+
+		update := &pb.GetLiveUsageRequest{
+			UserId:      req.UserId,
+			ServiceName: req.ServiceName,
+			Granularity: req.Granularity,
+			Points:      liveUsageUpdatePoints,
+		}
+		for {
+			select {
+			case <-deadline.C:
+				return nil, nil
+			case <-ticker.C:
+			}
+			series, err := s.GetLiveUsage(streamCtx, update)
+			if err != nil {
+				// Redis 暂时不可用时跳过本次推送
+				s.log.Warnf("Stream live usage failed: user_id=%s, error=%v", req.UserId, err)
+				continue
+			}
+			if err := writeLiveUsageEvent(w, rc, "usage", series); err != nil {
+				// 客户端已断开
+				return nil, nil
+			}
+		}
+	})
+	_, err := h(ctx, &req)
+	return err
+}
+
+// writeLiveUsageEvent 写入一条 SSE 事件并立即刷新
+func writeLiveUsageEvent(w io.Writer, rc *stdhttp.ResponseController, event string, series *pb.GetUsageSeriesReply) error {
+	data, err := liveUsageMarshaler.Marshal(series)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
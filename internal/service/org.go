@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateOrganization 创建组织
+func (s *BillingService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.OrganizationReply, error) {
+	org, err := s.uc.CreateOrganization(ctx, req.UserId, req.Name)
+	if err != nil {
+		s.log.Errorf("CreateOrganization failed: user_id=%s, error=%v", req.UserId, err)
+		return nil, err
+	}
+	owner := &biz.OrgMember{OrgID: org.ID, UserID: org.OwnerID, Role: constants.OrgRoleOwner, Status: constants.OrgMemberStatusActive, CreatedAt: org.CreatedAt}
+	return &pb.OrganizationReply{
+		Organization: toPBOrganization(org),
+		Members:      []*pb.OrgMember{toPBOrgMember(owner)},
+	}, nil
+}
+
+// GetOrganization 获取组织及成员
+func (s *BillingService) GetOrganization(ctx context.Context, req *pb.GetOrganizationRequest) (*pb.OrganizationReply, error) {
+	org, members, err := s.uc.GetOrganization(ctx, req.UserId, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.OrganizationReply{
+		Organization: toPBOrganization(org),
+		Members:      make([]*pb.OrgMember, 0, len(members)),
+	}
+	for _, m := range members {
+		reply.Members = append(reply.Members, toPBOrgMember(m))
+	}
+	return reply, nil
+}
+
+// AddOrgMember 邀请组织成员
+func (s *BillingService) AddOrgMember(ctx context.Context, req *pb.AddOrgMemberRequest) (*pb.OrgMember, error) {
+	member, err := s.uc.AddOrgMember(ctx, req.UserId, &biz.OrgMember{
+		OrgID:      req.OrgId,
+		UserID:     req.MemberId,
+		Role:       req.Role,
+		SpendLimit: req.SpendLimit,
+	})
+	if err != nil {
+		s.log.Errorf("AddOrgMember failed: user_id=%s, org_id=%s, member_id=%s, error=%v", req.UserId, req.OrgId, req.MemberId, err)
+		return nil, err
+	}
+	return toPBOrgMember(member), nil
+}
+
+// AcceptOrgInvitation 接受组织邀请
+func (s *BillingService) AcceptOrgInvitation(ctx context.Context, req *pb.AcceptOrgInvitationRequest) (*pb.OrgMember, error) {
+	member, err := s.uc.AcceptOrgInvitation(ctx, req.UserId, req.OrgId)
+	if err != nil {
+		s.log.Errorf("AcceptOrgInvitation failed: user_id=%s, org_id=%s, error=%v", req.UserId, req.OrgId, err)
+		return nil, err
+	}
+	return toPBOrgMember(member), nil
+}
+
+// UpdateOrgMember 更新组织成员
+func (s *BillingService) UpdateOrgMember(ctx context.Context, req *pb.UpdateOrgMemberRequest) (*pb.OrgMember, error) {
+	member, err := s.uc.UpdateOrgMember(ctx, req.UserId, &biz.OrgMember{
+		OrgID:      req.OrgId,
+		UserID:     req.MemberId,
+		Role:       req.Role,
+		SpendLimit: req.SpendLimit,
+	})
+	if err != nil {
+		s.log.Errorf("UpdateOrgMember failed: user_id=%s, org_id=%s, member_id=%s, error=%v", req.UserId, req.OrgId, req.MemberId, err)
+		return nil, err
+	}
+	return toPBOrgMember(member), nil
+}
+
+// RemoveOrgMember 移除组织成员
+func (s *BillingService) RemoveOrgMember(ctx context.Context, req *pb.RemoveOrgMemberRequest) (*pb.RemoveOrgMemberReply, error) {
+	if err := s.uc.RemoveOrgMember(ctx, req.UserId, req.OrgId, req.MemberId); err != nil {
+		s.log.Errorf("RemoveOrgMember failed: user_id=%s, org_id=%s, member_id=%s, error=%v", req.UserId, req.OrgId, req.MemberId, err)
+		return &pb.RemoveOrgMemberReply{Success: false}, err
+	}
+	return &pb.RemoveOrgMemberReply{Success: true}, nil
+}
+
+func toPBOrganization(org *biz.Organization) *pb.Organization {
+	return &pb.Organization{
+		OrgId:     org.ID,
+		Name:      org.Name,
+		OwnerId:   org.OwnerID,
+		CreatedAt: timestamppb.New(org.CreatedAt),
+	}
+}
+
+func toPBOrgMember(m *biz.OrgMember) *pb.OrgMember {
+	if m == nil {
+		return nil
+	}
+	return &pb.OrgMember{
+		OrgId:      m.OrgID,
+		MemberId:   m.UserID,
+		Role:       m.Role,
+		SpendLimit: m.SpendLimit,
+		Spent:      m.Spent,
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+		Status:     m.Status,
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+	"billing-service/internal/constants"
+	billingErrors "billing-service/internal/errors"
+
+	pkgErrors "github.com/gaoyong06/go-pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListPackageCatalog 获取可购买的用量包目录
+func (s *BillingService) ListPackageCatalog(ctx context.Context, req *pb.ListPackageCatalogRequest) (*pb.ListPackageCatalogReply, error) {
+	catalog := s.uc.ListPackageCatalog()
+	reply := &pb.ListPackageCatalogReply{Packages: make([]*pb.UsagePackage, 0, len(catalog))}
+	for _, pkg := range catalog {
+		reply.Packages = append(reply.Packages, &pb.UsagePackage{
+			Id:          pkg.ID,
+			Name:        pkg.Name,
+			ServiceName: pkg.ServiceName,
+			Units:       pkg.Units,
+			Unit:        s.conf.Unit(pkg.ServiceName),
+			Price:       pkg.Price,
+			ValidMonths: int32(pkg.ValidMonths),
+		})
+	}
+	return reply, nil
+}
+
+// PurchasePackage 购买用量包（返回支付链接）
+func (s *BillingService) PurchasePackage(ctx context.Context, req *pb.PurchasePackageRequest) (*pb.PurchasePackageReply, error) {
+	// payment_method: "alipay" -> 1, "wechatpay" -> 2, 默认 -> 1 (alipay)，与 Recharge 一致
+	method := int32(1)
+	if req.PaymentMethod == constants.PaymentMethodWechat {
+		method = 2
+	}
+	if req.Currency == "" {
+		return nil, pkgErrors.NewBizErrorWithLang(ctx, billingErrors.ErrCodeCurrencyRequired)
+	}
+
+	orderID, payURL, err := s.uc.PurchasePackage(ctx, req.UserId, req.PackageId, method, req.Currency, "", "")
+	if err != nil {
+		s.log.Errorf("PurchasePackage failed: user_id=%s, package_id=%s, error=%v", req.UserId, req.PackageId, err)
+		return nil, err
+	}
+	return &pb.PurchasePackageReply{OrderId: orderID, PaymentUrl: payURL}, nil
+}
+
+// ListUserPackages 获取账户持有的用量包
+func (s *BillingService) ListUserPackages(ctx context.Context, req *pb.ListUserPackagesRequest) (*pb.ListUserPackagesReply, error) {
+	packages, err := s.uc.ListUserPackages(ctx, req.UserId, req.ServiceName, req.IncludeExpired)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListUserPackagesReply{Packages: toPBUserPackages(packages, time.Now())}, nil
+}
+
+func toPBUserPackages(packages []*biz.UserPackage, now time.Time) []*pb.UserPackage {
+	items := make([]*pb.UserPackage, 0, len(packages))
+	for _, p := range packages {
+		items = append(items, &pb.UserPackage{
+			Id:             p.ID,
+			PackageId:      p.PackageID,
+			Name:           p.Name,
+			ServiceName:    p.ServiceName,
+			TotalUnits:     p.TotalUnits,
+			UsedUnits:      p.UsedUnits,
+			RemainingUnits: p.Remaining(now),
+			ExpiresAt:      timestamppb.New(p.ExpiresAt),
+			CreatedAt:      timestamppb.New(p.CreatedAt),
+			OrderId:        p.OrderID,
+		})
+	}
+	return items
+}
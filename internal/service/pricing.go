@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreatePriceRule 创建账户价格规则
+func (s *AdminService) CreatePriceRule(ctx context.Context, req *pb.CreatePriceRuleRequest) (*pb.PriceRule, error) {
+	rule := &biz.PriceRule{
+		UserID:          req.UserId,
+		ServiceName:     req.ServiceName,
+		UnitPrice:       req.UnitPrice,
+		DiscountPercent: req.DiscountPercent,
+	}
+	if req.StartsAt != nil {
+		rule.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		rule.EndsAt = req.EndsAt.AsTime()
+	}
+	created, err := s.uc.CreatePriceRule(ctx, rule)
+	if err != nil {
+		s.log.Errorf("CreatePriceRule failed: user_id=%s, service=%s, error=%v", req.UserId, req.ServiceName, err)
+		return nil, err
+	}
+	return toPBPriceRule(created), nil
+}
+
+// ListPriceRules 查询账户价格规则及各服务当前适用单价
+func (s *AdminService) ListPriceRules(ctx context.Context, req *pb.ListPriceRulesRequest) (*pb.ListPriceRulesReply, error) {
+	rules, err := s.uc.ListPriceRules(ctx, req.UserId, req.IncludeExpired)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := s.uc.GetAccountRates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListPriceRulesReply{
+		Rules:     make([]*pb.PriceRule, 0, len(rules)),
+		Effective: toPBServiceRates(rates),
+	}
+	for _, rule := range rules {
+		reply.Rules = append(reply.Rules, toPBPriceRule(rule))
+	}
+	return reply, nil
+}
+
+// DeletePriceRule 删除账户价格规则
+func (s *AdminService) DeletePriceRule(ctx context.Context, req *pb.DeletePriceRuleRequest) (*pb.DeletePriceRuleReply, error) {
+	if err := s.uc.DeletePriceRule(ctx, req.UserId, req.RuleId); err != nil {
+		s.log.Errorf("DeletePriceRule failed: user_id=%s, rule_id=%s, error=%v", req.UserId, req.RuleId, err)
+		return nil, err
+	}
+	s.log.Infof("Price rule deleted: user_id=%s, rule_id=%s", req.UserId, req.RuleId)
+	return &pb.DeletePriceRuleReply{}, nil
+}
+
+// CreateContract 创建承诺消费合同
+func (s *AdminService) CreateContract(ctx context.Context, req *pb.CreateContractRequest) (*pb.Contract, error) {
+	contract := &biz.Contract{
+		UserID:       req.UserId,
+		CommitAmount: req.CommitAmount,
+		Rates:        make([]*biz.PriceRule, 0, len(req.Rates)),
+	}
+	if req.StartsAt != nil {
+		contract.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		contract.EndsAt = req.EndsAt.AsTime()
+	}
+	for _, rate := range req.Rates {
+		contract.Rates = append(contract.Rates, &biz.PriceRule{
+			ServiceName:     rate.ServiceName,
+			UnitPrice:       rate.UnitPrice,
+			DiscountPercent: rate.DiscountPercent,
+		})
+	}
+	created, err := s.uc.CreateContract(ctx, contract)
+	if err != nil {
+		s.log.Errorf("CreateContract failed: user_id=%s, commit=%.2f, error=%v", req.UserId, req.CommitAmount, err)
+		return nil, err
+	}
+	return toPBContract(created), nil
+}
+
+// ListContracts 查询账户合同
+func (s *AdminService) ListContracts(ctx context.Context, req *pb.ListContractsRequest) (*pb.ListContractsReply, error) {
+	contracts, err := s.uc.ListContracts(ctx, req.UserId, req.IncludeSettled)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListContractsReply{Contracts: toPBContracts(contracts)}, nil
+}
+
+func toPBPriceRule(rule *biz.PriceRule) *pb.PriceRule {
+	r := &pb.PriceRule{
+		Id:              rule.ID,
+		UserId:          rule.UserID,
+		ServiceName:     rule.ServiceName,
+		UnitPrice:       rule.UnitPrice,
+		DiscountPercent: rule.DiscountPercent,
+		ContractId:      rule.ContractID,
+		StartsAt:        timestamppb.New(rule.StartsAt),
+		CreatedAt:       timestamppb.New(rule.CreatedAt),
+	}
+	if !rule.EndsAt.IsZero() {
+		r.EndsAt = timestamppb.New(rule.EndsAt)
+	}
+	return r
+}
+
+func toPBServiceRates(rates []*biz.Rate) []*pb.ServiceRate {
+	result := make([]*pb.ServiceRate, 0, len(rates))
+	for _, rate := range rates {
+		result = append(result, &pb.ServiceRate{
+			ServiceName: rate.ServiceName,
+			ListPrice:   rate.ListPrice,
+			UnitPrice:   rate.UnitPrice,
+			Source:      rate.Source,
+			RuleId:      rate.RuleID,
+			ContractId:  rate.ContractID,
+		})
+	}
+	return result
+}
+
+func toPBContracts(contracts []*biz.Contract) []*pb.Contract {
+	result := make([]*pb.Contract, 0, len(contracts))
+	for _, c := range contracts {
+		result = append(result, toPBContract(c))
+	}
+	return result
+}
+
+func toPBContract(c *biz.Contract) *pb.Contract {
+	r := &pb.Contract{
+		Id:              c.ID,
+		UserId:          c.UserID,
+		CommitAmount:    c.CommitAmount,
+		DrawnAmount:     c.DrawnAmount,
+		RemainingAmount: c.Remaining(),
+		StartsAt:        timestamppb.New(c.StartsAt),
+		EndsAt:          timestamppb.New(c.EndsAt),
+		Status:          c.Status,
+		TrueUpAmount:    c.TrueUpAmount,
+		TrueUpCharged:   c.TrueUpCharged,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		Rates:           make([]*pb.ContractRate, 0, len(c.Rates)),
+	}
+	if !c.SettledAt.IsZero() {
+		r.SettledAt = timestamppb.New(c.SettledAt)
+	}
+	for _, rate := range c.Rates {
+		r.Rates = append(r.Rates, &pb.ContractRate{
+			ServiceName:     rate.ServiceName,
+			UnitPrice:       rate.UnitPrice,
+			DiscountPercent: rate.DiscountPercent,
+		})
+	}
+	return r
+}
+
+// accountPricing GetAccount 中的各服务适用单价与未结算合同
+func (s *BillingService) accountPricing(ctx context.Context, userID string) ([]*pb.ServiceRate, []*pb.Contract, error) {
+	rates, err := s.uc.GetAccountRates(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	contracts, err := s.uc.ListContracts(ctx, userID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toPBServiceRates(rates), toPBContracts(contracts), nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetUserRateLimit 设置用户限流
+func (s *AdminService) SetUserRateLimit(ctx context.Context, req *pb.SetUserRateLimitRequest) (*pb.UserRateLimitReply, error) {
+	limit := &biz.UserRateLimit{
+		UserID:    req.UserId,
+		Plan:      req.Plan,
+		Overrides: make(map[string]biz.RateLimitRule, len(req.Overrides)),
+	}
+	for _, rule := range req.Overrides {
+		limit.Overrides[rule.ServiceName] = biz.RateLimitRule{PerSecond: rule.PerSecond, PerDay: rule.PerDay}
+	}
+	if err := s.uc.SetUserRateLimit(ctx, limit); err != nil {
+		s.log.Errorf("SetUserRateLimit failed: user_id=%s, plan=%s, error=%v", req.UserId, req.Plan, err)
+		return nil, err
+	}
+	s.log.Infof("User rate limit updated: user_id=%s, plan=%s, overrides=%d", req.UserId, req.Plan, len(req.Overrides))
+	return s.GetUserRateLimit(ctx, &pb.GetUserRateLimitRequest{UserId: req.UserId})
+}
+
+// GetUserRateLimit 查询用户限流设置及各服务生效的规则
+func (s *AdminService) GetUserRateLimit(ctx context.Context, req *pb.GetUserRateLimitRequest) (*pb.UserRateLimitReply, error) {
+	limit, effective, err := s.uc.GetUserRateLimit(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.UserRateLimitReply{
+		UserId:    req.UserId,
+		Plan:      limit.Plan,
+		Overrides: make([]*pb.RateLimitRule, 0, len(limit.Overrides)),
+		Effective: make([]*pb.RateLimitRule, 0, len(effective)),
+	}
+	if !limit.UpdatedAt.IsZero() {
+		reply.UpdatedAt = timestamppb.New(limit.UpdatedAt)
+	}
+	services := make([]string, 0, len(limit.Overrides))
+	for serviceName := range limit.Overrides {
+		services = append(services, serviceName)
+	}
+	slices.Sort(services)
+	for _, serviceName := range services {
+		reply.Overrides = append(reply.Overrides, toPBRateLimitRule(serviceName, limit.Overrides[serviceName]))
+	}
+	for _, check := range effective {
+		reply.Effective = append(reply.Effective, toPBRateLimitRule(check.ServiceName, check.Rule))
+	}
+	return reply, nil
+}
+
+func toPBRateLimitRule(serviceName string, rule biz.RateLimitRule) *pb.RateLimitRule {
+	return &pb.RateLimitRule{
+		ServiceName: serviceName,
+		PerSecond:   rule.PerSecond,
+		PerDay:      rule.PerDay,
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	pb "billing-service/api/billing/v1"
+	"billing-service/internal/biz"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+// StreamDeduct 流式扣费
+// 接收协程读取请求，批处理协程按 MaxBatchSize / MaxBatchWait 攒批后调用批量扣费并逐条返回结果。
+// 每条流最多 MaxInFlight 个已接收未返回的请求，达到上限后暂停 Recv，由 gRPC 流控反压调用方。
+// 只有批处理协程调用 Send，gRPC 流不支持并发 Send
+func (s *BillingService) StreamDeduct(stream pb.BillingInternalService_StreamDeductServer) error {
+	ctx := stream.Context()
+	conf := s.conf.StreamDeduct
+
+	tokens := make(chan struct{}, conf.MaxInFlight) // 在途令牌，Send 之后归还
+	reqCh := make(chan *pb.StreamDeductRequest, conf.MaxInFlight)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		defer close(reqCh)
+		for {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				recvErr <- ctx.Err()
+				return
+			}
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			s.streamInFlight(1)
+			reqCh <- req
+		}
+	}()
+
+	batch := make([]*pb.StreamDeductRequest, 0, conf.MaxBatchSize)
+	for req := range reqCh {
+		batch = append(batch[:0], req)
+		timer := time.NewTimer(conf.MaxBatchWait)
+	collect:
+		for len(batch) < conf.MaxBatchSize {
+			select {
+			case req, ok := <-reqCh:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		if err := s.flushStreamDeduct(ctx, stream, batch, tokens); err != nil {
+			s.log.Errorf("StreamDeduct send failed: %v", err)
+			// 已接收未处理的请求不再返回；handler 返回后流上下文取消，接收协程退出并关闭 reqCh
+			go func() {
+				for range reqCh {
+					s.streamInFlight(-1)
+				}
+			}()
+			return err
+		}
+	}
+
+	if err := <-recvErr; !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// flushStreamDeduct 批量扣费并逐条返回结果
+func (s *BillingService) flushStreamDeduct(ctx context.Context, stream pb.BillingInternalService_StreamDeductServer, batch []*pb.StreamDeductRequest, tokens chan struct{}) error {
+	if s.metrics != nil {
+		s.metrics.StreamDeductBatchSize.Observe(float64(len(batch)))
+	}
+
+	reqs := make([]*biz.DeductRequest, len(batch))
+	for i, req := range batch {
+		reqs[i] = &biz.DeductRequest{
+			UserID:      req.UserId,
+			ServiceName: req.ServiceName,
+			Count:       int(req.Count),
+			Unit:        req.Unit,
+			CallerCost:  req.Cost,
+			Metadata:    toDeductMetadata(ctx, req.Metadata),
+		}
+	}
+	results := s.uc.DeductQuotaBatch(ctx, reqs)
+
+	for i, res := range results {
+		reply := &pb.StreamDeductReply{
+			CorrelationId: batch[i].CorrelationId,
+			Success:       res.Err == nil,
+			RecordId:      res.RecordID,
+		}
+		if res.Err != nil {
+			s.log.Errorf("StreamDeduct failed: user_id=%s, service=%s, count=%d, error=%v",
+				batch[i].UserId, batch[i].ServiceName, batch[i].Count, res.Err)
+			e := kratosErrors.FromError(res.Err)
+			reply.ErrorCode = e.Code
+			reply.ErrorMessage = e.Message
+		}
+		err := stream.Send(reply)
+		s.streamInFlight(-1)
+		<-tokens
+		if err != nil {
+			// 剩余请求同样不再返回，归还其在途计数
+			for range results[i+1:] {
+				s.streamInFlight(-1)
+				<-tokens
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *BillingService) streamInFlight(delta float64) {
+	if s.metrics != nil {
+		s.metrics.StreamDeductInFlight.Add(delta)
+	}
+}